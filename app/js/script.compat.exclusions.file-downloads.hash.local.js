@@ -1 +1 @@
-!function(){"use strict";var l=window.location,p=window.document,o=p.getElementById("plausible"),s=o.getAttribute("data-api")||(f=(f=o).src.split("/"),d=f[0],f=f[2],d+"//"+f+"/api/event");function c(e,t){e&&console.warn("Ignoring Event: "+e),t&&t.callback&&t.callback()}function e(e,t){try{if("true"===window.localStorage.plausible_ignore)return c("localStorage flag",t)}catch(e){}var a=o&&o.getAttribute("data-include"),i=o&&o.getAttribute("data-exclude");if("pageview"===e){a=!a||a.split(",").some(n),i=i&&i.split(",").some(n);if(!a||i)return c("exclusion rule",t)}function n(e){var t=l.pathname;return(t+=l.hash).match(new RegExp("^"+e.trim().replace(/\*\*/g,".*").replace(/([^\.])\*/g,"$1[^\\s/]*")+"/?$"))}var a={},r=(a.n=e,a.u=l.href,a.d=o.getAttribute("data-domain"),a.r=p.referrer||null,t&&t.meta&&(a.m=JSON.stringify(t.meta)),t&&t.props&&(a.p=t.props),a.h=1,new XMLHttpRequest);r.open("POST",s,!0),r.setRequestHeader("Content-Type","text/plain"),r.send(JSON.stringify(a)),r.onreadystatechange=function(){4===r.readyState&&t&&t.callback&&t.callback({status:r.status})}}var t=window.plausible&&window.plausible.q||[];window.plausible=e;for(var a,i=0;i<t.length;i++)e.apply(this,t[i]);function n(){a=l.pathname,e("pageview")}window.addEventListener("hashchange",n),"prerender"===p.visibilityState?p.addEventListener("visibilitychange",function(){a||"visible"!==p.visibilityState||n()}):n();var u=1;function r(e){var t,a,i,n,r,l,p;function o(){n||(n=!0,window.location=i.href)}"auxclick"===e.type&&e.button!==u||(t=function(e){for(;e&&(void 0===e.tagName||!(t=e)||!t.tagName||"a"!==t.tagName.toLowerCase()||!e.href);)e=e.parentNode;var t;return e}(e.target),a=t&&t.href&&t.href.split("?")[0],(l=a)&&(p=l.split(".").pop(),m.some(function(e){return e===p}))&&(n=!(l={name:"File Download",props:{url:a}}),!function(e,t){if(!e.defaultPrevented)return t=!t.target||t.target.match(/^_(self|parent|top)$/i),e=!(e.ctrlKey||e.metaKey||e.shiftKey)&&"click"===e.type,t&&e}(a=e,i=t)?(r={props:l.props},plausible(l.name,r)):(r={props:l.props,callback:o},plausible(l.name,r),setTimeout(o,5e3),a.preventDefault())))}p.addEventListener("click",r),p.addEventListener("auxclick",r);var d=["pdf","xlsx","docx","txt","rtf","csv","exe","key","pps","ppt","pptx","7z","pkg","rar","gz","zip","avi","mov","mp4","mpeg","wmv","midi","mp3","wav","wma","dmg"],f=o.getAttribute("file-types"),g=o.getAttribute("add-file-types"),m=f&&f.split(",")||g&&g.split(",").concat(d)||d}();
\ No newline at end of file
+!function(){"use strict";var l=window.location,p=window.document,o=p.getElementById("plausible"),s=o.getAttribute("data-api")||(f=(f=o).src.split("/"),d=f[0],f=f[2],d+"//"+f+"/api/event");function c(e,t){e&&console.warn("Ignoring Event: "+e),t&&t.callback&&t.callback()}function e(e,t){try{if("true"===window.localStorage.plausible_ignore)return c("localStorage flag",t)}catch(e){}var a=o&&o.getAttribute("data-include"),i=o&&o.getAttribute("data-exclude");if("pageview"===e){a=!a||a.split(",").some(n),i=i&&i.split(",").some(n);if(!a||i)return c("exclusion rule",t)}function n(e){var t=l.pathname;return(t+=l.hash).match(new RegExp("^"+e.trim().replace(/\*\*/g,".*").replace(/([^\.])\*/g,"$1[^\\s/]*")+"/?$"))}var a={},r=(a.n=e,a.u=l.href,a.d=o.getAttribute("data-domain"),a.r=p.referrer||null,function(){var c=o.getAttribute("data-exclude-cookie");c&&p.cookie.split(";").some(function(t){return t.trim().split("=")[0]===c})&&(a.c=c)}(),t&&t.meta&&(a.m=JSON.stringify(t.meta)),t&&t.props&&(a.p=t.props),a.h=1,new XMLHttpRequest);r.open("POST",s,!0),r.setRequestHeader("Content-Type","text/plain"),r.send(JSON.stringify(a)),r.onreadystatechange=function(){4===r.readyState&&t&&t.callback&&t.callback({status:r.status})}}var t=window.plausible&&window.plausible.q||[];window.plausible=e;for(var a,i=0;i<t.length;i++)e.apply(this,t[i]);function n(){a=l.pathname,e("pageview")}window.addEventListener("hashchange",n),"prerender"===p.visibilityState?p.addEventListener("visibilitychange",function(){a||"visible"!==p.visibilityState||n()}):n();var u=1;function r(e){var t,a,i,n,r,l,p;function o(){n||(n=!0,window.location=i.href)}"auxclick"===e.type&&e.button!==u||(t=function(e){for(;e&&(void 0===e.tagName||!(t=e)||!t.tagName||"a"!==t.tagName.toLowerCase()||!e.href);)e=e.parentNode;var t;return e}(e.target),a=t&&t.href&&t.href.split("?")[0],(l=a)&&(p=l.split(".").pop(),m.some(function(e){return e===p}))&&(n=!(l={name:"File Download",props:{url:a}}),!function(e,t){if(!e.defaultPrevented)return t=!t.target||t.target.match(/^_(self|parent|top)$/i),e=!(e.ctrlKey||e.metaKey||e.shiftKey)&&"click"===e.type,t&&e}(a=e,i=t)?(r={props:l.props},plausible(l.name,r)):(r={props:l.props,callback:o},plausible(l.name,r),setTimeout(o,5e3),a.preventDefault())))}p.addEventListener("click",r),p.addEventListener("auxclick",r);var d=["pdf","xlsx","docx","txt","rtf","csv","exe","key","pps","ppt","pptx","7z","pkg","rar","gz","zip","avi","mov","mp4","mpeg","wmv","midi","mp3","wav","wma","dmg"],f=o.getAttribute("file-types"),g=o.getAttribute("add-file-types"),m=f&&f.split(",")||g&&g.split(",").concat(d)||d}();
\ No newline at end of file
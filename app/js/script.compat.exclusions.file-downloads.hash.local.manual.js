@@ -1 +1 @@
-!function(){"use strict";var l=window.location,p=window.document,o=p.getElementById("plausible"),s=o.getAttribute("data-api")||(n=(n=o).src.split("/"),i=n[0],n=n[2],i+"//"+n+"/api/event");function c(e,t){e&&console.warn("Ignoring Event: "+e),t&&t.callback&&t.callback()}function e(e,t){try{if("true"===window.localStorage.plausible_ignore)return c("localStorage flag",t)}catch(e){}var a=o&&o.getAttribute("data-include"),r=o&&o.getAttribute("data-exclude");if("pageview"===e){a=!a||a.split(",").some(i),r=r&&r.split(",").some(i);if(!a||r)return c("exclusion rule",t)}function i(e){var t=l.pathname;return(t+=l.hash).match(new RegExp("^"+e.trim().replace(/\*\*/g,".*").replace(/([^\.])\*/g,"$1[^\\s/]*")+"/?$"))}var a={},n=(a.n=e,a.u=t&&t.u?t.u:l.href,a.d=o.getAttribute("data-domain"),a.r=p.referrer||null,t&&t.meta&&(a.m=JSON.stringify(t.meta)),t&&t.props&&(a.p=t.props),a.h=1,new XMLHttpRequest);n.open("POST",s,!0),n.setRequestHeader("Content-Type","text/plain"),n.send(JSON.stringify(a)),n.onreadystatechange=function(){4===n.readyState&&t&&t.callback&&t.callback({status:n.status})}}var t=window.plausible&&window.plausible.q||[];window.plausible=e;for(var a=0;a<t.length;a++)e.apply(this,t[a]);var u=1;function r(e){var t,a,r,i,n,l,p;function o(){i||(i=!0,window.location=r.href)}"auxclick"===e.type&&e.button!==u||(t=function(e){for(;e&&(void 0===e.tagName||!(t=e)||!t.tagName||"a"!==t.tagName.toLowerCase()||!e.href);)e=e.parentNode;var t;return e}(e.target),a=t&&t.href&&t.href.split("?")[0],(l=a)&&(p=l.split(".").pop(),f.some(function(e){return e===p}))&&(i=!(l={name:"File Download",props:{url:a}}),!function(e,t){if(!e.defaultPrevented)return t=!t.target||t.target.match(/^_(self|parent|top)$/i),e=!(e.ctrlKey||e.metaKey||e.shiftKey)&&"click"===e.type,t&&e}(a=e,r=t)?(n={props:l.props},plausible(l.name,n)):(n={props:l.props,callback:o},plausible(l.name,n),setTimeout(o,5e3),a.preventDefault())))}p.addEventListener("click",r),p.addEventListener("auxclick",r);var i=["pdf","xlsx","docx","txt","rtf","csv","exe","key","pps","ppt","pptx","7z","pkg","rar","gz","zip","avi","mov","mp4","mpeg","wmv","midi","mp3","wav","wma","dmg"],n=o.getAttribute("file-types"),d=o.getAttribute("add-file-types"),f=n&&n.split(",")||d&&d.split(",").concat(i)||i}();
\ No newline at end of file
+!function(){"use strict";var l=window.location,p=window.document,o=p.getElementById("plausible"),s=o.getAttribute("data-api")||(n=(n=o).src.split("/"),i=n[0],n=n[2],i+"//"+n+"/api/event");function c(e,t){e&&console.warn("Ignoring Event: "+e),t&&t.callback&&t.callback()}function e(e,t){try{if("true"===window.localStorage.plausible_ignore)return c("localStorage flag",t)}catch(e){}var a=o&&o.getAttribute("data-include"),r=o&&o.getAttribute("data-exclude");if("pageview"===e){a=!a||a.split(",").some(i),r=r&&r.split(",").some(i);if(!a||r)return c("exclusion rule",t)}function i(e){var t=l.pathname;return(t+=l.hash).match(new RegExp("^"+e.trim().replace(/\*\*/g,".*").replace(/([^\.])\*/g,"$1[^\\s/]*")+"/?$"))}var a={},n=(a.n=e,a.u=t&&t.u?t.u:l.href,a.d=o.getAttribute("data-domain"),a.r=p.referrer||null,function(){var c=o.getAttribute("data-exclude-cookie");c&&p.cookie.split(";").some(function(t){return t.trim().split("=")[0]===c})&&(a.c=c)}(),t&&t.meta&&(a.m=JSON.stringify(t.meta)),t&&t.props&&(a.p=t.props),a.h=1,new XMLHttpRequest);n.open("POST",s,!0),n.setRequestHeader("Content-Type","text/plain"),n.send(JSON.stringify(a)),n.onreadystatechange=function(){4===n.readyState&&t&&t.callback&&t.callback({status:n.status})}}var t=window.plausible&&window.plausible.q||[];window.plausible=e;for(var a=0;a<t.length;a++)e.apply(this,t[a]);var u=1;function r(e){var t,a,r,i,n,l,p;function o(){i||(i=!0,window.location=r.href)}"auxclick"===e.type&&e.button!==u||(t=function(e){for(;e&&(void 0===e.tagName||!(t=e)||!t.tagName||"a"!==t.tagName.toLowerCase()||!e.href);)e=e.parentNode;var t;return e}(e.target),a=t&&t.href&&t.href.split("?")[0],(l=a)&&(p=l.split(".").pop(),f.some(function(e){return e===p}))&&(i=!(l={name:"File Download",props:{url:a}}),!function(e,t){if(!e.defaultPrevented)return t=!t.target||t.target.match(/^_(self|parent|top)$/i),e=!(e.ctrlKey||e.metaKey||e.shiftKey)&&"click"===e.type,t&&e}(a=e,r=t)?(n={props:l.props},plausible(l.name,n)):(n={props:l.props,callback:o},plausible(l.name,n),setTimeout(o,5e3),a.preventDefault())))}p.addEventListener("click",r),p.addEventListener("auxclick",r);var i=["pdf","xlsx","docx","txt","rtf","csv","exe","key","pps","ppt","pptx","7z","pkg","rar","gz","zip","avi","mov","mp4","mpeg","wmv","midi","mp3","wav","wma","dmg"],n=o.getAttribute("file-types"),d=o.getAttribute("add-file-types"),f=n&&n.split(",")||d&&d.split(",").concat(i)||i}();
\ No newline at end of file
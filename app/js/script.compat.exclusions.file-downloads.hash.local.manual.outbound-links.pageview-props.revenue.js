@@ -1 +1 @@
-!function(){"use strict";var l=window.location,p=window.document,u=p.getElementById("plausible"),s=u.getAttribute("data-api")||(f=(f=u).src.split("/"),n=f[0],f=f[2],n+"//"+f+"/api/event");function c(e,t){e&&console.warn("Ignoring Event: "+e),t&&t.callback&&t.callback()}function e(e,t){try{if("true"===window.localStorage.plausible_ignore)return c("localStorage flag",t)}catch(e){}var a=u&&u.getAttribute("data-include"),r=u&&u.getAttribute("data-exclude");if("pageview"===e){a=!a||a.split(",").some(n),r=r&&r.split(",").some(n);if(!a||r)return c("exclusion rule",t)}function n(e){var t=l.pathname;return(t+=l.hash).match(new RegExp("^"+e.trim().replace(/\*\*/g,".*").replace(/([^\.])\*/g,"$1[^\\s/]*")+"/?$"))}var a={},r=(a.n=e,a.u=t&&t.u?t.u:l.href,a.d=u.getAttribute("data-domain"),a.r=p.referrer||null,t&&t.meta&&(a.m=JSON.stringify(t.meta)),t&&t.props&&(a.p=t.props),t&&t.revenue&&(a.$=t.revenue),u.getAttributeNames().filter(function(e){return"event-"===e.substring(0,6)})),i=a.p||{},o=(r.forEach(function(e){var t=e.replace("event-",""),e=u.getAttribute(e);i[t]=i[t]||e}),a.p=i,a.h=1,new XMLHttpRequest);o.open("POST",s,!0),o.setRequestHeader("Content-Type","text/plain"),o.send(JSON.stringify(a)),o.onreadystatechange=function(){4===o.readyState&&t&&t.callback&&t.callback({status:o.status})}}var t=window.plausible&&window.plausible.q||[];window.plausible=e;for(var a=0;a<t.length;a++)e.apply(this,t[a]);var i=1;function r(e){var t,a,r,n;if("auxclick"!==e.type||e.button===i)return t=function(e){for(;e&&(void 0===e.tagName||!(t=e)||!t.tagName||"a"!==t.tagName.toLowerCase()||!e.href);)e=e.parentNode;var t;return e}(e.target),a=t&&t.href&&t.href.split("?")[0],(r=t)&&r.href&&r.host&&r.host!==l.host?o(e,t,{name:"Outbound Link: Click",props:{url:t.href}}):(r=a)&&(n=r.split(".").pop(),g.some(function(e){return e===n}))?o(e,t,{name:"File Download",props:{url:a}}):void 0}function o(e,t,a){var r,n=!1;function i(){n||(n=!0,window.location=t.href)}!function(e,t){if(!e.defaultPrevented)return t=!t.target||t.target.match(/^_(self|parent|top)$/i),e=!(e.ctrlKey||e.metaKey||e.shiftKey)&&"click"===e.type,t&&e}(e,t)?((r={props:a.props}).revenue=a.revenue,plausible(a.name,r)):((r={props:a.props,callback:i}).revenue=a.revenue,plausible(a.name,r),setTimeout(i,5e3),e.preventDefault())}p.addEventListener("click",r),p.addEventListener("auxclick",r);var n=["pdf","xlsx","docx","txt","rtf","csv","exe","key","pps","ppt","pptx","7z","pkg","rar","gz","zip","avi","mov","mp4","mpeg","wmv","midi","mp3","wav","wma","dmg"],f=u.getAttribute("file-types"),d=u.getAttribute("add-file-types"),g=f&&f.split(",")||d&&d.split(",").concat(n)||n}();
\ No newline at end of file
+!function(){"use strict";var l=window.location,p=window.document,u=p.getElementById("plausible"),s=u.getAttribute("data-api")||(f=(f=u).src.split("/"),n=f[0],f=f[2],n+"//"+f+"/api/event");function c(e,t){e&&console.warn("Ignoring Event: "+e),t&&t.callback&&t.callback()}function e(e,t){try{if("true"===window.localStorage.plausible_ignore)return c("localStorage flag",t)}catch(e){}var a=u&&u.getAttribute("data-include"),r=u&&u.getAttribute("data-exclude");if("pageview"===e){a=!a||a.split(",").some(n),r=r&&r.split(",").some(n);if(!a||r)return c("exclusion rule",t)}function n(e){var t=l.pathname;return(t+=l.hash).match(new RegExp("^"+e.trim().replace(/\*\*/g,".*").replace(/([^\.])\*/g,"$1[^\\s/]*")+"/?$"))}var a={},r=(a.n=e,a.u=t&&t.u?t.u:l.href,a.d=u.getAttribute("data-domain"),a.r=p.referrer||null,function(){var c=u.getAttribute("data-exclude-cookie");c&&p.cookie.split(";").some(function(t){return t.trim().split("=")[0]===c})&&(a.c=c)}(),t&&t.meta&&(a.m=JSON.stringify(t.meta)),t&&t.props&&(a.p=t.props),t&&t.revenue&&(a.$=t.revenue),u.getAttributeNames().filter(function(e){return"event-"===e.substring(0,6)})),i=a.p||{},o=(r.forEach(function(e){var t=e.replace("event-",""),e=u.getAttribute(e);i[t]=i[t]||e}),a.p=i,a.h=1,new XMLHttpRequest);o.open("POST",s,!0),o.setRequestHeader("Content-Type","text/plain"),o.send(JSON.stringify(a)),o.onreadystatechange=function(){4===o.readyState&&t&&t.callback&&t.callback({status:o.status})}}var t=window.plausible&&window.plausible.q||[];window.plausible=e;for(var a=0;a<t.length;a++)e.apply(this,t[a]);var i=1;function r(e){var t,a,r,n;if("auxclick"!==e.type||e.button===i)return t=function(e){for(;e&&(void 0===e.tagName||!(t=e)||!t.tagName||"a"!==t.tagName.toLowerCase()||!e.href);)e=e.parentNode;var t;return e}(e.target),a=t&&t.href&&t.href.split("?")[0],(r=t)&&r.href&&r.host&&r.host!==l.host?o(e,t,{name:"Outbound Link: Click",props:{url:t.href}}):(r=a)&&(n=r.split(".").pop(),g.some(function(e){return e===n}))?o(e,t,{name:"File Download",props:{url:a}}):void 0}function o(e,t,a){var r,n=!1;function i(){n||(n=!0,window.location=t.href)}!function(e,t){if(!e.defaultPrevented)return t=!t.target||t.target.match(/^_(self|parent|top)$/i),e=!(e.ctrlKey||e.metaKey||e.shiftKey)&&"click"===e.type,t&&e}(e,t)?((r={props:a.props}).revenue=a.revenue,plausible(a.name,r)):((r={props:a.props,callback:i}).revenue=a.revenue,plausible(a.name,r),setTimeout(i,5e3),e.preventDefault())}p.addEventListener("click",r),p.addEventListener("auxclick",r);var n=["pdf","xlsx","docx","txt","rtf","csv","exe","key","pps","ppt","pptx","7z","pkg","rar","gz","zip","avi","mov","mp4","mpeg","wmv","midi","mp3","wav","wma","dmg"],f=u.getAttribute("file-types"),d=u.getAttribute("add-file-types"),g=f&&f.split(",")||d&&d.split(",").concat(n)||n}();
\ No newline at end of file
@@ -1 +1 @@
-!function(){"use strict";var p=window.location,l=window.document,u=l.getElementById("plausible"),s=u.getAttribute("data-api")||(i=(i=u).src.split("/"),n=i[0],i=i[2],n+"//"+i+"/api/event");function c(e,t){e&&console.warn("Ignoring Event: "+e),t&&t.callback&&t.callback()}function e(e,t){try{if("true"===window.localStorage.plausible_ignore)return c("localStorage flag",t)}catch(e){}var a=u&&u.getAttribute("data-include"),r=u&&u.getAttribute("data-exclude");if("pageview"===e){a=!a||a.split(",").some(n),r=r&&r.split(",").some(n);if(!a||r)return c("exclusion rule",t)}function n(e){var t=p.pathname;return(t+=p.hash).match(new RegExp("^"+e.trim().replace(/\*\*/g,".*").replace(/([^\.])\*/g,"$1[^\\s/]*")+"/?$"))}var a={},r=(a.n=e,a.u=t&&t.u?t.u:p.href,a.d=u.getAttribute("data-domain"),a.r=l.referrer||null,t&&t.meta&&(a.m=JSON.stringify(t.meta)),t&&t.props&&(a.p=t.props),u.getAttributeNames().filter(function(e){return"event-"===e.substring(0,6)})),i=a.p||{},o=(r.forEach(function(e){var t=e.replace("event-",""),e=u.getAttribute(e);i[t]=i[t]||e}),a.p=i,a.h=1,new XMLHttpRequest);o.open("POST",s,!0),o.setRequestHeader("Content-Type","text/plain"),o.send(JSON.stringify(a)),o.onreadystatechange=function(){4===o.readyState&&t&&t.callback&&t.callback({status:o.status})}}var t=window.plausible&&window.plausible.q||[];window.plausible=e;for(var a=0;a<t.length;a++)e.apply(this,t[a]);function f(e){return e&&e.tagName&&"a"===e.tagName.toLowerCase()}var m=1;function r(e){if("auxclick"!==e.type||e.button===m){var t,a,r=function(e){for(;e&&(void 0===e.tagName||!f(e)||!e.href);)e=e.parentNode;return e}(e.target),n=r&&r.href&&r.href.split("?")[0];if(!function e(t,a){if(!t||b<a)return!1;if(w(t))return!0;return e(t.parentNode,a+1)}(r,0))return(t=r)&&t.href&&t.host&&t.host!==p.host?d(e,r,{name:"Outbound Link: Click",props:{url:r.href}}):(t=n)&&(a=t.split(".").pop(),g.some(function(e){return e===a}))?d(e,r,{name:"File Download",props:{url:n}}):void 0}}function d(e,t,a){var r,n=!1;function i(){n||(n=!0,window.location=t.href)}!function(e,t){if(!e.defaultPrevented)return t=!t.target||t.target.match(/^_(self|parent|top)$/i),e=!(e.ctrlKey||e.metaKey||e.shiftKey)&&"click"===e.type,t&&e}(e,t)?(r={props:a.props},plausible(a.name,r)):(r={props:a.props,callback:i},plausible(a.name,r),setTimeout(i,5e3),e.preventDefault())}l.addEventListener("click",r),l.addEventListener("auxclick",r);var n=["pdf","xlsx","docx","txt","rtf","csv","exe","key","pps","ppt","pptx","7z","pkg","rar","gz","zip","avi","mov","mp4","mpeg","wmv","midi","mp3","wav","wma","dmg"],i=u.getAttribute("file-types"),o=u.getAttribute("add-file-types"),g=i&&i.split(",")||o&&o.split(",").concat(n)||n;function v(e){var e=w(e)?e:e&&e.parentNode,t={name:null,props:{}},a=e&&e.classList;if(a)for(var r=0;r<a.length;r++){var n,i=a.item(r).match(/plausible-event-(.+)(=|--)(.+)/);i&&(n=i[1],i=i[3].replace(/\+/g," "),"name"==n.toLowerCase()?t.name=i:t.props[n]=i)}return t}var b=3;function h(e){if("auxclick"!==e.type||e.button===m){for(var t,a,r,n,i=e.target,o=0;o<=b&&i;o++){if((r=i)&&r.tagName&&"form"===r.tagName.toLowerCase())return;f(i)&&(t=i),w(i)&&(a=i),i=i.parentNode}a&&(n=v(a),t?(n.props.url=t.href,d(e,t,n)):((e={}).props=n.props,plausible(n.name,e)))}}function w(e){var t=e&&e.classList;if(t)for(var a=0;a<t.length;a++)if(t.item(a).match(/plausible-event-name(=|--)(.+)/))return!0;return!1}l.addEventListener("submit",function(e){var t,a=e.target,r=v(a);function n(){t||(t=!0,a.submit())}r.name&&(e.preventDefault(),t=!1,setTimeout(n,5e3),e={props:r.props,callback:n},plausible(r.name,e))}),l.addEventListener("click",h),l.addEventListener("auxclick",h)}();
\ No newline at end of file
+!function(){"use strict";var p=window.location,l=window.document,u=l.getElementById("plausible"),s=u.getAttribute("data-api")||(i=(i=u).src.split("/"),n=i[0],i=i[2],n+"//"+i+"/api/event");function c(e,t){e&&console.warn("Ignoring Event: "+e),t&&t.callback&&t.callback()}function e(e,t){try{if("true"===window.localStorage.plausible_ignore)return c("localStorage flag",t)}catch(e){}var a=u&&u.getAttribute("data-include"),r=u&&u.getAttribute("data-exclude");if("pageview"===e){a=!a||a.split(",").some(n),r=r&&r.split(",").some(n);if(!a||r)return c("exclusion rule",t)}function n(e){var t=p.pathname;return(t+=p.hash).match(new RegExp("^"+e.trim().replace(/\*\*/g,".*").replace(/([^\.])\*/g,"$1[^\\s/]*")+"/?$"))}var a={},r=(a.n=e,a.u=t&&t.u?t.u:p.href,a.d=u.getAttribute("data-domain"),a.r=l.referrer||null,function(){var c=u.getAttribute("data-exclude-cookie");c&&l.cookie.split(";").some(function(t){return t.trim().split("=")[0]===c})&&(a.c=c)}(),t&&t.meta&&(a.m=JSON.stringify(t.meta)),t&&t.props&&(a.p=t.props),u.getAttributeNames().filter(function(e){return"event-"===e.substring(0,6)})),i=a.p||{},o=(r.forEach(function(e){var t=e.replace("event-",""),e=u.getAttribute(e);i[t]=i[t]||e}),a.p=i,a.h=1,new XMLHttpRequest);o.open("POST",s,!0),o.setRequestHeader("Content-Type","text/plain"),o.send(JSON.stringify(a)),o.onreadystatechange=function(){4===o.readyState&&t&&t.callback&&t.callback({status:o.status})}}var t=window.plausible&&window.plausible.q||[];window.plausible=e;for(var a=0;a<t.length;a++)e.apply(this,t[a]);function f(e){return e&&e.tagName&&"a"===e.tagName.toLowerCase()}var m=1;function r(e){if("auxclick"!==e.type||e.button===m){var t,a,r=function(e){for(;e&&(void 0===e.tagName||!f(e)||!e.href);)e=e.parentNode;return e}(e.target),n=r&&r.href&&r.href.split("?")[0];if(!function e(t,a){if(!t||b<a)return!1;if(w(t))return!0;return e(t.parentNode,a+1)}(r,0))return(t=r)&&t.href&&t.host&&t.host!==p.host?d(e,r,{name:"Outbound Link: Click",props:{url:r.href}}):(t=n)&&(a=t.split(".").pop(),g.some(function(e){return e===a}))?d(e,r,{name:"File Download",props:{url:n}}):void 0}}function d(e,t,a){var r,n=!1;function i(){n||(n=!0,window.location=t.href)}!function(e,t){if(!e.defaultPrevented)return t=!t.target||t.target.match(/^_(self|parent|top)$/i),e=!(e.ctrlKey||e.metaKey||e.shiftKey)&&"click"===e.type,t&&e}(e,t)?(r={props:a.props},plausible(a.name,r)):(r={props:a.props,callback:i},plausible(a.name,r),setTimeout(i,5e3),e.preventDefault())}l.addEventListener("click",r),l.addEventListener("auxclick",r);var n=["pdf","xlsx","docx","txt","rtf","csv","exe","key","pps","ppt","pptx","7z","pkg","rar","gz","zip","avi","mov","mp4","mpeg","wmv","midi","mp3","wav","wma","dmg"],i=u.getAttribute("file-types"),o=u.getAttribute("add-file-types"),g=i&&i.split(",")||o&&o.split(",").concat(n)||n;function v(e){var e=w(e)?e:e&&e.parentNode,t={name:null,props:{}},a=e&&e.classList;if(a)for(var r=0;r<a.length;r++){var n,i=a.item(r).match(/plausible-event-(.+)(=|--)(.+)/);i&&(n=i[1],i=i[3].replace(/\+/g," "),"name"==n.toLowerCase()?t.name=i:t.props[n]=i)}return t}var b=3;function h(e){if("auxclick"!==e.type||e.button===m){for(var t,a,r,n,i=e.target,o=0;o<=b&&i;o++){if((r=i)&&r.tagName&&"form"===r.tagName.toLowerCase())return;f(i)&&(t=i),w(i)&&(a=i),i=i.parentNode}a&&(n=v(a),t?(n.props.url=t.href,d(e,t,n)):((e={}).props=n.props,plausible(n.name,e)))}}function w(e){var t=e&&e.classList;if(t)for(var a=0;a<t.length;a++)if(t.item(a).match(/plausible-event-name(=|--)(.+)/))return!0;return!1}l.addEventListener("submit",function(e){var t,a=e.target,r=v(a);function n(){t||(t=!0,a.submit())}r.name&&(e.preventDefault(),t=!1,setTimeout(n,5e3),e={props:r.props,callback:n},plausible(r.name,e))}),l.addEventListener("click",h),l.addEventListener("auxclick",h)}();
\ No newline at end of file
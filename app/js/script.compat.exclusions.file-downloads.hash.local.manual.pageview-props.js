@@ -1 +1 @@
-!function(){"use strict";var p=window.location,o=window.document,s=o.getElementById("plausible"),u=s.getAttribute("data-api")||(i=(i=s).src.split("/"),n=i[0],i=i[2],n+"//"+i+"/api/event");function c(t,e){t&&console.warn("Ignoring Event: "+t),e&&e.callback&&e.callback()}function t(t,e){try{if("true"===window.localStorage.plausible_ignore)return c("localStorage flag",e)}catch(t){}var a=s&&s.getAttribute("data-include"),r=s&&s.getAttribute("data-exclude");if("pageview"===t){a=!a||a.split(",").some(n),r=r&&r.split(",").some(n);if(!a||r)return c("exclusion rule",e)}function n(t){var e=p.pathname;return(e+=p.hash).match(new RegExp("^"+t.trim().replace(/\*\*/g,".*").replace(/([^\.])\*/g,"$1[^\\s/]*")+"/?$"))}var a={},r=(a.n=t,a.u=e&&e.u?e.u:p.href,a.d=s.getAttribute("data-domain"),a.r=o.referrer||null,e&&e.meta&&(a.m=JSON.stringify(e.meta)),e&&e.props&&(a.p=e.props),s.getAttributeNames().filter(function(t){return"event-"===t.substring(0,6)})),i=a.p||{},l=(r.forEach(function(t){var e=t.replace("event-",""),t=s.getAttribute(t);i[e]=i[e]||t}),a.p=i,a.h=1,new XMLHttpRequest);l.open("POST",u,!0),l.setRequestHeader("Content-Type","text/plain"),l.send(JSON.stringify(a)),l.onreadystatechange=function(){4===l.readyState&&e&&e.callback&&e.callback({status:l.status})}}var e=window.plausible&&window.plausible.q||[];window.plausible=t;for(var a=0;a<e.length;a++)t.apply(this,e[a]);var f=1;function r(t){var e,a,r,n,i,l,p;function o(){n||(n=!0,window.location=r.href)}"auxclick"===t.type&&t.button!==f||(e=function(t){for(;t&&(void 0===t.tagName||!(e=t)||!e.tagName||"a"!==e.tagName.toLowerCase()||!t.href);)t=t.parentNode;var e;return t}(t.target),a=e&&e.href&&e.href.split("?")[0],(l=a)&&(p=l.split(".").pop(),d.some(function(t){return t===p}))&&(n=!(l={name:"File Download",props:{url:a}}),!function(t,e){if(!t.defaultPrevented)return e=!e.target||e.target.match(/^_(self|parent|top)$/i),t=!(t.ctrlKey||t.metaKey||t.shiftKey)&&"click"===t.type,e&&t}(a=t,r=e)?(i={props:l.props},plausible(l.name,i)):(i={props:l.props,callback:o},plausible(l.name,i),setTimeout(o,5e3),a.preventDefault())))}o.addEventListener("click",r),o.addEventListener("auxclick",r);var n=["pdf","xlsx","docx","txt","rtf","csv","exe","key","pps","ppt","pptx","7z","pkg","rar","gz","zip","avi","mov","mp4","mpeg","wmv","midi","mp3","wav","wma","dmg"],i=s.getAttribute("file-types"),l=s.getAttribute("add-file-types"),d=i&&i.split(",")||l&&l.split(",").concat(n)||n}();
\ No newline at end of file
+!function(){"use strict";var p=window.location,o=window.document,s=o.getElementById("plausible"),u=s.getAttribute("data-api")||(i=(i=s).src.split("/"),n=i[0],i=i[2],n+"//"+i+"/api/event");function c(t,e){t&&console.warn("Ignoring Event: "+t),e&&e.callback&&e.callback()}function t(t,e){try{if("true"===window.localStorage.plausible_ignore)return c("localStorage flag",e)}catch(t){}var a=s&&s.getAttribute("data-include"),r=s&&s.getAttribute("data-exclude");if("pageview"===t){a=!a||a.split(",").some(n),r=r&&r.split(",").some(n);if(!a||r)return c("exclusion rule",e)}function n(t){var e=p.pathname;return(e+=p.hash).match(new RegExp("^"+t.trim().replace(/\*\*/g,".*").replace(/([^\.])\*/g,"$1[^\\s/]*")+"/?$"))}var a={},r=(a.n=t,a.u=e&&e.u?e.u:p.href,a.d=s.getAttribute("data-domain"),a.r=o.referrer||null,function(){var c=s.getAttribute("data-exclude-cookie");c&&o.cookie.split(";").some(function(t){return t.trim().split("=")[0]===c})&&(a.c=c)}(),e&&e.meta&&(a.m=JSON.stringify(e.meta)),e&&e.props&&(a.p=e.props),s.getAttributeNames().filter(function(t){return"event-"===t.substring(0,6)})),i=a.p||{},l=(r.forEach(function(t){var e=t.replace("event-",""),t=s.getAttribute(t);i[e]=i[e]||t}),a.p=i,a.h=1,new XMLHttpRequest);l.open("POST",u,!0),l.setRequestHeader("Content-Type","text/plain"),l.send(JSON.stringify(a)),l.onreadystatechange=function(){4===l.readyState&&e&&e.callback&&e.callback({status:l.status})}}var e=window.plausible&&window.plausible.q||[];window.plausible=t;for(var a=0;a<e.length;a++)t.apply(this,e[a]);var f=1;function r(t){var e,a,r,n,i,l,p;function o(){n||(n=!0,window.location=r.href)}"auxclick"===t.type&&t.button!==f||(e=function(t){for(;t&&(void 0===t.tagName||!(e=t)||!e.tagName||"a"!==e.tagName.toLowerCase()||!t.href);)t=t.parentNode;var e;return t}(t.target),a=e&&e.href&&e.href.split("?")[0],(l=a)&&(p=l.split(".").pop(),d.some(function(t){return t===p}))&&(n=!(l={name:"File Download",props:{url:a}}),!function(t,e){if(!t.defaultPrevented)return e=!e.target||e.target.match(/^_(self|parent|top)$/i),t=!(t.ctrlKey||t.metaKey||t.shiftKey)&&"click"===t.type,e&&t}(a=t,r=e)?(i={props:l.props},plausible(l.name,i)):(i={props:l.props,callback:o},plausible(l.name,i),setTimeout(o,5e3),a.preventDefault())))}o.addEventListener("click",r),o.addEventListener("auxclick",r);var n=["pdf","xlsx","docx","txt","rtf","csv","exe","key","pps","ppt","pptx","7z","pkg","rar","gz","zip","avi","mov","mp4","mpeg","wmv","midi","mp3","wav","wma","dmg"],i=s.getAttribute("file-types"),l=s.getAttribute("add-file-types"),d=i&&i.split(",")||l&&l.split(",").concat(n)||n}();
\ No newline at end of file
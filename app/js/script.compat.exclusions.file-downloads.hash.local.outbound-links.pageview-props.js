@@ -1 +1 @@
-!function(){"use strict";var o=window.location,p=window.document,s=p.getElementById("plausible"),u=s.getAttribute("data-api")||(g=(g=s).src.split("/"),f=g[0],g=g[2],f+"//"+g+"/api/event");function c(t,e){t&&console.warn("Ignoring Event: "+t),e&&e.callback&&e.callback()}function t(t,e){try{if("true"===window.localStorage.plausible_ignore)return c("localStorage flag",e)}catch(t){}var a=s&&s.getAttribute("data-include"),i=s&&s.getAttribute("data-exclude");if("pageview"===t){a=!a||a.split(",").some(n),i=i&&i.split(",").some(n);if(!a||i)return c("exclusion rule",e)}function n(t){var e=o.pathname;return(e+=o.hash).match(new RegExp("^"+t.trim().replace(/\*\*/g,".*").replace(/([^\.])\*/g,"$1[^\\s/]*")+"/?$"))}var a={},i=(a.n=t,a.u=o.href,a.d=s.getAttribute("data-domain"),a.r=p.referrer||null,e&&e.meta&&(a.m=JSON.stringify(e.meta)),e&&e.props&&(a.p=e.props),s.getAttributeNames().filter(function(t){return"event-"===t.substring(0,6)})),r=a.p||{},l=(i.forEach(function(t){var e=t.replace("event-",""),t=s.getAttribute(t);r[e]=r[e]||t}),a.p=r,a.h=1,new XMLHttpRequest);l.open("POST",u,!0),l.setRequestHeader("Content-Type","text/plain"),l.send(JSON.stringify(a)),l.onreadystatechange=function(){4===l.readyState&&e&&e.callback&&e.callback({status:l.status})}}var e=window.plausible&&window.plausible.q||[];window.plausible=t;for(var a,i=0;i<e.length;i++)t.apply(this,e[i]);function n(){a=o.pathname,t("pageview")}window.addEventListener("hashchange",n),"prerender"===p.visibilityState?p.addEventListener("visibilitychange",function(){a||"visible"!==p.visibilityState||n()}):n();var r=1;function l(t){var e,a,i,n;if("auxclick"!==t.type||t.button===r)return e=function(t){for(;t&&(void 0===t.tagName||!(e=t)||!e.tagName||"a"!==e.tagName.toLowerCase()||!t.href);)t=t.parentNode;var e;return t}(t.target),a=e&&e.href&&e.href.split("?")[0],(i=e)&&i.href&&i.host&&i.host!==o.host?d(t,e,{name:"Outbound Link: Click",props:{url:e.href}}):(i=a)&&(n=i.split(".").pop(),m.some(function(t){return t===n}))?d(t,e,{name:"File Download",props:{url:a}}):void 0}function d(t,e,a){var i,n=!1;function r(){n||(n=!0,window.location=e.href)}!function(t,e){if(!t.defaultPrevented)return e=!e.target||e.target.match(/^_(self|parent|top)$/i),t=!(t.ctrlKey||t.metaKey||t.shiftKey)&&"click"===t.type,e&&t}(t,e)?(i={props:a.props},plausible(a.name,i)):(i={props:a.props,callback:r},plausible(a.name,i),setTimeout(r,5e3),t.preventDefault())}p.addEventListener("click",l),p.addEventListener("auxclick",l);var f=["pdf","xlsx","docx","txt","rtf","csv","exe","key","pps","ppt","pptx","7z","pkg","rar","gz","zip","avi","mov","mp4","mpeg","wmv","midi","mp3","wav","wma","dmg"],g=s.getAttribute("file-types"),v=s.getAttribute("add-file-types"),m=g&&g.split(",")||v&&v.split(",").concat(f)||f}();
\ No newline at end of file
+!function(){"use strict";var o=window.location,p=window.document,s=p.getElementById("plausible"),u=s.getAttribute("data-api")||(g=(g=s).src.split("/"),f=g[0],g=g[2],f+"//"+g+"/api/event");function c(t,e){t&&console.warn("Ignoring Event: "+t),e&&e.callback&&e.callback()}function t(t,e){try{if("true"===window.localStorage.plausible_ignore)return c("localStorage flag",e)}catch(t){}var a=s&&s.getAttribute("data-include"),i=s&&s.getAttribute("data-exclude");if("pageview"===t){a=!a||a.split(",").some(n),i=i&&i.split(",").some(n);if(!a||i)return c("exclusion rule",e)}function n(t){var e=o.pathname;return(e+=o.hash).match(new RegExp("^"+t.trim().replace(/\*\*/g,".*").replace(/([^\.])\*/g,"$1[^\\s/]*")+"/?$"))}var a={},i=(a.n=t,a.u=o.href,a.d=s.getAttribute("data-domain"),a.r=p.referrer||null,function(){var c=s.getAttribute("data-exclude-cookie");c&&p.cookie.split(";").some(function(t){return t.trim().split("=")[0]===c})&&(a.c=c)}(),e&&e.meta&&(a.m=JSON.stringify(e.meta)),e&&e.props&&(a.p=e.props),s.getAttributeNames().filter(function(t){return"event-"===t.substring(0,6)})),r=a.p||{},l=(i.forEach(function(t){var e=t.replace("event-",""),t=s.getAttribute(t);r[e]=r[e]||t}),a.p=r,a.h=1,new XMLHttpRequest);l.open("POST",u,!0),l.setRequestHeader("Content-Type","text/plain"),l.send(JSON.stringify(a)),l.onreadystatechange=function(){4===l.readyState&&e&&e.callback&&e.callback({status:l.status})}}var e=window.plausible&&window.plausible.q||[];window.plausible=t;for(var a,i=0;i<e.length;i++)t.apply(this,e[i]);function n(){a=o.pathname,t("pageview")}window.addEventListener("hashchange",n),"prerender"===p.visibilityState?p.addEventListener("visibilitychange",function(){a||"visible"!==p.visibilityState||n()}):n();var r=1;function l(t){var e,a,i,n;if("auxclick"!==t.type||t.button===r)return e=function(t){for(;t&&(void 0===t.tagName||!(e=t)||!e.tagName||"a"!==e.tagName.toLowerCase()||!t.href);)t=t.parentNode;var e;return t}(t.target),a=e&&e.href&&e.href.split("?")[0],(i=e)&&i.href&&i.host&&i.host!==o.host?d(t,e,{name:"Outbound Link: Click",props:{url:e.href}}):(i=a)&&(n=i.split(".").pop(),m.some(function(t){return t===n}))?d(t,e,{name:"File Download",props:{url:a}}):void 0}function d(t,e,a){var i,n=!1;function r(){n||(n=!0,window.location=e.href)}!function(t,e){if(!t.defaultPrevented)return e=!e.target||e.target.match(/^_(self|parent|top)$/i),t=!(t.ctrlKey||t.metaKey||t.shiftKey)&&"click"===t.type,e&&t}(t,e)?(i={props:a.props},plausible(a.name,i)):(i={props:a.props,callback:r},plausible(a.name,i),setTimeout(r,5e3),t.preventDefault())}p.addEventListener("click",l),p.addEventListener("auxclick",l);var f=["pdf","xlsx","docx","txt","rtf","csv","exe","key","pps","ppt","pptx","7z","pkg","rar","gz","zip","avi","mov","mp4","mpeg","wmv","midi","mp3","wav","wma","dmg"],g=s.getAttribute("file-types"),v=s.getAttribute("add-file-types"),m=g&&g.split(",")||v&&v.split(",").concat(f)||f}();
\ No newline at end of file
@@ -1 +1 @@
-!function(){"use strict";var o=window.location,p=window.document,s=p.getElementById("plausible"),u=s.getAttribute("data-api")||(v=(v=s).src.split("/"),f=v[0],v=v[2],f+"//"+v+"/api/event");function c(e,t){e&&console.warn("Ignoring Event: "+e),t&&t.callback&&t.callback()}function e(e,t){try{if("true"===window.localStorage.plausible_ignore)return c("localStorage flag",t)}catch(e){}var a=s&&s.getAttribute("data-include"),n=s&&s.getAttribute("data-exclude");if("pageview"===e){a=!a||a.split(",").some(i),n=n&&n.split(",").some(i);if(!a||n)return c("exclusion rule",t)}function i(e){var t=o.pathname;return(t+=o.hash).match(new RegExp("^"+e.trim().replace(/\*\*/g,".*").replace(/([^\.])\*/g,"$1[^\\s/]*")+"/?$"))}var a={},n=(a.n=e,a.u=o.href,a.d=s.getAttribute("data-domain"),a.r=p.referrer||null,t&&t.meta&&(a.m=JSON.stringify(t.meta)),t&&t.props&&(a.p=t.props),t&&t.revenue&&(a.$=t.revenue),s.getAttributeNames().filter(function(e){return"event-"===e.substring(0,6)})),r=a.p||{},l=(n.forEach(function(e){var t=e.replace("event-",""),e=s.getAttribute(e);r[t]=r[t]||e}),a.p=r,a.h=1,new XMLHttpRequest);l.open("POST",u,!0),l.setRequestHeader("Content-Type","text/plain"),l.send(JSON.stringify(a)),l.onreadystatechange=function(){4===l.readyState&&t&&t.callback&&t.callback({status:l.status})}}var t=window.plausible&&window.plausible.q||[];window.plausible=e;for(var a,n=0;n<t.length;n++)e.apply(this,t[n]);function i(){a=o.pathname,e("pageview")}window.addEventListener("hashchange",i),"prerender"===p.visibilityState?p.addEventListener("visibilitychange",function(){a||"visible"!==p.visibilityState||i()}):i();var r=1;function l(e){var t,a,n,i;if("auxclick"!==e.type||e.button===r)return t=function(e){for(;e&&(void 0===e.tagName||!(t=e)||!t.tagName||"a"!==t.tagName.toLowerCase()||!e.href);)e=e.parentNode;var t;return e}(e.target),a=t&&t.href&&t.href.split("?")[0],(n=t)&&n.href&&n.host&&n.host!==o.host?d(e,t,{name:"Outbound Link: Click",props:{url:t.href}}):(n=a)&&(i=n.split(".").pop(),m.some(function(e){return e===i}))?d(e,t,{name:"File Download",props:{url:a}}):void 0}function d(e,t,a){var n,i=!1;function r(){i||(i=!0,window.location=t.href)}!function(e,t){if(!e.defaultPrevented)return t=!t.target||t.target.match(/^_(self|parent|top)$/i),e=!(e.ctrlKey||e.metaKey||e.shiftKey)&&"click"===e.type,t&&e}(e,t)?((n={props:a.props}).revenue=a.revenue,plausible(a.name,n)):((n={props:a.props,callback:r}).revenue=a.revenue,plausible(a.name,n),setTimeout(r,5e3),e.preventDefault())}p.addEventListener("click",l),p.addEventListener("auxclick",l);var f=["pdf","xlsx","docx","txt","rtf","csv","exe","key","pps","ppt","pptx","7z","pkg","rar","gz","zip","avi","mov","mp4","mpeg","wmv","midi","mp3","wav","wma","dmg"],v=s.getAttribute("file-types"),g=s.getAttribute("add-file-types"),m=v&&v.split(",")||g&&g.split(",").concat(f)||f}();
\ No newline at end of file
+!function(){"use strict";var o=window.location,p=window.document,s=p.getElementById("plausible"),u=s.getAttribute("data-api")||(v=(v=s).src.split("/"),f=v[0],v=v[2],f+"//"+v+"/api/event");function c(e,t){e&&console.warn("Ignoring Event: "+e),t&&t.callback&&t.callback()}function e(e,t){try{if("true"===window.localStorage.plausible_ignore)return c("localStorage flag",t)}catch(e){}var a=s&&s.getAttribute("data-include"),n=s&&s.getAttribute("data-exclude");if("pageview"===e){a=!a||a.split(",").some(i),n=n&&n.split(",").some(i);if(!a||n)return c("exclusion rule",t)}function i(e){var t=o.pathname;return(t+=o.hash).match(new RegExp("^"+e.trim().replace(/\*\*/g,".*").replace(/([^\.])\*/g,"$1[^\\s/]*")+"/?$"))}var a={},n=(a.n=e,a.u=o.href,a.d=s.getAttribute("data-domain"),a.r=p.referrer||null,function(){var c=s.getAttribute("data-exclude-cookie");c&&p.cookie.split(";").some(function(t){return t.trim().split("=")[0]===c})&&(a.c=c)}(),t&&t.meta&&(a.m=JSON.stringify(t.meta)),t&&t.props&&(a.p=t.props),t&&t.revenue&&(a.$=t.revenue),s.getAttributeNames().filter(function(e){return"event-"===e.substring(0,6)})),r=a.p||{},l=(n.forEach(function(e){var t=e.replace("event-",""),e=s.getAttribute(e);r[t]=r[t]||e}),a.p=r,a.h=1,new XMLHttpRequest);l.open("POST",u,!0),l.setRequestHeader("Content-Type","text/plain"),l.send(JSON.stringify(a)),l.onreadystatechange=function(){4===l.readyState&&t&&t.callback&&t.callback({status:l.status})}}var t=window.plausible&&window.plausible.q||[];window.plausible=e;for(var a,n=0;n<t.length;n++)e.apply(this,t[n]);function i(){a=o.pathname,e("pageview")}window.addEventListener("hashchange",i),"prerender"===p.visibilityState?p.addEventListener("visibilitychange",function(){a||"visible"!==p.visibilityState||i()}):i();var r=1;function l(e){var t,a,n,i;if("auxclick"!==e.type||e.button===r)return t=function(e){for(;e&&(void 0===e.tagName||!(t=e)||!t.tagName||"a"!==t.tagName.toLowerCase()||!e.href);)e=e.parentNode;var t;return e}(e.target),a=t&&t.href&&t.href.split("?")[0],(n=t)&&n.href&&n.host&&n.host!==o.host?d(e,t,{name:"Outbound Link: Click",props:{url:t.href}}):(n=a)&&(i=n.split(".").pop(),m.some(function(e){return e===i}))?d(e,t,{name:"File Download",props:{url:a}}):void 0}function d(e,t,a){var n,i=!1;function r(){i||(i=!0,window.location=t.href)}!function(e,t){if(!e.defaultPrevented)return t=!t.target||t.target.match(/^_(self|parent|top)$/i),e=!(e.ctrlKey||e.metaKey||e.shiftKey)&&"click"===e.type,t&&e}(e,t)?((n={props:a.props}).revenue=a.revenue,plausible(a.name,n)):((n={props:a.props,callback:r}).revenue=a.revenue,plausible(a.name,n),setTimeout(r,5e3),e.preventDefault())}p.addEventListener("click",l),p.addEventListener("auxclick",l);var f=["pdf","xlsx","docx","txt","rtf","csv","exe","key","pps","ppt","pptx","7z","pkg","rar","gz","zip","avi","mov","mp4","mpeg","wmv","midi","mp3","wav","wma","dmg"],v=s.getAttribute("file-types"),g=s.getAttribute("add-file-types"),m=v&&v.split(",")||g&&g.split(",").concat(f)||f}();
\ No newline at end of file
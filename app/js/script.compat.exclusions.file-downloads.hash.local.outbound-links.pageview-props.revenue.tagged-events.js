@@ -1 +1 @@
-!function(){"use strict";var o=window.location,l=window.document,p=l.getElementById("plausible"),s=p.getAttribute("data-api")||(m=(m=p).src.split("/"),u=m[0],m=m[2],u+"//"+m+"/api/event");function c(e,t){e&&console.warn("Ignoring Event: "+e),t&&t.callback&&t.callback()}function e(e,t){try{if("true"===window.localStorage.plausible_ignore)return c("localStorage flag",t)}catch(e){}var r=p&&p.getAttribute("data-include"),n=p&&p.getAttribute("data-exclude");if("pageview"===e){r=!r||r.split(",").some(a),n=n&&n.split(",").some(a);if(!r||n)return c("exclusion rule",t)}function a(e){var t=o.pathname;return(t+=o.hash).match(new RegExp("^"+e.trim().replace(/\*\*/g,".*").replace(/([^\.])\*/g,"$1[^\\s/]*")+"/?$"))}var r={},n=(r.n=e,r.u=o.href,r.d=p.getAttribute("data-domain"),r.r=l.referrer||null,t&&t.meta&&(r.m=JSON.stringify(t.meta)),t&&t.props&&(r.p=t.props),t&&t.revenue&&(r.$=t.revenue),p.getAttributeNames().filter(function(e){return"event-"===e.substring(0,6)})),i=r.p||{},u=(n.forEach(function(e){var t=e.replace("event-",""),e=p.getAttribute(e);i[t]=i[t]||e}),r.p=i,r.h=1,new XMLHttpRequest);u.open("POST",s,!0),u.setRequestHeader("Content-Type","text/plain"),u.send(JSON.stringify(r)),u.onreadystatechange=function(){4===u.readyState&&t&&t.callback&&t.callback({status:u.status})}}var t=window.plausible&&window.plausible.q||[];window.plausible=e;for(var r,n=0;n<t.length;n++)e.apply(this,t[n]);function a(){r=o.pathname,e("pageview")}function f(e){return e&&e.tagName&&"a"===e.tagName.toLowerCase()}window.addEventListener("hashchange",a),"prerender"===l.visibilityState?l.addEventListener("visibilitychange",function(){r||"visible"!==l.visibilityState||a()}):a();var v=1;function i(e){if("auxclick"!==e.type||e.button===v){var t,r,n=function(e){for(;e&&(void 0===e.tagName||!f(e)||!e.href);)e=e.parentNode;return e}(e.target),a=n&&n.href&&n.href.split("?")[0];if(!function e(t,r){if(!t||w<r)return!1;if(k(t))return!0;return e(t.parentNode,r+1)}(n,0))return(t=n)&&t.href&&t.host&&t.host!==o.host?d(e,n,{name:"Outbound Link: Click",props:{url:n.href}}):(t=a)&&(r=t.split(".").pop(),b.some(function(e){return e===r}))?d(e,n,{name:"File Download",props:{url:a}}):void 0}}function d(e,t,r){var n,a=!1;function i(){a||(a=!0,window.location=t.href)}!function(e,t){if(!e.defaultPrevented)return t=!t.target||t.target.match(/^_(self|parent|top)$/i),e=!(e.ctrlKey||e.metaKey||e.shiftKey)&&"click"===e.type,t&&e}(e,t)?((n={props:r.props}).revenue=r.revenue,plausible(r.name,n)):((n={props:r.props,callback:i}).revenue=r.revenue,plausible(r.name,n),setTimeout(i,5e3),e.preventDefault())}l.addEventListener("click",i),l.addEventListener("auxclick",i);var u=["pdf","xlsx","docx","txt","rtf","csv","exe","key","pps","ppt","pptx","7z","pkg","rar","gz","zip","avi","mov","mp4","mpeg","wmv","midi","mp3","wav","wma","dmg"],m=p.getAttribute("file-types"),g=p.getAttribute("add-file-types"),b=m&&m.split(",")||g&&g.split(",").concat(u)||u;function h(e){var e=k(e)?e:e&&e.parentNode,t={name:null,props:{},revenue:{}},r=e&&e.classList;if(r)for(var n=0;n<r.length;n++){var a,i,u=r.item(n),o=u.match(/plausible-event-(.+)(=|--)(.+)/),o=(o&&(a=o[1],i=o[3].replace(/\+/g," "),"name"==a.toLowerCase()?t.name=i:t.props[a]=i),u.match(/plausible-revenue-(.+)(=|--)(.+)/));o&&(a=o[1],i=o[3],t.revenue[a]=i)}return t}var w=3;function y(e){if("auxclick"!==e.type||e.button===v){for(var t,r,n,a,i=e.target,u=0;u<=w&&i;u++){if((n=i)&&n.tagName&&"form"===n.tagName.toLowerCase())return;f(i)&&(t=i),k(i)&&(r=i),i=i.parentNode}r&&(a=h(r),t?(a.props.url=t.href,d(e,t,a)):((e={}).props=a.props,e.revenue=a.revenue,plausible(a.name,e)))}}function k(e){var t=e&&e.classList;if(t)for(var r=0;r<t.length;r++)if(t.item(r).match(/plausible-event-name(=|--)(.+)/))return!0;return!1}l.addEventListener("submit",function(e){var t,r=e.target,n=h(r);function a(){t||(t=!0,r.submit())}n.name&&(e.preventDefault(),t=!1,setTimeout(a,5e3),(e={props:n.props,callback:a}).revenue=n.revenue,plausible(n.name,e))}),l.addEventListener("click",y),l.addEventListener("auxclick",y)}();
\ No newline at end of file
+!function(){"use strict";var o=window.location,l=window.document,p=l.getElementById("plausible"),s=p.getAttribute("data-api")||(m=(m=p).src.split("/"),u=m[0],m=m[2],u+"//"+m+"/api/event");function c(e,t){e&&console.warn("Ignoring Event: "+e),t&&t.callback&&t.callback()}function e(e,t){try{if("true"===window.localStorage.plausible_ignore)return c("localStorage flag",t)}catch(e){}var r=p&&p.getAttribute("data-include"),n=p&&p.getAttribute("data-exclude");if("pageview"===e){r=!r||r.split(",").some(a),n=n&&n.split(",").some(a);if(!r||n)return c("exclusion rule",t)}function a(e){var t=o.pathname;return(t+=o.hash).match(new RegExp("^"+e.trim().replace(/\*\*/g,".*").replace(/([^\.])\*/g,"$1[^\\s/]*")+"/?$"))}var r={},n=(r.n=e,r.u=o.href,r.d=p.getAttribute("data-domain"),r.r=l.referrer||null,function(){var c=p.getAttribute("data-exclude-cookie");c&&l.cookie.split(";").some(function(t){return t.trim().split("=")[0]===c})&&(r.c=c)}(),t&&t.meta&&(r.m=JSON.stringify(t.meta)),t&&t.props&&(r.p=t.props),t&&t.revenue&&(r.$=t.revenue),p.getAttributeNames().filter(function(e){return"event-"===e.substring(0,6)})),i=r.p||{},u=(n.forEach(function(e){var t=e.replace("event-",""),e=p.getAttribute(e);i[t]=i[t]||e}),r.p=i,r.h=1,new XMLHttpRequest);u.open("POST",s,!0),u.setRequestHeader("Content-Type","text/plain"),u.send(JSON.stringify(r)),u.onreadystatechange=function(){4===u.readyState&&t&&t.callback&&t.callback({status:u.status})}}var t=window.plausible&&window.plausible.q||[];window.plausible=e;for(var r,n=0;n<t.length;n++)e.apply(this,t[n]);function a(){r=o.pathname,e("pageview")}function f(e){return e&&e.tagName&&"a"===e.tagName.toLowerCase()}window.addEventListener("hashchange",a),"prerender"===l.visibilityState?l.addEventListener("visibilitychange",function(){r||"visible"!==l.visibilityState||a()}):a();var v=1;function i(e){if("auxclick"!==e.type||e.button===v){var t,r,n=function(e){for(;e&&(void 0===e.tagName||!f(e)||!e.href);)e=e.parentNode;return e}(e.target),a=n&&n.href&&n.href.split("?")[0];if(!function e(t,r){if(!t||w<r)return!1;if(k(t))return!0;return e(t.parentNode,r+1)}(n,0))return(t=n)&&t.href&&t.host&&t.host!==o.host?d(e,n,{name:"Outbound Link: Click",props:{url:n.href}}):(t=a)&&(r=t.split(".").pop(),b.some(function(e){return e===r}))?d(e,n,{name:"File Download",props:{url:a}}):void 0}}function d(e,t,r){var n,a=!1;function i(){a||(a=!0,window.location=t.href)}!function(e,t){if(!e.defaultPrevented)return t=!t.target||t.target.match(/^_(self|parent|top)$/i),e=!(e.ctrlKey||e.metaKey||e.shiftKey)&&"click"===e.type,t&&e}(e,t)?((n={props:r.props}).revenue=r.revenue,plausible(r.name,n)):((n={props:r.props,callback:i}).revenue=r.revenue,plausible(r.name,n),setTimeout(i,5e3),e.preventDefault())}l.addEventListener("click",i),l.addEventListener("auxclick",i);var u=["pdf","xlsx","docx","txt","rtf","csv","exe","key","pps","ppt","pptx","7z","pkg","rar","gz","zip","avi","mov","mp4","mpeg","wmv","midi","mp3","wav","wma","dmg"],m=p.getAttribute("file-types"),g=p.getAttribute("add-file-types"),b=m&&m.split(",")||g&&g.split(",").concat(u)||u;function h(e){var e=k(e)?e:e&&e.parentNode,t={name:null,props:{},revenue:{}},r=e&&e.classList;if(r)for(var n=0;n<r.length;n++){var a,i,u=r.item(n),o=u.match(/plausible-event-(.+)(=|--)(.+)/),o=(o&&(a=o[1],i=o[3].replace(/\+/g," "),"name"==a.toLowerCase()?t.name=i:t.props[a]=i),u.match(/plausible-revenue-(.+)(=|--)(.+)/));o&&(a=o[1],i=o[3],t.revenue[a]=i)}return t}var w=3;function y(e){if("auxclick"!==e.type||e.button===v){for(var t,r,n,a,i=e.target,u=0;u<=w&&i;u++){if((n=i)&&n.tagName&&"form"===n.tagName.toLowerCase())return;f(i)&&(t=i),k(i)&&(r=i),i=i.parentNode}r&&(a=h(r),t?(a.props.url=t.href,d(e,t,a)):((e={}).props=a.props,e.revenue=a.revenue,plausible(a.name,e)))}}function k(e){var t=e&&e.classList;if(t)for(var r=0;r<t.length;r++)if(t.item(r).match(/plausible-event-name(=|--)(.+)/))return!0;return!1}l.addEventListener("submit",function(e){var t,r=e.target,n=h(r);function a(){t||(t=!0,r.submit())}n.name&&(e.preventDefault(),t=!1,setTimeout(a,5e3),(e={props:n.props,callback:a}).revenue=n.revenue,plausible(n.name,e))}),l.addEventListener("click",y),l.addEventListener("auxclick",y)}();
\ No newline at end of file
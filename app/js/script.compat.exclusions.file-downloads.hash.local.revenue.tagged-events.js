@@ -1 +1 @@
-!function(){"use strict";var u=window.location,l=window.document,o=l.getElementById("plausible"),p=o.getAttribute("data-api")||(m=(m=o).src.split("/"),d=m[0],m=m[2],d+"//"+m+"/api/event");function s(e,t){e&&console.warn("Ignoring Event: "+e),t&&t.callback&&t.callback()}function e(e,t){try{if("true"===window.localStorage.plausible_ignore)return s("localStorage flag",t)}catch(e){}var a=o&&o.getAttribute("data-include"),n=o&&o.getAttribute("data-exclude");if("pageview"===e){a=!a||a.split(",").some(r),n=n&&n.split(",").some(r);if(!a||n)return s("exclusion rule",t)}function r(e){var t=u.pathname;return(t+=u.hash).match(new RegExp("^"+e.trim().replace(/\*\*/g,".*").replace(/([^\.])\*/g,"$1[^\\s/]*")+"/?$"))}var a={},i=(a.n=e,a.u=u.href,a.d=o.getAttribute("data-domain"),a.r=l.referrer||null,t&&t.meta&&(a.m=JSON.stringify(t.meta)),t&&t.props&&(a.p=t.props),t&&t.revenue&&(a.$=t.revenue),a.h=1,new XMLHttpRequest);i.open("POST",p,!0),i.setRequestHeader("Content-Type","text/plain"),i.send(JSON.stringify(a)),i.onreadystatechange=function(){4===i.readyState&&t&&t.callback&&t.callback({status:i.status})}}var t=window.plausible&&window.plausible.q||[];window.plausible=e;for(var a,n=0;n<t.length;n++)e.apply(this,t[n]);function r(){a=u.pathname,e("pageview")}function c(e){return e&&e.tagName&&"a"===e.tagName.toLowerCase()}window.addEventListener("hashchange",r),"prerender"===l.visibilityState?l.addEventListener("visibilitychange",function(){a||"visible"!==l.visibilityState||r()}):r();var v=1;function i(e){var t,a,n,r;if("auxclick"!==e.type||e.button===v)return t=function(e){for(;e&&(void 0===e.tagName||!c(e)||!e.href);)e=e.parentNode;return e}(e.target),a=t&&t.href&&t.href.split("?")[0],!function e(t,a){if(!t||h<a)return!1;if(k(t))return!0;return e(t.parentNode,a+1)}(t,0)&&(n=a)&&(r=n.split(".").pop(),b.some(function(e){return e===r}))?f(e,t,{name:"File Download",props:{url:a}}):void 0}function f(e,t,a){var n,r=!1;function i(){r||(r=!0,window.location=t.href)}!function(e,t){if(!e.defaultPrevented)return t=!t.target||t.target.match(/^_(self|parent|top)$/i),e=!(e.ctrlKey||e.metaKey||e.shiftKey)&&"click"===e.type,t&&e}(e,t)?((n={props:a.props}).revenue=a.revenue,plausible(a.name,n)):((n={props:a.props,callback:i}).revenue=a.revenue,plausible(a.name,n),setTimeout(i,5e3),e.preventDefault())}l.addEventListener("click",i),l.addEventListener("auxclick",i);var d=["pdf","xlsx","docx","txt","rtf","csv","exe","key","pps","ppt","pptx","7z","pkg","rar","gz","zip","avi","mov","mp4","mpeg","wmv","midi","mp3","wav","wma","dmg"],m=o.getAttribute("file-types"),g=o.getAttribute("add-file-types"),b=m&&m.split(",")||g&&g.split(",").concat(d)||d;function w(e){var e=k(e)?e:e&&e.parentNode,t={name:null,props:{},revenue:{}},a=e&&e.classList;if(a)for(var n=0;n<a.length;n++){var r,i,u=a.item(n),l=u.match(/plausible-event-(.+)(=|--)(.+)/),l=(l&&(r=l[1],i=l[3].replace(/\+/g," "),"name"==r.toLowerCase()?t.name=i:t.props[r]=i),u.match(/plausible-revenue-(.+)(=|--)(.+)/));l&&(r=l[1],i=l[3],t.revenue[r]=i)}return t}var h=3;function y(e){if("auxclick"!==e.type||e.button===v){for(var t,a,n,r,i=e.target,u=0;u<=h&&i;u++){if((n=i)&&n.tagName&&"form"===n.tagName.toLowerCase())return;c(i)&&(t=i),k(i)&&(a=i),i=i.parentNode}a&&(r=w(a),t?(r.props.url=t.href,f(e,t,r)):((e={}).props=r.props,e.revenue=r.revenue,plausible(r.name,e)))}}function k(e){var t=e&&e.classList;if(t)for(var a=0;a<t.length;a++)if(t.item(a).match(/plausible-event-name(=|--)(.+)/))return!0;return!1}l.addEventListener("submit",function(e){var t,a=e.target,n=w(a);function r(){t||(t=!0,a.submit())}n.name&&(e.preventDefault(),t=!1,setTimeout(r,5e3),(e={props:n.props,callback:r}).revenue=n.revenue,plausible(n.name,e))}),l.addEventListener("click",y),l.addEventListener("auxclick",y)}();
\ No newline at end of file
+!function(){"use strict";var u=window.location,l=window.document,o=l.getElementById("plausible"),p=o.getAttribute("data-api")||(m=(m=o).src.split("/"),d=m[0],m=m[2],d+"//"+m+"/api/event");function s(e,t){e&&console.warn("Ignoring Event: "+e),t&&t.callback&&t.callback()}function e(e,t){try{if("true"===window.localStorage.plausible_ignore)return s("localStorage flag",t)}catch(e){}var a=o&&o.getAttribute("data-include"),n=o&&o.getAttribute("data-exclude");if("pageview"===e){a=!a||a.split(",").some(r),n=n&&n.split(",").some(r);if(!a||n)return s("exclusion rule",t)}function r(e){var t=u.pathname;return(t+=u.hash).match(new RegExp("^"+e.trim().replace(/\*\*/g,".*").replace(/([^\.])\*/g,"$1[^\\s/]*")+"/?$"))}var a={},i=(a.n=e,a.u=u.href,a.d=o.getAttribute("data-domain"),a.r=l.referrer||null,function(){var c=o.getAttribute("data-exclude-cookie");c&&l.cookie.split(";").some(function(t){return t.trim().split("=")[0]===c})&&(a.c=c)}(),t&&t.meta&&(a.m=JSON.stringify(t.meta)),t&&t.props&&(a.p=t.props),t&&t.revenue&&(a.$=t.revenue),a.h=1,new XMLHttpRequest);i.open("POST",p,!0),i.setRequestHeader("Content-Type","text/plain"),i.send(JSON.stringify(a)),i.onreadystatechange=function(){4===i.readyState&&t&&t.callback&&t.callback({status:i.status})}}var t=window.plausible&&window.plausible.q||[];window.plausible=e;for(var a,n=0;n<t.length;n++)e.apply(this,t[n]);function r(){a=u.pathname,e("pageview")}function c(e){return e&&e.tagName&&"a"===e.tagName.toLowerCase()}window.addEventListener("hashchange",r),"prerender"===l.visibilityState?l.addEventListener("visibilitychange",function(){a||"visible"!==l.visibilityState||r()}):r();var v=1;function i(e){var t,a,n,r;if("auxclick"!==e.type||e.button===v)return t=function(e){for(;e&&(void 0===e.tagName||!c(e)||!e.href);)e=e.parentNode;return e}(e.target),a=t&&t.href&&t.href.split("?")[0],!function e(t,a){if(!t||h<a)return!1;if(k(t))return!0;return e(t.parentNode,a+1)}(t,0)&&(n=a)&&(r=n.split(".").pop(),b.some(function(e){return e===r}))?f(e,t,{name:"File Download",props:{url:a}}):void 0}function f(e,t,a){var n,r=!1;function i(){r||(r=!0,window.location=t.href)}!function(e,t){if(!e.defaultPrevented)return t=!t.target||t.target.match(/^_(self|parent|top)$/i),e=!(e.ctrlKey||e.metaKey||e.shiftKey)&&"click"===e.type,t&&e}(e,t)?((n={props:a.props}).revenue=a.revenue,plausible(a.name,n)):((n={props:a.props,callback:i}).revenue=a.revenue,plausible(a.name,n),setTimeout(i,5e3),e.preventDefault())}l.addEventListener("click",i),l.addEventListener("auxclick",i);var d=["pdf","xlsx","docx","txt","rtf","csv","exe","key","pps","ppt","pptx","7z","pkg","rar","gz","zip","avi","mov","mp4","mpeg","wmv","midi","mp3","wav","wma","dmg"],m=o.getAttribute("file-types"),g=o.getAttribute("add-file-types"),b=m&&m.split(",")||g&&g.split(",").concat(d)||d;function w(e){var e=k(e)?e:e&&e.parentNode,t={name:null,props:{},revenue:{}},a=e&&e.classList;if(a)for(var n=0;n<a.length;n++){var r,i,u=a.item(n),l=u.match(/plausible-event-(.+)(=|--)(.+)/),l=(l&&(r=l[1],i=l[3].replace(/\+/g," "),"name"==r.toLowerCase()?t.name=i:t.props[r]=i),u.match(/plausible-revenue-(.+)(=|--)(.+)/));l&&(r=l[1],i=l[3],t.revenue[r]=i)}return t}var h=3;function y(e){if("auxclick"!==e.type||e.button===v){for(var t,a,n,r,i=e.target,u=0;u<=h&&i;u++){if((n=i)&&n.tagName&&"form"===n.tagName.toLowerCase())return;c(i)&&(t=i),k(i)&&(a=i),i=i.parentNode}a&&(r=w(a),t?(r.props.url=t.href,f(e,t,r)):((e={}).props=r.props,e.revenue=r.revenue,plausible(r.name,e)))}}function k(e){var t=e&&e.classList;if(t)for(var a=0;a<t.length;a++)if(t.item(a).match(/plausible-event-name(=|--)(.+)/))return!0;return!1}l.addEventListener("submit",function(e){var t,a=e.target,n=w(a);function r(){t||(t=!0,a.submit())}n.name&&(e.preventDefault(),t=!1,setTimeout(r,5e3),(e={props:n.props,callback:r}).revenue=n.revenue,plausible(n.name,e))}),l.addEventListener("click",y),l.addEventListener("auxclick",y)}();
\ No newline at end of file
@@ -1 +1 @@
-!function(){"use strict";var o=window.location,l=window.document,s=l.getElementById("plausible"),u=s.getAttribute("data-api")||(v=(v=s).src.split("/"),p=v[0],v=v[2],p+"//"+v+"/api/event");function c(t,e){t&&console.warn("Ignoring Event: "+t),e&&e.callback&&e.callback()}function t(t,e){try{if("true"===window.localStorage.plausible_ignore)return c("localStorage flag",e)}catch(t){}var a=s&&s.getAttribute("data-include"),i=s&&s.getAttribute("data-exclude");if("pageview"===t){a=!a||a.split(",").some(n),i=i&&i.split(",").some(n);if(!a||i)return c("exclusion rule",e)}function n(t){return o.pathname.match(new RegExp("^"+t.trim().replace(/\*\*/g,".*").replace(/([^\.])\*/g,"$1[^\\s/]*")+"/?$"))}var a={},i=(a.n=t,a.u=o.href,a.d=s.getAttribute("data-domain"),a.r=l.referrer||null,e&&e.meta&&(a.m=JSON.stringify(e.meta)),e&&e.props&&(a.p=e.props),s.getAttributeNames().filter(function(t){return"event-"===t.substring(0,6)})),r=a.p||{},p=(i.forEach(function(t){var e=t.replace("event-",""),t=s.getAttribute(t);r[e]=r[e]||t}),a.p=r,new XMLHttpRequest);p.open("POST",u,!0),p.setRequestHeader("Content-Type","text/plain"),p.send(JSON.stringify(a)),p.onreadystatechange=function(){4===p.readyState&&e&&e.callback&&e.callback({status:p.status})}}var e=window.plausible&&window.plausible.q||[];window.plausible=t;for(var a,i=0;i<e.length;i++)t.apply(this,e[i]);function n(){a!==o.pathname&&(a=o.pathname,t("pageview"))}var r,p=window.history;p.pushState&&(r=p.pushState,p.pushState=function(){r.apply(this,arguments),n()},window.addEventListener("popstate",n)),"prerender"===l.visibilityState?l.addEventListener("visibilitychange",function(){a||"visible"!==l.visibilityState||n()}):n();var d=1;function f(t){var e,a,i,n;if("auxclick"!==t.type||t.button===d)return e=function(t){for(;t&&(void 0===t.tagName||!(e=t)||!e.tagName||"a"!==e.tagName.toLowerCase()||!t.href);)t=t.parentNode;var e;return t}(t.target),a=e&&e.href&&e.href.split("?")[0],(i=e)&&i.href&&i.host&&i.host!==o.host?g(t,e,{name:"Outbound Link: Click",props:{url:e.href}}):(i=a)&&(n=i.split(".").pop(),b.some(function(t){return t===n}))?g(t,e,{name:"File Download",props:{url:a}}):void 0}function g(t,e,a){var i,n=!1;function r(){n||(n=!0,window.location=e.href)}!function(t,e){if(!t.defaultPrevented)return e=!e.target||e.target.match(/^_(self|parent|top)$/i),t=!(t.ctrlKey||t.metaKey||t.shiftKey)&&"click"===t.type,e&&t}(t,e)?(i={props:a.props},plausible(a.name,i)):(i={props:a.props,callback:r},plausible(a.name,i),setTimeout(r,5e3),t.preventDefault())}l.addEventListener("click",f),l.addEventListener("auxclick",f);var v=["pdf","xlsx","docx","txt","rtf","csv","exe","key","pps","ppt","pptx","7z","pkg","rar","gz","zip","avi","mov","mp4","mpeg","wmv","midi","mp3","wav","wma","dmg"],m=s.getAttribute("file-types"),w=s.getAttribute("add-file-types"),b=m&&m.split(",")||w&&w.split(",").concat(v)||v}();
\ No newline at end of file
+!function(){"use strict";var o=window.location,l=window.document,s=l.getElementById("plausible"),u=s.getAttribute("data-api")||(v=(v=s).src.split("/"),p=v[0],v=v[2],p+"//"+v+"/api/event");function c(t,e){t&&console.warn("Ignoring Event: "+t),e&&e.callback&&e.callback()}function t(t,e){try{if("true"===window.localStorage.plausible_ignore)return c("localStorage flag",e)}catch(t){}var a=s&&s.getAttribute("data-include"),i=s&&s.getAttribute("data-exclude");if("pageview"===t){a=!a||a.split(",").some(n),i=i&&i.split(",").some(n);if(!a||i)return c("exclusion rule",e)}function n(t){return o.pathname.match(new RegExp("^"+t.trim().replace(/\*\*/g,".*").replace(/([^\.])\*/g,"$1[^\\s/]*")+"/?$"))}var a={},i=(a.n=t,a.u=o.href,a.d=s.getAttribute("data-domain"),a.r=l.referrer||null,function(){var c=s.getAttribute("data-exclude-cookie");c&&l.cookie.split(";").some(function(t){return t.trim().split("=")[0]===c})&&(a.c=c)}(),e&&e.meta&&(a.m=JSON.stringify(e.meta)),e&&e.props&&(a.p=e.props),s.getAttributeNames().filter(function(t){return"event-"===t.substring(0,6)})),r=a.p||{},p=(i.forEach(function(t){var e=t.replace("event-",""),t=s.getAttribute(t);r[e]=r[e]||t}),a.p=r,new XMLHttpRequest);p.open("POST",u,!0),p.setRequestHeader("Content-Type","text/plain"),p.send(JSON.stringify(a)),p.onreadystatechange=function(){4===p.readyState&&e&&e.callback&&e.callback({status:p.status})}}var e=window.plausible&&window.plausible.q||[];window.plausible=t;for(var a,i=0;i<e.length;i++)t.apply(this,e[i]);function n(){a!==o.pathname&&(a=o.pathname,t("pageview"))}var r,p=window.history;p.pushState&&(r=p.pushState,p.pushState=function(){r.apply(this,arguments),n()},window.addEventListener("popstate",n)),"prerender"===l.visibilityState?l.addEventListener("visibilitychange",function(){a||"visible"!==l.visibilityState||n()}):n();var d=1;function f(t){var e,a,i,n;if("auxclick"!==t.type||t.button===d)return e=function(t){for(;t&&(void 0===t.tagName||!(e=t)||!e.tagName||"a"!==e.tagName.toLowerCase()||!t.href);)t=t.parentNode;var e;return t}(t.target),a=e&&e.href&&e.href.split("?")[0],(i=e)&&i.href&&i.host&&i.host!==o.host?g(t,e,{name:"Outbound Link: Click",props:{url:e.href}}):(i=a)&&(n=i.split(".").pop(),b.some(function(t){return t===n}))?g(t,e,{name:"File Download",props:{url:a}}):void 0}function g(t,e,a){var i,n=!1;function r(){n||(n=!0,window.location=e.href)}!function(t,e){if(!t.defaultPrevented)return e=!e.target||e.target.match(/^_(self|parent|top)$/i),t=!(t.ctrlKey||t.metaKey||t.shiftKey)&&"click"===t.type,e&&t}(t,e)?(i={props:a.props},plausible(a.name,i)):(i={props:a.props,callback:r},plausible(a.name,i),setTimeout(r,5e3),t.preventDefault())}l.addEventListener("click",f),l.addEventListener("auxclick",f);var v=["pdf","xlsx","docx","txt","rtf","csv","exe","key","pps","ppt","pptx","7z","pkg","rar","gz","zip","avi","mov","mp4","mpeg","wmv","midi","mp3","wav","wma","dmg"],m=s.getAttribute("file-types"),w=s.getAttribute("add-file-types"),b=m&&m.split(",")||w&&w.split(",").concat(v)||v}();
\ No newline at end of file
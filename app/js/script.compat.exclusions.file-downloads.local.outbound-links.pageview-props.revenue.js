@@ -1 +1 @@
-!function(){"use strict";var o=window.location,l=window.document,s=l.getElementById("plausible"),u=s.getAttribute("data-api")||(g=(g=s).src.split("/"),p=g[0],g=g[2],p+"//"+g+"/api/event");function c(e,t){e&&console.warn("Ignoring Event: "+e),t&&t.callback&&t.callback()}function e(e,t){try{if("true"===window.localStorage.plausible_ignore)return c("localStorage flag",t)}catch(e){}var a=s&&s.getAttribute("data-include"),n=s&&s.getAttribute("data-exclude");if("pageview"===e){a=!a||a.split(",").some(i),n=n&&n.split(",").some(i);if(!a||n)return c("exclusion rule",t)}function i(e){return o.pathname.match(new RegExp("^"+e.trim().replace(/\*\*/g,".*").replace(/([^\.])\*/g,"$1[^\\s/]*")+"/?$"))}var a={},n=(a.n=e,a.u=o.href,a.d=s.getAttribute("data-domain"),a.r=l.referrer||null,t&&t.meta&&(a.m=JSON.stringify(t.meta)),t&&t.props&&(a.p=t.props),t&&t.revenue&&(a.$=t.revenue),s.getAttributeNames().filter(function(e){return"event-"===e.substring(0,6)})),r=a.p||{},p=(n.forEach(function(e){var t=e.replace("event-",""),e=s.getAttribute(e);r[t]=r[t]||e}),a.p=r,new XMLHttpRequest);p.open("POST",u,!0),p.setRequestHeader("Content-Type","text/plain"),p.send(JSON.stringify(a)),p.onreadystatechange=function(){4===p.readyState&&t&&t.callback&&t.callback({status:p.status})}}var t=window.plausible&&window.plausible.q||[];window.plausible=e;for(var a,n=0;n<t.length;n++)e.apply(this,t[n]);function i(){a!==o.pathname&&(a=o.pathname,e("pageview"))}var r,p=window.history;p.pushState&&(r=p.pushState,p.pushState=function(){r.apply(this,arguments),i()},window.addEventListener("popstate",i)),"prerender"===l.visibilityState?l.addEventListener("visibilitychange",function(){a||"visible"!==l.visibilityState||i()}):i();var d=1;function f(e){var t,a,n,i;if("auxclick"!==e.type||e.button===d)return t=function(e){for(;e&&(void 0===e.tagName||!(t=e)||!t.tagName||"a"!==t.tagName.toLowerCase()||!e.href);)e=e.parentNode;var t;return e}(e.target),a=t&&t.href&&t.href.split("?")[0],(n=t)&&n.href&&n.host&&n.host!==o.host?v(e,t,{name:"Outbound Link: Click",props:{url:t.href}}):(n=a)&&(i=n.split(".").pop(),b.some(function(e){return e===i}))?v(e,t,{name:"File Download",props:{url:a}}):void 0}function v(e,t,a){var n,i=!1;function r(){i||(i=!0,window.location=t.href)}!function(e,t){if(!e.defaultPrevented)return t=!t.target||t.target.match(/^_(self|parent|top)$/i),e=!(e.ctrlKey||e.metaKey||e.shiftKey)&&"click"===e.type,t&&e}(e,t)?((n={props:a.props}).revenue=a.revenue,plausible(a.name,n)):((n={props:a.props,callback:r}).revenue=a.revenue,plausible(a.name,n),setTimeout(r,5e3),e.preventDefault())}l.addEventListener("click",f),l.addEventListener("auxclick",f);var g=["pdf","xlsx","docx","txt","rtf","csv","exe","key","pps","ppt","pptx","7z","pkg","rar","gz","zip","avi","mov","mp4","mpeg","wmv","midi","mp3","wav","wma","dmg"],m=s.getAttribute("file-types"),w=s.getAttribute("add-file-types"),b=m&&m.split(",")||w&&w.split(",").concat(g)||g}();
\ No newline at end of file
+!function(){"use strict";var o=window.location,l=window.document,s=l.getElementById("plausible"),u=s.getAttribute("data-api")||(g=(g=s).src.split("/"),p=g[0],g=g[2],p+"//"+g+"/api/event");function c(e,t){e&&console.warn("Ignoring Event: "+e),t&&t.callback&&t.callback()}function e(e,t){try{if("true"===window.localStorage.plausible_ignore)return c("localStorage flag",t)}catch(e){}var a=s&&s.getAttribute("data-include"),n=s&&s.getAttribute("data-exclude");if("pageview"===e){a=!a||a.split(",").some(i),n=n&&n.split(",").some(i);if(!a||n)return c("exclusion rule",t)}function i(e){return o.pathname.match(new RegExp("^"+e.trim().replace(/\*\*/g,".*").replace(/([^\.])\*/g,"$1[^\\s/]*")+"/?$"))}var a={},n=(a.n=e,a.u=o.href,a.d=s.getAttribute("data-domain"),a.r=l.referrer||null,function(){var c=s.getAttribute("data-exclude-cookie");c&&l.cookie.split(";").some(function(t){return t.trim().split("=")[0]===c})&&(a.c=c)}(),t&&t.meta&&(a.m=JSON.stringify(t.meta)),t&&t.props&&(a.p=t.props),t&&t.revenue&&(a.$=t.revenue),s.getAttributeNames().filter(function(e){return"event-"===e.substring(0,6)})),r=a.p||{},p=(n.forEach(function(e){var t=e.replace("event-",""),e=s.getAttribute(e);r[t]=r[t]||e}),a.p=r,new XMLHttpRequest);p.open("POST",u,!0),p.setRequestHeader("Content-Type","text/plain"),p.send(JSON.stringify(a)),p.onreadystatechange=function(){4===p.readyState&&t&&t.callback&&t.callback({status:p.status})}}var t=window.plausible&&window.plausible.q||[];window.plausible=e;for(var a,n=0;n<t.length;n++)e.apply(this,t[n]);function i(){a!==o.pathname&&(a=o.pathname,e("pageview"))}var r,p=window.history;p.pushState&&(r=p.pushState,p.pushState=function(){r.apply(this,arguments),i()},window.addEventListener("popstate",i)),"prerender"===l.visibilityState?l.addEventListener("visibilitychange",function(){a||"visible"!==l.visibilityState||i()}):i();var d=1;function f(e){var t,a,n,i;if("auxclick"!==e.type||e.button===d)return t=function(e){for(;e&&(void 0===e.tagName||!(t=e)||!t.tagName||"a"!==t.tagName.toLowerCase()||!e.href);)e=e.parentNode;var t;return e}(e.target),a=t&&t.href&&t.href.split("?")[0],(n=t)&&n.href&&n.host&&n.host!==o.host?v(e,t,{name:"Outbound Link: Click",props:{url:t.href}}):(n=a)&&(i=n.split(".").pop(),b.some(function(e){return e===i}))?v(e,t,{name:"File Download",props:{url:a}}):void 0}function v(e,t,a){var n,i=!1;function r(){i||(i=!0,window.location=t.href)}!function(e,t){if(!e.defaultPrevented)return t=!t.target||t.target.match(/^_(self|parent|top)$/i),e=!(e.ctrlKey||e.metaKey||e.shiftKey)&&"click"===e.type,t&&e}(e,t)?((n={props:a.props}).revenue=a.revenue,plausible(a.name,n)):((n={props:a.props,callback:r}).revenue=a.revenue,plausible(a.name,n),setTimeout(r,5e3),e.preventDefault())}l.addEventListener("click",f),l.addEventListener("auxclick",f);var g=["pdf","xlsx","docx","txt","rtf","csv","exe","key","pps","ppt","pptx","7z","pkg","rar","gz","zip","avi","mov","mp4","mpeg","wmv","midi","mp3","wav","wma","dmg"],m=s.getAttribute("file-types"),w=s.getAttribute("add-file-types"),b=m&&m.split(",")||w&&w.split(",").concat(g)||g}();
\ No newline at end of file
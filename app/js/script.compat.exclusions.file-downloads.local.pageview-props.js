@@ -1 +1 @@
-!function(){"use strict";var l=window.location,o=window.document,s=o.getElementById("plausible"),u=s.getAttribute("data-api")||(g=(g=s).src.split("/"),p=g[0],g=g[2],p+"//"+g+"/api/event");function c(t,e){t&&console.warn("Ignoring Event: "+t),e&&e.callback&&e.callback()}function t(t,e){try{if("true"===window.localStorage.plausible_ignore)return c("localStorage flag",e)}catch(t){}var a=s&&s.getAttribute("data-include"),i=s&&s.getAttribute("data-exclude");if("pageview"===t){a=!a||a.split(",").some(n),i=i&&i.split(",").some(n);if(!a||i)return c("exclusion rule",e)}function n(t){return l.pathname.match(new RegExp("^"+t.trim().replace(/\*\*/g,".*").replace(/([^\.])\*/g,"$1[^\\s/]*")+"/?$"))}var a={},i=(a.n=t,a.u=l.href,a.d=s.getAttribute("data-domain"),a.r=o.referrer||null,e&&e.meta&&(a.m=JSON.stringify(e.meta)),e&&e.props&&(a.p=e.props),s.getAttributeNames().filter(function(t){return"event-"===t.substring(0,6)})),r=a.p||{},p=(i.forEach(function(t){var e=t.replace("event-",""),t=s.getAttribute(t);r[e]=r[e]||t}),a.p=r,new XMLHttpRequest);p.open("POST",u,!0),p.setRequestHeader("Content-Type","text/plain"),p.send(JSON.stringify(a)),p.onreadystatechange=function(){4===p.readyState&&e&&e.callback&&e.callback({status:p.status})}}var e=window.plausible&&window.plausible.q||[];window.plausible=t;for(var a,i=0;i<e.length;i++)t.apply(this,e[i]);function n(){a!==l.pathname&&(a=l.pathname,t("pageview"))}var r,p=window.history;p.pushState&&(r=p.pushState,p.pushState=function(){r.apply(this,arguments),n()},window.addEventListener("popstate",n)),"prerender"===o.visibilityState?o.addEventListener("visibilitychange",function(){a||"visible"!==o.visibilityState||n()}):n();var d=1;function f(t){var e,a,i,n,r,p,l;function o(){n||(n=!0,window.location=i.href)}"auxclick"===t.type&&t.button!==d||(e=function(t){for(;t&&(void 0===t.tagName||!(e=t)||!e.tagName||"a"!==e.tagName.toLowerCase()||!t.href);)t=t.parentNode;var e;return t}(t.target),a=e&&e.href&&e.href.split("?")[0],(p=a)&&(l=p.split(".").pop(),w.some(function(t){return t===l}))&&(n=!(p={name:"File Download",props:{url:a}}),!function(t,e){if(!t.defaultPrevented)return e=!e.target||e.target.match(/^_(self|parent|top)$/i),t=!(t.ctrlKey||t.metaKey||t.shiftKey)&&"click"===t.type,e&&t}(a=t,i=e)?(r={props:p.props},plausible(p.name,r)):(r={props:p.props,callback:o},plausible(p.name,r),setTimeout(o,5e3),a.preventDefault())))}o.addEventListener("click",f),o.addEventListener("auxclick",f);var g=["pdf","xlsx","docx","txt","rtf","csv","exe","key","pps","ppt","pptx","7z","pkg","rar","gz","zip","avi","mov","mp4","mpeg","wmv","midi","mp3","wav","wma","dmg"],v=s.getAttribute("file-types"),m=s.getAttribute("add-file-types"),w=v&&v.split(",")||m&&m.split(",").concat(g)||g}();
\ No newline at end of file
+!function(){"use strict";var l=window.location,o=window.document,s=o.getElementById("plausible"),u=s.getAttribute("data-api")||(g=(g=s).src.split("/"),p=g[0],g=g[2],p+"//"+g+"/api/event");function c(t,e){t&&console.warn("Ignoring Event: "+t),e&&e.callback&&e.callback()}function t(t,e){try{if("true"===window.localStorage.plausible_ignore)return c("localStorage flag",e)}catch(t){}var a=s&&s.getAttribute("data-include"),i=s&&s.getAttribute("data-exclude");if("pageview"===t){a=!a||a.split(",").some(n),i=i&&i.split(",").some(n);if(!a||i)return c("exclusion rule",e)}function n(t){return l.pathname.match(new RegExp("^"+t.trim().replace(/\*\*/g,".*").replace(/([^\.])\*/g,"$1[^\\s/]*")+"/?$"))}var a={},i=(a.n=t,a.u=l.href,a.d=s.getAttribute("data-domain"),a.r=o.referrer||null,function(){var c=s.getAttribute("data-exclude-cookie");c&&o.cookie.split(";").some(function(t){return t.trim().split("=")[0]===c})&&(a.c=c)}(),e&&e.meta&&(a.m=JSON.stringify(e.meta)),e&&e.props&&(a.p=e.props),s.getAttributeNames().filter(function(t){return"event-"===t.substring(0,6)})),r=a.p||{},p=(i.forEach(function(t){var e=t.replace("event-",""),t=s.getAttribute(t);r[e]=r[e]||t}),a.p=r,new XMLHttpRequest);p.open("POST",u,!0),p.setRequestHeader("Content-Type","text/plain"),p.send(JSON.stringify(a)),p.onreadystatechange=function(){4===p.readyState&&e&&e.callback&&e.callback({status:p.status})}}var e=window.plausible&&window.plausible.q||[];window.plausible=t;for(var a,i=0;i<e.length;i++)t.apply(this,e[i]);function n(){a!==l.pathname&&(a=l.pathname,t("pageview"))}var r,p=window.history;p.pushState&&(r=p.pushState,p.pushState=function(){r.apply(this,arguments),n()},window.addEventListener("popstate",n)),"prerender"===o.visibilityState?o.addEventListener("visibilitychange",function(){a||"visible"!==o.visibilityState||n()}):n();var d=1;function f(t){var e,a,i,n,r,p,l;function o(){n||(n=!0,window.location=i.href)}"auxclick"===t.type&&t.button!==d||(e=function(t){for(;t&&(void 0===t.tagName||!(e=t)||!e.tagName||"a"!==e.tagName.toLowerCase()||!t.href);)t=t.parentNode;var e;return t}(t.target),a=e&&e.href&&e.href.split("?")[0],(p=a)&&(l=p.split(".").pop(),w.some(function(t){return t===l}))&&(n=!(p={name:"File Download",props:{url:a}}),!function(t,e){if(!t.defaultPrevented)return e=!e.target||e.target.match(/^_(self|parent|top)$/i),t=!(t.ctrlKey||t.metaKey||t.shiftKey)&&"click"===t.type,e&&t}(a=t,i=e)?(r={props:p.props},plausible(p.name,r)):(r={props:p.props,callback:o},plausible(p.name,r),setTimeout(o,5e3),a.preventDefault())))}o.addEventListener("click",f),o.addEventListener("auxclick",f);var g=["pdf","xlsx","docx","txt","rtf","csv","exe","key","pps","ppt","pptx","7z","pkg","rar","gz","zip","avi","mov","mp4","mpeg","wmv","midi","mp3","wav","wma","dmg"],v=s.getAttribute("file-types"),m=s.getAttribute("add-file-types"),w=v&&v.split(",")||m&&m.split(",").concat(g)||g}();
\ No newline at end of file
@@ -1 +1 @@
-!function(){"use strict";var p=window.location,o=window.document,l=o.getElementById("plausible"),s=l.getAttribute("data-api")||(g=(g=l).src.split("/"),u=g[0],g=g[2],u+"//"+g+"/api/event");function c(e,t){e&&console.warn("Ignoring Event: "+e),t&&t.callback&&t.callback()}function e(e,t){try{if("true"===window.localStorage.plausible_ignore)return c("localStorage flag",t)}catch(e){}var n=l&&l.getAttribute("data-include"),a=l&&l.getAttribute("data-exclude");if("pageview"===e){n=!n||n.split(",").some(r),a=a&&a.split(",").some(r);if(!n||a)return c("exclusion rule",t)}function r(e){return p.pathname.match(new RegExp("^"+e.trim().replace(/\*\*/g,".*").replace(/([^\.])\*/g,"$1[^\\s/]*")+"/?$"))}var n={},a=(n.n=e,n.u=p.href,n.d=l.getAttribute("data-domain"),n.r=o.referrer||null,t&&t.meta&&(n.m=JSON.stringify(t.meta)),t&&t.props&&(n.p=t.props),t&&t.revenue&&(n.$=t.revenue),l.getAttributeNames().filter(function(e){return"event-"===e.substring(0,6)})),i=n.p||{},u=(a.forEach(function(e){var t=e.replace("event-",""),e=l.getAttribute(e);i[t]=i[t]||e}),n.p=i,new XMLHttpRequest);u.open("POST",s,!0),u.setRequestHeader("Content-Type","text/plain"),u.send(JSON.stringify(n)),u.onreadystatechange=function(){4===u.readyState&&t&&t.callback&&t.callback({status:u.status})}}var t=window.plausible&&window.plausible.q||[];window.plausible=e;for(var n,a=0;a<t.length;a++)e.apply(this,t[a]);function r(){n!==p.pathname&&(n=p.pathname,e("pageview"))}var i,u=window.history;function f(e){return e&&e.tagName&&"a"===e.tagName.toLowerCase()}u.pushState&&(i=u.pushState,u.pushState=function(){i.apply(this,arguments),r()},window.addEventListener("popstate",r)),"prerender"===o.visibilityState?o.addEventListener("visibilitychange",function(){n||"visible"!==o.visibilityState||r()}):r();var v=1;function d(e){var t,n,a,r;if("auxclick"!==e.type||e.button===v)return t=function(e){for(;e&&(void 0===e.tagName||!f(e)||!e.href);)e=e.parentNode;return e}(e.target),n=t&&t.href&&t.href.split("?")[0],!function e(t,n){if(!t||k<n)return!1;if(L(t))return!0;return e(t.parentNode,n+1)}(t,0)&&(a=n)&&(r=a.split(".").pop(),h.some(function(e){return e===r}))?m(e,t,{name:"File Download",props:{url:n}}):void 0}function m(e,t,n){var a,r=!1;function i(){r||(r=!0,window.location=t.href)}!function(e,t){if(!e.defaultPrevented)return t=!t.target||t.target.match(/^_(self|parent|top)$/i),e=!(e.ctrlKey||e.metaKey||e.shiftKey)&&"click"===e.type,t&&e}(e,t)?((a={props:n.props}).revenue=n.revenue,plausible(n.name,a)):((a={props:n.props,callback:i}).revenue=n.revenue,plausible(n.name,a),setTimeout(i,5e3),e.preventDefault())}o.addEventListener("click",d),o.addEventListener("auxclick",d);var g=["pdf","xlsx","docx","txt","rtf","csv","exe","key","pps","ppt","pptx","7z","pkg","rar","gz","zip","avi","mov","mp4","mpeg","wmv","midi","mp3","wav","wma","dmg"],b=l.getAttribute("file-types"),w=l.getAttribute("add-file-types"),h=b&&b.split(",")||w&&w.split(",").concat(g)||g;function y(e){var e=L(e)?e:e&&e.parentNode,t={name:null,props:{},revenue:{}},n=e&&e.classList;if(n)for(var a=0;a<n.length;a++){var r,i,u=n.item(a),p=u.match(/plausible-event-(.+)(=|--)(.+)/),p=(p&&(r=p[1],i=p[3].replace(/\+/g," "),"name"==r.toLowerCase()?t.name=i:t.props[r]=i),u.match(/plausible-revenue-(.+)(=|--)(.+)/));p&&(r=p[1],i=p[3],t.revenue[r]=i)}return t}var k=3;function x(e){if("auxclick"!==e.type||e.button===v){for(var t,n,a,r,i=e.target,u=0;u<=k&&i;u++){if((a=i)&&a.tagName&&"form"===a.tagName.toLowerCase())return;f(i)&&(t=i),L(i)&&(n=i),i=i.parentNode}n&&(r=y(n),t?(r.props.url=t.href,m(e,t,r)):((e={}).props=r.props,e.revenue=r.revenue,plausible(r.name,e)))}}function L(e){var t=e&&e.classList;if(t)for(var n=0;n<t.length;n++)if(t.item(n).match(/plausible-event-name(=|--)(.+)/))return!0;return!1}o.addEventListener("submit",function(e){var t,n=e.target,a=y(n);function r(){t||(t=!0,n.submit())}a.name&&(e.preventDefault(),t=!1,setTimeout(r,5e3),(e={props:a.props,callback:r}).revenue=a.revenue,plausible(a.name,e))}),o.addEventListener("click",x),o.addEventListener("auxclick",x)}();
\ No newline at end of file
+!function(){"use strict";var p=window.location,o=window.document,l=o.getElementById("plausible"),s=l.getAttribute("data-api")||(g=(g=l).src.split("/"),u=g[0],g=g[2],u+"//"+g+"/api/event");function c(e,t){e&&console.warn("Ignoring Event: "+e),t&&t.callback&&t.callback()}function e(e,t){try{if("true"===window.localStorage.plausible_ignore)return c("localStorage flag",t)}catch(e){}var n=l&&l.getAttribute("data-include"),a=l&&l.getAttribute("data-exclude");if("pageview"===e){n=!n||n.split(",").some(r),a=a&&a.split(",").some(r);if(!n||a)return c("exclusion rule",t)}function r(e){return p.pathname.match(new RegExp("^"+e.trim().replace(/\*\*/g,".*").replace(/([^\.])\*/g,"$1[^\\s/]*")+"/?$"))}var n={},a=(n.n=e,n.u=p.href,n.d=l.getAttribute("data-domain"),n.r=o.referrer||null,function(){var c=l.getAttribute("data-exclude-cookie");c&&o.cookie.split(";").some(function(t){return t.trim().split("=")[0]===c})&&(n.c=c)}(),t&&t.meta&&(n.m=JSON.stringify(t.meta)),t&&t.props&&(n.p=t.props),t&&t.revenue&&(n.$=t.revenue),l.getAttributeNames().filter(function(e){return"event-"===e.substring(0,6)})),i=n.p||{},u=(a.forEach(function(e){var t=e.replace("event-",""),e=l.getAttribute(e);i[t]=i[t]||e}),n.p=i,new XMLHttpRequest);u.open("POST",s,!0),u.setRequestHeader("Content-Type","text/plain"),u.send(JSON.stringify(n)),u.onreadystatechange=function(){4===u.readyState&&t&&t.callback&&t.callback({status:u.status})}}var t=window.plausible&&window.plausible.q||[];window.plausible=e;for(var n,a=0;a<t.length;a++)e.apply(this,t[a]);function r(){n!==p.pathname&&(n=p.pathname,e("pageview"))}var i,u=window.history;function f(e){return e&&e.tagName&&"a"===e.tagName.toLowerCase()}u.pushState&&(i=u.pushState,u.pushState=function(){i.apply(this,arguments),r()},window.addEventListener("popstate",r)),"prerender"===o.visibilityState?o.addEventListener("visibilitychange",function(){n||"visible"!==o.visibilityState||r()}):r();var v=1;function d(e){var t,n,a,r;if("auxclick"!==e.type||e.button===v)return t=function(e){for(;e&&(void 0===e.tagName||!f(e)||!e.href);)e=e.parentNode;return e}(e.target),n=t&&t.href&&t.href.split("?")[0],!function e(t,n){if(!t||k<n)return!1;if(L(t))return!0;return e(t.parentNode,n+1)}(t,0)&&(a=n)&&(r=a.split(".").pop(),h.some(function(e){return e===r}))?m(e,t,{name:"File Download",props:{url:n}}):void 0}function m(e,t,n){var a,r=!1;function i(){r||(r=!0,window.location=t.href)}!function(e,t){if(!e.defaultPrevented)return t=!t.target||t.target.match(/^_(self|parent|top)$/i),e=!(e.ctrlKey||e.metaKey||e.shiftKey)&&"click"===e.type,t&&e}(e,t)?((a={props:n.props}).revenue=n.revenue,plausible(n.name,a)):((a={props:n.props,callback:i}).revenue=n.revenue,plausible(n.name,a),setTimeout(i,5e3),e.preventDefault())}o.addEventListener("click",d),o.addEventListener("auxclick",d);var g=["pdf","xlsx","docx","txt","rtf","csv","exe","key","pps","ppt","pptx","7z","pkg","rar","gz","zip","avi","mov","mp4","mpeg","wmv","midi","mp3","wav","wma","dmg"],b=l.getAttribute("file-types"),w=l.getAttribute("add-file-types"),h=b&&b.split(",")||w&&w.split(",").concat(g)||g;function y(e){var e=L(e)?e:e&&e.parentNode,t={name:null,props:{},revenue:{}},n=e&&e.classList;if(n)for(var a=0;a<n.length;a++){var r,i,u=n.item(a),p=u.match(/plausible-event-(.+)(=|--)(.+)/),p=(p&&(r=p[1],i=p[3].replace(/\+/g," "),"name"==r.toLowerCase()?t.name=i:t.props[r]=i),u.match(/plausible-revenue-(.+)(=|--)(.+)/));p&&(r=p[1],i=p[3],t.revenue[r]=i)}return t}var k=3;function x(e){if("auxclick"!==e.type||e.button===v){for(var t,n,a,r,i=e.target,u=0;u<=k&&i;u++){if((a=i)&&a.tagName&&"form"===a.tagName.toLowerCase())return;f(i)&&(t=i),L(i)&&(n=i),i=i.parentNode}n&&(r=y(n),t?(r.props.url=t.href,m(e,t,r)):((e={}).props=r.props,e.revenue=r.revenue,plausible(r.name,e)))}}function L(e){var t=e&&e.classList;if(t)for(var n=0;n<t.length;n++)if(t.item(n).match(/plausible-event-name(=|--)(.+)/))return!0;return!1}o.addEventListener("submit",function(e){var t,n=e.target,a=y(n);function r(){t||(t=!0,n.submit())}a.name&&(e.preventDefault(),t=!1,setTimeout(r,5e3),(e={props:a.props,callback:r}).revenue=a.revenue,plausible(a.name,e))}),o.addEventListener("click",x),o.addEventListener("auxclick",x)}();
\ No newline at end of file
@@ -1 +1 @@
-!function(){"use strict";var p=window.location,l=window.document,s=l.getElementById("plausible"),u=s.getAttribute("data-api")||(g=(g=s).src.split("/"),o=g[0],g=g[2],o+"//"+g+"/api/event");function c(t,e){t&&console.warn("Ignoring Event: "+t),e&&e.callback&&e.callback()}function t(t,e){if(/^localhost$|^127(\.[0-9]+){0,2}\.[0-9]+$|^\[::1?\]$/.test(p.hostname)||"file:"===p.protocol)return c("localhost",e);if((window._phantom||window.__nightmare||window.navigator.webdriver||window.Cypress)&&!window.__plausible)return c(null,e);try{if("true"===window.localStorage.plausible_ignore)return c("localStorage flag",e)}catch(t){}var a=s&&s.getAttribute("data-include"),n=s&&s.getAttribute("data-exclude");if("pageview"===t){a=!a||a.split(",").some(r),n=n&&n.split(",").some(r);if(!a||n)return c("exclusion rule",e)}function r(t){return p.pathname.match(new RegExp("^"+t.trim().replace(/\*\*/g,".*").replace(/([^\.])\*/g,"$1[^\\s/]*")+"/?$"))}var a={},n=(a.n=t,a.u=p.href,a.d=s.getAttribute("data-domain"),a.r=l.referrer||null,e&&e.meta&&(a.m=JSON.stringify(e.meta)),e&&e.props&&(a.p=e.props),s.getAttributeNames().filter(function(t){return"event-"===t.substring(0,6)})),i=a.p||{},o=(n.forEach(function(t){var e=t.replace("event-",""),t=s.getAttribute(t);i[e]=i[e]||t}),a.p=i,new XMLHttpRequest);o.open("POST",u,!0),o.setRequestHeader("Content-Type","text/plain"),o.send(JSON.stringify(a)),o.onreadystatechange=function(){4===o.readyState&&e&&e.callback&&e.callback({status:o.status})}}var e=window.plausible&&window.plausible.q||[];window.plausible=t;for(var a,n=0;n<e.length;n++)t.apply(this,e[n]);function r(){a!==p.pathname&&(a=p.pathname,t("pageview"))}var i,o=window.history;function f(t){return t&&t.tagName&&"a"===t.tagName.toLowerCase()}o.pushState&&(i=o.pushState,o.pushState=function(){i.apply(this,arguments),r()},window.addEventListener("popstate",r)),"prerender"===l.visibilityState?l.addEventListener("visibilitychange",function(){a||"visible"!==l.visibilityState||r()}):r();var d=1;function m(t){if("auxclick"!==t.type||t.button===d){var e,a,n=function(t){for(;t&&(void 0===t.tagName||!f(t)||!t.href);)t=t.parentNode;return t}(t.target),r=n&&n.href&&n.href.split("?")[0];if(!function t(e,a){if(!e||k<a)return!1;if(L(e))return!0;return t(e.parentNode,a+1)}(n,0))return(e=n)&&e.href&&e.host&&e.host!==p.host?v(t,n,{name:"Outbound Link: Click",props:{url:n.href}}):(e=r)&&(a=e.split(".").pop(),h.some(function(t){return t===a}))?v(t,n,{name:"File Download",props:{url:r}}):void 0}}function v(t,e,a){var n,r=!1;function i(){r||(r=!0,window.location=e.href)}!function(t,e){if(!t.defaultPrevented)return e=!e.target||e.target.match(/^_(self|parent|top)$/i),t=!(t.ctrlKey||t.metaKey||t.shiftKey)&&"click"===t.type,e&&t}(t,e)?(n={props:a.props},plausible(a.name,n)):(n={props:a.props,callback:i},plausible(a.name,n),setTimeout(i,5e3),t.preventDefault())}l.addEventListener("click",m),l.addEventListener("auxclick",m);var g=["pdf","xlsx","docx","txt","rtf","csv","exe","key","pps","ppt","pptx","7z","pkg","rar","gz","zip","avi","mov","mp4","mpeg","wmv","midi","mp3","wav","wma","dmg"],w=s.getAttribute("file-types"),b=s.getAttribute("add-file-types"),h=w&&w.split(",")||b&&b.split(",").concat(g)||g;function y(t){var t=L(t)?t:t&&t.parentNode,e={name:null,props:{}},a=t&&t.classList;if(a)for(var n=0;n<a.length;n++){var r,i=a.item(n).match(/plausible-event-(.+)(=|--)(.+)/);i&&(r=i[1],i=i[3].replace(/\+/g," "),"name"==r.toLowerCase()?e.name=i:e.props[r]=i)}return e}var k=3;function x(t){if("auxclick"!==t.type||t.button===d){for(var e,a,n,r,i=t.target,o=0;o<=k&&i;o++){if((n=i)&&n.tagName&&"form"===n.tagName.toLowerCase())return;f(i)&&(e=i),L(i)&&(a=i),i=i.parentNode}a&&(r=y(a),e?(r.props.url=e.href,v(t,e,r)):((t={}).props=r.props,plausible(r.name,t)))}}function L(t){var e=t&&t.classList;if(e)for(var a=0;a<e.length;a++)if(e.item(a).match(/plausible-event-name(=|--)(.+)/))return!0;return!1}l.addEventListener("submit",function(t){var e,a=t.target,n=y(a);function r(){e||(e=!0,a.submit())}n.name&&(t.preventDefault(),e=!1,setTimeout(r,5e3),t={props:n.props,callback:r},plausible(n.name,t))}),l.addEventListener("click",x),l.addEventListener("auxclick",x)}();
\ No newline at end of file
+!function(){"use strict";var p=window.location,l=window.document,s=l.getElementById("plausible"),u=s.getAttribute("data-api")||(g=(g=s).src.split("/"),o=g[0],g=g[2],o+"//"+g+"/api/event");function c(t,e){t&&console.warn("Ignoring Event: "+t),e&&e.callback&&e.callback()}function t(t,e){if(/^localhost$|^127(\.[0-9]+){0,2}\.[0-9]+$|^\[::1?\]$/.test(p.hostname)||"file:"===p.protocol)return c("localhost",e);if((window._phantom||window.__nightmare||window.navigator.webdriver||window.Cypress)&&!window.__plausible)return c(null,e);try{if("true"===window.localStorage.plausible_ignore)return c("localStorage flag",e)}catch(t){}var a=s&&s.getAttribute("data-include"),n=s&&s.getAttribute("data-exclude");if("pageview"===t){a=!a||a.split(",").some(r),n=n&&n.split(",").some(r);if(!a||n)return c("exclusion rule",e)}function r(t){return p.pathname.match(new RegExp("^"+t.trim().replace(/\*\*/g,".*").replace(/([^\.])\*/g,"$1[^\\s/]*")+"/?$"))}var a={},n=(a.n=t,a.u=p.href,a.d=s.getAttribute("data-domain"),a.r=l.referrer||null,function(){var c=s.getAttribute("data-exclude-cookie");c&&l.cookie.split(";").some(function(t){return t.trim().split("=")[0]===c})&&(a.c=c)}(),e&&e.meta&&(a.m=JSON.stringify(e.meta)),e&&e.props&&(a.p=e.props),s.getAttributeNames().filter(function(t){return"event-"===t.substring(0,6)})),i=a.p||{},o=(n.forEach(function(t){var e=t.replace("event-",""),t=s.getAttribute(t);i[e]=i[e]||t}),a.p=i,new XMLHttpRequest);o.open("POST",u,!0),o.setRequestHeader("Content-Type","text/plain"),o.send(JSON.stringify(a)),o.onreadystatechange=function(){4===o.readyState&&e&&e.callback&&e.callback({status:o.status})}}var e=window.plausible&&window.plausible.q||[];window.plausible=t;for(var a,n=0;n<e.length;n++)t.apply(this,e[n]);function r(){a!==p.pathname&&(a=p.pathname,t("pageview"))}var i,o=window.history;function f(t){return t&&t.tagName&&"a"===t.tagName.toLowerCase()}o.pushState&&(i=o.pushState,o.pushState=function(){i.apply(this,arguments),r()},window.addEventListener("popstate",r)),"prerender"===l.visibilityState?l.addEventListener("visibilitychange",function(){a||"visible"!==l.visibilityState||r()}):r();var d=1;function m(t){if("auxclick"!==t.type||t.button===d){var e,a,n=function(t){for(;t&&(void 0===t.tagName||!f(t)||!t.href);)t=t.parentNode;return t}(t.target),r=n&&n.href&&n.href.split("?")[0];if(!function t(e,a){if(!e||k<a)return!1;if(L(e))return!0;return t(e.parentNode,a+1)}(n,0))return(e=n)&&e.href&&e.host&&e.host!==p.host?v(t,n,{name:"Outbound Link: Click",props:{url:n.href}}):(e=r)&&(a=e.split(".").pop(),h.some(function(t){return t===a}))?v(t,n,{name:"File Download",props:{url:r}}):void 0}}function v(t,e,a){var n,r=!1;function i(){r||(r=!0,window.location=e.href)}!function(t,e){if(!t.defaultPrevented)return e=!e.target||e.target.match(/^_(self|parent|top)$/i),t=!(t.ctrlKey||t.metaKey||t.shiftKey)&&"click"===t.type,e&&t}(t,e)?(n={props:a.props},plausible(a.name,n)):(n={props:a.props,callback:i},plausible(a.name,n),setTimeout(i,5e3),t.preventDefault())}l.addEventListener("click",m),l.addEventListener("auxclick",m);var g=["pdf","xlsx","docx","txt","rtf","csv","exe","key","pps","ppt","pptx","7z","pkg","rar","gz","zip","avi","mov","mp4","mpeg","wmv","midi","mp3","wav","wma","dmg"],w=s.getAttribute("file-types"),b=s.getAttribute("add-file-types"),h=w&&w.split(",")||b&&b.split(",").concat(g)||g;function y(t){var t=L(t)?t:t&&t.parentNode,e={name:null,props:{}},a=t&&t.classList;if(a)for(var n=0;n<a.length;n++){var r,i=a.item(n).match(/plausible-event-(.+)(=|--)(.+)/);i&&(r=i[1],i=i[3].replace(/\+/g," "),"name"==r.toLowerCase()?e.name=i:e.props[r]=i)}return e}var k=3;function x(t){if("auxclick"!==t.type||t.button===d){for(var e,a,n,r,i=t.target,o=0;o<=k&&i;o++){if((n=i)&&n.tagName&&"form"===n.tagName.toLowerCase())return;f(i)&&(e=i),L(i)&&(a=i),i=i.parentNode}a&&(r=y(a),e?(r.props.url=e.href,v(t,e,r)):((t={}).props=r.props,plausible(r.name,t)))}}function L(t){var e=t&&t.classList;if(e)for(var a=0;a<e.length;a++)if(e.item(a).match(/plausible-event-name(=|--)(.+)/))return!0;return!1}l.addEventListener("submit",function(t){var e,a=t.target,n=y(a);function r(){e||(e=!0,a.submit())}n.name&&(t.preventDefault(),e=!1,setTimeout(r,5e3),t={props:n.props,callback:r},plausible(n.name,t))}),l.addEventListener("click",x),l.addEventListener("auxclick",x)}();
\ No newline at end of file
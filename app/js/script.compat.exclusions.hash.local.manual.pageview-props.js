@@ -1 +1 @@
-!function(){"use strict";var t,e,u=window.location,o=window.document,s=o.getElementById("plausible"),c=s.getAttribute("data-api")||(t=(t=s).src.split("/"),e=t[0],t=t[2],e+"//"+t+"/api/event");function p(t,e){t&&console.warn("Ignoring Event: "+t),e&&e.callback&&e.callback()}function a(t,e){try{if("true"===window.localStorage.plausible_ignore)return p("localStorage flag",e)}catch(t){}var a=s&&s.getAttribute("data-include"),n=s&&s.getAttribute("data-exclude");if("pageview"===t){a=!a||a.split(",").some(r),n=n&&n.split(",").some(r);if(!a||n)return p("exclusion rule",e)}function r(t){var e=u.pathname;return(e+=u.hash).match(new RegExp("^"+t.trim().replace(/\*\*/g,".*").replace(/([^\.])\*/g,"$1[^\\s/]*")+"/?$"))}var a={},n=(a.n=t,a.u=e&&e.u?e.u:u.href,a.d=s.getAttribute("data-domain"),a.r=o.referrer||null,e&&e.meta&&(a.m=JSON.stringify(e.meta)),e&&e.props&&(a.p=e.props),s.getAttributeNames().filter(function(t){return"event-"===t.substring(0,6)})),i=a.p||{},l=(n.forEach(function(t){var e=t.replace("event-",""),t=s.getAttribute(t);i[e]=i[e]||t}),a.p=i,a.h=1,new XMLHttpRequest);l.open("POST",c,!0),l.setRequestHeader("Content-Type","text/plain"),l.send(JSON.stringify(a)),l.onreadystatechange=function(){4===l.readyState&&e&&e.callback&&e.callback({status:l.status})}}var n=window.plausible&&window.plausible.q||[];window.plausible=a;for(var r=0;r<n.length;r++)a.apply(this,n[r])}();
\ No newline at end of file
+!function(){"use strict";var t,e,u=window.location,o=window.document,s=o.getElementById("plausible"),c=s.getAttribute("data-api")||(t=(t=s).src.split("/"),e=t[0],t=t[2],e+"//"+t+"/api/event");function p(t,e){t&&console.warn("Ignoring Event: "+t),e&&e.callback&&e.callback()}function a(t,e){try{if("true"===window.localStorage.plausible_ignore)return p("localStorage flag",e)}catch(t){}var a=s&&s.getAttribute("data-include"),n=s&&s.getAttribute("data-exclude");if("pageview"===t){a=!a||a.split(",").some(r),n=n&&n.split(",").some(r);if(!a||n)return p("exclusion rule",e)}function r(t){var e=u.pathname;return(e+=u.hash).match(new RegExp("^"+t.trim().replace(/\*\*/g,".*").replace(/([^\.])\*/g,"$1[^\\s/]*")+"/?$"))}var a={},n=(a.n=t,a.u=e&&e.u?e.u:u.href,a.d=s.getAttribute("data-domain"),a.r=o.referrer||null,function(){var c=s.getAttribute("data-exclude-cookie");c&&o.cookie.split(";").some(function(t){return t.trim().split("=")[0]===c})&&(a.c=c)}(),e&&e.meta&&(a.m=JSON.stringify(e.meta)),e&&e.props&&(a.p=e.props),s.getAttributeNames().filter(function(t){return"event-"===t.substring(0,6)})),i=a.p||{},l=(n.forEach(function(t){var e=t.replace("event-",""),t=s.getAttribute(t);i[e]=i[e]||t}),a.p=i,a.h=1,new XMLHttpRequest);l.open("POST",c,!0),l.setRequestHeader("Content-Type","text/plain"),l.send(JSON.stringify(a)),l.onreadystatechange=function(){4===l.readyState&&e&&e.callback&&e.callback({status:l.status})}}var n=window.plausible&&window.plausible.q||[];window.plausible=a;for(var r=0;r<n.length;r++)a.apply(this,n[r])}();
\ No newline at end of file
@@ -1 +1 @@
-!function(){"use strict";var e,t,o=window.location,u=window.document,c=u.getElementById("plausible"),s=c.getAttribute("data-api")||(e=(e=c).src.split("/"),t=e[0],e=e[2],t+"//"+e+"/api/event");function p(e,t){e&&console.warn("Ignoring Event: "+e),t&&t.callback&&t.callback()}function a(e,t){try{if("true"===window.localStorage.plausible_ignore)return p("localStorage flag",t)}catch(e){}var a=c&&c.getAttribute("data-include"),r=c&&c.getAttribute("data-exclude");if("pageview"===e){a=!a||a.split(",").some(n),r=r&&r.split(",").some(n);if(!a||r)return p("exclusion rule",t)}function n(e){return o.pathname.match(new RegExp("^"+e.trim().replace(/\*\*/g,".*").replace(/([^\.])\*/g,"$1[^\\s/]*")+"/?$"))}var a={},r=(a.n=e,a.u=t&&t.u?t.u:o.href,a.d=c.getAttribute("data-domain"),a.r=u.referrer||null,t&&t.meta&&(a.m=JSON.stringify(t.meta)),t&&t.props&&(a.p=t.props),c.getAttributeNames().filter(function(e){return"event-"===e.substring(0,6)})),i=a.p||{},l=(r.forEach(function(e){var t=e.replace("event-",""),e=c.getAttribute(e);i[t]=i[t]||e}),a.p=i,new XMLHttpRequest);l.open("POST",s,!0),l.setRequestHeader("Content-Type","text/plain"),l.send(JSON.stringify(a)),l.onreadystatechange=function(){4===l.readyState&&t&&t.callback&&t.callback({status:l.status})}}var r=window.plausible&&window.plausible.q||[];window.plausible=a;for(var n=0;n<r.length;n++)a.apply(this,r[n]);var f=1;function i(e){var t,a,r,n,i;function l(){r||(r=!0,window.location=a.href)}"auxclick"===e.type&&e.button!==f||((t=function(e){for(;e&&(void 0===e.tagName||!(t=e)||!t.tagName||"a"!==t.tagName.toLowerCase()||!e.href);)e=e.parentNode;var t;return e}(e.target))&&t.href&&t.href.split("?")[0],(i=t)&&i.href&&i.host&&i.host!==o.host&&(i=e,e={name:"Outbound Link: Click",props:{url:(a=t).href}},r=!1,!function(e,t){if(!e.defaultPrevented)return t=!t.target||t.target.match(/^_(self|parent|top)$/i),e=!(e.ctrlKey||e.metaKey||e.shiftKey)&&"click"===e.type,t&&e}(i,a)?(n={props:e.props},plausible(e.name,n)):(n={props:e.props,callback:l},plausible(e.name,n),setTimeout(l,5e3),i.preventDefault())))}u.addEventListener("click",i),u.addEventListener("auxclick",i)}();
\ No newline at end of file
+!function(){"use strict";var e,t,o=window.location,u=window.document,c=u.getElementById("plausible"),s=c.getAttribute("data-api")||(e=(e=c).src.split("/"),t=e[0],e=e[2],t+"//"+e+"/api/event");function p(e,t){e&&console.warn("Ignoring Event: "+e),t&&t.callback&&t.callback()}function a(e,t){try{if("true"===window.localStorage.plausible_ignore)return p("localStorage flag",t)}catch(e){}var a=c&&c.getAttribute("data-include"),r=c&&c.getAttribute("data-exclude");if("pageview"===e){a=!a||a.split(",").some(n),r=r&&r.split(",").some(n);if(!a||r)return p("exclusion rule",t)}function n(e){return o.pathname.match(new RegExp("^"+e.trim().replace(/\*\*/g,".*").replace(/([^\.])\*/g,"$1[^\\s/]*")+"/?$"))}var a={},r=(a.n=e,a.u=t&&t.u?t.u:o.href,a.d=c.getAttribute("data-domain"),a.r=u.referrer||null,function(){var x=c.getAttribute("data-exclude-cookie");x&&u.cookie.split(";").some(function(t){return t.trim().split("=")[0]===x})&&(a.c=x)}(),t&&t.meta&&(a.m=JSON.stringify(t.meta)),t&&t.props&&(a.p=t.props),c.getAttributeNames().filter(function(e){return"event-"===e.substring(0,6)})),i=a.p||{},l=(r.forEach(function(e){var t=e.replace("event-",""),e=c.getAttribute(e);i[t]=i[t]||e}),a.p=i,new XMLHttpRequest);l.open("POST",s,!0),l.setRequestHeader("Content-Type","text/plain"),l.send(JSON.stringify(a)),l.onreadystatechange=function(){4===l.readyState&&t&&t.callback&&t.callback({status:l.status})}}var r=window.plausible&&window.plausible.q||[];window.plausible=a;for(var n=0;n<r.length;n++)a.apply(this,r[n]);var f=1;function i(e){var t,a,r,n,i;function l(){r||(r=!0,window.location=a.href)}"auxclick"===e.type&&e.button!==f||((t=function(e){for(;e&&(void 0===e.tagName||!(t=e)||!t.tagName||"a"!==t.tagName.toLowerCase()||!e.href);)e=e.parentNode;var t;return e}(e.target))&&t.href&&t.href.split("?")[0],(i=t)&&i.href&&i.host&&i.host!==o.host&&(i=e,e={name:"Outbound Link: Click",props:{url:(a=t).href}},r=!1,!function(e,t){if(!e.defaultPrevented)return t=!t.target||t.target.match(/^_(self|parent|top)$/i),e=!(e.ctrlKey||e.metaKey||e.shiftKey)&&"click"===e.type,t&&e}(i,a)?(n={props:e.props},plausible(e.name,n)):(n={props:e.props,callback:l},plausible(e.name,n),setTimeout(l,5e3),i.preventDefault())))}u.addEventListener("click",i),u.addEventListener("auxclick",i)}();
\ No newline at end of file
@@ -1 +1 @@
-!function(){"use strict";var t,o=window.location,l=window.document,s=l.getElementById("plausible"),p=s.getAttribute("data-api")||(t=(t=s).src.split("/"),d=t[0],t=t[2],d+"//"+t+"/api/event");function u(t,e){t&&console.warn("Ignoring Event: "+t),e&&e.callback&&e.callback()}function e(t,e){try{if("true"===window.localStorage.plausible_ignore)return u("localStorage flag",e)}catch(t){}var a=s&&s.getAttribute("data-include"),i=s&&s.getAttribute("data-exclude");if("pageview"===t){a=!a||a.split(",").some(n),i=i&&i.split(",").some(n);if(!a||i)return u("exclusion rule",e)}function n(t){return o.pathname.match(new RegExp("^"+t.trim().replace(/\*\*/g,".*").replace(/([^\.])\*/g,"$1[^\\s/]*")+"/?$"))}var a={},r=(a.n=t,a.u=o.href,a.d=s.getAttribute("data-domain"),a.r=l.referrer||null,e&&e.meta&&(a.m=JSON.stringify(e.meta)),e&&e.props&&(a.p=e.props),new XMLHttpRequest);r.open("POST",p,!0),r.setRequestHeader("Content-Type","text/plain"),r.send(JSON.stringify(a)),r.onreadystatechange=function(){4===r.readyState&&e&&e.callback&&e.callback({status:r.status})}}var a=window.plausible&&window.plausible.q||[];window.plausible=e;for(var i,n=0;n<a.length;n++)e.apply(this,a[n]);function r(){i!==o.pathname&&(i=o.pathname,e("pageview"))}var c,d=window.history;d.pushState&&(c=d.pushState,d.pushState=function(){c.apply(this,arguments),r()},window.addEventListener("popstate",r)),"prerender"===l.visibilityState?l.addEventListener("visibilitychange",function(){i||"visible"!==l.visibilityState||r()}):r();var f=1;function g(t){var e,a,i,n,r;function l(){i||(i=!0,window.location=a.href)}"auxclick"===t.type&&t.button!==f||((e=function(t){for(;t&&(void 0===t.tagName||!(e=t)||!e.tagName||"a"!==e.tagName.toLowerCase()||!t.href);)t=t.parentNode;var e;return t}(t.target))&&e.href&&e.href.split("?")[0],(r=e)&&r.href&&r.host&&r.host!==o.host&&(r=t,t={name:"Outbound Link: Click",props:{url:(a=e).href}},i=!1,!function(t,e){if(!t.defaultPrevented)return e=!e.target||e.target.match(/^_(self|parent|top)$/i),t=!(t.ctrlKey||t.metaKey||t.shiftKey)&&"click"===t.type,e&&t}(r,a)?(n={props:t.props},plausible(t.name,n)):(n={props:t.props,callback:l},plausible(t.name,n),setTimeout(l,5e3),r.preventDefault())))}l.addEventListener("click",g),l.addEventListener("auxclick",g)}();
\ No newline at end of file
+!function(){"use strict";var t,o=window.location,l=window.document,s=l.getElementById("plausible"),p=s.getAttribute("data-api")||(t=(t=s).src.split("/"),d=t[0],t=t[2],d+"//"+t+"/api/event");function u(t,e){t&&console.warn("Ignoring Event: "+t),e&&e.callback&&e.callback()}function e(t,e){try{if("true"===window.localStorage.plausible_ignore)return u("localStorage flag",e)}catch(t){}var a=s&&s.getAttribute("data-include"),i=s&&s.getAttribute("data-exclude");if("pageview"===t){a=!a||a.split(",").some(n),i=i&&i.split(",").some(n);if(!a||i)return u("exclusion rule",e)}function n(t){return o.pathname.match(new RegExp("^"+t.trim().replace(/\*\*/g,".*").replace(/([^\.])\*/g,"$1[^\\s/]*")+"/?$"))}var a={},r=(a.n=t,a.u=o.href,a.d=s.getAttribute("data-domain"),a.r=l.referrer||null,function(){var c=s.getAttribute("data-exclude-cookie");c&&l.cookie.split(";").some(function(t){return t.trim().split("=")[0]===c})&&(a.c=c)}(),e&&e.meta&&(a.m=JSON.stringify(e.meta)),e&&e.props&&(a.p=e.props),new XMLHttpRequest);r.open("POST",p,!0),r.setRequestHeader("Content-Type","text/plain"),r.send(JSON.stringify(a)),r.onreadystatechange=function(){4===r.readyState&&e&&e.callback&&e.callback({status:r.status})}}var a=window.plausible&&window.plausible.q||[];window.plausible=e;for(var i,n=0;n<a.length;n++)e.apply(this,a[n]);function r(){i!==o.pathname&&(i=o.pathname,e("pageview"))}var c,d=window.history;d.pushState&&(c=d.pushState,d.pushState=function(){c.apply(this,arguments),r()},window.addEventListener("popstate",r)),"prerender"===l.visibilityState?l.addEventListener("visibilitychange",function(){i||"visible"!==l.visibilityState||r()}):r();var f=1;function g(t){var e,a,i,n,r;function l(){i||(i=!0,window.location=a.href)}"auxclick"===t.type&&t.button!==f||((e=function(t){for(;t&&(void 0===t.tagName||!(e=t)||!e.tagName||"a"!==e.tagName.toLowerCase()||!t.href);)t=t.parentNode;var e;return t}(t.target))&&e.href&&e.href.split("?")[0],(r=e)&&r.href&&r.host&&r.host!==o.host&&(r=t,t={name:"Outbound Link: Click",props:{url:(a=e).href}},i=!1,!function(t,e){if(!t.defaultPrevented)return e=!e.target||e.target.match(/^_(self|parent|top)$/i),t=!(t.ctrlKey||t.metaKey||t.shiftKey)&&"click"===t.type,e&&t}(r,a)?(n={props:t.props},plausible(t.name,n)):(n={props:t.props,callback:l},plausible(t.name,n),setTimeout(l,5e3),r.preventDefault())))}l.addEventListener("click",g),l.addEventListener("auxclick",g)}();
\ No newline at end of file
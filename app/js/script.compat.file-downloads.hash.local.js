@@ -1 +1 @@
-!function(){"use strict";var r=window.location,l=window.document,p=l.getElementById("plausible"),o=p.getAttribute("data-api")||(d=(d=p).src.split("/"),u=d[0],d=d[2],u+"//"+d+"/api/event");function e(e,t){try{if("true"===window.localStorage.plausible_ignore)return a=t,(i="localStorage flag")&&console.warn("Ignoring Event: "+i),void(a&&a.callback&&a.callback())}catch(e){}var a,i={},n=(i.n=e,i.u=r.href,i.d=p.getAttribute("data-domain"),i.r=l.referrer||null,t&&t.meta&&(i.m=JSON.stringify(t.meta)),t&&t.props&&(i.p=t.props),i.h=1,new XMLHttpRequest);n.open("POST",o,!0),n.setRequestHeader("Content-Type","text/plain"),n.send(JSON.stringify(i)),n.onreadystatechange=function(){4===n.readyState&&t&&t.callback&&t.callback({status:n.status})}}var t=window.plausible&&window.plausible.q||[];window.plausible=e;for(var a,i=0;i<t.length;i++)e.apply(this,t[i]);function n(){a=r.pathname,e("pageview")}window.addEventListener("hashchange",n),"prerender"===l.visibilityState?l.addEventListener("visibilitychange",function(){a||"visible"!==l.visibilityState||n()}):n();var s=1;function c(e){var t,a,i,n,r,l,p;function o(){n||(n=!0,window.location=i.href)}"auxclick"===e.type&&e.button!==s||(t=function(e){for(;e&&(void 0===e.tagName||!(t=e)||!t.tagName||"a"!==t.tagName.toLowerCase()||!e.href);)e=e.parentNode;var t;return e}(e.target),a=t&&t.href&&t.href.split("?")[0],(l=a)&&(p=l.split(".").pop(),g.some(function(e){return e===p}))&&(n=!(l={name:"File Download",props:{url:a}}),!function(e,t){if(!e.defaultPrevented)return t=!t.target||t.target.match(/^_(self|parent|top)$/i),e=!(e.ctrlKey||e.metaKey||e.shiftKey)&&"click"===e.type,t&&e}(a=e,i=t)?(r={props:l.props},plausible(l.name,r)):(r={props:l.props,callback:o},plausible(l.name,r),setTimeout(o,5e3),a.preventDefault())))}l.addEventListener("click",c),l.addEventListener("auxclick",c);var u=["pdf","xlsx","docx","txt","rtf","csv","exe","key","pps","ppt","pptx","7z","pkg","rar","gz","zip","avi","mov","mp4","mpeg","wmv","midi","mp3","wav","wma","dmg"],d=p.getAttribute("file-types"),f=p.getAttribute("add-file-types"),g=d&&d.split(",")||f&&f.split(",").concat(u)||u}();
\ No newline at end of file
+!function(){"use strict";var r=window.location,l=window.document,p=l.getElementById("plausible"),o=p.getAttribute("data-api")||(d=(d=p).src.split("/"),u=d[0],d=d[2],u+"//"+d+"/api/event");function e(e,t){try{if("true"===window.localStorage.plausible_ignore)return a=t,(i="localStorage flag")&&console.warn("Ignoring Event: "+i),void(a&&a.callback&&a.callback())}catch(e){}var a,i={},n=(i.n=e,i.u=r.href,i.d=p.getAttribute("data-domain"),i.r=l.referrer||null,function(){var c=p.getAttribute("data-exclude-cookie");c&&l.cookie.split(";").some(function(t){return t.trim().split("=")[0]===c})&&(i.c=c)}(),t&&t.meta&&(i.m=JSON.stringify(t.meta)),t&&t.props&&(i.p=t.props),i.h=1,new XMLHttpRequest);n.open("POST",o,!0),n.setRequestHeader("Content-Type","text/plain"),n.send(JSON.stringify(i)),n.onreadystatechange=function(){4===n.readyState&&t&&t.callback&&t.callback({status:n.status})}}var t=window.plausible&&window.plausible.q||[];window.plausible=e;for(var a,i=0;i<t.length;i++)e.apply(this,t[i]);function n(){a=r.pathname,e("pageview")}window.addEventListener("hashchange",n),"prerender"===l.visibilityState?l.addEventListener("visibilitychange",function(){a||"visible"!==l.visibilityState||n()}):n();var s=1;function c(e){var t,a,i,n,r,l,p;function o(){n||(n=!0,window.location=i.href)}"auxclick"===e.type&&e.button!==s||(t=function(e){for(;e&&(void 0===e.tagName||!(t=e)||!t.tagName||"a"!==t.tagName.toLowerCase()||!e.href);)e=e.parentNode;var t;return e}(e.target),a=t&&t.href&&t.href.split("?")[0],(l=a)&&(p=l.split(".").pop(),g.some(function(e){return e===p}))&&(n=!(l={name:"File Download",props:{url:a}}),!function(e,t){if(!e.defaultPrevented)return t=!t.target||t.target.match(/^_(self|parent|top)$/i),e=!(e.ctrlKey||e.metaKey||e.shiftKey)&&"click"===e.type,t&&e}(a=e,i=t)?(r={props:l.props},plausible(l.name,r)):(r={props:l.props,callback:o},plausible(l.name,r),setTimeout(o,5e3),a.preventDefault())))}l.addEventListener("click",c),l.addEventListener("auxclick",c);var u=["pdf","xlsx","docx","txt","rtf","csv","exe","key","pps","ppt","pptx","7z","pkg","rar","gz","zip","avi","mov","mp4","mpeg","wmv","midi","mp3","wav","wma","dmg"],d=p.getAttribute("file-types"),f=p.getAttribute("add-file-types"),g=d&&d.split(",")||f&&f.split(",").concat(u)||u}();
\ No newline at end of file
@@ -1 +1 @@
-!function(){"use strict";var i=window.location,l=window.document,p=l.getElementById("plausible"),o=p.getAttribute("data-api")||(c=(c=p).src.split("/"),n=c[0],c=c[2],n+"//"+c+"/api/event");function t(t,e){try{if("true"===window.localStorage.plausible_ignore)return a=e,(r="localStorage flag")&&console.warn("Ignoring Event: "+r),void(a&&a.callback&&a.callback())}catch(t){}var a,r={},n=(r.n=t,r.u=e&&e.u?e.u:i.href,r.d=p.getAttribute("data-domain"),r.r=l.referrer||null,e&&e.meta&&(r.m=JSON.stringify(e.meta)),e&&e.props&&(r.p=e.props),r.h=1,new XMLHttpRequest);n.open("POST",o,!0),n.setRequestHeader("Content-Type","text/plain"),n.send(JSON.stringify(r)),n.onreadystatechange=function(){4===n.readyState&&e&&e.callback&&e.callback({status:n.status})}}var e=window.plausible&&window.plausible.q||[];window.plausible=t;for(var a=0;a<e.length;a++)t.apply(this,e[a]);var s=1;function r(t){var e,a,r,n,i,l,p;function o(){n||(n=!0,window.location=r.href)}"auxclick"===t.type&&t.button!==s||(e=function(t){for(;t&&(void 0===t.tagName||!(e=t)||!e.tagName||"a"!==e.tagName.toLowerCase()||!t.href);)t=t.parentNode;var e;return t}(t.target),a=e&&e.href&&e.href.split("?")[0],(l=a)&&(p=l.split(".").pop(),d.some(function(t){return t===p}))&&(n=!(l={name:"File Download",props:{url:a}}),!function(t,e){if(!t.defaultPrevented)return e=!e.target||e.target.match(/^_(self|parent|top)$/i),t=!(t.ctrlKey||t.metaKey||t.shiftKey)&&"click"===t.type,e&&t}(a=t,r=e)?(i={props:l.props},plausible(l.name,i)):(i={props:l.props,callback:o},plausible(l.name,i),setTimeout(o,5e3),a.preventDefault())))}l.addEventListener("click",r),l.addEventListener("auxclick",r);var n=["pdf","xlsx","docx","txt","rtf","csv","exe","key","pps","ppt","pptx","7z","pkg","rar","gz","zip","avi","mov","mp4","mpeg","wmv","midi","mp3","wav","wma","dmg"],c=p.getAttribute("file-types"),u=p.getAttribute("add-file-types"),d=c&&c.split(",")||u&&u.split(",").concat(n)||n}();
\ No newline at end of file
+!function(){"use strict";var i=window.location,l=window.document,p=l.getElementById("plausible"),o=p.getAttribute("data-api")||(c=(c=p).src.split("/"),n=c[0],c=c[2],n+"//"+c+"/api/event");function t(t,e){try{if("true"===window.localStorage.plausible_ignore)return a=e,(r="localStorage flag")&&console.warn("Ignoring Event: "+r),void(a&&a.callback&&a.callback())}catch(t){}var a,r={},n=(r.n=t,r.u=e&&e.u?e.u:i.href,r.d=p.getAttribute("data-domain"),r.r=l.referrer||null,function(){var c=p.getAttribute("data-exclude-cookie");c&&l.cookie.split(";").some(function(t){return t.trim().split("=")[0]===c})&&(r.c=c)}(),e&&e.meta&&(r.m=JSON.stringify(e.meta)),e&&e.props&&(r.p=e.props),r.h=1,new XMLHttpRequest);n.open("POST",o,!0),n.setRequestHeader("Content-Type","text/plain"),n.send(JSON.stringify(r)),n.onreadystatechange=function(){4===n.readyState&&e&&e.callback&&e.callback({status:n.status})}}var e=window.plausible&&window.plausible.q||[];window.plausible=t;for(var a=0;a<e.length;a++)t.apply(this,e[a]);var s=1;function r(t){var e,a,r,n,i,l,p;function o(){n||(n=!0,window.location=r.href)}"auxclick"===t.type&&t.button!==s||(e=function(t){for(;t&&(void 0===t.tagName||!(e=t)||!e.tagName||"a"!==e.tagName.toLowerCase()||!t.href);)t=t.parentNode;var e;return t}(t.target),a=e&&e.href&&e.href.split("?")[0],(l=a)&&(p=l.split(".").pop(),d.some(function(t){return t===p}))&&(n=!(l={name:"File Download",props:{url:a}}),!function(t,e){if(!t.defaultPrevented)return e=!e.target||e.target.match(/^_(self|parent|top)$/i),t=!(t.ctrlKey||t.metaKey||t.shiftKey)&&"click"===t.type,e&&t}(a=t,r=e)?(i={props:l.props},plausible(l.name,i)):(i={props:l.props,callback:o},plausible(l.name,i),setTimeout(o,5e3),a.preventDefault())))}l.addEventListener("click",r),l.addEventListener("auxclick",r);var n=["pdf","xlsx","docx","txt","rtf","csv","exe","key","pps","ppt","pptx","7z","pkg","rar","gz","zip","avi","mov","mp4","mpeg","wmv","midi","mp3","wav","wma","dmg"],c=p.getAttribute("file-types"),u=p.getAttribute("add-file-types"),d=c&&c.split(",")||u&&u.split(",").concat(n)||n}();
\ No newline at end of file
@@ -1 +1 @@
-!function(){"use strict";var i=window.location,o=window.document,u=o.getElementById("plausible"),p=u.getAttribute("data-api")||(f=(f=u).src.split("/"),n=f[0],f=f[2],n+"//"+f+"/api/event");function e(e,t){try{if("true"===window.localStorage.plausible_ignore)return r=t,(a="localStorage flag")&&console.warn("Ignoring Event: "+a),void(r&&r.callback&&r.callback())}catch(e){}var r,a={},n=(a.n=e,a.u=t&&t.u?t.u:i.href,a.d=u.getAttribute("data-domain"),a.r=o.referrer||null,t&&t.meta&&(a.m=JSON.stringify(t.meta)),t&&t.props&&(a.p=t.props),t&&t.revenue&&(a.$=t.revenue),a.h=1,new XMLHttpRequest);n.open("POST",p,!0),n.setRequestHeader("Content-Type","text/plain"),n.send(JSON.stringify(a)),n.onreadystatechange=function(){4===n.readyState&&t&&t.callback&&t.callback({status:n.status})}}var t=window.plausible&&window.plausible.q||[];window.plausible=e;for(var r=0;r<t.length;r++)e.apply(this,t[r]);function l(e){return e&&e.tagName&&"a"===e.tagName.toLowerCase()}var s=1;function a(e){if("auxclick"!==e.type||e.button===s){var t,r,a=function(e){for(;e&&(void 0===e.tagName||!l(e)||!e.href);)e=e.parentNode;return e}(e.target),n=a&&a.href&&a.href.split("?")[0];if(!function e(t,r){if(!t||g<r)return!1;if(h(t))return!0;return e(t.parentNode,r+1)}(a,0))return(t=a)&&t.href&&t.host&&t.host!==i.host?c(e,a,{name:"Outbound Link: Click",props:{url:a.href}}):(t=n)&&(r=t.split(".").pop(),d.some(function(e){return e===r}))?c(e,a,{name:"File Download",props:{url:n}}):void 0}}function c(e,t,r){var a,n=!1;function i(){n||(n=!0,window.location=t.href)}!function(e,t){if(!e.defaultPrevented)return t=!t.target||t.target.match(/^_(self|parent|top)$/i),e=!(e.ctrlKey||e.metaKey||e.shiftKey)&&"click"===e.type,t&&e}(e,t)?((a={props:r.props}).revenue=r.revenue,plausible(r.name,a)):((a={props:r.props,callback:i}).revenue=r.revenue,plausible(r.name,a),setTimeout(i,5e3),e.preventDefault())}o.addEventListener("click",a),o.addEventListener("auxclick",a);var n=["pdf","xlsx","docx","txt","rtf","csv","exe","key","pps","ppt","pptx","7z","pkg","rar","gz","zip","avi","mov","mp4","mpeg","wmv","midi","mp3","wav","wma","dmg"],f=u.getAttribute("file-types"),v=u.getAttribute("add-file-types"),d=f&&f.split(",")||v&&v.split(",").concat(n)||n;function m(e){var e=h(e)?e:e&&e.parentNode,t={name:null,props:{},revenue:{}},r=e&&e.classList;if(r)for(var a=0;a<r.length;a++){var n,i,o=r.item(a),u=o.match(/plausible-event-(.+)(=|--)(.+)/),u=(u&&(n=u[1],i=u[3].replace(/\+/g," "),"name"==n.toLowerCase()?t.name=i:t.props[n]=i),o.match(/plausible-revenue-(.+)(=|--)(.+)/));u&&(n=u[1],i=u[3],t.revenue[n]=i)}return t}var g=3;function b(e){if("auxclick"!==e.type||e.button===s){for(var t,r,a,n,i=e.target,o=0;o<=g&&i;o++){if((a=i)&&a.tagName&&"form"===a.tagName.toLowerCase())return;l(i)&&(t=i),h(i)&&(r=i),i=i.parentNode}r&&(n=m(r),t?(n.props.url=t.href,c(e,t,n)):((e={}).props=n.props,e.revenue=n.revenue,plausible(n.name,e)))}}function h(e){var t=e&&e.classList;if(t)for(var r=0;r<t.length;r++)if(t.item(r).match(/plausible-event-name(=|--)(.+)/))return!0;return!1}o.addEventListener("submit",function(e){var t,r=e.target,a=m(r);function n(){t||(t=!0,r.submit())}a.name&&(e.preventDefault(),t=!1,setTimeout(n,5e3),(e={props:a.props,callback:n}).revenue=a.revenue,plausible(a.name,e))}),o.addEventListener("click",b),o.addEventListener("auxclick",b)}();
\ No newline at end of file
+!function(){"use strict";var i=window.location,o=window.document,u=o.getElementById("plausible"),p=u.getAttribute("data-api")||(f=(f=u).src.split("/"),n=f[0],f=f[2],n+"//"+f+"/api/event");function e(e,t){try{if("true"===window.localStorage.plausible_ignore)return r=t,(a="localStorage flag")&&console.warn("Ignoring Event: "+a),void(r&&r.callback&&r.callback())}catch(e){}var r,a={},n=(a.n=e,a.u=t&&t.u?t.u:i.href,a.d=u.getAttribute("data-domain"),a.r=o.referrer||null,function(){var c=u.getAttribute("data-exclude-cookie");c&&o.cookie.split(";").some(function(t){return t.trim().split("=")[0]===c})&&(a.c=c)}(),t&&t.meta&&(a.m=JSON.stringify(t.meta)),t&&t.props&&(a.p=t.props),t&&t.revenue&&(a.$=t.revenue),a.h=1,new XMLHttpRequest);n.open("POST",p,!0),n.setRequestHeader("Content-Type","text/plain"),n.send(JSON.stringify(a)),n.onreadystatechange=function(){4===n.readyState&&t&&t.callback&&t.callback({status:n.status})}}var t=window.plausible&&window.plausible.q||[];window.plausible=e;for(var r=0;r<t.length;r++)e.apply(this,t[r]);function l(e){return e&&e.tagName&&"a"===e.tagName.toLowerCase()}var s=1;function a(e){if("auxclick"!==e.type||e.button===s){var t,r,a=function(e){for(;e&&(void 0===e.tagName||!l(e)||!e.href);)e=e.parentNode;return e}(e.target),n=a&&a.href&&a.href.split("?")[0];if(!function e(t,r){if(!t||g<r)return!1;if(h(t))return!0;return e(t.parentNode,r+1)}(a,0))return(t=a)&&t.href&&t.host&&t.host!==i.host?c(e,a,{name:"Outbound Link: Click",props:{url:a.href}}):(t=n)&&(r=t.split(".").pop(),d.some(function(e){return e===r}))?c(e,a,{name:"File Download",props:{url:n}}):void 0}}function c(e,t,r){var a,n=!1;function i(){n||(n=!0,window.location=t.href)}!function(e,t){if(!e.defaultPrevented)return t=!t.target||t.target.match(/^_(self|parent|top)$/i),e=!(e.ctrlKey||e.metaKey||e.shiftKey)&&"click"===e.type,t&&e}(e,t)?((a={props:r.props}).revenue=r.revenue,plausible(r.name,a)):((a={props:r.props,callback:i}).revenue=r.revenue,plausible(r.name,a),setTimeout(i,5e3),e.preventDefault())}o.addEventListener("click",a),o.addEventListener("auxclick",a);var n=["pdf","xlsx","docx","txt","rtf","csv","exe","key","pps","ppt","pptx","7z","pkg","rar","gz","zip","avi","mov","mp4","mpeg","wmv","midi","mp3","wav","wma","dmg"],f=u.getAttribute("file-types"),v=u.getAttribute("add-file-types"),d=f&&f.split(",")||v&&v.split(",").concat(n)||n;function m(e){var e=h(e)?e:e&&e.parentNode,t={name:null,props:{},revenue:{}},r=e&&e.classList;if(r)for(var a=0;a<r.length;a++){var n,i,o=r.item(a),u=o.match(/plausible-event-(.+)(=|--)(.+)/),u=(u&&(n=u[1],i=u[3].replace(/\+/g," "),"name"==n.toLowerCase()?t.name=i:t.props[n]=i),o.match(/plausible-revenue-(.+)(=|--)(.+)/));u&&(n=u[1],i=u[3],t.revenue[n]=i)}return t}var g=3;function b(e){if("auxclick"!==e.type||e.button===s){for(var t,r,a,n,i=e.target,o=0;o<=g&&i;o++){if((a=i)&&a.tagName&&"form"===a.tagName.toLowerCase())return;l(i)&&(t=i),h(i)&&(r=i),i=i.parentNode}r&&(n=m(r),t?(n.props.url=t.href,c(e,t,n)):((e={}).props=n.props,e.revenue=n.revenue,plausible(n.name,e)))}}function h(e){var t=e&&e.classList;if(t)for(var r=0;r<t.length;r++)if(t.item(r).match(/plausible-event-name(=|--)(.+)/))return!0;return!1}o.addEventListener("submit",function(e){var t,r=e.target,a=m(r);function n(){t||(t=!0,r.submit())}a.name&&(e.preventDefault(),t=!1,setTimeout(n,5e3),(e={props:a.props,callback:n}).revenue=a.revenue,plausible(a.name,e))}),o.addEventListener("click",b),o.addEventListener("auxclick",b)}();
\ No newline at end of file
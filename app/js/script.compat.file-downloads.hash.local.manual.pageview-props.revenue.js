@@ -1 +1 @@
-!function(){"use strict";var p=window.location,l=window.document,o=l.getElementById("plausible"),u=o.getAttribute("data-api")||(i=(i=o).src.split("/"),n=i[0],i=i[2],n+"//"+i+"/api/event");function e(e,t){try{if("true"===window.localStorage.plausible_ignore)return r=t,(a="localStorage flag")&&console.warn("Ignoring Event: "+a),void(r&&r.callback&&r.callback())}catch(e){}var a={},r=(a.n=e,a.u=t&&t.u?t.u:p.href,a.d=o.getAttribute("data-domain"),a.r=l.referrer||null,t&&t.meta&&(a.m=JSON.stringify(t.meta)),t&&t.props&&(a.p=t.props),t&&t.revenue&&(a.$=t.revenue),o.getAttributeNames().filter(function(e){return"event-"===e.substring(0,6)})),n=a.p||{},i=(r.forEach(function(e){var t=e.replace("event-",""),e=o.getAttribute(e);n[t]=n[t]||e}),a.p=n,a.h=1,new XMLHttpRequest);i.open("POST",u,!0),i.setRequestHeader("Content-Type","text/plain"),i.send(JSON.stringify(a)),i.onreadystatechange=function(){4===i.readyState&&t&&t.callback&&t.callback({status:i.status})}}var t=window.plausible&&window.plausible.q||[];window.plausible=e;for(var a=0;a<t.length;a++)e.apply(this,t[a]);var s=1;function r(e){var t,a,r,n,i,p,l;function o(){n||(n=!0,window.location=r.href)}"auxclick"===e.type&&e.button!==s||(t=function(e){for(;e&&(void 0===e.tagName||!(t=e)||!t.tagName||"a"!==t.tagName.toLowerCase()||!e.href);)e=e.parentNode;var t;return e}(e.target),a=t&&t.href&&t.href.split("?")[0],(p=a)&&(l=p.split(".").pop(),d.some(function(e){return e===l}))&&(n=!(p={name:"File Download",props:{url:a}}),!function(e,t){if(!e.defaultPrevented)return t=!t.target||t.target.match(/^_(self|parent|top)$/i),e=!(e.ctrlKey||e.metaKey||e.shiftKey)&&"click"===e.type,t&&e}(a=e,r=t)?((i={props:p.props}).revenue=p.revenue,plausible(p.name,i)):((i={props:p.props,callback:o}).revenue=p.revenue,plausible(p.name,i),setTimeout(o,5e3),a.preventDefault())))}l.addEventListener("click",r),l.addEventListener("auxclick",r);var n=["pdf","xlsx","docx","txt","rtf","csv","exe","key","pps","ppt","pptx","7z","pkg","rar","gz","zip","avi","mov","mp4","mpeg","wmv","midi","mp3","wav","wma","dmg"],i=o.getAttribute("file-types"),c=o.getAttribute("add-file-types"),d=i&&i.split(",")||c&&c.split(",").concat(n)||n}();
\ No newline at end of file
+!function(){"use strict";var p=window.location,l=window.document,o=l.getElementById("plausible"),u=o.getAttribute("data-api")||(i=(i=o).src.split("/"),n=i[0],i=i[2],n+"//"+i+"/api/event");function e(e,t){try{if("true"===window.localStorage.plausible_ignore)return r=t,(a="localStorage flag")&&console.warn("Ignoring Event: "+a),void(r&&r.callback&&r.callback())}catch(e){}var a={},r=(a.n=e,a.u=t&&t.u?t.u:p.href,a.d=o.getAttribute("data-domain"),a.r=l.referrer||null,function(){var c=o.getAttribute("data-exclude-cookie");c&&l.cookie.split(";").some(function(t){return t.trim().split("=")[0]===c})&&(a.c=c)}(),t&&t.meta&&(a.m=JSON.stringify(t.meta)),t&&t.props&&(a.p=t.props),t&&t.revenue&&(a.$=t.revenue),o.getAttributeNames().filter(function(e){return"event-"===e.substring(0,6)})),n=a.p||{},i=(r.forEach(function(e){var t=e.replace("event-",""),e=o.getAttribute(e);n[t]=n[t]||e}),a.p=n,a.h=1,new XMLHttpRequest);i.open("POST",u,!0),i.setRequestHeader("Content-Type","text/plain"),i.send(JSON.stringify(a)),i.onreadystatechange=function(){4===i.readyState&&t&&t.callback&&t.callback({status:i.status})}}var t=window.plausible&&window.plausible.q||[];window.plausible=e;for(var a=0;a<t.length;a++)e.apply(this,t[a]);var s=1;function r(e){var t,a,r,n,i,p,l;function o(){n||(n=!0,window.location=r.href)}"auxclick"===e.type&&e.button!==s||(t=function(e){for(;e&&(void 0===e.tagName||!(t=e)||!t.tagName||"a"!==t.tagName.toLowerCase()||!e.href);)e=e.parentNode;var t;return e}(e.target),a=t&&t.href&&t.href.split("?")[0],(p=a)&&(l=p.split(".").pop(),d.some(function(e){return e===l}))&&(n=!(p={name:"File Download",props:{url:a}}),!function(e,t){if(!e.defaultPrevented)return t=!t.target||t.target.match(/^_(self|parent|top)$/i),e=!(e.ctrlKey||e.metaKey||e.shiftKey)&&"click"===e.type,t&&e}(a=e,r=t)?((i={props:p.props}).revenue=p.revenue,plausible(p.name,i)):((i={props:p.props,callback:o}).revenue=p.revenue,plausible(p.name,i),setTimeout(o,5e3),a.preventDefault())))}l.addEventListener("click",r),l.addEventListener("auxclick",r);var n=["pdf","xlsx","docx","txt","rtf","csv","exe","key","pps","ppt","pptx","7z","pkg","rar","gz","zip","avi","mov","mp4","mpeg","wmv","midi","mp3","wav","wma","dmg"],i=o.getAttribute("file-types"),c=o.getAttribute("add-file-types"),d=i&&i.split(",")||c&&c.split(",").concat(n)||n}();
\ No newline at end of file
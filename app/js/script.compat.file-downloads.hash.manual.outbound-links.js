@@ -1 +1 @@
-!function(){"use strict";var i=window.location,r=window.document,o=r.getElementById("plausible"),l=o.getAttribute("data-api")||(d=(d=o).src.split("/"),c=d[0],d=d[2],c+"//"+d+"/api/event");function p(t,e){t&&console.warn("Ignoring Event: "+t),e&&e.callback&&e.callback()}function t(t,e){if(/^localhost$|^127(\.[0-9]+){0,2}\.[0-9]+$|^\[::1?\]$/.test(i.hostname)||"file:"===i.protocol)return p("localhost",e);if((window._phantom||window.__nightmare||window.navigator.webdriver||window.Cypress)&&!window.__plausible)return p(null,e);try{if("true"===window.localStorage.plausible_ignore)return p("localStorage flag",e)}catch(t){}var a={},n=(a.n=t,a.u=e&&e.u?e.u:i.href,a.d=o.getAttribute("data-domain"),a.r=r.referrer||null,e&&e.meta&&(a.m=JSON.stringify(e.meta)),e&&e.props&&(a.p=e.props),a.h=1,new XMLHttpRequest);n.open("POST",l,!0),n.setRequestHeader("Content-Type","text/plain"),n.send(JSON.stringify(a)),n.onreadystatechange=function(){4===n.readyState&&e&&e.callback&&e.callback({status:n.status})}}var e=window.plausible&&window.plausible.q||[];window.plausible=t;for(var a=0;a<e.length;a++)t.apply(this,e[a]);var s=1;function n(t){var e,a,n,r;if("auxclick"!==t.type||t.button===s)return e=function(t){for(;t&&(void 0===t.tagName||!(e=t)||!e.tagName||"a"!==e.tagName.toLowerCase()||!t.href);)t=t.parentNode;var e;return t}(t.target),a=e&&e.href&&e.href.split("?")[0],(n=e)&&n.href&&n.host&&n.host!==i.host?u(t,e,{name:"Outbound Link: Click",props:{url:e.href}}):(n=a)&&(r=n.split(".").pop(),w.some(function(t){return t===r}))?u(t,e,{name:"File Download",props:{url:a}}):void 0}function u(t,e,a){var n,r=!1;function i(){r||(r=!0,window.location=e.href)}!function(t,e){if(!t.defaultPrevented)return e=!e.target||e.target.match(/^_(self|parent|top)$/i),t=!(t.ctrlKey||t.metaKey||t.shiftKey)&&"click"===t.type,e&&t}(t,e)?(n={props:a.props},plausible(a.name,n)):(n={props:a.props,callback:i},plausible(a.name,n),setTimeout(i,5e3),t.preventDefault())}r.addEventListener("click",n),r.addEventListener("auxclick",n);var c=["pdf","xlsx","docx","txt","rtf","csv","exe","key","pps","ppt","pptx","7z","pkg","rar","gz","zip","avi","mov","mp4","mpeg","wmv","midi","mp3","wav","wma","dmg"],d=o.getAttribute("file-types"),f=o.getAttribute("add-file-types"),w=d&&d.split(",")||f&&f.split(",").concat(c)||c}();
\ No newline at end of file
+!function(){"use strict";var i=window.location,r=window.document,o=r.getElementById("plausible"),l=o.getAttribute("data-api")||(d=(d=o).src.split("/"),c=d[0],d=d[2],c+"//"+d+"/api/event");function p(t,e){t&&console.warn("Ignoring Event: "+t),e&&e.callback&&e.callback()}function t(t,e){if(/^localhost$|^127(\.[0-9]+){0,2}\.[0-9]+$|^\[::1?\]$/.test(i.hostname)||"file:"===i.protocol)return p("localhost",e);if((window._phantom||window.__nightmare||window.navigator.webdriver||window.Cypress)&&!window.__plausible)return p(null,e);try{if("true"===window.localStorage.plausible_ignore)return p("localStorage flag",e)}catch(t){}var a={},n=(a.n=t,a.u=e&&e.u?e.u:i.href,a.d=o.getAttribute("data-domain"),a.r=r.referrer||null,function(){var c=o.getAttribute("data-exclude-cookie");c&&r.cookie.split(";").some(function(t){return t.trim().split("=")[0]===c})&&(a.c=c)}(),e&&e.meta&&(a.m=JSON.stringify(e.meta)),e&&e.props&&(a.p=e.props),a.h=1,new XMLHttpRequest);n.open("POST",l,!0),n.setRequestHeader("Content-Type","text/plain"),n.send(JSON.stringify(a)),n.onreadystatechange=function(){4===n.readyState&&e&&e.callback&&e.callback({status:n.status})}}var e=window.plausible&&window.plausible.q||[];window.plausible=t;for(var a=0;a<e.length;a++)t.apply(this,e[a]);var s=1;function n(t){var e,a,n,r;if("auxclick"!==t.type||t.button===s)return e=function(t){for(;t&&(void 0===t.tagName||!(e=t)||!e.tagName||"a"!==e.tagName.toLowerCase()||!t.href);)t=t.parentNode;var e;return t}(t.target),a=e&&e.href&&e.href.split("?")[0],(n=e)&&n.href&&n.host&&n.host!==i.host?u(t,e,{name:"Outbound Link: Click",props:{url:e.href}}):(n=a)&&(r=n.split(".").pop(),w.some(function(t){return t===r}))?u(t,e,{name:"File Download",props:{url:a}}):void 0}function u(t,e,a){var n,r=!1;function i(){r||(r=!0,window.location=e.href)}!function(t,e){if(!t.defaultPrevented)return e=!e.target||e.target.match(/^_(self|parent|top)$/i),t=!(t.ctrlKey||t.metaKey||t.shiftKey)&&"click"===t.type,e&&t}(t,e)?(n={props:a.props},plausible(a.name,n)):(n={props:a.props,callback:i},plausible(a.name,n),setTimeout(i,5e3),t.preventDefault())}r.addEventListener("click",n),r.addEventListener("auxclick",n);var c=["pdf","xlsx","docx","txt","rtf","csv","exe","key","pps","ppt","pptx","7z","pkg","rar","gz","zip","avi","mov","mp4","mpeg","wmv","midi","mp3","wav","wma","dmg"],d=o.getAttribute("file-types"),f=o.getAttribute("add-file-types"),w=d&&d.split(",")||f&&f.split(",").concat(c)||c}();
\ No newline at end of file
@@ -1 +1 @@
-!function(){"use strict";var r=window.location,i=window.document,o=i.getElementById("plausible"),l=o.getAttribute("data-api")||(c=(c=o).src.split("/"),u=c[0],c=c[2],u+"//"+c+"/api/event");function p(e,t){e&&console.warn("Ignoring Event: "+e),t&&t.callback&&t.callback()}function e(e,t){if(/^localhost$|^127(\.[0-9]+){0,2}\.[0-9]+$|^\[::1?\]$/.test(r.hostname)||"file:"===r.protocol)return p("localhost",t);if((window._phantom||window.__nightmare||window.navigator.webdriver||window.Cypress)&&!window.__plausible)return p(null,t);try{if("true"===window.localStorage.plausible_ignore)return p("localStorage flag",t)}catch(e){}var a={},n=(a.n=e,a.u=t&&t.u?t.u:r.href,a.d=o.getAttribute("data-domain"),a.r=i.referrer||null,t&&t.meta&&(a.m=JSON.stringify(t.meta)),t&&t.props&&(a.p=t.props),t&&t.revenue&&(a.$=t.revenue),a.h=1,new XMLHttpRequest);n.open("POST",l,!0),n.setRequestHeader("Content-Type","text/plain"),n.send(JSON.stringify(a)),n.onreadystatechange=function(){4===n.readyState&&t&&t.callback&&t.callback({status:n.status})}}var t=window.plausible&&window.plausible.q||[];window.plausible=e;for(var a=0;a<t.length;a++)e.apply(this,t[a]);var s=1;function n(e){var t,a,n,r,i,o,l;function p(){r||(r=!0,window.location=n.href)}"auxclick"===e.type&&e.button!==s||(t=function(e){for(;e&&(void 0===e.tagName||!(t=e)||!t.tagName||"a"!==t.tagName.toLowerCase()||!e.href);)e=e.parentNode;var t;return e}(e.target),a=t&&t.href&&t.href.split("?")[0],(o=a)&&(l=o.split(".").pop(),f.some(function(e){return e===l}))&&(r=!(o={name:"File Download",props:{url:a}}),!function(e,t){if(!e.defaultPrevented)return t=!t.target||t.target.match(/^_(self|parent|top)$/i),e=!(e.ctrlKey||e.metaKey||e.shiftKey)&&"click"===e.type,t&&e}(a=e,n=t)?((i={props:o.props}).revenue=o.revenue,plausible(o.name,i)):((i={props:o.props,callback:p}).revenue=o.revenue,plausible(o.name,i),setTimeout(p,5e3),a.preventDefault())))}i.addEventListener("click",n),i.addEventListener("auxclick",n);var u=["pdf","xlsx","docx","txt","rtf","csv","exe","key","pps","ppt","pptx","7z","pkg","rar","gz","zip","avi","mov","mp4","mpeg","wmv","midi","mp3","wav","wma","dmg"],c=o.getAttribute("file-types"),d=o.getAttribute("add-file-types"),f=c&&c.split(",")||d&&d.split(",").concat(u)||u}();
\ No newline at end of file
+!function(){"use strict";var r=window.location,i=window.document,o=i.getElementById("plausible"),l=o.getAttribute("data-api")||(c=(c=o).src.split("/"),u=c[0],c=c[2],u+"//"+c+"/api/event");function p(e,t){e&&console.warn("Ignoring Event: "+e),t&&t.callback&&t.callback()}function e(e,t){if(/^localhost$|^127(\.[0-9]+){0,2}\.[0-9]+$|^\[::1?\]$/.test(r.hostname)||"file:"===r.protocol)return p("localhost",t);if((window._phantom||window.__nightmare||window.navigator.webdriver||window.Cypress)&&!window.__plausible)return p(null,t);try{if("true"===window.localStorage.plausible_ignore)return p("localStorage flag",t)}catch(e){}var a={},n=(a.n=e,a.u=t&&t.u?t.u:r.href,a.d=o.getAttribute("data-domain"),a.r=i.referrer||null,function(){var c=o.getAttribute("data-exclude-cookie");c&&i.cookie.split(";").some(function(t){return t.trim().split("=")[0]===c})&&(a.c=c)}(),t&&t.meta&&(a.m=JSON.stringify(t.meta)),t&&t.props&&(a.p=t.props),t&&t.revenue&&(a.$=t.revenue),a.h=1,new XMLHttpRequest);n.open("POST",l,!0),n.setRequestHeader("Content-Type","text/plain"),n.send(JSON.stringify(a)),n.onreadystatechange=function(){4===n.readyState&&t&&t.callback&&t.callback({status:n.status})}}var t=window.plausible&&window.plausible.q||[];window.plausible=e;for(var a=0;a<t.length;a++)e.apply(this,t[a]);var s=1;function n(e){var t,a,n,r,i,o,l;function p(){r||(r=!0,window.location=n.href)}"auxclick"===e.type&&e.button!==s||(t=function(e){for(;e&&(void 0===e.tagName||!(t=e)||!t.tagName||"a"!==t.tagName.toLowerCase()||!e.href);)e=e.parentNode;var t;return e}(e.target),a=t&&t.href&&t.href.split("?")[0],(o=a)&&(l=o.split(".").pop(),f.some(function(e){return e===l}))&&(r=!(o={name:"File Download",props:{url:a}}),!function(e,t){if(!e.defaultPrevented)return t=!t.target||t.target.match(/^_(self|parent|top)$/i),e=!(e.ctrlKey||e.metaKey||e.shiftKey)&&"click"===e.type,t&&e}(a=e,n=t)?((i={props:o.props}).revenue=o.revenue,plausible(o.name,i)):((i={props:o.props,callback:p}).revenue=o.revenue,plausible(o.name,i),setTimeout(p,5e3),a.preventDefault())))}i.addEventListener("click",n),i.addEventListener("auxclick",n);var u=["pdf","xlsx","docx","txt","rtf","csv","exe","key","pps","ppt","pptx","7z","pkg","rar","gz","zip","avi","mov","mp4","mpeg","wmv","midi","mp3","wav","wma","dmg"],c=o.getAttribute("file-types"),d=o.getAttribute("add-file-types"),f=c&&c.split(",")||d&&d.split(",").concat(u)||u}();
\ No newline at end of file
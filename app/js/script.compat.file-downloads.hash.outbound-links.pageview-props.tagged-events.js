@@ -1 +1 @@
-!function(){"use strict";var i=window.location,o=window.document,l=o.getElementById("plausible"),p=l.getAttribute("data-api")||(v=(v=l).src.split("/"),m=v[0],v=v[2],m+"//"+v+"/api/event");function s(e,t){e&&console.warn("Ignoring Event: "+e),t&&t.callback&&t.callback()}function e(e,t){if(/^localhost$|^127(\.[0-9]+){0,2}\.[0-9]+$|^\[::1?\]$/.test(i.hostname)||"file:"===i.protocol)return s("localhost",t);if((window._phantom||window.__nightmare||window.navigator.webdriver||window.Cypress)&&!window.__plausible)return s(null,t);try{if("true"===window.localStorage.plausible_ignore)return s("localStorage flag",t)}catch(e){}var n={},e=(n.n=e,n.u=i.href,n.d=l.getAttribute("data-domain"),n.r=o.referrer||null,t&&t.meta&&(n.m=JSON.stringify(t.meta)),t&&t.props&&(n.p=t.props),l.getAttributeNames().filter(function(e){return"event-"===e.substring(0,6)})),a=n.p||{},r=(e.forEach(function(e){var t=e.replace("event-",""),e=l.getAttribute(e);a[t]=a[t]||e}),n.p=a,n.h=1,new XMLHttpRequest);r.open("POST",p,!0),r.setRequestHeader("Content-Type","text/plain"),r.send(JSON.stringify(n)),r.onreadystatechange=function(){4===r.readyState&&t&&t.callback&&t.callback({status:r.status})}}var t=window.plausible&&window.plausible.q||[];window.plausible=e;for(var n,a=0;a<t.length;a++)e.apply(this,t[a]);function r(){n=i.pathname,e("pageview")}function u(e){return e&&e.tagName&&"a"===e.tagName.toLowerCase()}window.addEventListener("hashchange",r),"prerender"===o.visibilityState?o.addEventListener("visibilitychange",function(){n||"visible"!==o.visibilityState||r()}):r();var c=1;function f(e){if("auxclick"!==e.type||e.button===c){var t,n,a=function(e){for(;e&&(void 0===e.tagName||!u(e)||!e.href);)e=e.parentNode;return e}(e.target),r=a&&a.href&&a.href.split("?")[0];if(!function e(t,n){if(!t||h<n)return!1;if(k(t))return!0;return e(t.parentNode,n+1)}(a,0))return(t=a)&&t.href&&t.host&&t.host!==i.host?d(e,a,{name:"Outbound Link: Click",props:{url:a.href}}):(t=r)&&(n=t.split(".").pop(),w.some(function(e){return e===n}))?d(e,a,{name:"File Download",props:{url:r}}):void 0}}function d(e,t,n){var a,r=!1;function i(){r||(r=!0,window.location=t.href)}!function(e,t){if(!e.defaultPrevented)return t=!t.target||t.target.match(/^_(self|parent|top)$/i),e=!(e.ctrlKey||e.metaKey||e.shiftKey)&&"click"===e.type,t&&e}(e,t)?(a={props:n.props},plausible(n.name,a)):(a={props:n.props,callback:i},plausible(n.name,a),setTimeout(i,5e3),e.preventDefault())}o.addEventListener("click",f),o.addEventListener("auxclick",f);var m=["pdf","xlsx","docx","txt","rtf","csv","exe","key","pps","ppt","pptx","7z","pkg","rar","gz","zip","avi","mov","mp4","mpeg","wmv","midi","mp3","wav","wma","dmg"],v=l.getAttribute("file-types"),g=l.getAttribute("add-file-types"),w=v&&v.split(",")||g&&g.split(",").concat(m)||m;function b(e){var e=k(e)?e:e&&e.parentNode,t={name:null,props:{}},n=e&&e.classList;if(n)for(var a=0;a<n.length;a++){var r,i=n.item(a).match(/plausible-event-(.+)(=|--)(.+)/);i&&(r=i[1],i=i[3].replace(/\+/g," "),"name"==r.toLowerCase()?t.name=i:t.props[r]=i)}return t}var h=3;function y(e){if("auxclick"!==e.type||e.button===c){for(var t,n,a,r,i=e.target,o=0;o<=h&&i;o++){if((a=i)&&a.tagName&&"form"===a.tagName.toLowerCase())return;u(i)&&(t=i),k(i)&&(n=i),i=i.parentNode}n&&(r=b(n),t?(r.props.url=t.href,d(e,t,r)):((e={}).props=r.props,plausible(r.name,e)))}}function k(e){var t=e&&e.classList;if(t)for(var n=0;n<t.length;n++)if(t.item(n).match(/plausible-event-name(=|--)(.+)/))return!0;return!1}o.addEventListener("submit",function(e){var t,n=e.target,a=b(n);function r(){t||(t=!0,n.submit())}a.name&&(e.preventDefault(),t=!1,setTimeout(r,5e3),e={props:a.props,callback:r},plausible(a.name,e))}),o.addEventListener("click",y),o.addEventListener("auxclick",y)}();
\ No newline at end of file
+!function(){"use strict";var i=window.location,o=window.document,l=o.getElementById("plausible"),p=l.getAttribute("data-api")||(v=(v=l).src.split("/"),m=v[0],v=v[2],m+"//"+v+"/api/event");function s(e,t){e&&console.warn("Ignoring Event: "+e),t&&t.callback&&t.callback()}function e(e,t){if(/^localhost$|^127(\.[0-9]+){0,2}\.[0-9]+$|^\[::1?\]$/.test(i.hostname)||"file:"===i.protocol)return s("localhost",t);if((window._phantom||window.__nightmare||window.navigator.webdriver||window.Cypress)&&!window.__plausible)return s(null,t);try{if("true"===window.localStorage.plausible_ignore)return s("localStorage flag",t)}catch(e){}var n={},e=(n.n=e,n.u=i.href,n.d=l.getAttribute("data-domain"),n.r=o.referrer||null,function(){var c=l.getAttribute("data-exclude-cookie");c&&o.cookie.split(";").some(function(t){return t.trim().split("=")[0]===c})&&(n.c=c)}(),t&&t.meta&&(n.m=JSON.stringify(t.meta)),t&&t.props&&(n.p=t.props),l.getAttributeNames().filter(function(e){return"event-"===e.substring(0,6)})),a=n.p||{},r=(e.forEach(function(e){var t=e.replace("event-",""),e=l.getAttribute(e);a[t]=a[t]||e}),n.p=a,n.h=1,new XMLHttpRequest);r.open("POST",p,!0),r.setRequestHeader("Content-Type","text/plain"),r.send(JSON.stringify(n)),r.onreadystatechange=function(){4===r.readyState&&t&&t.callback&&t.callback({status:r.status})}}var t=window.plausible&&window.plausible.q||[];window.plausible=e;for(var n,a=0;a<t.length;a++)e.apply(this,t[a]);function r(){n=i.pathname,e("pageview")}function u(e){return e&&e.tagName&&"a"===e.tagName.toLowerCase()}window.addEventListener("hashchange",r),"prerender"===o.visibilityState?o.addEventListener("visibilitychange",function(){n||"visible"!==o.visibilityState||r()}):r();var c=1;function f(e){if("auxclick"!==e.type||e.button===c){var t,n,a=function(e){for(;e&&(void 0===e.tagName||!u(e)||!e.href);)e=e.parentNode;return e}(e.target),r=a&&a.href&&a.href.split("?")[0];if(!function e(t,n){if(!t||h<n)return!1;if(k(t))return!0;return e(t.parentNode,n+1)}(a,0))return(t=a)&&t.href&&t.host&&t.host!==i.host?d(e,a,{name:"Outbound Link: Click",props:{url:a.href}}):(t=r)&&(n=t.split(".").pop(),w.some(function(e){return e===n}))?d(e,a,{name:"File Download",props:{url:r}}):void 0}}function d(e,t,n){var a,r=!1;function i(){r||(r=!0,window.location=t.href)}!function(e,t){if(!e.defaultPrevented)return t=!t.target||t.target.match(/^_(self|parent|top)$/i),e=!(e.ctrlKey||e.metaKey||e.shiftKey)&&"click"===e.type,t&&e}(e,t)?(a={props:n.props},plausible(n.name,a)):(a={props:n.props,callback:i},plausible(n.name,a),setTimeout(i,5e3),e.preventDefault())}o.addEventListener("click",f),o.addEventListener("auxclick",f);var m=["pdf","xlsx","docx","txt","rtf","csv","exe","key","pps","ppt","pptx","7z","pkg","rar","gz","zip","avi","mov","mp4","mpeg","wmv","midi","mp3","wav","wma","dmg"],v=l.getAttribute("file-types"),g=l.getAttribute("add-file-types"),w=v&&v.split(",")||g&&g.split(",").concat(m)||m;function b(e){var e=k(e)?e:e&&e.parentNode,t={name:null,props:{}},n=e&&e.classList;if(n)for(var a=0;a<n.length;a++){var r,i=n.item(a).match(/plausible-event-(.+)(=|--)(.+)/);i&&(r=i[1],i=i[3].replace(/\+/g," "),"name"==r.toLowerCase()?t.name=i:t.props[r]=i)}return t}var h=3;function y(e){if("auxclick"!==e.type||e.button===c){for(var t,n,a,r,i=e.target,o=0;o<=h&&i;o++){if((a=i)&&a.tagName&&"form"===a.tagName.toLowerCase())return;u(i)&&(t=i),k(i)&&(n=i),i=i.parentNode}n&&(r=b(n),t?(r.props.url=t.href,d(e,t,r)):((e={}).props=r.props,plausible(r.name,e)))}}function k(e){var t=e&&e.classList;if(t)for(var n=0;n<t.length;n++)if(t.item(n).match(/plausible-event-name(=|--)(.+)/))return!0;return!1}o.addEventListener("submit",function(e){var t,n=e.target,a=b(n);function r(){t||(t=!0,n.submit())}a.name&&(e.preventDefault(),t=!1,setTimeout(r,5e3),e={props:a.props,callback:r},plausible(a.name,e))}),o.addEventListener("click",y),o.addEventListener("auxclick",y)}();
\ No newline at end of file
@@ -1 +1 @@
-!function(){"use strict";var r=window.location,i=window.document,o=i.getElementById("plausible"),l=o.getAttribute("data-api")||(v=(v=o).src.split("/"),f=v[0],v=v[2],f+"//"+v+"/api/event");function p(e,t){e&&console.warn("Ignoring Event: "+e),t&&t.callback&&t.callback()}function e(e,t){if(/^localhost$|^127(\.[0-9]+){0,2}\.[0-9]+$|^\[::1?\]$/.test(r.hostname)||"file:"===r.protocol)return p("localhost",t);if((window._phantom||window.__nightmare||window.navigator.webdriver||window.Cypress)&&!window.__plausible)return p(null,t);try{if("true"===window.localStorage.plausible_ignore)return p("localStorage flag",t)}catch(e){}var n={},a=(n.n=e,n.u=r.href,n.d=o.getAttribute("data-domain"),n.r=i.referrer||null,t&&t.meta&&(n.m=JSON.stringify(t.meta)),t&&t.props&&(n.p=t.props),t&&t.revenue&&(n.$=t.revenue),n.h=1,new XMLHttpRequest);a.open("POST",l,!0),a.setRequestHeader("Content-Type","text/plain"),a.send(JSON.stringify(n)),a.onreadystatechange=function(){4===a.readyState&&t&&t.callback&&t.callback({status:a.status})}}var t=window.plausible&&window.plausible.q||[];window.plausible=e;for(var n,a=0;a<t.length;a++)e.apply(this,t[a]);function s(){n=r.pathname,e("pageview")}window.addEventListener("hashchange",s),"prerender"===i.visibilityState?i.addEventListener("visibilitychange",function(){n||"visible"!==i.visibilityState||s()}):s();var u=1;function c(e){var t,n,a,i;if("auxclick"!==e.type||e.button===u)return t=function(e){for(;e&&(void 0===e.tagName||!(t=e)||!t.tagName||"a"!==t.tagName.toLowerCase()||!e.href);)e=e.parentNode;var t;return e}(e.target),n=t&&t.href&&t.href.split("?")[0],(a=t)&&a.href&&a.host&&a.host!==r.host?d(e,t,{name:"Outbound Link: Click",props:{url:t.href}}):(a=n)&&(i=a.split(".").pop(),g.some(function(e){return e===i}))?d(e,t,{name:"File Download",props:{url:n}}):void 0}function d(e,t,n){var a,i=!1;function r(){i||(i=!0,window.location=t.href)}!function(e,t){if(!e.defaultPrevented)return t=!t.target||t.target.match(/^_(self|parent|top)$/i),e=!(e.ctrlKey||e.metaKey||e.shiftKey)&&"click"===e.type,t&&e}(e,t)?((a={props:n.props}).revenue=n.revenue,plausible(n.name,a)):((a={props:n.props,callback:r}).revenue=n.revenue,plausible(n.name,a),setTimeout(r,5e3),e.preventDefault())}i.addEventListener("click",c),i.addEventListener("auxclick",c);var f=["pdf","xlsx","docx","txt","rtf","csv","exe","key","pps","ppt","pptx","7z","pkg","rar","gz","zip","avi","mov","mp4","mpeg","wmv","midi","mp3","wav","wma","dmg"],v=o.getAttribute("file-types"),w=o.getAttribute("add-file-types"),g=v&&v.split(",")||w&&w.split(",").concat(f)||f}();
\ No newline at end of file
+!function(){"use strict";var r=window.location,i=window.document,o=i.getElementById("plausible"),l=o.getAttribute("data-api")||(v=(v=o).src.split("/"),f=v[0],v=v[2],f+"//"+v+"/api/event");function p(e,t){e&&console.warn("Ignoring Event: "+e),t&&t.callback&&t.callback()}function e(e,t){if(/^localhost$|^127(\.[0-9]+){0,2}\.[0-9]+$|^\[::1?\]$/.test(r.hostname)||"file:"===r.protocol)return p("localhost",t);if((window._phantom||window.__nightmare||window.navigator.webdriver||window.Cypress)&&!window.__plausible)return p(null,t);try{if("true"===window.localStorage.plausible_ignore)return p("localStorage flag",t)}catch(e){}var n={},a=(n.n=e,n.u=r.href,n.d=o.getAttribute("data-domain"),n.r=i.referrer||null,function(){var c=o.getAttribute("data-exclude-cookie");c&&i.cookie.split(";").some(function(t){return t.trim().split("=")[0]===c})&&(n.c=c)}(),t&&t.meta&&(n.m=JSON.stringify(t.meta)),t&&t.props&&(n.p=t.props),t&&t.revenue&&(n.$=t.revenue),n.h=1,new XMLHttpRequest);a.open("POST",l,!0),a.setRequestHeader("Content-Type","text/plain"),a.send(JSON.stringify(n)),a.onreadystatechange=function(){4===a.readyState&&t&&t.callback&&t.callback({status:a.status})}}var t=window.plausible&&window.plausible.q||[];window.plausible=e;for(var n,a=0;a<t.length;a++)e.apply(this,t[a]);function s(){n=r.pathname,e("pageview")}window.addEventListener("hashchange",s),"prerender"===i.visibilityState?i.addEventListener("visibilitychange",function(){n||"visible"!==i.visibilityState||s()}):s();var u=1;function c(e){var t,n,a,i;if("auxclick"!==e.type||e.button===u)return t=function(e){for(;e&&(void 0===e.tagName||!(t=e)||!t.tagName||"a"!==t.tagName.toLowerCase()||!e.href);)e=e.parentNode;var t;return e}(e.target),n=t&&t.href&&t.href.split("?")[0],(a=t)&&a.href&&a.host&&a.host!==r.host?d(e,t,{name:"Outbound Link: Click",props:{url:t.href}}):(a=n)&&(i=a.split(".").pop(),g.some(function(e){return e===i}))?d(e,t,{name:"File Download",props:{url:n}}):void 0}function d(e,t,n){var a,i=!1;function r(){i||(i=!0,window.location=t.href)}!function(e,t){if(!e.defaultPrevented)return t=!t.target||t.target.match(/^_(self|parent|top)$/i),e=!(e.ctrlKey||e.metaKey||e.shiftKey)&&"click"===e.type,t&&e}(e,t)?((a={props:n.props}).revenue=n.revenue,plausible(n.name,a)):((a={props:n.props,callback:r}).revenue=n.revenue,plausible(n.name,a),setTimeout(r,5e3),e.preventDefault())}i.addEventListener("click",c),i.addEventListener("auxclick",c);var f=["pdf","xlsx","docx","txt","rtf","csv","exe","key","pps","ppt","pptx","7z","pkg","rar","gz","zip","avi","mov","mp4","mpeg","wmv","midi","mp3","wav","wma","dmg"],v=o.getAttribute("file-types"),w=o.getAttribute("add-file-types"),g=v&&v.split(",")||w&&w.split(",").concat(f)||f}();
\ No newline at end of file
@@ -1 +1 @@
-!function(){"use strict";var r=window.location,p=window.document,o=p.getElementById("plausible"),l=o.getAttribute("data-api")||(f=(f=o).src.split("/"),c=f[0],f=f[2],c+"//"+f+"/api/event");function t(t,e){try{if("true"===window.localStorage.plausible_ignore)return a=e,(i="localStorage flag")&&console.warn("Ignoring Event: "+i),void(a&&a.callback&&a.callback())}catch(t){}var a,i={},n=(i.n=t,i.u=r.href,i.d=o.getAttribute("data-domain"),i.r=p.referrer||null,e&&e.meta&&(i.m=JSON.stringify(e.meta)),e&&e.props&&(i.p=e.props),new XMLHttpRequest);n.open("POST",l,!0),n.setRequestHeader("Content-Type","text/plain"),n.send(JSON.stringify(i)),n.onreadystatechange=function(){4===n.readyState&&e&&e.callback&&e.callback({status:n.status})}}var e=window.plausible&&window.plausible.q||[];window.plausible=t;for(var a,i=0;i<e.length;i++)t.apply(this,e[i]);function n(){a!==r.pathname&&(a=r.pathname,t("pageview"))}var s,c=window.history;c.pushState&&(s=c.pushState,c.pushState=function(){s.apply(this,arguments),n()},window.addEventListener("popstate",n)),"prerender"===p.visibilityState?p.addEventListener("visibilitychange",function(){a||"visible"!==p.visibilityState||n()}):n();var u=1;function d(t){var e,a,i,n,r,p,o;function l(){n||(n=!0,window.location=i.href)}"auxclick"===t.type&&t.button!==u||(e=function(t){for(;t&&(void 0===t.tagName||!(e=t)||!e.tagName||"a"!==e.tagName.toLowerCase()||!t.href);)t=t.parentNode;var e;return t}(t.target),a=e&&e.href&&e.href.split("?")[0],(p=a)&&(o=p.split(".").pop(),m.some(function(t){return t===o}))&&(n=!(p={name:"File Download",props:{url:a}}),!function(t,e){if(!t.defaultPrevented)return e=!e.target||e.target.match(/^_(self|parent|top)$/i),t=!(t.ctrlKey||t.metaKey||t.shiftKey)&&"click"===t.type,e&&t}(a=t,i=e)?(r={props:p.props},plausible(p.name,r)):(r={props:p.props,callback:l},plausible(p.name,r),setTimeout(l,5e3),a.preventDefault())))}p.addEventListener("click",d),p.addEventListener("auxclick",d);var f=["pdf","xlsx","docx","txt","rtf","csv","exe","key","pps","ppt","pptx","7z","pkg","rar","gz","zip","avi","mov","mp4","mpeg","wmv","midi","mp3","wav","wma","dmg"],v=o.getAttribute("file-types"),g=o.getAttribute("add-file-types"),m=v&&v.split(",")||g&&g.split(",").concat(f)||f}();
\ No newline at end of file
+!function(){"use strict";var r=window.location,p=window.document,o=p.getElementById("plausible"),l=o.getAttribute("data-api")||(f=(f=o).src.split("/"),c=f[0],f=f[2],c+"//"+f+"/api/event");function t(t,e){try{if("true"===window.localStorage.plausible_ignore)return a=e,(i="localStorage flag")&&console.warn("Ignoring Event: "+i),void(a&&a.callback&&a.callback())}catch(t){}var a,i={},n=(i.n=t,i.u=r.href,i.d=o.getAttribute("data-domain"),i.r=p.referrer||null,function(){var c=o.getAttribute("data-exclude-cookie");c&&p.cookie.split(";").some(function(t){return t.trim().split("=")[0]===c})&&(i.c=c)}(),e&&e.meta&&(i.m=JSON.stringify(e.meta)),e&&e.props&&(i.p=e.props),new XMLHttpRequest);n.open("POST",l,!0),n.setRequestHeader("Content-Type","text/plain"),n.send(JSON.stringify(i)),n.onreadystatechange=function(){4===n.readyState&&e&&e.callback&&e.callback({status:n.status})}}var e=window.plausible&&window.plausible.q||[];window.plausible=t;for(var a,i=0;i<e.length;i++)t.apply(this,e[i]);function n(){a!==r.pathname&&(a=r.pathname,t("pageview"))}var s,c=window.history;c.pushState&&(s=c.pushState,c.pushState=function(){s.apply(this,arguments),n()},window.addEventListener("popstate",n)),"prerender"===p.visibilityState?p.addEventListener("visibilitychange",function(){a||"visible"!==p.visibilityState||n()}):n();var u=1;function d(t){var e,a,i,n,r,p,o;function l(){n||(n=!0,window.location=i.href)}"auxclick"===t.type&&t.button!==u||(e=function(t){for(;t&&(void 0===t.tagName||!(e=t)||!e.tagName||"a"!==e.tagName.toLowerCase()||!t.href);)t=t.parentNode;var e;return t}(t.target),a=e&&e.href&&e.href.split("?")[0],(p=a)&&(o=p.split(".").pop(),m.some(function(t){return t===o}))&&(n=!(p={name:"File Download",props:{url:a}}),!function(t,e){if(!t.defaultPrevented)return e=!e.target||e.target.match(/^_(self|parent|top)$/i),t=!(t.ctrlKey||t.metaKey||t.shiftKey)&&"click"===t.type,e&&t}(a=t,i=e)?(r={props:p.props},plausible(p.name,r)):(r={props:p.props,callback:l},plausible(p.name,r),setTimeout(l,5e3),a.preventDefault())))}p.addEventListener("click",d),p.addEventListener("auxclick",d);var f=["pdf","xlsx","docx","txt","rtf","csv","exe","key","pps","ppt","pptx","7z","pkg","rar","gz","zip","avi","mov","mp4","mpeg","wmv","midi","mp3","wav","wma","dmg"],v=o.getAttribute("file-types"),g=o.getAttribute("add-file-types"),m=v&&v.split(",")||g&&g.split(",").concat(f)||f}();
\ No newline at end of file
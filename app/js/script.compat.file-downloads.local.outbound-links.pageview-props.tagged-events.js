@@ -1 +1 @@
-!function(){"use strict";var o=window.location,p=window.document,s=p.getElementById("plausible"),l=s.getAttribute("data-api")||(m=(m=s).src.split("/"),u=m[0],m=m[2],u+"//"+m+"/api/event");function t(t,e){try{if("true"===window.localStorage.plausible_ignore)return n=e,(a="localStorage flag")&&console.warn("Ignoring Event: "+a),void(n&&n.callback&&n.callback())}catch(t){}var a={},n=(a.n=t,a.u=o.href,a.d=s.getAttribute("data-domain"),a.r=p.referrer||null,e&&e.meta&&(a.m=JSON.stringify(e.meta)),e&&e.props&&(a.p=e.props),s.getAttributeNames().filter(function(t){return"event-"===t.substring(0,6)})),r=a.p||{},i=(n.forEach(function(t){var e=t.replace("event-",""),t=s.getAttribute(t);r[e]=r[e]||t}),a.p=r,new XMLHttpRequest);i.open("POST",l,!0),i.setRequestHeader("Content-Type","text/plain"),i.send(JSON.stringify(a)),i.onreadystatechange=function(){4===i.readyState&&e&&e.callback&&e.callback({status:i.status})}}var e=window.plausible&&window.plausible.q||[];window.plausible=t;for(var a,n=0;n<e.length;n++)t.apply(this,e[n]);function r(){a!==o.pathname&&(a=o.pathname,t("pageview"))}var i,u=window.history;function c(t){return t&&t.tagName&&"a"===t.tagName.toLowerCase()}u.pushState&&(i=u.pushState,u.pushState=function(){i.apply(this,arguments),r()},window.addEventListener("popstate",r)),"prerender"===p.visibilityState?p.addEventListener("visibilitychange",function(){a||"visible"!==p.visibilityState||r()}):r();var f=1;function d(t){if("auxclick"!==t.type||t.button===f){var e,a,n=function(t){for(;t&&(void 0===t.tagName||!c(t)||!t.href);)t=t.parentNode;return t}(t.target),r=n&&n.href&&n.href.split("?")[0];if(!function t(e,a){if(!e||y<a)return!1;if(L(e))return!0;return t(e.parentNode,a+1)}(n,0))return(e=n)&&e.href&&e.host&&e.host!==o.host?v(t,n,{name:"Outbound Link: Click",props:{url:n.href}}):(e=r)&&(a=e.split(".").pop(),h.some(function(t){return t===a}))?v(t,n,{name:"File Download",props:{url:r}}):void 0}}function v(t,e,a){var n,r=!1;function i(){r||(r=!0,window.location=e.href)}!function(t,e){if(!t.defaultPrevented)return e=!e.target||e.target.match(/^_(self|parent|top)$/i),t=!(t.ctrlKey||t.metaKey||t.shiftKey)&&"click"===t.type,e&&t}(t,e)?(n={props:a.props},plausible(a.name,n)):(n={props:a.props,callback:i},plausible(a.name,n),setTimeout(i,5e3),t.preventDefault())}p.addEventListener("click",d),p.addEventListener("auxclick",d);var m=["pdf","xlsx","docx","txt","rtf","csv","exe","key","pps","ppt","pptx","7z","pkg","rar","gz","zip","avi","mov","mp4","mpeg","wmv","midi","mp3","wav","wma","dmg"],g=s.getAttribute("file-types"),b=s.getAttribute("add-file-types"),h=g&&g.split(",")||b&&b.split(",").concat(m)||m;function w(t){var t=L(t)?t:t&&t.parentNode,e={name:null,props:{}},a=t&&t.classList;if(a)for(var n=0;n<a.length;n++){var r,i=a.item(n).match(/plausible-event-(.+)(=|--)(.+)/);i&&(r=i[1],i=i[3].replace(/\+/g," "),"name"==r.toLowerCase()?e.name=i:e.props[r]=i)}return e}var y=3;function k(t){if("auxclick"!==t.type||t.button===f){for(var e,a,n,r,i=t.target,o=0;o<=y&&i;o++){if((n=i)&&n.tagName&&"form"===n.tagName.toLowerCase())return;c(i)&&(e=i),L(i)&&(a=i),i=i.parentNode}a&&(r=w(a),e?(r.props.url=e.href,v(t,e,r)):((t={}).props=r.props,plausible(r.name,t)))}}function L(t){var e=t&&t.classList;if(e)for(var a=0;a<e.length;a++)if(e.item(a).match(/plausible-event-name(=|--)(.+)/))return!0;return!1}p.addEventListener("submit",function(t){var e,a=t.target,n=w(a);function r(){e||(e=!0,a.submit())}n.name&&(t.preventDefault(),e=!1,setTimeout(r,5e3),t={props:n.props,callback:r},plausible(n.name,t))}),p.addEventListener("click",k),p.addEventListener("auxclick",k)}();
\ No newline at end of file
+!function(){"use strict";var o=window.location,p=window.document,s=p.getElementById("plausible"),l=s.getAttribute("data-api")||(m=(m=s).src.split("/"),u=m[0],m=m[2],u+"//"+m+"/api/event");function t(t,e){try{if("true"===window.localStorage.plausible_ignore)return n=e,(a="localStorage flag")&&console.warn("Ignoring Event: "+a),void(n&&n.callback&&n.callback())}catch(t){}var a={},n=(a.n=t,a.u=o.href,a.d=s.getAttribute("data-domain"),a.r=p.referrer||null,function(){var c=s.getAttribute("data-exclude-cookie");c&&p.cookie.split(";").some(function(t){return t.trim().split("=")[0]===c})&&(a.c=c)}(),e&&e.meta&&(a.m=JSON.stringify(e.meta)),e&&e.props&&(a.p=e.props),s.getAttributeNames().filter(function(t){return"event-"===t.substring(0,6)})),r=a.p||{},i=(n.forEach(function(t){var e=t.replace("event-",""),t=s.getAttribute(t);r[e]=r[e]||t}),a.p=r,new XMLHttpRequest);i.open("POST",l,!0),i.setRequestHeader("Content-Type","text/plain"),i.send(JSON.stringify(a)),i.onreadystatechange=function(){4===i.readyState&&e&&e.callback&&e.callback({status:i.status})}}var e=window.plausible&&window.plausible.q||[];window.plausible=t;for(var a,n=0;n<e.length;n++)t.apply(this,e[n]);function r(){a!==o.pathname&&(a=o.pathname,t("pageview"))}var i,u=window.history;function c(t){return t&&t.tagName&&"a"===t.tagName.toLowerCase()}u.pushState&&(i=u.pushState,u.pushState=function(){i.apply(this,arguments),r()},window.addEventListener("popstate",r)),"prerender"===p.visibilityState?p.addEventListener("visibilitychange",function(){a||"visible"!==p.visibilityState||r()}):r();var f=1;function d(t){if("auxclick"!==t.type||t.button===f){var e,a,n=function(t){for(;t&&(void 0===t.tagName||!c(t)||!t.href);)t=t.parentNode;return t}(t.target),r=n&&n.href&&n.href.split("?")[0];if(!function t(e,a){if(!e||y<a)return!1;if(L(e))return!0;return t(e.parentNode,a+1)}(n,0))return(e=n)&&e.href&&e.host&&e.host!==o.host?v(t,n,{name:"Outbound Link: Click",props:{url:n.href}}):(e=r)&&(a=e.split(".").pop(),h.some(function(t){return t===a}))?v(t,n,{name:"File Download",props:{url:r}}):void 0}}function v(t,e,a){var n,r=!1;function i(){r||(r=!0,window.location=e.href)}!function(t,e){if(!t.defaultPrevented)return e=!e.target||e.target.match(/^_(self|parent|top)$/i),t=!(t.ctrlKey||t.metaKey||t.shiftKey)&&"click"===t.type,e&&t}(t,e)?(n={props:a.props},plausible(a.name,n)):(n={props:a.props,callback:i},plausible(a.name,n),setTimeout(i,5e3),t.preventDefault())}p.addEventListener("click",d),p.addEventListener("auxclick",d);var m=["pdf","xlsx","docx","txt","rtf","csv","exe","key","pps","ppt","pptx","7z","pkg","rar","gz","zip","avi","mov","mp4","mpeg","wmv","midi","mp3","wav","wma","dmg"],g=s.getAttribute("file-types"),b=s.getAttribute("add-file-types"),h=g&&g.split(",")||b&&b.split(",").concat(m)||m;function w(t){var t=L(t)?t:t&&t.parentNode,e={name:null,props:{}},a=t&&t.classList;if(a)for(var n=0;n<a.length;n++){var r,i=a.item(n).match(/plausible-event-(.+)(=|--)(.+)/);i&&(r=i[1],i=i[3].replace(/\+/g," "),"name"==r.toLowerCase()?e.name=i:e.props[r]=i)}return e}var y=3;function k(t){if("auxclick"!==t.type||t.button===f){for(var e,a,n,r,i=t.target,o=0;o<=y&&i;o++){if((n=i)&&n.tagName&&"form"===n.tagName.toLowerCase())return;c(i)&&(e=i),L(i)&&(a=i),i=i.parentNode}a&&(r=w(a),e?(r.props.url=e.href,v(t,e,r)):((t={}).props=r.props,plausible(r.name,t)))}}function L(t){var e=t&&t.classList;if(e)for(var a=0;a<e.length;a++)if(e.item(a).match(/plausible-event-name(=|--)(.+)/))return!0;return!1}p.addEventListener("submit",function(t){var e,a=t.target,n=w(a);function r(){e||(e=!0,a.submit())}n.name&&(t.preventDefault(),e=!1,setTimeout(r,5e3),t={props:n.props,callback:r},plausible(n.name,t))}),p.addEventListener("click",k),p.addEventListener("auxclick",k)}();
\ No newline at end of file
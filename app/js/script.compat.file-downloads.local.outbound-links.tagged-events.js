@@ -1 +1 @@
-!function(){"use strict";var i=window.location,o=window.document,p=o.getElementById("plausible"),s=p.getAttribute("data-api")||(v=(v=p).src.split("/"),u=v[0],v=v[2],u+"//"+v+"/api/event");function t(t,e){try{if("true"===window.localStorage.plausible_ignore)return a=e,(n="localStorage flag")&&console.warn("Ignoring Event: "+n),void(a&&a.callback&&a.callback())}catch(t){}var a,n={},r=(n.n=t,n.u=i.href,n.d=p.getAttribute("data-domain"),n.r=o.referrer||null,e&&e.meta&&(n.m=JSON.stringify(e.meta)),e&&e.props&&(n.p=e.props),new XMLHttpRequest);r.open("POST",s,!0),r.setRequestHeader("Content-Type","text/plain"),r.send(JSON.stringify(n)),r.onreadystatechange=function(){4===r.readyState&&e&&e.callback&&e.callback({status:r.status})}}var e=window.plausible&&window.plausible.q||[];window.plausible=t;for(var a,n=0;n<e.length;n++)t.apply(this,e[n]);function r(){a!==i.pathname&&(a=i.pathname,t("pageview"))}var l,u=window.history;function c(t){return t&&t.tagName&&"a"===t.tagName.toLowerCase()}u.pushState&&(l=u.pushState,u.pushState=function(){l.apply(this,arguments),r()},window.addEventListener("popstate",r)),"prerender"===o.visibilityState?o.addEventListener("visibilitychange",function(){a||"visible"!==o.visibilityState||r()}):r();var f=1;function d(t){if("auxclick"!==t.type||t.button===f){var e,a,n=function(t){for(;t&&(void 0===t.tagName||!c(t)||!t.href);)t=t.parentNode;return t}(t.target),r=n&&n.href&&n.href.split("?")[0];if(!function t(e,a){if(!e||y<a)return!1;if(L(e))return!0;return t(e.parentNode,a+1)}(n,0))return(e=n)&&e.href&&e.host&&e.host!==i.host?m(t,n,{name:"Outbound Link: Click",props:{url:n.href}}):(e=r)&&(a=e.split(".").pop(),h.some(function(t){return t===a}))?m(t,n,{name:"File Download",props:{url:r}}):void 0}}function m(t,e,a){var n,r=!1;function i(){r||(r=!0,window.location=e.href)}!function(t,e){if(!t.defaultPrevented)return e=!e.target||e.target.match(/^_(self|parent|top)$/i),t=!(t.ctrlKey||t.metaKey||t.shiftKey)&&"click"===t.type,e&&t}(t,e)?(n={props:a.props},plausible(a.name,n)):(n={props:a.props,callback:i},plausible(a.name,n),setTimeout(i,5e3),t.preventDefault())}o.addEventListener("click",d),o.addEventListener("auxclick",d);var v=["pdf","xlsx","docx","txt","rtf","csv","exe","key","pps","ppt","pptx","7z","pkg","rar","gz","zip","avi","mov","mp4","mpeg","wmv","midi","mp3","wav","wma","dmg"],g=p.getAttribute("file-types"),b=p.getAttribute("add-file-types"),h=g&&g.split(",")||b&&b.split(",").concat(v)||v;function w(t){var t=L(t)?t:t&&t.parentNode,e={name:null,props:{}},a=t&&t.classList;if(a)for(var n=0;n<a.length;n++){var r,i=a.item(n).match(/plausible-event-(.+)(=|--)(.+)/);i&&(r=i[1],i=i[3].replace(/\+/g," "),"name"==r.toLowerCase()?e.name=i:e.props[r]=i)}return e}var y=3;function k(t){if("auxclick"!==t.type||t.button===f){for(var e,a,n,r,i=t.target,o=0;o<=y&&i;o++){if((n=i)&&n.tagName&&"form"===n.tagName.toLowerCase())return;c(i)&&(e=i),L(i)&&(a=i),i=i.parentNode}a&&(r=w(a),e?(r.props.url=e.href,m(t,e,r)):((t={}).props=r.props,plausible(r.name,t)))}}function L(t){var e=t&&t.classList;if(e)for(var a=0;a<e.length;a++)if(e.item(a).match(/plausible-event-name(=|--)(.+)/))return!0;return!1}o.addEventListener("submit",function(t){var e,a=t.target,n=w(a);function r(){e||(e=!0,a.submit())}n.name&&(t.preventDefault(),e=!1,setTimeout(r,5e3),t={props:n.props,callback:r},plausible(n.name,t))}),o.addEventListener("click",k),o.addEventListener("auxclick",k)}();
\ No newline at end of file
+!function(){"use strict";var i=window.location,o=window.document,p=o.getElementById("plausible"),s=p.getAttribute("data-api")||(v=(v=p).src.split("/"),u=v[0],v=v[2],u+"//"+v+"/api/event");function t(t,e){try{if("true"===window.localStorage.plausible_ignore)return a=e,(n="localStorage flag")&&console.warn("Ignoring Event: "+n),void(a&&a.callback&&a.callback())}catch(t){}var a,n={},r=(n.n=t,n.u=i.href,n.d=p.getAttribute("data-domain"),n.r=o.referrer||null,function(){var c=p.getAttribute("data-exclude-cookie");c&&o.cookie.split(";").some(function(t){return t.trim().split("=")[0]===c})&&(n.c=c)}(),e&&e.meta&&(n.m=JSON.stringify(e.meta)),e&&e.props&&(n.p=e.props),new XMLHttpRequest);r.open("POST",s,!0),r.setRequestHeader("Content-Type","text/plain"),r.send(JSON.stringify(n)),r.onreadystatechange=function(){4===r.readyState&&e&&e.callback&&e.callback({status:r.status})}}var e=window.plausible&&window.plausible.q||[];window.plausible=t;for(var a,n=0;n<e.length;n++)t.apply(this,e[n]);function r(){a!==i.pathname&&(a=i.pathname,t("pageview"))}var l,u=window.history;function c(t){return t&&t.tagName&&"a"===t.tagName.toLowerCase()}u.pushState&&(l=u.pushState,u.pushState=function(){l.apply(this,arguments),r()},window.addEventListener("popstate",r)),"prerender"===o.visibilityState?o.addEventListener("visibilitychange",function(){a||"visible"!==o.visibilityState||r()}):r();var f=1;function d(t){if("auxclick"!==t.type||t.button===f){var e,a,n=function(t){for(;t&&(void 0===t.tagName||!c(t)||!t.href);)t=t.parentNode;return t}(t.target),r=n&&n.href&&n.href.split("?")[0];if(!function t(e,a){if(!e||y<a)return!1;if(L(e))return!0;return t(e.parentNode,a+1)}(n,0))return(e=n)&&e.href&&e.host&&e.host!==i.host?m(t,n,{name:"Outbound Link: Click",props:{url:n.href}}):(e=r)&&(a=e.split(".").pop(),h.some(function(t){return t===a}))?m(t,n,{name:"File Download",props:{url:r}}):void 0}}function m(t,e,a){var n,r=!1;function i(){r||(r=!0,window.location=e.href)}!function(t,e){if(!t.defaultPrevented)return e=!e.target||e.target.match(/^_(self|parent|top)$/i),t=!(t.ctrlKey||t.metaKey||t.shiftKey)&&"click"===t.type,e&&t}(t,e)?(n={props:a.props},plausible(a.name,n)):(n={props:a.props,callback:i},plausible(a.name,n),setTimeout(i,5e3),t.preventDefault())}o.addEventListener("click",d),o.addEventListener("auxclick",d);var v=["pdf","xlsx","docx","txt","rtf","csv","exe","key","pps","ppt","pptx","7z","pkg","rar","gz","zip","avi","mov","mp4","mpeg","wmv","midi","mp3","wav","wma","dmg"],g=p.getAttribute("file-types"),b=p.getAttribute("add-file-types"),h=g&&g.split(",")||b&&b.split(",").concat(v)||v;function w(t){var t=L(t)?t:t&&t.parentNode,e={name:null,props:{}},a=t&&t.classList;if(a)for(var n=0;n<a.length;n++){var r,i=a.item(n).match(/plausible-event-(.+)(=|--)(.+)/);i&&(r=i[1],i=i[3].replace(/\+/g," "),"name"==r.toLowerCase()?e.name=i:e.props[r]=i)}return e}var y=3;function k(t){if("auxclick"!==t.type||t.button===f){for(var e,a,n,r,i=t.target,o=0;o<=y&&i;o++){if((n=i)&&n.tagName&&"form"===n.tagName.toLowerCase())return;c(i)&&(e=i),L(i)&&(a=i),i=i.parentNode}a&&(r=w(a),e?(r.props.url=e.href,m(t,e,r)):((t={}).props=r.props,plausible(r.name,t)))}}function L(t){var e=t&&t.classList;if(e)for(var a=0;a<e.length;a++)if(e.item(a).match(/plausible-event-name(=|--)(.+)/))return!0;return!1}o.addEventListener("submit",function(t){var e,a=t.target,n=w(a);function r(){e||(e=!0,a.submit())}n.name&&(t.preventDefault(),e=!1,setTimeout(r,5e3),t={props:n.props,callback:r},plausible(n.name,t))}),o.addEventListener("click",k),o.addEventListener("auxclick",k)}();
\ No newline at end of file
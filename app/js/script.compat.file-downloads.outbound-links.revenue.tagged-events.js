@@ -1 +1 @@
-!function(){"use strict";var i=window.location,r=window.document,o=r.getElementById("plausible"),p=o.getAttribute("data-api")||(w=(w=o).src.split("/"),c=w[0],w=w[2],c+"//"+w+"/api/event");function l(e,t){e&&console.warn("Ignoring Event: "+e),t&&t.callback&&t.callback()}function e(e,t){if(/^localhost$|^127(\.[0-9]+){0,2}\.[0-9]+$|^\[::1?\]$/.test(i.hostname)||"file:"===i.protocol)return l("localhost",t);if((window._phantom||window.__nightmare||window.navigator.webdriver||window.Cypress)&&!window.__plausible)return l(null,t);try{if("true"===window.localStorage.plausible_ignore)return l("localStorage flag",t)}catch(e){}var n={},a=(n.n=e,n.u=i.href,n.d=o.getAttribute("data-domain"),n.r=r.referrer||null,t&&t.meta&&(n.m=JSON.stringify(t.meta)),t&&t.props&&(n.p=t.props),t&&t.revenue&&(n.$=t.revenue),new XMLHttpRequest);a.open("POST",p,!0),a.setRequestHeader("Content-Type","text/plain"),a.send(JSON.stringify(n)),a.onreadystatechange=function(){4===a.readyState&&t&&t.callback&&t.callback({status:a.status})}}var t=window.plausible&&window.plausible.q||[];window.plausible=e;for(var n,a=0;a<t.length;a++)e.apply(this,t[a]);function u(){n!==i.pathname&&(n=i.pathname,e("pageview"))}var s,c=window.history;function f(e){return e&&e.tagName&&"a"===e.tagName.toLowerCase()}c.pushState&&(s=c.pushState,c.pushState=function(){s.apply(this,arguments),u()},window.addEventListener("popstate",u)),"prerender"===r.visibilityState?r.addEventListener("visibilitychange",function(){n||"visible"!==r.visibilityState||u()}):u();var v=1;function d(e){if("auxclick"!==e.type||e.button===v){var t,n,a=function(e){for(;e&&(void 0===e.tagName||!f(e)||!e.href);)e=e.parentNode;return e}(e.target),r=a&&a.href&&a.href.split("?")[0];if(!function e(t,n){if(!t||k<n)return!1;if(x(t))return!0;return e(t.parentNode,n+1)}(a,0))return(t=a)&&t.href&&t.host&&t.host!==i.host?m(e,a,{name:"Outbound Link: Click",props:{url:a.href}}):(t=r)&&(n=t.split(".").pop(),b.some(function(e){return e===n}))?m(e,a,{name:"File Download",props:{url:r}}):void 0}}function m(e,t,n){var a,r=!1;function i(){r||(r=!0,window.location=t.href)}!function(e,t){if(!e.defaultPrevented)return t=!t.target||t.target.match(/^_(self|parent|top)$/i),e=!(e.ctrlKey||e.metaKey||e.shiftKey)&&"click"===e.type,t&&e}(e,t)?((a={props:n.props}).revenue=n.revenue,plausible(n.name,a)):((a={props:n.props,callback:i}).revenue=n.revenue,plausible(n.name,a),setTimeout(i,5e3),e.preventDefault())}r.addEventListener("click",d),r.addEventListener("auxclick",d);var w=["pdf","xlsx","docx","txt","rtf","csv","exe","key","pps","ppt","pptx","7z","pkg","rar","gz","zip","avi","mov","mp4","mpeg","wmv","midi","mp3","wav","wma","dmg"],g=o.getAttribute("file-types"),h=o.getAttribute("add-file-types"),b=g&&g.split(",")||h&&h.split(",").concat(w)||w;function y(e){var e=x(e)?e:e&&e.parentNode,t={name:null,props:{},revenue:{}},n=e&&e.classList;if(n)for(var a=0;a<n.length;a++){var r,i,o=n.item(a),p=o.match(/plausible-event-(.+)(=|--)(.+)/),p=(p&&(r=p[1],i=p[3].replace(/\+/g," "),"name"==r.toLowerCase()?t.name=i:t.props[r]=i),o.match(/plausible-revenue-(.+)(=|--)(.+)/));p&&(r=p[1],i=p[3],t.revenue[r]=i)}return t}var k=3;function L(e){if("auxclick"!==e.type||e.button===v){for(var t,n,a,r,i=e.target,o=0;o<=k&&i;o++){if((a=i)&&a.tagName&&"form"===a.tagName.toLowerCase())return;f(i)&&(t=i),x(i)&&(n=i),i=i.parentNode}n&&(r=y(n),t?(r.props.url=t.href,m(e,t,r)):((e={}).props=r.props,e.revenue=r.revenue,plausible(r.name,e)))}}function x(e){var t=e&&e.classList;if(t)for(var n=0;n<t.length;n++)if(t.item(n).match(/plausible-event-name(=|--)(.+)/))return!0;return!1}r.addEventListener("submit",function(e){var t,n=e.target,a=y(n);function r(){t||(t=!0,n.submit())}a.name&&(e.preventDefault(),t=!1,setTimeout(r,5e3),(e={props:a.props,callback:r}).revenue=a.revenue,plausible(a.name,e))}),r.addEventListener("click",L),r.addEventListener("auxclick",L)}();
\ No newline at end of file
+!function(){"use strict";var i=window.location,r=window.document,o=r.getElementById("plausible"),p=o.getAttribute("data-api")||(w=(w=o).src.split("/"),c=w[0],w=w[2],c+"//"+w+"/api/event");function l(e,t){e&&console.warn("Ignoring Event: "+e),t&&t.callback&&t.callback()}function e(e,t){if(/^localhost$|^127(\.[0-9]+){0,2}\.[0-9]+$|^\[::1?\]$/.test(i.hostname)||"file:"===i.protocol)return l("localhost",t);if((window._phantom||window.__nightmare||window.navigator.webdriver||window.Cypress)&&!window.__plausible)return l(null,t);try{if("true"===window.localStorage.plausible_ignore)return l("localStorage flag",t)}catch(e){}var n={},a=(n.n=e,n.u=i.href,n.d=o.getAttribute("data-domain"),n.r=r.referrer||null,function(){var c=o.getAttribute("data-exclude-cookie");c&&r.cookie.split(";").some(function(t){return t.trim().split("=")[0]===c})&&(n.c=c)}(),t&&t.meta&&(n.m=JSON.stringify(t.meta)),t&&t.props&&(n.p=t.props),t&&t.revenue&&(n.$=t.revenue),new XMLHttpRequest);a.open("POST",p,!0),a.setRequestHeader("Content-Type","text/plain"),a.send(JSON.stringify(n)),a.onreadystatechange=function(){4===a.readyState&&t&&t.callback&&t.callback({status:a.status})}}var t=window.plausible&&window.plausible.q||[];window.plausible=e;for(var n,a=0;a<t.length;a++)e.apply(this,t[a]);function u(){n!==i.pathname&&(n=i.pathname,e("pageview"))}var s,c=window.history;function f(e){return e&&e.tagName&&"a"===e.tagName.toLowerCase()}c.pushState&&(s=c.pushState,c.pushState=function(){s.apply(this,arguments),u()},window.addEventListener("popstate",u)),"prerender"===r.visibilityState?r.addEventListener("visibilitychange",function(){n||"visible"!==r.visibilityState||u()}):u();var v=1;function d(e){if("auxclick"!==e.type||e.button===v){var t,n,a=function(e){for(;e&&(void 0===e.tagName||!f(e)||!e.href);)e=e.parentNode;return e}(e.target),r=a&&a.href&&a.href.split("?")[0];if(!function e(t,n){if(!t||k<n)return!1;if(x(t))return!0;return e(t.parentNode,n+1)}(a,0))return(t=a)&&t.href&&t.host&&t.host!==i.host?m(e,a,{name:"Outbound Link: Click",props:{url:a.href}}):(t=r)&&(n=t.split(".").pop(),b.some(function(e){return e===n}))?m(e,a,{name:"File Download",props:{url:r}}):void 0}}function m(e,t,n){var a,r=!1;function i(){r||(r=!0,window.location=t.href)}!function(e,t){if(!e.defaultPrevented)return t=!t.target||t.target.match(/^_(self|parent|top)$/i),e=!(e.ctrlKey||e.metaKey||e.shiftKey)&&"click"===e.type,t&&e}(e,t)?((a={props:n.props}).revenue=n.revenue,plausible(n.name,a)):((a={props:n.props,callback:i}).revenue=n.revenue,plausible(n.name,a),setTimeout(i,5e3),e.preventDefault())}r.addEventListener("click",d),r.addEventListener("auxclick",d);var w=["pdf","xlsx","docx","txt","rtf","csv","exe","key","pps","ppt","pptx","7z","pkg","rar","gz","zip","avi","mov","mp4","mpeg","wmv","midi","mp3","wav","wma","dmg"],g=o.getAttribute("file-types"),h=o.getAttribute("add-file-types"),b=g&&g.split(",")||h&&h.split(",").concat(w)||w;function y(e){var e=x(e)?e:e&&e.parentNode,t={name:null,props:{},revenue:{}},n=e&&e.classList;if(n)for(var a=0;a<n.length;a++){var r,i,o=n.item(a),p=o.match(/plausible-event-(.+)(=|--)(.+)/),p=(p&&(r=p[1],i=p[3].replace(/\+/g," "),"name"==r.toLowerCase()?t.name=i:t.props[r]=i),o.match(/plausible-revenue-(.+)(=|--)(.+)/));p&&(r=p[1],i=p[3],t.revenue[r]=i)}return t}var k=3;function L(e){if("auxclick"!==e.type||e.button===v){for(var t,n,a,r,i=e.target,o=0;o<=k&&i;o++){if((a=i)&&a.tagName&&"form"===a.tagName.toLowerCase())return;f(i)&&(t=i),x(i)&&(n=i),i=i.parentNode}n&&(r=y(n),t?(r.props.url=t.href,m(e,t,r)):((e={}).props=r.props,e.revenue=r.revenue,plausible(r.name,e)))}}function x(e){var t=e&&e.classList;if(t)for(var n=0;n<t.length;n++)if(t.item(n).match(/plausible-event-name(=|--)(.+)/))return!0;return!1}r.addEventListener("submit",function(e){var t,n=e.target,a=y(n);function r(){t||(t=!0,n.submit())}a.name&&(e.preventDefault(),t=!1,setTimeout(r,5e3),(e={props:a.props,callback:r}).revenue=a.revenue,plausible(a.name,e))}),r.addEventListener("click",L),r.addEventListener("auxclick",L)}();
\ No newline at end of file
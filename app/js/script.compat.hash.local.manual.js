@@ -1 +1 @@
-!function(){"use strict";var t,e,l=window.location,r=window.document,o=r.getElementById("plausible"),s=o.getAttribute("data-api")||(t=(t=o).src.split("/"),e=t[0],t=t[2],e+"//"+t+"/api/event");function a(t,e){try{if("true"===window.localStorage.plausible_ignore)return a=e,(n="localStorage flag")&&console.warn("Ignoring Event: "+n),void(a&&a.callback&&a.callback())}catch(t){}var a,n={},i=(n.n=t,n.u=e&&e.u?e.u:l.href,n.d=o.getAttribute("data-domain"),n.r=r.referrer||null,e&&e.meta&&(n.m=JSON.stringify(e.meta)),e&&e.props&&(n.p=e.props),n.h=1,new XMLHttpRequest);i.open("POST",s,!0),i.setRequestHeader("Content-Type","text/plain"),i.send(JSON.stringify(n)),i.onreadystatechange=function(){4===i.readyState&&e&&e.callback&&e.callback({status:i.status})}}var n=window.plausible&&window.plausible.q||[];window.plausible=a;for(var i=0;i<n.length;i++)a.apply(this,n[i])}();
\ No newline at end of file
+!function(){"use strict";var t,e,l=window.location,r=window.document,o=r.getElementById("plausible"),s=o.getAttribute("data-api")||(t=(t=o).src.split("/"),e=t[0],t=t[2],e+"//"+t+"/api/event");function a(t,e){try{if("true"===window.localStorage.plausible_ignore)return a=e,(n="localStorage flag")&&console.warn("Ignoring Event: "+n),void(a&&a.callback&&a.callback())}catch(t){}var a,n={},i=(n.n=t,n.u=e&&e.u?e.u:l.href,n.d=o.getAttribute("data-domain"),n.r=r.referrer||null,function(){var c=o.getAttribute("data-exclude-cookie");c&&r.cookie.split(";").some(function(t){return t.trim().split("=")[0]===c})&&(n.c=c)}(),e&&e.meta&&(n.m=JSON.stringify(e.meta)),e&&e.props&&(n.p=e.props),n.h=1,new XMLHttpRequest);i.open("POST",s,!0),i.setRequestHeader("Content-Type","text/plain"),i.send(JSON.stringify(n)),i.onreadystatechange=function(){4===i.readyState&&e&&e.callback&&e.callback({status:i.status})}}var n=window.plausible&&window.plausible.q||[];window.plausible=a;for(var i=0;i<n.length;i++)a.apply(this,n[i])}();
\ No newline at end of file
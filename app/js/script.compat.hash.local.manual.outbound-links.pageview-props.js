@@ -1 +1 @@
-!function(){"use strict";var t,e,l=window.location,o=window.document,u=o.getElementById("plausible"),s=u.getAttribute("data-api")||(t=(t=u).src.split("/"),e=t[0],t=t[2],e+"//"+t+"/api/event");function a(t,e){try{if("true"===window.localStorage.plausible_ignore)return n=e,(a="localStorage flag")&&console.warn("Ignoring Event: "+a),void(n&&n.callback&&n.callback())}catch(t){}var a={},n=(a.n=t,a.u=e&&e.u?e.u:l.href,a.d=u.getAttribute("data-domain"),a.r=o.referrer||null,e&&e.meta&&(a.m=JSON.stringify(e.meta)),e&&e.props&&(a.p=e.props),u.getAttributeNames().filter(function(t){return"event-"===t.substring(0,6)})),r=a.p||{},i=(n.forEach(function(t){var e=t.replace("event-",""),t=u.getAttribute(t);r[e]=r[e]||t}),a.p=r,a.h=1,new XMLHttpRequest);i.open("POST",s,!0),i.setRequestHeader("Content-Type","text/plain"),i.send(JSON.stringify(a)),i.onreadystatechange=function(){4===i.readyState&&e&&e.callback&&e.callback({status:i.status})}}var n=window.plausible&&window.plausible.q||[];window.plausible=a;for(var r=0;r<n.length;r++)a.apply(this,n[r]);var c=1;function i(t){var e,a,n,r,i;function o(){n||(n=!0,window.location=a.href)}"auxclick"===t.type&&t.button!==c||((e=function(t){for(;t&&(void 0===t.tagName||!(e=t)||!e.tagName||"a"!==e.tagName.toLowerCase()||!t.href);)t=t.parentNode;var e;return t}(t.target))&&e.href&&e.href.split("?")[0],(i=e)&&i.href&&i.host&&i.host!==l.host&&(i=t,t={name:"Outbound Link: Click",props:{url:(a=e).href}},n=!1,!function(t,e){if(!t.defaultPrevented)return e=!e.target||e.target.match(/^_(self|parent|top)$/i),t=!(t.ctrlKey||t.metaKey||t.shiftKey)&&"click"===t.type,e&&t}(i,a)?(r={props:t.props},plausible(t.name,r)):(r={props:t.props,callback:o},plausible(t.name,r),setTimeout(o,5e3),i.preventDefault())))}o.addEventListener("click",i),o.addEventListener("auxclick",i)}();
\ No newline at end of file
+!function(){"use strict";var t,e,l=window.location,o=window.document,u=o.getElementById("plausible"),s=u.getAttribute("data-api")||(t=(t=u).src.split("/"),e=t[0],t=t[2],e+"//"+t+"/api/event");function a(t,e){try{if("true"===window.localStorage.plausible_ignore)return n=e,(a="localStorage flag")&&console.warn("Ignoring Event: "+a),void(n&&n.callback&&n.callback())}catch(t){}var a={},n=(a.n=t,a.u=e&&e.u?e.u:l.href,a.d=u.getAttribute("data-domain"),a.r=o.referrer||null,function(){var c=u.getAttribute("data-exclude-cookie");c&&o.cookie.split(";").some(function(t){return t.trim().split("=")[0]===c})&&(a.c=c)}(),e&&e.meta&&(a.m=JSON.stringify(e.meta)),e&&e.props&&(a.p=e.props),u.getAttributeNames().filter(function(t){return"event-"===t.substring(0,6)})),r=a.p||{},i=(n.forEach(function(t){var e=t.replace("event-",""),t=u.getAttribute(t);r[e]=r[e]||t}),a.p=r,a.h=1,new XMLHttpRequest);i.open("POST",s,!0),i.setRequestHeader("Content-Type","text/plain"),i.send(JSON.stringify(a)),i.onreadystatechange=function(){4===i.readyState&&e&&e.callback&&e.callback({status:i.status})}}var n=window.plausible&&window.plausible.q||[];window.plausible=a;for(var r=0;r<n.length;r++)a.apply(this,n[r]);var c=1;function i(t){var e,a,n,r,i;function o(){n||(n=!0,window.location=a.href)}"auxclick"===t.type&&t.button!==c||((e=function(t){for(;t&&(void 0===t.tagName||!(e=t)||!e.tagName||"a"!==e.tagName.toLowerCase()||!t.href);)t=t.parentNode;var e;return t}(t.target))&&e.href&&e.href.split("?")[0],(i=e)&&i.href&&i.host&&i.host!==l.host&&(i=t,t={name:"Outbound Link: Click",props:{url:(a=e).href}},n=!1,!function(t,e){if(!t.defaultPrevented)return e=!e.target||e.target.match(/^_(self|parent|top)$/i),t=!(t.ctrlKey||t.metaKey||t.shiftKey)&&"click"===t.type,e&&t}(i,a)?(r={props:t.props},plausible(t.name,r)):(r={props:t.props,callback:o},plausible(t.name,r),setTimeout(o,5e3),i.preventDefault())))}o.addEventListener("click",i),o.addEventListener("auxclick",i)}();
\ No newline at end of file
@@ -1 +1 @@
-!function(){"use strict";var t,e,l=window.location,r=window.document,o=r.getElementById("plausible"),i=o.getAttribute("data-api")||(t=(t=o).src.split("/"),e=t[0],t=t[2],e+"//"+t+"/api/event");function s(t,e){t&&console.warn("Ignoring Event: "+t),e&&e.callback&&e.callback()}function a(t,e){if(/^localhost$|^127(\.[0-9]+){0,2}\.[0-9]+$|^\[::1?\]$/.test(l.hostname)||"file:"===l.protocol)return s("localhost",e);if((window._phantom||window.__nightmare||window.navigator.webdriver||window.Cypress)&&!window.__plausible)return s(null,e);try{if("true"===window.localStorage.plausible_ignore)return s("localStorage flag",e)}catch(t){}var a={},n=(a.n=t,a.u=e&&e.u?e.u:l.href,a.d=o.getAttribute("data-domain"),a.r=r.referrer||null,e&&e.meta&&(a.m=JSON.stringify(e.meta)),e&&e.props&&(a.p=e.props),a.h=1,new XMLHttpRequest);n.open("POST",i,!0),n.setRequestHeader("Content-Type","text/plain"),n.send(JSON.stringify(a)),n.onreadystatechange=function(){4===n.readyState&&e&&e.callback&&e.callback({status:n.status})}}var n=window.plausible&&window.plausible.q||[];window.plausible=a;for(var u=0;u<n.length;u++)a.apply(this,n[u]);var c=1;function p(t){var e,a,n,r,o;function i(){n||(n=!0,window.location=a.href)}"auxclick"===t.type&&t.button!==c||((e=function(t){for(;t&&(void 0===t.tagName||!(e=t)||!e.tagName||"a"!==e.tagName.toLowerCase()||!t.href);)t=t.parentNode;var e;return t}(t.target))&&e.href&&e.href.split("?")[0],(o=e)&&o.href&&o.host&&o.host!==l.host&&(o=t,t={name:"Outbound Link: Click",props:{url:(a=e).href}},n=!1,!function(t,e){if(!t.defaultPrevented)return e=!e.target||e.target.match(/^_(self|parent|top)$/i),t=!(t.ctrlKey||t.metaKey||t.shiftKey)&&"click"===t.type,e&&t}(o,a)?(r={props:t.props},plausible(t.name,r)):(r={props:t.props,callback:i},plausible(t.name,r),setTimeout(i,5e3),o.preventDefault())))}r.addEventListener("click",p),r.addEventListener("auxclick",p)}();
\ No newline at end of file
+!function(){"use strict";var t,e,l=window.location,r=window.document,o=r.getElementById("plausible"),i=o.getAttribute("data-api")||(t=(t=o).src.split("/"),e=t[0],t=t[2],e+"//"+t+"/api/event");function s(t,e){t&&console.warn("Ignoring Event: "+t),e&&e.callback&&e.callback()}function a(t,e){if(/^localhost$|^127(\.[0-9]+){0,2}\.[0-9]+$|^\[::1?\]$/.test(l.hostname)||"file:"===l.protocol)return s("localhost",e);if((window._phantom||window.__nightmare||window.navigator.webdriver||window.Cypress)&&!window.__plausible)return s(null,e);try{if("true"===window.localStorage.plausible_ignore)return s("localStorage flag",e)}catch(t){}var a={},n=(a.n=t,a.u=e&&e.u?e.u:l.href,a.d=o.getAttribute("data-domain"),a.r=r.referrer||null,function(){var c=o.getAttribute("data-exclude-cookie");c&&r.cookie.split(";").some(function(t){return t.trim().split("=")[0]===c})&&(a.c=c)}(),e&&e.meta&&(a.m=JSON.stringify(e.meta)),e&&e.props&&(a.p=e.props),a.h=1,new XMLHttpRequest);n.open("POST",i,!0),n.setRequestHeader("Content-Type","text/plain"),n.send(JSON.stringify(a)),n.onreadystatechange=function(){4===n.readyState&&e&&e.callback&&e.callback({status:n.status})}}var n=window.plausible&&window.plausible.q||[];window.plausible=a;for(var u=0;u<n.length;u++)a.apply(this,n[u]);var c=1;function p(t){var e,a,n,r,o;function i(){n||(n=!0,window.location=a.href)}"auxclick"===t.type&&t.button!==c||((e=function(t){for(;t&&(void 0===t.tagName||!(e=t)||!e.tagName||"a"!==e.tagName.toLowerCase()||!t.href);)t=t.parentNode;var e;return t}(t.target))&&e.href&&e.href.split("?")[0],(o=e)&&o.href&&o.host&&o.host!==l.host&&(o=t,t={name:"Outbound Link: Click",props:{url:(a=e).href}},n=!1,!function(t,e){if(!t.defaultPrevented)return e=!e.target||e.target.match(/^_(self|parent|top)$/i),t=!(t.ctrlKey||t.metaKey||t.shiftKey)&&"click"===t.type,e&&t}(o,a)?(r={props:t.props},plausible(t.name,r)):(r={props:t.props,callback:i},plausible(t.name,r),setTimeout(i,5e3),o.preventDefault())))}r.addEventListener("click",p),r.addEventListener("auxclick",p)}();
\ No newline at end of file
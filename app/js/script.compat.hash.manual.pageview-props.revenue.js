@@ -1 +1 @@
-!function(){"use strict";var t,e,i=window.location,o=window.document,l=o.getElementById("plausible"),u=l.getAttribute("data-api")||(t=(t=l).src.split("/"),e=t[0],t=t[2],e+"//"+t+"/api/event");function s(t,e){t&&console.warn("Ignoring Event: "+t),e&&e.callback&&e.callback()}function n(t,e){if(/^localhost$|^127(\.[0-9]+){0,2}\.[0-9]+$|^\[::1?\]$/.test(i.hostname)||"file:"===i.protocol)return s("localhost",e);if((window._phantom||window.__nightmare||window.navigator.webdriver||window.Cypress)&&!window.__plausible)return s(null,e);try{if("true"===window.localStorage.plausible_ignore)return s("localStorage flag",e)}catch(t){}var n={},t=(n.n=t,n.u=e&&e.u?e.u:i.href,n.d=l.getAttribute("data-domain"),n.r=o.referrer||null,e&&e.meta&&(n.m=JSON.stringify(e.meta)),e&&e.props&&(n.p=e.props),e&&e.revenue&&(n.$=e.revenue),l.getAttributeNames().filter(function(t){return"event-"===t.substring(0,6)})),a=n.p||{},r=(t.forEach(function(t){var e=t.replace("event-",""),t=l.getAttribute(t);a[e]=a[e]||t}),n.p=a,n.h=1,new XMLHttpRequest);r.open("POST",u,!0),r.setRequestHeader("Content-Type","text/plain"),r.send(JSON.stringify(n)),r.onreadystatechange=function(){4===r.readyState&&e&&e.callback&&e.callback({status:r.status})}}var a=window.plausible&&window.plausible.q||[];window.plausible=n;for(var r=0;r<a.length;r++)n.apply(this,a[r])}();
\ No newline at end of file
+!function(){"use strict";var t,e,i=window.location,o=window.document,l=o.getElementById("plausible"),u=l.getAttribute("data-api")||(t=(t=l).src.split("/"),e=t[0],t=t[2],e+"//"+t+"/api/event");function s(t,e){t&&console.warn("Ignoring Event: "+t),e&&e.callback&&e.callback()}function n(t,e){if(/^localhost$|^127(\.[0-9]+){0,2}\.[0-9]+$|^\[::1?\]$/.test(i.hostname)||"file:"===i.protocol)return s("localhost",e);if((window._phantom||window.__nightmare||window.navigator.webdriver||window.Cypress)&&!window.__plausible)return s(null,e);try{if("true"===window.localStorage.plausible_ignore)return s("localStorage flag",e)}catch(t){}var n={},t=(n.n=t,n.u=e&&e.u?e.u:i.href,n.d=l.getAttribute("data-domain"),n.r=o.referrer||null,function(){var c=l.getAttribute("data-exclude-cookie");c&&o.cookie.split(";").some(function(t){return t.trim().split("=")[0]===c})&&(n.c=c)}(),e&&e.meta&&(n.m=JSON.stringify(e.meta)),e&&e.props&&(n.p=e.props),e&&e.revenue&&(n.$=e.revenue),l.getAttributeNames().filter(function(t){return"event-"===t.substring(0,6)})),a=n.p||{},r=(t.forEach(function(t){var e=t.replace("event-",""),t=l.getAttribute(t);a[e]=a[e]||t}),n.p=a,n.h=1,new XMLHttpRequest);r.open("POST",u,!0),r.setRequestHeader("Content-Type","text/plain"),r.send(JSON.stringify(n)),r.onreadystatechange=function(){4===r.readyState&&e&&e.callback&&e.callback({status:r.status})}}var a=window.plausible&&window.plausible.q||[];window.plausible=n;for(var r=0;r<a.length;r++)n.apply(this,a[r])}();
\ No newline at end of file
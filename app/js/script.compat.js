@@ -1 +1 @@
-!function(){"use strict";var t,n=window.location,o=window.document,r=o.getElementById("plausible"),l=r.getAttribute("data-api")||(t=(t=r).src.split("/"),c=t[0],t=t[2],c+"//"+t+"/api/event");function s(t,e){t&&console.warn("Ignoring Event: "+t),e&&e.callback&&e.callback()}function e(t,e){if(/^localhost$|^127(\.[0-9]+){0,2}\.[0-9]+$|^\[::1?\]$/.test(n.hostname)||"file:"===n.protocol)return s("localhost",e);if((window._phantom||window.__nightmare||window.navigator.webdriver||window.Cypress)&&!window.__plausible)return s(null,e);try{if("true"===window.localStorage.plausible_ignore)return s("localStorage flag",e)}catch(t){}var a={},i=(a.n=t,a.u=n.href,a.d=r.getAttribute("data-domain"),a.r=o.referrer||null,e&&e.meta&&(a.m=JSON.stringify(e.meta)),e&&e.props&&(a.p=e.props),new XMLHttpRequest);i.open("POST",l,!0),i.setRequestHeader("Content-Type","text/plain"),i.send(JSON.stringify(a)),i.onreadystatechange=function(){4===i.readyState&&e&&e.callback&&e.callback({status:i.status})}}var a=window.plausible&&window.plausible.q||[];window.plausible=e;for(var i,p=0;p<a.length;p++)e.apply(this,a[p]);function u(){i!==n.pathname&&(i=n.pathname,e("pageview"))}var w,c=window.history;c.pushState&&(w=c.pushState,c.pushState=function(){w.apply(this,arguments),u()},window.addEventListener("popstate",u)),"prerender"===o.visibilityState?o.addEventListener("visibilitychange",function(){i||"visible"!==o.visibilityState||u()}):u()}();
\ No newline at end of file
+!function(){"use strict";var t,n=window.location,o=window.document,r=o.getElementById("plausible"),l=r.getAttribute("data-api")||(t=(t=r).src.split("/"),c=t[0],t=t[2],c+"//"+t+"/api/event");function s(t,e){t&&console.warn("Ignoring Event: "+t),e&&e.callback&&e.callback()}function e(t,e){if(/^localhost$|^127(\.[0-9]+){0,2}\.[0-9]+$|^\[::1?\]$/.test(n.hostname)||"file:"===n.protocol)return s("localhost",e);if((window._phantom||window.__nightmare||window.navigator.webdriver||window.Cypress)&&!window.__plausible)return s(null,e);try{if("true"===window.localStorage.plausible_ignore)return s("localStorage flag",e)}catch(t){}var a={},i=(a.n=t,a.u=n.href,a.d=r.getAttribute("data-domain"),a.r=o.referrer||null,function(){var c=r.getAttribute("data-exclude-cookie");c&&o.cookie.split(";").some(function(t){return t.trim().split("=")[0]===c})&&(a.c=c)}(),e&&e.meta&&(a.m=JSON.stringify(e.meta)),e&&e.props&&(a.p=e.props),new XMLHttpRequest);i.open("POST",l,!0),i.setRequestHeader("Content-Type","text/plain"),i.send(JSON.stringify(a)),i.onreadystatechange=function(){4===i.readyState&&e&&e.callback&&e.callback({status:i.status})}}var a=window.plausible&&window.plausible.q||[];window.plausible=e;for(var i,p=0;p<a.length;p++)e.apply(this,a[p]);function u(){i!==n.pathname&&(i=n.pathname,e("pageview"))}var w,c=window.history;c.pushState&&(w=c.pushState,c.pushState=function(){w.apply(this,arguments),u()},window.addEventListener("popstate",u)),"prerender"===o.visibilityState?o.addEventListener("visibilitychange",function(){i||"visible"!==o.visibilityState||u()}):u()}();
\ No newline at end of file
@@ -1 +1 @@
-!function(){"use strict";var t,i=window.location,o=window.document,r=o.getElementById("plausible"),l=r.getAttribute("data-api")||(t=(t=r).src.split("/"),c=t[0],t=t[2],c+"//"+t+"/api/event");function s(t,e){t&&console.warn("Ignoring Event: "+t),e&&e.callback&&e.callback()}function e(t,e){if(/^localhost$|^127(\.[0-9]+){0,2}\.[0-9]+$|^\[::1?\]$/.test(i.hostname)||"file:"===i.protocol)return s("localhost",e);if((window._phantom||window.__nightmare||window.navigator.webdriver||window.Cypress)&&!window.__plausible)return s(null,e);try{if("true"===window.localStorage.plausible_ignore)return s("localStorage flag",e)}catch(t){}var n={},a=(n.n=t,n.u=i.href,n.d=r.getAttribute("data-domain"),n.r=o.referrer||null,e&&e.meta&&(n.m=JSON.stringify(e.meta)),e&&e.props&&(n.p=e.props),e&&e.revenue&&(n.$=e.revenue),new XMLHttpRequest);a.open("POST",l,!0),a.setRequestHeader("Content-Type","text/plain"),a.send(JSON.stringify(n)),a.onreadystatechange=function(){4===a.readyState&&e&&e.callback&&e.callback({status:a.status})}}var n=window.plausible&&window.plausible.q||[];window.plausible=e;for(var a,p=0;p<n.length;p++)e.apply(this,n[p]);function u(){a!==i.pathname&&(a=i.pathname,e("pageview"))}var w,c=window.history;c.pushState&&(w=c.pushState,c.pushState=function(){w.apply(this,arguments),u()},window.addEventListener("popstate",u)),"prerender"===o.visibilityState?o.addEventListener("visibilitychange",function(){a||"visible"!==o.visibilityState||u()}):u()}();
\ No newline at end of file
+!function(){"use strict";var t,i=window.location,o=window.document,r=o.getElementById("plausible"),l=r.getAttribute("data-api")||(t=(t=r).src.split("/"),c=t[0],t=t[2],c+"//"+t+"/api/event");function s(t,e){t&&console.warn("Ignoring Event: "+t),e&&e.callback&&e.callback()}function e(t,e){if(/^localhost$|^127(\.[0-9]+){0,2}\.[0-9]+$|^\[::1?\]$/.test(i.hostname)||"file:"===i.protocol)return s("localhost",e);if((window._phantom||window.__nightmare||window.navigator.webdriver||window.Cypress)&&!window.__plausible)return s(null,e);try{if("true"===window.localStorage.plausible_ignore)return s("localStorage flag",e)}catch(t){}var n={},a=(n.n=t,n.u=i.href,n.d=r.getAttribute("data-domain"),n.r=o.referrer||null,function(){var c=r.getAttribute("data-exclude-cookie");c&&o.cookie.split(";").some(function(t){return t.trim().split("=")[0]===c})&&(n.c=c)}(),e&&e.meta&&(n.m=JSON.stringify(e.meta)),e&&e.props&&(n.p=e.props),e&&e.revenue&&(n.$=e.revenue),new XMLHttpRequest);a.open("POST",l,!0),a.setRequestHeader("Content-Type","text/plain"),a.send(JSON.stringify(n)),a.onreadystatechange=function(){4===a.readyState&&e&&e.callback&&e.callback({status:a.status})}}var n=window.plausible&&window.plausible.q||[];window.plausible=e;for(var a,p=0;p<n.length;p++)e.apply(this,n[p]);function u(){a!==i.pathname&&(a=i.pathname,e("pageview"))}var w,c=window.history;c.pushState&&(w=c.pushState,c.pushState=function(){w.apply(this,arguments),u()},window.addEventListener("popstate",u)),"prerender"===o.visibilityState?o.addEventListener("visibilitychange",function(){a||"visible"!==o.visibilityState||u()}):u()}();
\ No newline at end of file
@@ -1 +1 @@
-!function(){"use strict";var u=window.location,c=window.document,o=c.currentScript,s=o.getAttribute("data-api")||new URL(o.src).origin+"/api/event";function p(e,t){e&&console.warn("Ignoring Event: "+e),t&&t.callback&&t.callback()}function e(e,t){try{if("true"===window.localStorage.plausible_ignore)return p("localStorage flag",t)}catch(e){}var n=o&&o.getAttribute("data-include"),a=o&&o.getAttribute("data-exclude");if("pageview"===e){n=!n||n.split(",").some(i),a=a&&a.split(",").some(i);if(!n||a)return p("exclusion rule",t)}function i(e){var t=u.pathname;return(t+=u.hash).match(new RegExp("^"+e.trim().replace(/\*\*/g,".*").replace(/([^\.])\*/g,"$1[^\\s/]*")+"/?$"))}var n={},a=(n.n=e,n.u=u.href,n.d=o.getAttribute("data-domain"),n.r=c.referrer||null,t&&t.meta&&(n.m=JSON.stringify(t.meta)),t&&t.props&&(n.p=t.props),t&&t.revenue&&(n.$=t.revenue),o.getAttributeNames().filter(function(e){return"event-"===e.substring(0,6)})),r=n.p||{},l=(a.forEach(function(e){var t=e.replace("event-",""),e=o.getAttribute(e);r[t]=r[t]||e}),n.p=r,n.h=1,new XMLHttpRequest);l.open("POST",s,!0),l.setRequestHeader("Content-Type","text/plain"),l.send(JSON.stringify(n)),l.onreadystatechange=function(){4===l.readyState&&t&&t.callback&&t.callback({status:l.status})}}var t=window.plausible&&window.plausible.q||[];window.plausible=e;for(var n,a=0;a<t.length;a++)e.apply(this,t[a]);function i(){n=u.pathname,e("pageview")}window.addEventListener("hashchange",i),"prerender"===c.visibilityState?c.addEventListener("visibilitychange",function(){n||"visible"!==c.visibilityState||i()}):i()}();
\ No newline at end of file
+!function(){"use strict";var u=window.location,c=window.document,o=c.currentScript,s=o.getAttribute("data-api")||new URL(o.src).origin+"/api/event";function p(e,t){e&&console.warn("Ignoring Event: "+e),t&&t.callback&&t.callback()}function e(e,t){try{if("true"===window.localStorage.plausible_ignore)return p("localStorage flag",t)}catch(e){}var n=o&&o.getAttribute("data-include"),a=o&&o.getAttribute("data-exclude");if("pageview"===e){n=!n||n.split(",").some(i),a=a&&a.split(",").some(i);if(!n||a)return p("exclusion rule",t)}function i(e){var t=u.pathname;return(t+=u.hash).match(new RegExp("^"+e.trim().replace(/\*\*/g,".*").replace(/([^\.])\*/g,"$1[^\\s/]*")+"/?$"))}var n={},a=(n.n=e,n.u=u.href,n.d=o.getAttribute("data-domain"),n.r=c.referrer||null,function(){var x=o.getAttribute("data-exclude-cookie");x&&c.cookie.split(";").some(function(t){return t.trim().split("=")[0]===x})&&(n.c=x)}(),t&&t.meta&&(n.m=JSON.stringify(t.meta)),t&&t.props&&(n.p=t.props),t&&t.revenue&&(n.$=t.revenue),o.getAttributeNames().filter(function(e){return"event-"===e.substring(0,6)})),r=n.p||{},l=(a.forEach(function(e){var t=e.replace("event-",""),e=o.getAttribute(e);r[t]=r[t]||e}),n.p=r,n.h=1,new XMLHttpRequest);l.open("POST",s,!0),l.setRequestHeader("Content-Type","text/plain"),l.send(JSON.stringify(n)),l.onreadystatechange=function(){4===l.readyState&&t&&t.callback&&t.callback({status:l.status})}}var t=window.plausible&&window.plausible.q||[];window.plausible=e;for(var n,a=0;a<t.length;a++)e.apply(this,t[a]);function i(){n=u.pathname,e("pageview")}window.addEventListener("hashchange",i),"prerender"===c.visibilityState?c.addEventListener("visibilitychange",function(){n||"visible"!==c.visibilityState||i()}):i()}();
\ No newline at end of file
@@ -1 +1 @@
-!function(){"use strict";var i=window.location,o=window.document,l=o.currentScript,p=l.getAttribute("data-api")||new URL(l.src).origin+"/api/event";function u(t,e){t&&console.warn("Ignoring Event: "+t),e&&e.callback&&e.callback()}function t(t,e){if(/^localhost$|^127(\.[0-9]+){0,2}\.[0-9]+$|^\[::1?\]$/.test(i.hostname)||"file:"===i.protocol)return u("localhost",e);if((window._phantom||window.__nightmare||window.navigator.webdriver||window.Cypress)&&!window.__plausible)return u(null,e);try{if("true"===window.localStorage.plausible_ignore)return u("localStorage flag",e)}catch(t){}var r={},t=(r.n=t,r.u=e&&e.u?e.u:i.href,r.d=l.getAttribute("data-domain"),r.r=o.referrer||null,e&&e.meta&&(r.m=JSON.stringify(e.meta)),e&&e.props&&(r.p=e.props),l.getAttributeNames().filter(function(t){return"event-"===t.substring(0,6)})),n=r.p||{},a=(t.forEach(function(t){var e=t.replace("event-",""),t=l.getAttribute(t);n[e]=n[e]||t}),r.p=n,r.h=1,new XMLHttpRequest);a.open("POST",p,!0),a.setRequestHeader("Content-Type","text/plain"),a.send(JSON.stringify(r)),a.onreadystatechange=function(){4===a.readyState&&e&&e.callback&&e.callback({status:a.status})}}var e=window.plausible&&window.plausible.q||[];window.plausible=t;for(var r=0;r<e.length;r++)t.apply(this,e[r]);function s(t){return t&&t.tagName&&"a"===t.tagName.toLowerCase()}var c=1;function n(t){if("auxclick"!==t.type||t.button===c){var e,r,n=function(t){for(;t&&(void 0===t.tagName||!s(t)||!t.href);)t=t.parentNode;return t}(t.target),a=n&&n.href&&n.href.split("?")[0];if(!function t(e,r){if(!e||w<r)return!1;if(h(e))return!0;return t(e.parentNode,r+1)}(n,0))return(e=n)&&e.href&&e.host&&e.host!==i.host?f(t,n,{name:"Outbound Link: Click",props:{url:n.href}}):(e=a)&&(r=e.split(".").pop(),v.some(function(t){return t===r}))?f(t,n,{name:"File Download",props:{url:a}}):void 0}}function f(t,e,r){var n,a=!1;function i(){a||(a=!0,window.location=e.href)}!function(t,e){if(!t.defaultPrevented)return e=!e.target||e.target.match(/^_(self|parent|top)$/i),t=!(t.ctrlKey||t.metaKey||t.shiftKey)&&"click"===t.type,e&&t}(t,e)?(n={props:r.props},plausible(r.name,n)):(n={props:r.props,callback:i},plausible(r.name,n),setTimeout(i,5e3),t.preventDefault())}o.addEventListener("click",n),o.addEventListener("auxclick",n);var a=["pdf","xlsx","docx","txt","rtf","csv","exe","key","pps","ppt","pptx","7z","pkg","rar","gz","zip","avi","mov","mp4","mpeg","wmv","midi","mp3","wav","wma","dmg"],d=l.getAttribute("file-types"),m=l.getAttribute("add-file-types"),v=d&&d.split(",")||m&&m.split(",").concat(a)||a;function g(t){var t=h(t)?t:t&&t.parentNode,e={name:null,props:{}},r=t&&t.classList;if(r)for(var n=0;n<r.length;n++){var a,i=r.item(n).match(/plausible-event-(.+)(=|--)(.+)/);i&&(a=i[1],i=i[3].replace(/\+/g," "),"name"==a.toLowerCase()?e.name=i:e.props[a]=i)}return e}var w=3;function b(t){if("auxclick"!==t.type||t.button===c){for(var e,r,n,a,i=t.target,o=0;o<=w&&i;o++){if((n=i)&&n.tagName&&"form"===n.tagName.toLowerCase())return;s(i)&&(e=i),h(i)&&(r=i),i=i.parentNode}r&&(a=g(r),e?(a.props.url=e.href,f(t,e,a)):((t={}).props=a.props,plausible(a.name,t)))}}function h(t){var e=t&&t.classList;if(e)for(var r=0;r<e.length;r++)if(e.item(r).match(/plausible-event-name(=|--)(.+)/))return!0;return!1}o.addEventListener("submit",function(t){var e,r=t.target,n=g(r);function a(){e||(e=!0,r.submit())}n.name&&(t.preventDefault(),e=!1,setTimeout(a,5e3),t={props:n.props,callback:a},plausible(n.name,t))}),o.addEventListener("click",b),o.addEventListener("auxclick",b)}();
\ No newline at end of file
+!function(){"use strict";var i=window.location,o=window.document,l=o.currentScript,p=l.getAttribute("data-api")||new URL(l.src).origin+"/api/event";function u(t,e){t&&console.warn("Ignoring Event: "+t),e&&e.callback&&e.callback()}function t(t,e){if(/^localhost$|^127(\.[0-9]+){0,2}\.[0-9]+$|^\[::1?\]$/.test(i.hostname)||"file:"===i.protocol)return u("localhost",e);if((window._phantom||window.__nightmare||window.navigator.webdriver||window.Cypress)&&!window.__plausible)return u(null,e);try{if("true"===window.localStorage.plausible_ignore)return u("localStorage flag",e)}catch(t){}var r={},t=(r.n=t,r.u=e&&e.u?e.u:i.href,r.d=l.getAttribute("data-domain"),r.r=o.referrer||null,function(){var c=l.getAttribute("data-exclude-cookie");c&&o.cookie.split(";").some(function(t){return t.trim().split("=")[0]===c})&&(r.c=c)}(),e&&e.meta&&(r.m=JSON.stringify(e.meta)),e&&e.props&&(r.p=e.props),l.getAttributeNames().filter(function(t){return"event-"===t.substring(0,6)})),n=r.p||{},a=(t.forEach(function(t){var e=t.replace("event-",""),t=l.getAttribute(t);n[e]=n[e]||t}),r.p=n,r.h=1,new XMLHttpRequest);a.open("POST",p,!0),a.setRequestHeader("Content-Type","text/plain"),a.send(JSON.stringify(r)),a.onreadystatechange=function(){4===a.readyState&&e&&e.callback&&e.callback({status:a.status})}}var e=window.plausible&&window.plausible.q||[];window.plausible=t;for(var r=0;r<e.length;r++)t.apply(this,e[r]);function s(t){return t&&t.tagName&&"a"===t.tagName.toLowerCase()}var c=1;function n(t){if("auxclick"!==t.type||t.button===c){var e,r,n=function(t){for(;t&&(void 0===t.tagName||!s(t)||!t.href);)t=t.parentNode;return t}(t.target),a=n&&n.href&&n.href.split("?")[0];if(!function t(e,r){if(!e||w<r)return!1;if(h(e))return!0;return t(e.parentNode,r+1)}(n,0))return(e=n)&&e.href&&e.host&&e.host!==i.host?f(t,n,{name:"Outbound Link: Click",props:{url:n.href}}):(e=a)&&(r=e.split(".").pop(),v.some(function(t){return t===r}))?f(t,n,{name:"File Download",props:{url:a}}):void 0}}function f(t,e,r){var n,a=!1;function i(){a||(a=!0,window.location=e.href)}!function(t,e){if(!t.defaultPrevented)return e=!e.target||e.target.match(/^_(self|parent|top)$/i),t=!(t.ctrlKey||t.metaKey||t.shiftKey)&&"click"===t.type,e&&t}(t,e)?(n={props:r.props},plausible(r.name,n)):(n={props:r.props,callback:i},plausible(r.name,n),setTimeout(i,5e3),t.preventDefault())}o.addEventListener("click",n),o.addEventListener("auxclick",n);var a=["pdf","xlsx","docx","txt","rtf","csv","exe","key","pps","ppt","pptx","7z","pkg","rar","gz","zip","avi","mov","mp4","mpeg","wmv","midi","mp3","wav","wma","dmg"],d=l.getAttribute("file-types"),m=l.getAttribute("add-file-types"),v=d&&d.split(",")||m&&m.split(",").concat(a)||a;function g(t){var t=h(t)?t:t&&t.parentNode,e={name:null,props:{}},r=t&&t.classList;if(r)for(var n=0;n<r.length;n++){var a,i=r.item(n).match(/plausible-event-(.+)(=|--)(.+)/);i&&(a=i[1],i=i[3].replace(/\+/g," "),"name"==a.toLowerCase()?e.name=i:e.props[a]=i)}return e}var w=3;function b(t){if("auxclick"!==t.type||t.button===c){for(var e,r,n,a,i=t.target,o=0;o<=w&&i;o++){if((n=i)&&n.tagName&&"form"===n.tagName.toLowerCase())return;s(i)&&(e=i),h(i)&&(r=i),i=i.parentNode}r&&(a=g(r),e?(a.props.url=e.href,f(t,e,a)):((t={}).props=a.props,plausible(a.name,t)))}}function h(t){var e=t&&t.classList;if(e)for(var r=0;r<e.length;r++)if(e.item(r).match(/plausible-event-name(=|--)(.+)/))return!0;return!1}o.addEventListener("submit",function(t){var e,r=t.target,n=g(r);function a(){e||(e=!0,r.submit())}n.name&&(t.preventDefault(),e=!1,setTimeout(a,5e3),t={props:n.props,callback:a},plausible(n.name,t))}),o.addEventListener("click",b),o.addEventListener("auxclick",b)}();
\ No newline at end of file
@@ -1 +1 @@
-!function(){"use strict";var i=window.location,o=window.document,p=o.currentScript,s=p.getAttribute("data-api")||new URL(p.src).origin+"/api/event";function l(t,e){t&&console.warn("Ignoring Event: "+t),e&&e.callback&&e.callback()}function t(t,e){if(/^localhost$|^127(\.[0-9]+){0,2}\.[0-9]+$|^\[::1?\]$/.test(i.hostname)||"file:"===i.protocol)return l("localhost",e);if((window._phantom||window.__nightmare||window.navigator.webdriver||window.Cypress)&&!window.__plausible)return l(null,e);try{if("true"===window.localStorage.plausible_ignore)return l("localStorage flag",e)}catch(t){}var n={},t=(n.n=t,n.u=i.href,n.d=p.getAttribute("data-domain"),n.r=o.referrer||null,e&&e.meta&&(n.m=JSON.stringify(e.meta)),e&&e.props&&(n.p=e.props),p.getAttributeNames().filter(function(t){return"event-"===t.substring(0,6)})),a=n.p||{},r=(t.forEach(function(t){var e=t.replace("event-",""),t=p.getAttribute(t);a[e]=a[e]||t}),n.p=a,new XMLHttpRequest);r.open("POST",s,!0),r.setRequestHeader("Content-Type","text/plain"),r.send(JSON.stringify(n)),r.onreadystatechange=function(){4===r.readyState&&e&&e.callback&&e.callback({status:r.status})}}var e=window.plausible&&window.plausible.q||[];window.plausible=t;for(var n,a=0;a<e.length;a++)t.apply(this,e[a]);function r(){n!==i.pathname&&(n=i.pathname,t("pageview"))}var u,c=window.history;function f(t){return t&&t.tagName&&"a"===t.tagName.toLowerCase()}c.pushState&&(u=c.pushState,c.pushState=function(){u.apply(this,arguments),r()},window.addEventListener("popstate",r)),"prerender"===o.visibilityState?o.addEventListener("visibilitychange",function(){n||"visible"!==o.visibilityState||r()}):r();var d=1;function v(t){if("auxclick"!==t.type||t.button===d){var e,n,a=function(t){for(;t&&(void 0===t.tagName||!f(t)||!t.href);)t=t.parentNode;return t}(t.target),r=a&&a.href&&a.href.split("?")[0];if(!function t(e,n){if(!e||y<n)return!1;if(L(e))return!0;return t(e.parentNode,n+1)}(a,0))return(e=a)&&e.href&&e.host&&e.host!==i.host?m(t,a,{name:"Outbound Link: Click",props:{url:a.href}}):(e=r)&&(n=e.split(".").pop(),h.some(function(t){return t===n}))?m(t,a,{name:"File Download",props:{url:r}}):void 0}}function m(t,e,n){var a,r=!1;function i(){r||(r=!0,window.location=e.href)}!function(t,e){if(!t.defaultPrevented)return e=!e.target||e.target.match(/^_(self|parent|top)$/i),t=!(t.ctrlKey||t.metaKey||t.shiftKey)&&"click"===t.type,e&&t}(t,e)?(a={props:n.props},plausible(n.name,a)):(a={props:n.props,callback:i},plausible(n.name,a),setTimeout(i,5e3),t.preventDefault())}o.addEventListener("click",v),o.addEventListener("auxclick",v);var c=["pdf","xlsx","docx","txt","rtf","csv","exe","key","pps","ppt","pptx","7z","pkg","rar","gz","zip","avi","mov","mp4","mpeg","wmv","midi","mp3","wav","wma","dmg"],w=p.getAttribute("file-types"),g=p.getAttribute("add-file-types"),h=w&&w.split(",")||g&&g.split(",").concat(c)||c;function b(t){var t=L(t)?t:t&&t.parentNode,e={name:null,props:{}},n=t&&t.classList;if(n)for(var a=0;a<n.length;a++){var r,i=n.item(a).match(/plausible-event-(.+)(=|--)(.+)/);i&&(r=i[1],i=i[3].replace(/\+/g," "),"name"==r.toLowerCase()?e.name=i:e.props[r]=i)}return e}var y=3;function k(t){if("auxclick"!==t.type||t.button===d){for(var e,n,a,r,i=t.target,o=0;o<=y&&i;o++){if((a=i)&&a.tagName&&"form"===a.tagName.toLowerCase())return;f(i)&&(e=i),L(i)&&(n=i),i=i.parentNode}n&&(r=b(n),e?(r.props.url=e.href,m(t,e,r)):((t={}).props=r.props,plausible(r.name,t)))}}function L(t){var e=t&&t.classList;if(e)for(var n=0;n<e.length;n++)if(e.item(n).match(/plausible-event-name(=|--)(.+)/))return!0;return!1}o.addEventListener("submit",function(t){var e,n=t.target,a=b(n);function r(){e||(e=!0,n.submit())}a.name&&(t.preventDefault(),e=!1,setTimeout(r,5e3),t={props:a.props,callback:r},plausible(a.name,t))}),o.addEventListener("click",k),o.addEventListener("auxclick",k)}();
\ No newline at end of file
+!function(){"use strict";var i=window.location,o=window.document,p=o.currentScript,s=p.getAttribute("data-api")||new URL(p.src).origin+"/api/event";function l(t,e){t&&console.warn("Ignoring Event: "+t),e&&e.callback&&e.callback()}function t(t,e){if(/^localhost$|^127(\.[0-9]+){0,2}\.[0-9]+$|^\[::1?\]$/.test(i.hostname)||"file:"===i.protocol)return l("localhost",e);if((window._phantom||window.__nightmare||window.navigator.webdriver||window.Cypress)&&!window.__plausible)return l(null,e);try{if("true"===window.localStorage.plausible_ignore)return l("localStorage flag",e)}catch(t){}var n={},t=(n.n=t,n.u=i.href,n.d=p.getAttribute("data-domain"),n.r=o.referrer||null,function(){var c=p.getAttribute("data-exclude-cookie");c&&o.cookie.split(";").some(function(t){return t.trim().split("=")[0]===c})&&(n.c=c)}(),e&&e.meta&&(n.m=JSON.stringify(e.meta)),e&&e.props&&(n.p=e.props),p.getAttributeNames().filter(function(t){return"event-"===t.substring(0,6)})),a=n.p||{},r=(t.forEach(function(t){var e=t.replace("event-",""),t=p.getAttribute(t);a[e]=a[e]||t}),n.p=a,new XMLHttpRequest);r.open("POST",s,!0),r.setRequestHeader("Content-Type","text/plain"),r.send(JSON.stringify(n)),r.onreadystatechange=function(){4===r.readyState&&e&&e.callback&&e.callback({status:r.status})}}var e=window.plausible&&window.plausible.q||[];window.plausible=t;for(var n,a=0;a<e.length;a++)t.apply(this,e[a]);function r(){n!==i.pathname&&(n=i.pathname,t("pageview"))}var u,c=window.history;function f(t){return t&&t.tagName&&"a"===t.tagName.toLowerCase()}c.pushState&&(u=c.pushState,c.pushState=function(){u.apply(this,arguments),r()},window.addEventListener("popstate",r)),"prerender"===o.visibilityState?o.addEventListener("visibilitychange",function(){n||"visible"!==o.visibilityState||r()}):r();var d=1;function v(t){if("auxclick"!==t.type||t.button===d){var e,n,a=function(t){for(;t&&(void 0===t.tagName||!f(t)||!t.href);)t=t.parentNode;return t}(t.target),r=a&&a.href&&a.href.split("?")[0];if(!function t(e,n){if(!e||y<n)return!1;if(L(e))return!0;return t(e.parentNode,n+1)}(a,0))return(e=a)&&e.href&&e.host&&e.host!==i.host?m(t,a,{name:"Outbound Link: Click",props:{url:a.href}}):(e=r)&&(n=e.split(".").pop(),h.some(function(t){return t===n}))?m(t,a,{name:"File Download",props:{url:r}}):void 0}}function m(t,e,n){var a,r=!1;function i(){r||(r=!0,window.location=e.href)}!function(t,e){if(!t.defaultPrevented)return e=!e.target||e.target.match(/^_(self|parent|top)$/i),t=!(t.ctrlKey||t.metaKey||t.shiftKey)&&"click"===t.type,e&&t}(t,e)?(a={props:n.props},plausible(n.name,a)):(a={props:n.props,callback:i},plausible(n.name,a),setTimeout(i,5e3),t.preventDefault())}o.addEventListener("click",v),o.addEventListener("auxclick",v);var c=["pdf","xlsx","docx","txt","rtf","csv","exe","key","pps","ppt","pptx","7z","pkg","rar","gz","zip","avi","mov","mp4","mpeg","wmv","midi","mp3","wav","wma","dmg"],w=p.getAttribute("file-types"),g=p.getAttribute("add-file-types"),h=w&&w.split(",")||g&&g.split(",").concat(c)||c;function b(t){var t=L(t)?t:t&&t.parentNode,e={name:null,props:{}},n=t&&t.classList;if(n)for(var a=0;a<n.length;a++){var r,i=n.item(a).match(/plausible-event-(.+)(=|--)(.+)/);i&&(r=i[1],i=i[3].replace(/\+/g," "),"name"==r.toLowerCase()?e.name=i:e.props[r]=i)}return e}var y=3;function k(t){if("auxclick"!==t.type||t.button===d){for(var e,n,a,r,i=t.target,o=0;o<=y&&i;o++){if((a=i)&&a.tagName&&"form"===a.tagName.toLowerCase())return;f(i)&&(e=i),L(i)&&(n=i),i=i.parentNode}n&&(r=b(n),e?(r.props.url=e.href,m(t,e,r)):((t={}).props=r.props,plausible(r.name,t)))}}function L(t){var e=t&&t.classList;if(e)for(var n=0;n<e.length;n++)if(e.item(n).match(/plausible-event-name(=|--)(.+)/))return!0;return!1}o.addEventListener("submit",function(t){var e,n=t.target,a=b(n);function r(){e||(e=!0,n.submit())}a.name&&(t.preventDefault(),e=!1,setTimeout(r,5e3),t={props:a.props,callback:r},plausible(a.name,t))}),o.addEventListener("click",k),o.addEventListener("auxclick",k)}();
\ No newline at end of file
@@ -1 +1 @@
-!function(){"use strict";var i=window.location,o=window.document,s=o.currentScript,l=s.getAttribute("data-api")||new URL(s.src).origin+"/api/event";function u(t,e){t&&console.warn("Ignoring Event: "+t),e&&e.callback&&e.callback()}function t(t,e){if(/^localhost$|^127(\.[0-9]+){0,2}\.[0-9]+$|^\[::1?\]$/.test(i.hostname)||"file:"===i.protocol)return u("localhost",e);if((window._phantom||window.__nightmare||window.navigator.webdriver||window.Cypress)&&!window.__plausible)return u(null,e);try{if("true"===window.localStorage.plausible_ignore)return u("localStorage flag",e)}catch(t){}var n={},t=(n.n=t,n.u=i.href,n.d=s.getAttribute("data-domain"),n.r=o.referrer||null,e&&e.meta&&(n.m=JSON.stringify(e.meta)),e&&e.props&&(n.p=e.props),s.getAttributeNames().filter(function(t){return"event-"===t.substring(0,6)})),a=n.p||{},r=(t.forEach(function(t){var e=t.replace("event-",""),t=s.getAttribute(t);a[e]=a[e]||t}),n.p=a,new XMLHttpRequest);r.open("POST",l,!0),r.setRequestHeader("Content-Type","text/plain"),r.send(JSON.stringify(n)),r.onreadystatechange=function(){4===r.readyState&&e&&e.callback&&e.callback({status:r.status})}}var e=window.plausible&&window.plausible.q||[];window.plausible=t;for(var n,a=0;a<e.length;a++)t.apply(this,e[a]);function r(){n!==i.pathname&&(n=i.pathname,t("pageview"))}var p,c=window.history;function f(t){return t&&t.tagName&&"a"===t.tagName.toLowerCase()}c.pushState&&(p=c.pushState,c.pushState=function(){p.apply(this,arguments),r()},window.addEventListener("popstate",r)),"prerender"===o.visibilityState?o.addEventListener("visibilitychange",function(){n||"visible"!==o.visibilityState||r()}):r();var d=1;function v(t){var e,n;if("auxclick"!==t.type||t.button===d)return(e=function(t){for(;t&&(void 0===t.tagName||!f(t)||!t.href);)t=t.parentNode;return t}(t.target))&&e.href&&e.href.split("?")[0],!function t(e,n){if(!e||h<n)return!1;if(b(e))return!0;return t(e.parentNode,n+1)}(e,0)&&(n=e)&&n.href&&n.host&&n.host!==i.host?m(t,e,{name:"Outbound Link: Click",props:{url:e.href}}):void 0}function m(t,e,n){var a,r=!1;function i(){r||(r=!0,window.location=e.href)}!function(t,e){if(!t.defaultPrevented)return e=!e.target||e.target.match(/^_(self|parent|top)$/i),t=!(t.ctrlKey||t.metaKey||t.shiftKey)&&"click"===t.type,e&&t}(t,e)?(a={props:n.props},plausible(n.name,a)):(a={props:n.props,callback:i},plausible(n.name,a),setTimeout(i,5e3),t.preventDefault())}function w(t){var t=b(t)?t:t&&t.parentNode,e={name:null,props:{}},n=t&&t.classList;if(n)for(var a=0;a<n.length;a++){var r,i=n.item(a).match(/plausible-event-(.+)(=|--)(.+)/);i&&(r=i[1],i=i[3].replace(/\+/g," "),"name"==r.toLowerCase()?e.name=i:e.props[r]=i)}return e}o.addEventListener("click",v),o.addEventListener("auxclick",v);var h=3;function g(t){if("auxclick"!==t.type||t.button===d){for(var e,n,a,r,i=t.target,o=0;o<=h&&i;o++){if((a=i)&&a.tagName&&"form"===a.tagName.toLowerCase())return;f(i)&&(e=i),b(i)&&(n=i),i=i.parentNode}n&&(r=w(n),e?(r.props.url=e.href,m(t,e,r)):((t={}).props=r.props,plausible(r.name,t)))}}function b(t){var e=t&&t.classList;if(e)for(var n=0;n<e.length;n++)if(e.item(n).match(/plausible-event-name(=|--)(.+)/))return!0;return!1}o.addEventListener("submit",function(t){var e,n=t.target,a=w(n);function r(){e||(e=!0,n.submit())}a.name&&(t.preventDefault(),e=!1,setTimeout(r,5e3),t={props:a.props,callback:r},plausible(a.name,t))}),o.addEventListener("click",g),o.addEventListener("auxclick",g)}();
\ No newline at end of file
+!function(){"use strict";var i=window.location,o=window.document,s=o.currentScript,l=s.getAttribute("data-api")||new URL(s.src).origin+"/api/event";function u(t,e){t&&console.warn("Ignoring Event: "+t),e&&e.callback&&e.callback()}function t(t,e){if(/^localhost$|^127(\.[0-9]+){0,2}\.[0-9]+$|^\[::1?\]$/.test(i.hostname)||"file:"===i.protocol)return u("localhost",e);if((window._phantom||window.__nightmare||window.navigator.webdriver||window.Cypress)&&!window.__plausible)return u(null,e);try{if("true"===window.localStorage.plausible_ignore)return u("localStorage flag",e)}catch(t){}var n={},t=(n.n=t,n.u=i.href,n.d=s.getAttribute("data-domain"),n.r=o.referrer||null,function(){var c=s.getAttribute("data-exclude-cookie");c&&o.cookie.split(";").some(function(t){return t.trim().split("=")[0]===c})&&(n.c=c)}(),e&&e.meta&&(n.m=JSON.stringify(e.meta)),e&&e.props&&(n.p=e.props),s.getAttributeNames().filter(function(t){return"event-"===t.substring(0,6)})),a=n.p||{},r=(t.forEach(function(t){var e=t.replace("event-",""),t=s.getAttribute(t);a[e]=a[e]||t}),n.p=a,new XMLHttpRequest);r.open("POST",l,!0),r.setRequestHeader("Content-Type","text/plain"),r.send(JSON.stringify(n)),r.onreadystatechange=function(){4===r.readyState&&e&&e.callback&&e.callback({status:r.status})}}var e=window.plausible&&window.plausible.q||[];window.plausible=t;for(var n,a=0;a<e.length;a++)t.apply(this,e[a]);function r(){n!==i.pathname&&(n=i.pathname,t("pageview"))}var p,c=window.history;function f(t){return t&&t.tagName&&"a"===t.tagName.toLowerCase()}c.pushState&&(p=c.pushState,c.pushState=function(){p.apply(this,arguments),r()},window.addEventListener("popstate",r)),"prerender"===o.visibilityState?o.addEventListener("visibilitychange",function(){n||"visible"!==o.visibilityState||r()}):r();var d=1;function v(t){var e,n;if("auxclick"!==t.type||t.button===d)return(e=function(t){for(;t&&(void 0===t.tagName||!f(t)||!t.href);)t=t.parentNode;return t}(t.target))&&e.href&&e.href.split("?")[0],!function t(e,n){if(!e||h<n)return!1;if(b(e))return!0;return t(e.parentNode,n+1)}(e,0)&&(n=e)&&n.href&&n.host&&n.host!==i.host?m(t,e,{name:"Outbound Link: Click",props:{url:e.href}}):void 0}function m(t,e,n){var a,r=!1;function i(){r||(r=!0,window.location=e.href)}!function(t,e){if(!t.defaultPrevented)return e=!e.target||e.target.match(/^_(self|parent|top)$/i),t=!(t.ctrlKey||t.metaKey||t.shiftKey)&&"click"===t.type,e&&t}(t,e)?(a={props:n.props},plausible(n.name,a)):(a={props:n.props,callback:i},plausible(n.name,a),setTimeout(i,5e3),t.preventDefault())}function w(t){var t=b(t)?t:t&&t.parentNode,e={name:null,props:{}},n=t&&t.classList;if(n)for(var a=0;a<n.length;a++){var r,i=n.item(a).match(/plausible-event-(.+)(=|--)(.+)/);i&&(r=i[1],i=i[3].replace(/\+/g," "),"name"==r.toLowerCase()?e.name=i:e.props[r]=i)}return e}o.addEventListener("click",v),o.addEventListener("auxclick",v);var h=3;function g(t){if("auxclick"!==t.type||t.button===d){for(var e,n,a,r,i=t.target,o=0;o<=h&&i;o++){if((a=i)&&a.tagName&&"form"===a.tagName.toLowerCase())return;f(i)&&(e=i),b(i)&&(n=i),i=i.parentNode}n&&(r=w(n),e?(r.props.url=e.href,m(t,e,r)):((t={}).props=r.props,plausible(r.name,t)))}}function b(t){var e=t&&t.classList;if(e)for(var n=0;n<e.length;n++)if(e.item(n).match(/plausible-event-name(=|--)(.+)/))return!0;return!1}o.addEventListener("submit",function(t){var e,n=t.target,a=w(n);function r(){e||(e=!0,n.submit())}a.name&&(t.preventDefault(),e=!1,setTimeout(r,5e3),t={props:a.props,callback:r},plausible(a.name,t))}),o.addEventListener("click",g),o.addEventListener("auxclick",g)}();
\ No newline at end of file
@@ -22,7 +22,7 @@ Error generating stack: `+i.message+`
                   cursor-pointer flex items-center justify-between`,tabIndex:"0",role:"button","aria-haspopup":"true","aria-expanded":"false","aria-controls":"calendar"},"Custom Range",mt.default.createElement(Q5,null,"C"))),!Pf.includes(e.period)&&mt.default.createElement("div",{className:"py-1 date-option-group border-t border-gray-200 dark:border-gray-500"},mt.default.createElement("span",{onClick:()=>{K5(c,e,t),r(!1)},className:"px-4 py-2 text-sm leading-tight hover:bg-gray-100 dark:hover:bg-gray-900 hover:text-gray-900 dark:hover:text-gray-100 cursor-pointer flex items-center justify-between"},vs(e.comparison)?"Disable comparison":"Compare",mt.default.createElement(Q5,null,"X")))));if(o==="calendar")return mt.default.createElement("div",{className:"h-0"},mt.default.createElement(Yk.default,{id:"calendar",options:{mode:"range",maxDate:"today",minDate:t.statsBegin,showMonths:1,static:!0,animate:!0},ref:l,className:"invisible",onClose:v}))}function k(){return mt.default.createElement("div",{className:"min-w-32 md:w-48 md:relative",ref:a},mt.default.createElement("div",{onClick:w,className:`flex items-center justify-between rounded bg-white dark:bg-gray-800 shadow px-2 md:px-3
           py-2 leading-tight cursor-pointer text-xs md:text-sm text-gray-800
           dark:text-gray-200 hover:bg-gray-200 dark:hover:bg-gray-900`,tabIndex:"0",role:"button","aria-haspopup":"true","aria-expanded":"false","aria-controls":"datemenu"},mt.default.createElement("span",{className:"truncate mr-1 md:mr-2"},mt.default.createElement("span",{className:"font-medium"},mt.default.createElement(JR,null))),mt.default.createElement(br,{className:"hidden sm:inline-block h-4 w-4 md:h-5 md:w-5 text-gray-500"})),mt.default.createElement(pr,{show:n,as:mt.Fragment,enter:"transition ease-out duration-100",enterFrom:"opacity-0 scale-95",enterTo:"opacity-100 scale-100",leave:"transition ease-in duration-75",leaveFrom:"opacity-100 scale-100",leaveTo:"opacity-0 scale-95"},L()))}return mt.default.createElement("div",{className:"flex ml-auto pl-2"},mt.default.createElement(XR,null),k())}var Y2=ZR;var Bt=ve(Ce());function qk({domain:e,className:t}){return Bt.default.createElement("img",{src:`/favicon/sources/${encodeURIComponent(e)}`,onError:n=>{n.target.onerror=null,n.target.src="/favicon/sources/placeholder"},referrerPolicy:"no-referrer",className:t})}var vu=class extends Bt.default.Component{constructor(){super(),this.handleClick=this.handleClick.bind(this),this.handleKeydown=this.handleKeydown.bind(this),this.populateSites=this.populateSites.bind(this),this.toggle=this.toggle.bind(this),this.siteSwitcherButton=Bt.default.createRef(),this.state={open:!1,sites:null,error:null,loading:!0}}componentDidMount(){this.populateSites(),this.siteSwitcherButton.current.addEventListener("click",this.toggle),document.addEventListener("keydown",this.handleKeydown),document.addEventListener("click",this.handleClick,!1)}componentWillUnmount(){this.siteSwitcherButton.current.removeEventListener("click",this.toggle),document.removeEventListener("keydown",this.handleKeydown),document.removeEventListener("click",this.handleClick,!1)}populateSites(){this.props.loggedIn&&fetch("/api/sites").then(t=>{if(!t.ok)throw t;return t.json()}).then(t=>this.setState({loading:!1,sites:t.data.map(n=>n.domain)})).catch(t=>this.setState({loading:!1,error:t}))}handleClick(t){this.dropDownNode&&this.dropDownNode.contains(t.target)||this.state.open&&this.setState({open:!1})}handleKeydown(t){if(!this.props.loggedIn)return;let{site:n}=this.props,{sites:r}=this.state;if(t.target.tagName==="INPUT")return!0;if(t.ctrlKey||t.metaKey||t.altKey||t.isComposing||t.keyCode===229||!r)return;let o=parseInt(t.key);1<=o&&o<=9&&o<=r.length&&r[o-1]!==n.domain&&(window.location=`/${encodeURIComponent(r[o-1])}`)}toggle(t){t.stopPropagation(),t.preventDefault(),this.props.loggedIn&&(this.setState(n=>({open:!n.open})),this.props.loggedIn&&!this.state.sites&&this.populateSites())}renderSiteLink(t,n){let r=t===this.props.site.domain?"font-medium text-gray-900 dark:text-gray-100 cursor-default font-bold":"hover:bg-gray-100 dark:hover:bg-gray-900 hover:text-gray-900 dark:hover:text-gray-100 focus:outline-none focus:bg-gray-100 dark:focus:bg-gray-900 focus:text-gray-900 dark:focus:text-gray-100",o=this.props.loggedIn&&this.state.sites.length>1;return Bt.default.createElement("a",{href:t===this.props.site.domain?null:`/${encodeURIComponent(t)}`,key:t,className:`flex items-center justify-between truncate px-4 py-2 md:text-sm leading-5 text-gray-700 dark:text-gray-300 ${r}`},Bt.default.createElement("span",null,Bt.default.createElement(qk,{domain:t,className:"inline w-4 mr-2 align-middle"}),Bt.default.createElement("span",{className:"truncate inline-block align-middle max-w-3xs pr-2"},t)),o&&n<9?Bt.default.createElement("span",null,n+1):null)}renderSettingsLink(){if(["owner","admin","super_admin"].includes(this.props.currentUserRole))return Bt.default.createElement(Bt.default.Fragment,null,Bt.default.createElement("div",{className:"py-1"},Bt.default.createElement("a",{href:`/${encodeURIComponent(this.props.site.domain)}/settings`,className:"group flex items-center px-4 py-2 md:text-sm leading-5 text-gray-700 dark:text-gray-300 hover:bg-gray-100 dark:hover:bg-gray-900 hover:text-gray-900 dark:hover:text-gray-100 focus:outline-none focus:bg-gray-100 dark:focus:bg-gray-900 focus:text-gray-900 dark:focus:text-gray-100",role:"menuitem"},Bt.default.createElement(c5,{className:"mr-2 h-4 w-4 text-gray-500 dark:text-gray-200 group-hover:text-gray-600 dark:group-hover:text-gray-400 group-focus:text-gray-500 dark:group-focus:text-gray-200"}),"Site Settings")),Bt.default.createElement("div",{className:"border-t border-gray-200 dark:border-gray-500"}))}renderDropdown(){return this.state.loading?Bt.default.createElement("div",{className:"px-4 py-6"},Bt.default.createElement("div",{className:"loading sm mx-auto"},Bt.default.createElement("div",null))):this.state.error?Bt.default.createElement("div",{className:"mx-auto px-4 py-6 dark:text-gray-100"},"Something went wrong, try again"):this.props.loggedIn?Bt.default.createElement(Bt.default.Fragment,null,this.renderSettingsLink(),Bt.default.createElement("div",{className:"py-1"},this.state.sites.map(this.renderSiteLink.bind(this))),Bt.default.createElement("div",{className:"border-t border-gray-200 dark:border-gray-500"}),Bt.default.createElement("a",{href:"/sites",className:"flex px-4 py-2 md:text-sm leading-5 text-gray-700 dark:text-gray-300 hover:bg-gray-100 dark:hover:bg-gray-900 hover:text-gray-900 dark:hover:text-gray-100 focus:outline-none focus:bg-gray-100 dark:focus:bg-gray-900 focus:text-gray-900 dark:focus:text-gray-100",role:"menuitem"},"View All")):Bt.default.createElement(Bt.default.Fragment,null,Bt.default.createElement("div",{className:"py-1"},[this.props.site.domain].map(this.renderSiteLink.bind(this))))}render(){let t=this.props.loggedIn?"hover:text-gray-500 dark:hover:text-gray-200 focus:border-blue-300 focus:ring ":"cursor-default";return Bt.default.createElement("div",{className:"relative inline-block text-left mr-2 sm:mr-4"},Bt.default.createElement("button",{ref:this.siteSwitcherButton,className:`inline-flex items-center md:text-lg w-full rounded-md py-2 leading-5 font-bold text-gray-700 dark:text-gray-300 focus:outline-none transition ease-in-out duration-150 ${t}`},Bt.default.createElement(qk,{domain:this.props.site.domain,className:"w-4 mr-1 md:mr-2 align-middle w-4 mr-2 align-middle"}),Bt.default.createElement("span",{className:"hidden sm:inline-block"},this.props.site.domain),this.props.loggedIn&&Bt.default.createElement(br,{className:"-mr-1 ml-1 md:ml-2 h-5 w-5"})),Bt.default.createElement(pr,{show:this.state.open,enter:"transition ease-out duration-100",enterFrom:"opacity-0 scale-95",enterTo:"opacity-100 scale-100",leave:"transition ease-in duration-75",leaveFrom:"opacity-100 scale-100",leaveTo:"opacity-0 scale-95"},Bt.default.createElement("div",{className:"origin-top-left absolute left-0 mt-2 w-64 rounded-md shadow-lg",ref:n=>this.dropDownNode=n},Bt.default.createElement("div",{className:"rounded-md bg-white dark:bg-gray-800 ring-1 ring-black ring-opacity-5"},this.renderDropdown()))))}};var ot=ve(Ce());var Kk=ve(zr());var ra={unwrapped:0,waiting:1,wrapped:2};function Gk(e,t,n){let r=n.filters.filter((i,a)=>e!=a),o=na(r,n.labels);qn(t,n,{filters:r,labels:o})}function Qk(e,t){qn(e,t,{filters:null,labels:null})}function Xk(e,[t,n,r]){let o=Yi[n];if(o)return ot.default.createElement(ot.default.Fragment,null,o," ",Sf[t]," ",r.map(i=>ot.default.createElement("b",{key:i},V2(e.labels,n,i))).reduce((i,a)=>[i," or ",a])," ");if(n.startsWith(Nn)){let i=Mf(n);return ot.default.createElement(ot.default.Fragment,null,"Property ",ot.default.createElement("b",null,i)," ",Sf[t]," ",r.map(a=>ot.default.createElement("b",{key:a},a)).reduce((a,l)=>[a," or ",l])," ")}throw new Error(`Unknown filter: ${n}`)}function eB(e,t,n,r){let[o,i,a]=t,l=i.startsWith(Nn)?"props":i;return ot.default.createElement(Vt.Item,{key:e},ot.default.createElement("div",{className:"px-3 md:px-4 sm:py-2 py-3 text-sm leading-tight flex items-center justify-between",key:e},ot.default.createElement(Zr,{title:`Edit filter: ${Yi[l]}`,to:"filter/$field",params:{field:Sh[l]},search:c=>c,className:"group flex w-full justify-between items-center",style:{width:"calc(100% - 1.5rem)"}},ot.default.createElement("span",{className:"inline-block w-full truncate"},Xk(r,t)),ot.default.createElement(d5,{className:"w-4 h-4 ml-1 cursor-pointer group-hover:text-indigo-700 dark:group-hover:text-indigo-500"})),ot.default.createElement("b",{title:`Remove filter: ${Yi[l]}`,className:"ml-2 cursor-pointer hover:text-indigo-700 dark:hover:text-indigo-500",onClick:()=>Gk(e,n,r)},ot.default.createElement(w2,{className:"w-4 h-4"}))))}function tB(e){return ot.default.createElement(Vt.Item,{key:e},({active:t})=>ot.default.createElement(Zr,{to:If.to,params:{field:e},search:n=>n,className:(0,Kk.default)(t?"bg-gray-100 dark:bg-gray-900 text-gray-900 dark:text-gray-100":"text-gray-800 dark:text-gray-300","block px-4 py-2 text-sm font-medium")},H2(e)))}function nB({wrapped:e}){let t=$n(),n=Ue(),{query:r}=Fe(),[o,i]=(0,ot.useState)(!1);if(e===ra.unwrapped||o){let a=Z({},ol);return n.propsAvailable||delete a.props,Object.keys(a).map(l=>tB(l))}return ot.default.createElement(ot.default.Fragment,null,ot.default.createElement("div",{className:"border-b border-gray-200 dark:border-gray-500 px-4 sm:py-2 py-3 text-sm leading-tight hover:text-indigo-700 dark:hover:text-indigo-500 hover:cursor-pointer",onClick:()=>i(!0)},"+ Add filter"),r.filters.map((a,l)=>eB(l,a,t,r)),ot.default.createElement(Vt.Item,{key:"clear"},ot.default.createElement("div",{className:"border-t border-gray-200 dark:border-gray-500 px-4 sm:py-2 py-3 text-sm leading-tight hover:text-indigo-700 dark:hover:text-indigo-500 hover:cursor-pointer",onClick:()=>Qk(t,r)},"Clear All Filters")))}function rB(){let e=$n(),{query:t}=Fe(),[n,r]=(0,ot.useState)(ra.waiting),[o,i]=(0,ot.useState)(1080);(0,ot.useEffect)(()=>(l(),window.addEventListener("resize",l,!1),document.addEventListener("keyup",a),()=>{window.removeEventListener("resize",l,!1),document.removeEventListener("keyup",a)}),[]),(0,ot.useEffect)(()=>{r(ra.waiting)},[t,o]),(0,ot.useEffect)(()=>{n===ra.waiting&&c()},[n]);function a(C){C.ctrlKey||C.metaKey||C.altKey||C.key==="Escape"&&Qk(e,t)}function l(){i(window.innerWidth||639)}function c(){let C=document.getElementById("filters"),L=C&&[...C.childNodes]||[];if(t.filters.length>0&&o<=768){r(ra.wrapped);return}r(ra.unwrapped),L.forEach(k=>{let M=k.getBoundingClientRect().top,P=L[0].getBoundingClientRect().top;M!==P&&r(ra.wrapped)})}function d(C,L){let k=Xk(t,L),[M,P,D]=L,T=P.startsWith(Nn)?"props":P;return ot.default.createElement("span",{key:C,className:"flex bg-white dark:bg-gray-800 text-gray-700 dark:text-gray-300 shadow text-sm rounded mr-2 items-center"},ot.default.createElement(Zr,{title:`Edit filter: ${Yi[T]}`,className:"flex w-full h-full items-center py-2 pl-3",to:If.to,params:{field:Sh[T]},search:O=>O},ot.default.createElement("span",{className:"inline-block max-w-2xs md:max-w-xs truncate"},k)),ot.default.createElement("span",{title:`Remove filter: ${Yi[T]}`,className:"flex h-full w-full px-2 cursor-pointer hover:text-indigo-700 dark:hover:text-indigo-500 items-center",onClick:()=>Gk(C,e,t)},ot.default.createElement(w2,{className:"w-4 h-4"})))}function g(){if(n===ra.wrapped){let C=t.filters.length;return ot.default.createElement(ot.default.Fragment,null,ot.default.createElement(l5,{className:"-ml-1 mr-1 h-4 w-4","aria-hidden":"true"}),C," Filter",C===1?"":"s")}return ot.default.createElement(ot.default.Fragment,null,ot.default.createElement(f5,{className:"-ml-1 mr-1 h-4 w-4 md:h-4 md:w-4","aria-hidden":"true"}),ot.default.createElement("span",{className:"sm:hidden"},"Filter"),ot.default.createElement("span",{className:"hidden sm:inline-block"},"Filter"))}function v(){window.plausible&&window.plausible("Filter Menu: Open",{u:`${window.location.protocol}//${window.location.hostname}/:dashboard`})}function w(){return ot.default.createElement(Vt,{as:"div",className:"md:relative ml-auto"},({open:C})=>ot.default.createElement(ot.default.Fragment,null,ot.default.createElement("div",null,ot.default.createElement(Vt.Button,{onClick:v,className:"flex items-center text-xs md:text-sm font-medium leading-tight px-3 py-2 cursor-pointer ml-auto text-gray-500 dark:text-gray-200 hover:bg-gray-200 dark:hover:bg-gray-900 rounded"},g())),ot.default.createElement(pr,{show:C,as:ot.Fragment,enter:"transition ease-out duration-100",enterFrom:"opacity-0 scale-95",enterTo:"opacity-100 scale-100",leave:"transition ease-in duration-75",leaveFrom:"opacity-100 scale-100",leaveTo:"opacity-0 scale-95"},ot.default.createElement(Vt.Items,{static:!0,className:"absolute w-full left-0 right-0 md:w-72 md:absolute md:top-auto md:left-auto md:right-0 mt-2 origin-top-right z-10"},ot.default.createElement("div",{className:`rounded-md shadow-lg  bg-white dark:bg-gray-800 ring-1 ring-black ring-opacity-5
-                  font-medium text-gray-800 dark:text-gray-200`},ot.default.createElement(nB,{wrapped:n}))))))}function S(){return n!==ra.wrapped?ot.default.createElement("div",{id:"filters",className:"flex flex-wrap"},t.filters.map((C,L)=>d(L,C))):null}return ot.default.createElement(ot.default.Fragment,null,S(),w())}var q2=rB;var Mr=ve(Ce());var _s=ve(Ce());var K2=ve(Ce());var J5=function(t){return t.reduce(function(n,r){var o=r[0],i=r[1];return n[o]=i,n},{})},Z5=typeof window!="undefined"&&window.document&&window.document.createElement?K2.useLayoutEffect:K2.useEffect;var al=ve(Ce()),wC=ve(Qc());var An="top",or="bottom",Kn="right",jn="left",G2="auto",il=[An,or,Kn,jn],oa="start",_u="end",Jk="clippingParents",Q2="viewport",Df="popper",Zk="reference",e6=il.reduce(function(e,t){return e.concat([t+"-"+oa,t+"-"+_u])},[]),X2=[].concat(il,[G2]).reduce(function(e,t){return e.concat([t,t+"-"+oa,t+"-"+_u])},[]),oB="beforeRead",iB="read",sB="afterRead",aB="beforeMain",lB="main",uB="afterMain",cB="beforeWrite",fB="write",dB="afterWrite",eC=[oB,iB,sB,aB,lB,uB,cB,fB,dB];function mr(e){return e?(e.nodeName||"").toLowerCase():null}function In(e){if(e==null)return window;if(e.toString()!=="[object Window]"){var t=e.ownerDocument;return t&&t.defaultView||window}return e}function fi(e){var t=In(e).Element;return e instanceof t||e instanceof Element}function ir(e){var t=In(e).HTMLElement;return e instanceof t||e instanceof HTMLElement}function Tf(e){if(typeof ShadowRoot=="undefined")return!1;var t=In(e).ShadowRoot;return e instanceof t||e instanceof ShadowRoot}function pB(e){var t=e.state;Object.keys(t.elements).forEach(function(n){var r=t.styles[n]||{},o=t.attributes[n]||{},i=t.elements[n];!ir(i)||!mr(i)||(Object.assign(i.style,r),Object.keys(o).forEach(function(a){var l=o[a];l===!1?i.removeAttribute(a):i.setAttribute(a,l===!0?"":l)}))})}function hB(e){var t=e.state,n={popper:{position:t.options.strategy,left:"0",top:"0",margin:"0"},arrow:{position:"absolute"},reference:{}};return Object.assign(t.elements.popper.style,n.popper),t.styles=n,t.elements.arrow&&Object.assign(t.elements.arrow.style,n.arrow),function(){Object.keys(t.elements).forEach(function(r){var o=t.elements[r],i=t.attributes[r]||{},a=Object.keys(t.styles.hasOwnProperty(r)?t.styles[r]:n[r]),l=a.reduce(function(c,d){return c[d]="",c},{});!ir(o)||!mr(o)||(Object.assign(o.style,l),Object.keys(i).forEach(function(c){o.removeAttribute(c)}))})}}var tC={name:"applyStyles",enabled:!0,phase:"write",fn:pB,effect:hB,requires:["computeStyles"]};function gr(e){return e.split("-")[0]}var qi=Math.max,xu=Math.min,ia=Math.round;function Lf(){var e=navigator.userAgentData;return e!=null&&e.brands?e.brands.map(function(t){return t.brand+"/"+t.version}).join(" "):navigator.userAgent}function Ih(){return!/^((?!chrome|android).)*safari/i.test(Lf())}function di(e,t,n){t===void 0&&(t=!1),n===void 0&&(n=!1);var r=e.getBoundingClientRect(),o=1,i=1;t&&ir(e)&&(o=e.offsetWidth>0&&ia(r.width)/e.offsetWidth||1,i=e.offsetHeight>0&&ia(r.height)/e.offsetHeight||1);var a=fi(e)?In(e):window,l=a.visualViewport,c=!Ih()&&n,d=(r.left+(c&&l?l.offsetLeft:0))/o,g=(r.top+(c&&l?l.offsetTop:0))/i,v=r.width/o,w=r.height/i;return{width:v,height:w,top:g,right:d+v,bottom:g+w,left:d,x:d,y:g}}function wu(e){var t=di(e),n=e.offsetWidth,r=e.offsetHeight;return Math.abs(t.width-n)<=1&&(n=t.width),Math.abs(t.height-r)<=1&&(r=t.height),{x:e.offsetLeft,y:e.offsetTop,width:n,height:r}}function Dh(e,t){var n=t.getRootNode&&t.getRootNode();if(e.contains(t))return!0;if(n&&Tf(n)){var r=t;do{if(r&&e.isSameNode(r))return!0;r=r.parentNode||r.host}while(r)}return!1}function to(e){return In(e).getComputedStyle(e)}function t6(e){return["table","td","th"].indexOf(mr(e))>=0}function Sr(e){return((fi(e)?e.ownerDocument:e.document)||window.document).documentElement}function sa(e){return mr(e)==="html"?e:e.assignedSlot||e.parentNode||(Tf(e)?e.host:null)||Sr(e)}function nC(e){return!ir(e)||to(e).position==="fixed"?null:e.offsetParent}function mB(e){var t=/firefox/i.test(Lf()),n=/Trident/i.test(Lf());if(n&&ir(e)){var r=to(e);if(r.position==="fixed")return null}var o=sa(e);for(Tf(o)&&(o=o.host);ir(o)&&["html","body"].indexOf(mr(o))<0;){var i=to(o);if(i.transform!=="none"||i.perspective!=="none"||i.contain==="paint"||["transform","perspective"].indexOf(i.willChange)!==-1||t&&i.willChange==="filter"||t&&i.filter&&i.filter!=="none")return o;o=o.parentNode}return null}function Ki(e){for(var t=In(e),n=nC(e);n&&t6(n)&&to(n).position==="static";)n=nC(n);return n&&(mr(n)==="html"||mr(n)==="body"&&to(n).position==="static")?t:n||mB(e)||t}function bu(e){return["top","bottom"].indexOf(e)>=0?"x":"y"}function Su(e,t,n){return qi(e,xu(t,n))}function rC(e,t,n){var r=Su(e,t,n);return r>n?n:r}function Th(){return{top:0,right:0,bottom:0,left:0}}function Lh(e){return Object.assign({},Th(),e)}function Oh(e,t){return t.reduce(function(n,r){return n[r]=e,n},{})}var gB=function(t,n){return t=typeof t=="function"?t(Object.assign({},n.rects,{placement:n.placement})):t,Lh(typeof t!="number"?t:Oh(t,il))};function yB(e){var t,n=e.state,r=e.name,o=e.options,i=n.elements.arrow,a=n.modifiersData.popperOffsets,l=gr(n.placement),c=bu(l),d=[jn,Kn].indexOf(l)>=0,g=d?"height":"width";if(!(!i||!a)){var v=gB(o.padding,n),w=wu(i),S=c==="y"?An:jn,C=c==="y"?or:Kn,L=n.rects.reference[g]+n.rects.reference[c]-a[c]-n.rects.popper[g],k=a[c]-n.rects.reference[c],M=Ki(i),P=M?c==="y"?M.clientHeight||0:M.clientWidth||0:0,D=L/2-k/2,T=v[S],O=P-w[g]-v[C],A=P/2-w[g]/2+D,R=Su(T,A,O),B=c;n.modifiersData[r]=(t={},t[B]=R,t.centerOffset=R-A,t)}}function vB(e){var t=e.state,n=e.options,r=n.element,o=r===void 0?"[data-popper-arrow]":r;o!=null&&(typeof o=="string"&&(o=t.elements.popper.querySelector(o),!o)||Dh(t.elements.popper,o)&&(t.elements.arrow=o))}var oC={name:"arrow",enabled:!0,phase:"main",fn:yB,effect:vB,requires:["popperOffsets"],requiresIfExists:["preventOverflow"]};function pi(e){return e.split("-")[1]}var _B={top:"auto",right:"auto",bottom:"auto",left:"auto"};function xB(e){var t=e.x,n=e.y,r=window,o=r.devicePixelRatio||1;return{x:ia(t*o)/o||0,y:ia(n*o)/o||0}}function iC(e){var t,n=e.popper,r=e.popperRect,o=e.placement,i=e.variation,a=e.offsets,l=e.position,c=e.gpuAcceleration,d=e.adaptive,g=e.roundOffsets,v=e.isFixed,w=a.x,S=w===void 0?0:w,C=a.y,L=C===void 0?0:C,k=typeof g=="function"?g({x:S,y:L}):{x:S,y:L};S=k.x,L=k.y;var M=a.hasOwnProperty("x"),P=a.hasOwnProperty("y"),D=jn,T=An,O=window;if(d){var A=Ki(n),R="clientHeight",B="clientWidth";if(A===In(n)&&(A=Sr(n),to(A).position!=="static"&&l==="absolute"&&(R="scrollHeight",B="scrollWidth")),A=A,o===An||(o===jn||o===Kn)&&i===_u){T=or;var Y=v&&A===O&&O.visualViewport?O.visualViewport.height:A[R];L-=Y-r.height,L*=c?1:-1}if(o===jn||(o===An||o===or)&&i===_u){D=Kn;var K=v&&A===O&&O.visualViewport?O.visualViewport.width:A[B];S-=K-r.width,S*=c?1:-1}}var Q=Object.assign({position:l},d&&_B),ne=g===!0?xB({x:S,y:L}):{x:S,y:L};if(S=ne.x,L=ne.y,c){var oe;return Object.assign({},Q,(oe={},oe[T]=P?"0":"",oe[D]=M?"0":"",oe.transform=(O.devicePixelRatio||1)<=1?"translate("+S+"px, "+L+"px)":"translate3d("+S+"px, "+L+"px, 0)",oe))}return Object.assign({},Q,(t={},t[T]=P?L+"px":"",t[D]=M?S+"px":"",t.transform="",t))}function wB(e){var t=e.state,n=e.options,r=n.gpuAcceleration,o=r===void 0?!0:r,i=n.adaptive,a=i===void 0?!0:i,l=n.roundOffsets,c=l===void 0?!0:l;if(0)var d;var g={placement:gr(t.placement),variation:pi(t.placement),popper:t.elements.popper,popperRect:t.rects.popper,gpuAcceleration:o,isFixed:t.options.strategy==="fixed"};t.modifiersData.popperOffsets!=null&&(t.styles.popper=Object.assign({},t.styles.popper,iC(Object.assign({},g,{offsets:t.modifiersData.popperOffsets,position:t.options.strategy,adaptive:a,roundOffsets:c})))),t.modifiersData.arrow!=null&&(t.styles.arrow=Object.assign({},t.styles.arrow,iC(Object.assign({},g,{offsets:t.modifiersData.arrow,position:"absolute",adaptive:!1,roundOffsets:c})))),t.attributes.popper=Object.assign({},t.attributes.popper,{"data-popper-placement":t.placement})}var sC={name:"computeStyles",enabled:!0,phase:"beforeWrite",fn:wB,data:{}};var J2={passive:!0};function bB(e){var t=e.state,n=e.instance,r=e.options,o=r.scroll,i=o===void 0?!0:o,a=r.resize,l=a===void 0?!0:a,c=In(t.elements.popper),d=[].concat(t.scrollParents.reference,t.scrollParents.popper);return i&&d.forEach(function(g){g.addEventListener("scroll",n.update,J2)}),l&&c.addEventListener("resize",n.update,J2),function(){i&&d.forEach(function(g){g.removeEventListener("scroll",n.update,J2)}),l&&c.removeEventListener("resize",n.update,J2)}}var aC={name:"eventListeners",enabled:!0,phase:"write",fn:function(){},effect:bB,data:{}};var SB={left:"right",right:"left",bottom:"top",top:"bottom"};function Of(e){return e.replace(/left|right|bottom|top/g,function(t){return SB[t]})}var MB={start:"end",end:"start"};function Z2(e){return e.replace(/start|end/g,function(t){return MB[t]})}function Mu(e){var t=In(e),n=t.pageXOffset,r=t.pageYOffset;return{scrollLeft:n,scrollTop:r}}function ku(e){return di(Sr(e)).left+Mu(e).scrollLeft}function n6(e,t){var n=In(e),r=Sr(e),o=n.visualViewport,i=r.clientWidth,a=r.clientHeight,l=0,c=0;if(o){i=o.width,a=o.height;var d=Ih();(d||!d&&t==="fixed")&&(l=o.offsetLeft,c=o.offsetTop)}return{width:i,height:a,x:l+ku(e),y:c}}function r6(e){var t,n=Sr(e),r=Mu(e),o=(t=e.ownerDocument)==null?void 0:t.body,i=qi(n.scrollWidth,n.clientWidth,o?o.scrollWidth:0,o?o.clientWidth:0),a=qi(n.scrollHeight,n.clientHeight,o?o.scrollHeight:0,o?o.clientHeight:0),l=-r.scrollLeft+ku(e),c=-r.scrollTop;return to(o||n).direction==="rtl"&&(l+=qi(n.clientWidth,o?o.clientWidth:0)-i),{width:i,height:a,x:l,y:c}}function Cu(e){var t=to(e),n=t.overflow,r=t.overflowX,o=t.overflowY;return/auto|scroll|overlay|hidden/.test(n+o+r)}function eg(e){return["html","body","#document"].indexOf(mr(e))>=0?e.ownerDocument.body:ir(e)&&Cu(e)?e:eg(sa(e))}function sl(e,t){var n;t===void 0&&(t=[]);var r=eg(e),o=r===((n=e.ownerDocument)==null?void 0:n.body),i=In(r),a=o?[i].concat(i.visualViewport||[],Cu(r)?r:[]):r,l=t.concat(a);return o?l:l.concat(sl(sa(a)))}function Nf(e){return Object.assign({},e,{left:e.x,top:e.y,right:e.x+e.width,bottom:e.y+e.height})}function kB(e,t){var n=di(e,!1,t==="fixed");return n.top=n.top+e.clientTop,n.left=n.left+e.clientLeft,n.bottom=n.top+e.clientHeight,n.right=n.left+e.clientWidth,n.width=e.clientWidth,n.height=e.clientHeight,n.x=n.left,n.y=n.top,n}function lC(e,t,n){return t===Q2?Nf(n6(e,n)):fi(t)?kB(t,n):Nf(r6(Sr(e)))}function CB(e){var t=sl(sa(e)),n=["absolute","fixed"].indexOf(to(e).position)>=0,r=n&&ir(e)?Ki(e):e;return fi(r)?t.filter(function(o){return fi(o)&&Dh(o,r)&&mr(o)!=="body"}):[]}function o6(e,t,n,r){var o=t==="clippingParents"?CB(e):[].concat(t),i=[].concat(o,[n]),a=i[0],l=i.reduce(function(c,d){var g=lC(e,d,r);return c.top=qi(g.top,c.top),c.right=xu(g.right,c.right),c.bottom=xu(g.bottom,c.bottom),c.left=qi(g.left,c.left),c},lC(e,a,r));return l.width=l.right-l.left,l.height=l.bottom-l.top,l.x=l.left,l.y=l.top,l}function Nh(e){var t=e.reference,n=e.element,r=e.placement,o=r?gr(r):null,i=r?pi(r):null,a=t.x+t.width/2-n.width/2,l=t.y+t.height/2-n.height/2,c;switch(o){case An:c={x:a,y:t.y-n.height};break;case or:c={x:a,y:t.y+t.height};break;case Kn:c={x:t.x+t.width,y:l};break;case jn:c={x:t.x-n.width,y:l};break;default:c={x:t.x,y:t.y}}var d=o?bu(o):null;if(d!=null){var g=d==="y"?"height":"width";switch(i){case oa:c[d]=c[d]-(t[g]/2-n[g]/2);break;case _u:c[d]=c[d]+(t[g]/2-n[g]/2);break;default:}}return c}function Gi(e,t){t===void 0&&(t={});var n=t,r=n.placement,o=r===void 0?e.placement:r,i=n.strategy,a=i===void 0?e.strategy:i,l=n.boundary,c=l===void 0?Jk:l,d=n.rootBoundary,g=d===void 0?Q2:d,v=n.elementContext,w=v===void 0?Df:v,S=n.altBoundary,C=S===void 0?!1:S,L=n.padding,k=L===void 0?0:L,M=Lh(typeof k!="number"?k:Oh(k,il)),P=w===Df?Zk:Df,D=e.rects.popper,T=e.elements[C?P:w],O=o6(fi(T)?T:T.contextElement||Sr(e.elements.popper),c,g,a),A=di(e.elements.reference),R=Nh({reference:A,element:D,strategy:"absolute",placement:o}),B=Nf(Object.assign({},D,R)),Y=w===Df?B:A,K={top:O.top-Y.top+M.top,bottom:Y.bottom-O.bottom+M.bottom,left:O.left-Y.left+M.left,right:Y.right-O.right+M.right},Q=e.modifiersData.offset;if(w===Df&&Q){var ne=Q[o];Object.keys(K).forEach(function(oe){var ie=[Kn,or].indexOf(oe)>=0?1:-1,te=[An,or].indexOf(oe)>=0?"y":"x";K[oe]+=ne[te]*ie})}return K}function i6(e,t){t===void 0&&(t={});var n=t,r=n.placement,o=n.boundary,i=n.rootBoundary,a=n.padding,l=n.flipVariations,c=n.allowedAutoPlacements,d=c===void 0?X2:c,g=pi(r),v=g?l?e6:e6.filter(function(C){return pi(C)===g}):il,w=v.filter(function(C){return d.indexOf(C)>=0});w.length===0&&(w=v);var S=w.reduce(function(C,L){return C[L]=Gi(e,{placement:L,boundary:o,rootBoundary:i,padding:a})[gr(L)],C},{});return Object.keys(S).sort(function(C,L){return S[C]-S[L]})}function PB(e){if(gr(e)===G2)return[];var t=Of(e);return[Z2(e),t,Z2(t)]}function EB(e){var t=e.state,n=e.options,r=e.name;if(!t.modifiersData[r]._skip){for(var o=n.mainAxis,i=o===void 0?!0:o,a=n.altAxis,l=a===void 0?!0:a,c=n.fallbackPlacements,d=n.padding,g=n.boundary,v=n.rootBoundary,w=n.altBoundary,S=n.flipVariations,C=S===void 0?!0:S,L=n.allowedAutoPlacements,k=t.options.placement,M=gr(k),P=M===k,D=c||(P||!C?[Of(k)]:PB(k)),T=[k].concat(D).reduce(function(it,_t){return it.concat(gr(_t)===G2?i6(t,{placement:_t,boundary:g,rootBoundary:v,padding:d,flipVariations:C,allowedAutoPlacements:L}):_t)},[]),O=t.rects.reference,A=t.rects.popper,R=new Map,B=!0,Y=T[0],K=0;K<T.length;K++){var Q=T[K],ne=gr(Q),oe=pi(Q)===oa,ie=[An,or].indexOf(ne)>=0,te=ie?"width":"height",z=Gi(t,{placement:Q,boundary:g,rootBoundary:v,altBoundary:w,padding:d}),V=ie?oe?Kn:jn:oe?or:An;O[te]>A[te]&&(V=Of(V));var p=Of(V),fe=[];if(i&&fe.push(z[ne]<=0),l&&fe.push(z[V]<=0,z[p]<=0),fe.every(function(it){return it})){Y=Q,B=!1;break}R.set(Q,fe)}if(B)for(var xe=C?3:1,Me=function(_t){var Je=T.find(function(Te){var je=R.get(Te);if(je)return je.slice(0,_t).every(function(st){return st})});if(Je)return Y=Je,"break"},Ae=xe;Ae>0;Ae--){var qe=Me(Ae);if(qe==="break")break}t.placement!==Y&&(t.modifiersData[r]._skip=!0,t.placement=Y,t.reset=!0)}}var uC={name:"flip",enabled:!0,phase:"main",fn:EB,requiresIfExists:["offset"],data:{_skip:!1}};function cC(e,t,n){return n===void 0&&(n={x:0,y:0}),{top:e.top-t.height-n.y,right:e.right-t.width+n.x,bottom:e.bottom-t.height+n.y,left:e.left-t.width-n.x}}function fC(e){return[An,Kn,or,jn].some(function(t){return e[t]>=0})}function IB(e){var t=e.state,n=e.name,r=t.rects.reference,o=t.rects.popper,i=t.modifiersData.preventOverflow,a=Gi(t,{elementContext:"reference"}),l=Gi(t,{altBoundary:!0}),c=cC(a,r),d=cC(l,o,i),g=fC(c),v=fC(d);t.modifiersData[n]={referenceClippingOffsets:c,popperEscapeOffsets:d,isReferenceHidden:g,hasPopperEscaped:v},t.attributes.popper=Object.assign({},t.attributes.popper,{"data-popper-reference-hidden":g,"data-popper-escaped":v})}var dC={name:"hide",enabled:!0,phase:"main",requiresIfExists:["preventOverflow"],fn:IB};function DB(e,t,n){var r=gr(e),o=[jn,An].indexOf(r)>=0?-1:1,i=typeof n=="function"?n(Object.assign({},t,{placement:e})):n,a=i[0],l=i[1];return a=a||0,l=(l||0)*o,[jn,Kn].indexOf(r)>=0?{x:l,y:a}:{x:a,y:l}}function TB(e){var t=e.state,n=e.options,r=e.name,o=n.offset,i=o===void 0?[0,0]:o,a=X2.reduce(function(g,v){return g[v]=DB(v,t.rects,i),g},{}),l=a[t.placement],c=l.x,d=l.y;t.modifiersData.popperOffsets!=null&&(t.modifiersData.popperOffsets.x+=c,t.modifiersData.popperOffsets.y+=d),t.modifiersData[r]=a}var pC={name:"offset",enabled:!0,phase:"main",requires:["popperOffsets"],fn:TB};function LB(e){var t=e.state,n=e.name;t.modifiersData[n]=Nh({reference:t.rects.reference,element:t.rects.popper,strategy:"absolute",placement:t.placement})}var hC={name:"popperOffsets",enabled:!0,phase:"read",fn:LB,data:{}};function s6(e){return e==="x"?"y":"x"}function OB(e){var t=e.state,n=e.options,r=e.name,o=n.mainAxis,i=o===void 0?!0:o,a=n.altAxis,l=a===void 0?!1:a,c=n.boundary,d=n.rootBoundary,g=n.altBoundary,v=n.padding,w=n.tether,S=w===void 0?!0:w,C=n.tetherOffset,L=C===void 0?0:C,k=Gi(t,{boundary:c,rootBoundary:d,padding:v,altBoundary:g}),M=gr(t.placement),P=pi(t.placement),D=!P,T=bu(M),O=s6(T),A=t.modifiersData.popperOffsets,R=t.rects.reference,B=t.rects.popper,Y=typeof L=="function"?L(Object.assign({},t.rects,{placement:t.placement})):L,K=typeof Y=="number"?{mainAxis:Y,altAxis:Y}:Object.assign({mainAxis:0,altAxis:0},Y),Q=t.modifiersData.offset?t.modifiersData.offset[t.placement]:null,ne={x:0,y:0};if(A){if(i){var oe,ie=T==="y"?An:jn,te=T==="y"?or:Kn,z=T==="y"?"height":"width",V=A[T],p=V+k[ie],fe=V-k[te],xe=S?-B[z]/2:0,Me=P===oa?R[z]:B[z],Ae=P===oa?-B[z]:-R[z],qe=t.elements.arrow,it=S&&qe?wu(qe):{width:0,height:0},_t=t.modifiersData["arrow#persistent"]?t.modifiersData["arrow#persistent"].padding:Th(),Je=_t[ie],Te=_t[te],je=Su(0,R[z],it[z]),st=D?R[z]/2-xe-je-Je-K.mainAxis:Me-je-Je-K.mainAxis,Ft=D?-R[z]/2+xe+je+Te+K.mainAxis:Ae+je+Te+K.mainAxis,ze=t.elements.arrow&&Ki(t.elements.arrow),Ze=ze?T==="y"?ze.clientTop||0:ze.clientLeft||0:0,bt=(oe=Q==null?void 0:Q[T])!=null?oe:0,_e=V+st-bt-Ze,re=V+Ft-bt,ge=Su(S?xu(p,_e):p,V,S?qi(fe,re):fe);A[T]=ge,ne[T]=ge-V}if(l){var ce,Ie=T==="x"?An:jn,tt=T==="x"?or:Kn,nt=A[O],ft=O==="y"?"height":"width",Ge=nt+k[Ie],yt=nt-k[tt],Ye=[An,jn].indexOf(M)!==-1,Kt=(ce=Q==null?void 0:Q[O])!=null?ce:0,Ko=Ye?Ge:nt-R[ft]-B[ft]-Kt+K.altAxis,Jn=Ye?nt+R[ft]+B[ft]-Kt-K.altAxis:yt,Ju=S&&Ye?rC(Ko,nt,Jn):Su(S?Ko:Ge,nt,S?Jn:yt);A[O]=Ju,ne[O]=Ju-nt}t.modifiersData[r]=ne}}var mC={name:"preventOverflow",enabled:!0,phase:"main",fn:OB,requiresIfExists:["offset"]};function a6(e){return{scrollLeft:e.scrollLeft,scrollTop:e.scrollTop}}function l6(e){return e===In(e)||!ir(e)?Mu(e):a6(e)}function NB(e){var t=e.getBoundingClientRect(),n=ia(t.width)/e.offsetWidth||1,r=ia(t.height)/e.offsetHeight||1;return n!==1||r!==1}function u6(e,t,n){n===void 0&&(n=!1);var r=ir(t),o=ir(t)&&NB(t),i=Sr(t),a=di(e,o,n),l={scrollLeft:0,scrollTop:0},c={x:0,y:0};return(r||!r&&!n)&&((mr(t)!=="body"||Cu(i))&&(l=l6(t)),ir(t)?(c=di(t,!0),c.x+=t.clientLeft,c.y+=t.clientTop):i&&(c.x=ku(i))),{x:a.left+l.scrollLeft-c.x,y:a.top+l.scrollTop-c.y,width:a.width,height:a.height}}function AB(e){var t=new Map,n=new Set,r=[];e.forEach(function(i){t.set(i.name,i)});function o(i){n.add(i.name);var a=[].concat(i.requires||[],i.requiresIfExists||[]);a.forEach(function(l){if(!n.has(l)){var c=t.get(l);c&&o(c)}}),r.push(i)}return e.forEach(function(i){n.has(i.name)||o(i)}),r}function c6(e){var t=AB(e);return eC.reduce(function(n,r){return n.concat(t.filter(function(o){return o.phase===r}))},[])}function f6(e){var t;return function(){return t||(t=new Promise(function(n){Promise.resolve().then(function(){t=void 0,n(e())})})),t}}function d6(e){var t=e.reduce(function(n,r){var o=n[r.name];return n[r.name]=o?Object.assign({},o,r,{options:Object.assign({},o.options,r.options),data:Object.assign({},o.data,r.data)}):r,n},{});return Object.keys(t).map(function(n){return t[n]})}var gC={placement:"bottom",modifiers:[],strategy:"absolute"};function yC(){for(var e=arguments.length,t=new Array(e),n=0;n<e;n++)t[n]=arguments[n];return!t.some(function(r){return!(r&&typeof r.getBoundingClientRect=="function")})}function vC(e){e===void 0&&(e={});var t=e,n=t.defaultModifiers,r=n===void 0?[]:n,o=t.defaultOptions,i=o===void 0?gC:o;return function(l,c,d){d===void 0&&(d=i);var g={placement:"bottom",orderedModifiers:[],options:Object.assign({},gC,i),modifiersData:{},elements:{reference:l,popper:c},attributes:{},styles:{}},v=[],w=!1,S={state:g,setOptions:function(M){var P=typeof M=="function"?M(g.options):M;L(),g.options=Object.assign({},i,g.options,P),g.scrollParents={reference:fi(l)?sl(l):l.contextElement?sl(l.contextElement):[],popper:sl(c)};var D=c6(d6([].concat(r,g.options.modifiers)));if(g.orderedModifiers=D.filter(function(Q){return Q.enabled}),0){var T;if(getBasePlacement(g.options.placement)===auto)var O;var A,R,B,Y,K}return C(),S.update()},forceUpdate:function(){if(!w){var M=g.elements,P=M.reference,D=M.popper;if(yC(P,D)){g.rects={reference:u6(P,Ki(D),g.options.strategy==="fixed"),popper:wu(D)},g.reset=!1,g.placement=g.options.placement,g.orderedModifiers.forEach(function(Q){return g.modifiersData[Q.name]=Object.assign({},Q.data)});for(var T=0,O=0;O<g.orderedModifiers.length;O++){if(g.reset===!0){g.reset=!1,O=-1;continue}var A=g.orderedModifiers[O],R=A.fn,B=A.options,Y=B===void 0?{}:B,K=A.name;typeof R=="function"&&(g=R({state:g,options:Y,name:K,instance:S})||g)}}}},update:f6(function(){return new Promise(function(k){S.forceUpdate(),k(g)})}),destroy:function(){L(),w=!0}};if(!yC(l,c))return S;S.setOptions(d).then(function(k){!w&&d.onFirstUpdate&&d.onFirstUpdate(k)});function C(){g.orderedModifiers.forEach(function(k){var M=k.name,P=k.options,D=P===void 0?{}:P,T=k.effect;if(typeof T=="function"){var O=T({state:g,name:M,instance:S,options:D}),A=function(){};v.push(O||A)}})}function L(){v.forEach(function(k){return k()}),v=[]}return S}}var FB=[aC,hC,sC,tC,pC,uC,mC,oC,dC],p6=vC({defaultModifiers:FB});var bC=ve(xC());var VB=[],h6=function(t,n,r){r===void 0&&(r={});var o=al.useRef(null),i={onFirstUpdate:r.onFirstUpdate,placement:r.placement||"bottom",strategy:r.strategy||"absolute",modifiers:r.modifiers||VB},a=al.useState({styles:{popper:{position:i.strategy,left:"0",top:"0"},arrow:{position:"absolute"}},attributes:{}}),l=a[0],c=a[1],d=al.useMemo(function(){return{name:"updateState",enabled:!0,phase:"write",fn:function(S){var C=S.state,L=Object.keys(C.elements);wC.flushSync(function(){c({styles:J5(L.map(function(k){return[k,C.styles[k]||{}]})),attributes:J5(L.map(function(k){return[k,C.attributes[k]]}))})})},requires:["computeStyles"]}},[]),g=al.useMemo(function(){var w={onFirstUpdate:i.onFirstUpdate,placement:i.placement,strategy:i.strategy,modifiers:[].concat(i.modifiers,[d,{name:"applyStyles",enabled:!1}])};return(0,bC.default)(o.current,w)?o.current||w:(o.current=w,w)},[i.onFirstUpdate,i.placement,i.strategy,i.modifiers,d]),v=al.useRef();return Z5(function(){v.current&&v.current.setOptions(g)},[g]),Z5(function(){if(!(t==null||n==null)){var w=r.createPopper||p6,S=w(t,n,g);return v.current=S,function(){S.destroy(),v.current=null}}},[t,n,r.createPopper]),{state:v.current?v.current.state:null,styles:l.styles,attributes:l.attributes,update:v.current?v.current.update:null,forceUpdate:v.current?v.current.forceUpdate:null}};var SC=ve(zr());function ng({children:e,info:t,className:n,onClick:r,boundary:o}){let[i,a]=(0,_s.useState)(!1),[l,c]=(0,_s.useState)(null),[d,g]=(0,_s.useState)(null),[v,w]=(0,_s.useState)(null),{styles:S,attributes:C}=h6(l,d,{placement:"top",modifiers:[{name:"arrow",options:{element:v}},{name:"offset",options:{offset:[0,4]}},o&&{name:"preventOverflow",options:{boundary:o}}]});return _s.default.createElement("div",{className:(0,SC.default)("relative",n)},_s.default.createElement("div",{ref:c,onMouseEnter:()=>a(!0),onMouseLeave:()=>a(!1),onClick:r},e),t&&i&&_s.default.createElement("div",he(Z({ref:g,style:S.popper},C.popper),{className:"z-50 p-2 rounded text-sm text-gray-100 font-bold popper-tooltip",role:"tooltip"}),t,_s.default.createElement("div",{ref:w,style:S.arrow,className:"tooltip-arrow"})))}var rg=ve(Ce());function og({lastLoadTimestamp:e}){let[t,n]=(0,rg.useState)(new Date);return(0,rg.useEffect)(()=>{let r=setInterval(()=>n(new Date),1e3);return()=>clearInterval(r)},[]),Math.round(Math.abs(e-t)/1e3)}function m6({tooltipBoundary:e}){let{query:t,lastLoadTimestamp:n}=Fe(),r=Ue(),[o,i]=(0,Mr.useState)(null),a=(0,Mr.useCallback)(()=>{It(`/api/stats/${encodeURIComponent(r.domain)}/current-visitors`).then(c=>i(c))},[r.domain]);(0,Mr.useEffect)(()=>(document.addEventListener("tick",a),()=>{document.removeEventListener("tick",a)}),[a]),(0,Mr.useEffect)(()=>{a()},[t,a]);function l(){return Mr.default.createElement("div",null,Mr.default.createElement("p",{className:"whitespace-nowrap text-small"},"Last updated ",Mr.default.createElement(og,{lastLoadTimestamp:n}),"s ago"),Mr.default.createElement("p",{className:"whitespace-nowrap font-normal text-xs"},"Click to view realtime dashboard"))}return o!==null&&t.filters.length===0?Mr.default.createElement(ng,{info:l(),boundary:e},Mr.default.createElement(Zr,{search:c=>he(Z({},c),{period:"realtime"}),className:"block ml-1 md:ml-2 mr-auto text-xs md:text-sm font-bold text-gray-500 dark:text-gray-300"},Mr.default.createElement("svg",{className:"inline w-2 mr-1 md:mr-2 text-green-500 fill-current",viewBox:"0 0 16 16",xmlns:"http://www.w3.org/2000/svg"},Mr.default.createElement("circle",{cx:"8",cy:"8",r:"8"})),o," ",Mr.default.createElement("span",{className:"hidden sm:inline-block"},"current visitor",o===1?"":"s"))):null}var nn=ve(Ce());function Gn(e){if(e>=1e3&&e<1e6){let t=e/1e3;return t===Math.floor(t)||e>=1e5?Math.floor(t)+"k":Math.floor(t*10)/10+"k"}else if(e>=1e6&&e<1e9){let t=e/1e6;return t===Math.floor(t)||e>=1e8?Math.floor(t)+"M":Math.floor(t*10)/10+"M"}else if(e>=1e9&&e<1e12){let t=e/1e9;return t===Math.floor(t)||e>=1e11?Math.floor(t)+"B":Math.floor(t*10)/10+"B"}else return e}function HB(e,t){return("000"+e).slice(t*-1)}function ll(e){let t=Math.floor(e/60/60),n=Math.floor(e/60)%60,r=Math.floor(e-n*60-t*60*60);return t>0?`${t}h ${n}m ${r}s`:n>0?`${n}m ${HB(r,2)}s`:`${r}s`}function Ah(e){return typeof e=="number"?e+"%":"-"}function ig(e,t){let n=e.period==="realtime",r=ct(e),o=Ro(e,"page").length>0;return n&&r?["visitors"]:n?["visitors","pageviews"]:r&&Uk(e,t)?["visitors","events","average_revenue","total_revenue","conversion_rate"]:r?["visitors","events","conversion_rate"]:o?["visitors","visits","pageviews","bounce_rate","time_on_page"]:["visitors","visits","pageviews","views_per_visit","bounce_rate","visit_duration"]}var sg={visitors:"Visitors",pageviews:"Pageviews",events:"Total Conversions",views_per_visit:"Views per Visit",visits:"Visits",bounce_rate:"Bounce Rate",visit_duration:"Visit Duration",conversions:"Converted Visitors",conversion_rate:"Conversion Rate",average_revenue:"Average Revenue",total_revenue:"Total Revenue"},ag={visitors:Gn,pageviews:Gn,events:Gn,visits:Gn,views_per_visit:e=>e,bounce_rate:e=>`${e}%`,visit_duration:ll,conversions:Gn,conversion_rate:e=>`${e}%`,total_revenue:Gn,average_revenue:Gn},UB=function(e){return e?[{data:e,borderColor:"rgba(60,70,110,0.2)",pointBackgroundColor:"rgba(60,70,110,0.2)",pointHoverBackgroundColor:"rgba(60, 70, 110)",yAxisID:"yComparison"}]:[]},WB=function(e,t){if(!t)return[];let n=e.slice(t-1,t+1);return[{data:new Array(t-1).concat(n),borderDash:[3,3],borderColor:"rgba(101,116,205)",pointHoverBackgroundColor:"rgba(71, 87, 193)",yAxisID:"y"}]},$B=function(e,t){return[{data:t?e.slice(0,t):e,borderColor:"rgba(101,116,205)",pointBackgroundColor:"rgba(101,116,205)",pointHoverBackgroundColor:"rgba(71, 87, 193)",yAxisID:"y"}]},MC=(e,t,n,r,o)=>{var i=r.createLinearGradient(0,0,0,300),a=r.createLinearGradient(0,0,0,300);i.addColorStop(0,"rgba(101,116,205, 0.2)"),i.addColorStop(1,"rgba(101,116,205, 0)"),a.addColorStop(0,"rgba(101,116,205, 0.075)"),a.addColorStop(1,"rgba(101,116,205, 0)");let l={label:o,borderWidth:3,pointBorderColor:"transparent",pointHoverRadius:4,backgroundColor:i,fill:!0};return[...$B(e,n),...WB(e,n),...UB(t)].map(d=>Object.assign(d,l))};var un=ve(Ce());var Fh=ve(zr());function g6({condition:e,children:t}){return e?t:null}function kC(e,t,n=!1){let r=Gn(Math.abs(t)),o=(0,Fh.default)({"pl-2 text-xs dark:text-gray-100":!n,"pl-2 text-xs text-gray-100":n}),i=(0,Fh.default)({"pl-2 text-xs text-gray-700 dark:text-gray-300":!n,"pl-2 text-xs text-gray-300":n});return t>0?un.default.createElement("span",{className:o},un.default.createElement("span",{className:(e==="Bounce rate"?"text-red-400":"text-green-500")+" font-bold"},"\u2191")," ",r,"%"):t<0?un.default.createElement("span",{className:o},un.default.createElement("span",{className:(e==="Bounce rate"?"text-green-500":"text-red-400")+" font-bold"},"\u2193")," ",r,"%"):t===0?un.default.createElement("span",{className:i},"\u3030 0%"):null}function CC(e,t){return["visit duration","time on page"].includes(e.toLowerCase())?ll(t):["bounce rate","conversion rate"].includes(e.toLowerCase())?t+"%":["average revenue","total revenue"].includes(e.toLowerCase())?t==null?void 0:t.short:Gn(t)}function y6(e,t){return["visit duration","time on page"].includes(e.toLowerCase())?ll(t):["bounce rate","conversion rate"].includes(e.toLowerCase())?t+"%":["average revenue","total revenue"].includes(e.toLowerCase())?t==null?void 0:t.long:(t||0).toLocaleString()}function v6({data:e,onMetricUpdate:t,tooltipBoundary:n}){let{query:r,lastLoadTimestamp:o}=Fe(),i=Ue();function a(C){let L=C.name.toLowerCase();return L=C.value===1?L.slice(0,-1):L,un.default.createElement("div",null,r.comparison&&un.default.createElement("div",{className:"whitespace-nowrap"},y6(C.name,C.value)," vs. ",y6(C.name,C.comparison_value)," ",L,un.default.createElement("span",{className:"ml-2"},kC(C.name,C.change,!0))),!r.comparison&&un.default.createElement("div",{className:"whitespace-nowrap"},y6(C.name,C.value)," ",L),C.name==="Current visitors"&&un.default.createElement("p",{className:"font-normal text-xs"},"Last updated ",un.default.createElement(og,{lastLoadTimestamp:o}),"s ago"))}function l(C){let L=ig(r,i);return C.graph_metric&&L.includes(C.graph_metric)}function c(C){l(C)&&(an(`metric__${i.domain}`,C.graph_metric),t(C.graph_metric))}function d(){return un.default.createElement("div",{key:"dot",className:"block pulsating-circle",style:{left:"125px",top:"52px"}})}function g(){return ln(`metric__${i.domain}`)}function v(C){let L=C.graph_metric===g(),[k,M]=C.name.split(/(\(.+\))/g),P=(0,Fh.default)("text-xs font-bold tracking-wide text-gray-500 uppercase dark:text-gray-400 whitespace-nowrap flex w-content border-b",{"text-indigo-700 dark:text-indigo-500 border-indigo-700 dark:border-indigo-500":L,"group-hover:text-indigo-700 dark:group-hover:text-indigo-500 border-transparent":!L});return un.default.createElement("div",{className:P},k,M&&un.default.createElement("span",{className:"hidden sm:inline-block ml-1"},M))}function w(C,L){let k=(0,Fh.default)("px-4 md:px-6 w-1/2 my-4 lg:w-auto group select-none",{"cursor-pointer":l(C),"lg:border-l border-gray-300":L>0,"border-r lg:border-r-0":L%2===0});return un.default.createElement(ng,{key:C.name,info:a(C,r),className:k,onClick:()=>{c(C)},boundary:n},v(C),un.default.createElement("div",{className:"my-1 space-y-2"},un.default.createElement("div",null,un.default.createElement("span",{className:"flex items-center justify-between whitespace-nowrap"},un.default.createElement("p",{className:"font-bold text-xl dark:text-gray-100",id:C.graph_metric},CC(C.name,C.value)),un.default.createElement(g6,{condition:!r.comparison},kC(C.name,C.change))),un.default.createElement(g6,{condition:r.comparison},un.default.createElement("p",{className:"text-xs dark:text-gray-100"},mu(i,e.from,e.to)))),un.default.createElement(g6,{condition:r.comparison},un.default.createElement("div",null,un.default.createElement("p",{className:"font-bold text-xl text-gray-500 dark:text-gray-400"},CC(C.name,C.comparison_value)),un.default.createElement("p",{className:"text-xs text-gray-500 dark:text-gray-400"},mu(i,e.comparing_from,e.comparing_to))))))}let S=e&&e.top_stats.map(w);return S&&r.period==="realtime"&&S.push(d()),S||null}var kr=ve(Ce()),EC=ve(zr());var PC={minute:"Minutes",hour:"Hours",date:"Days",week:"Weeks",month:"Months"};function IC(e,t){return t.period==="custom"?t.to.diff(t.from,"days")<7?["date"]:t.to.diff(t.from,"months")<1?["date","week"]:t.to.diff(t.from,"months")<12?["date","week","month"]:["week","month"]:e.validIntervalsByPeriod[t.period]}function YB(e,t){let n={day:"hour","7d":"date","6mo":"month","12mo":"month",year:"month"};return e.period==="custom"?qB(e.from,e.to):n[e.period]||t[0]}function qB(e,t){return t.diff(e,"days")<30?"date":t.diff(e,"months")<6?"week":"month"}function KB(e,t){return ln(`interval__${e}__${t}`)}function GB(e,t,n){an(`interval__${e}__${t}`,n)}function QB(e){let t=(0,kr.useCallback)(n=>{var r;$k(n,"i")&&((r=e.current)==null||r.click())},[]);(0,kr.useEffect)(()=>(document.addEventListener("keydown",t),()=>document.removeEventListener("keydown",t)),[t])}var Af=function(e,t){let n=IC(e,t),r=KB(t.period,e.domain),o=YB(t,n);return r&&n.includes(r)?r:o};function DC({onIntervalUpdate:e}){let{query:t}=Fe(),n=Ue();if(t.period=="realtime")return null;let r=kr.default.useRef(null),o=IC(n,t),i=Af(n,t);QB(r);function a(c){GB(t.period,n.domain,c),e(c)}function l(c){return kr.default.createElement(Vt.Item,{onClick:()=>a(c),key:c,disabled:c==i},({active:d})=>kr.default.createElement("span",{className:(0,EC.default)({"bg-gray-100 dark:bg-gray-900 text-gray-900 dark:text-gray-200 cursor-pointer":d,"text-gray-700 dark:text-gray-200":!d,"font-bold cursor-none select-none":c==i},"block px-4 py-2 text-sm")},PC[c]))}return kr.default.createElement(Vt,{as:"div",className:"relative inline-block pl-2"},({open:c})=>kr.default.createElement(kr.default.Fragment,null,kr.default.createElement(Vt.Button,{ref:r,className:"text-sm inline-flex focus:outline-none text-gray-700 dark:text-gray-300 hover:text-indigo-600 dark:hover:text-indigo-600 items-center"},PC[i],kr.default.createElement(br,{className:"ml-1 h-4 w-4","aria-hidden":"true"})),kr.default.createElement(pr,{as:kr.Fragment,show:c,enter:"transition ease-out duration-100",enterFrom:"opacity-0 scale-95",enterTo:"opacity-100 scale-100",leave:"transition ease-in duration-75",leaveFrom:"opacity-100 scale-100",leaveTo:"opacity-0 scale-95"},kr.default.createElement(Vt.Items,{className:"py-1 text-left origin-top-right absolute right-0 mt-2 w-56 rounded-md shadow-lg bg-white dark:bg-gray-800 ring-1 ring-black ring-opacity-5 focus:outline-none z-10",static:!0},o.map(l)))))}var hi=ve(Ce());function _6(){let e=Ue(),{query:t}=Fe(),[n,r]=(0,hi.useState)(!1);function o(){r(!0),document.cookie="exporting=",i()}function i(){document.cookie.includes("exporting")?setTimeout(i,1e3):r(!1)}function a(){return hi.default.createElement("svg",{className:"animate-spin h-4 w-4 text-indigo-500",xmlns:"http://www.w3.org/2000/svg",fill:"none",viewBox:"0 0 24 24"},hi.default.createElement("circle",{className:"opacity-25",cx:"12",cy:"12",r:"10",stroke:"currentColor",strokeWidth:"4"}),hi.default.createElement("path",{className:"opacity-75",fill:"currentColor",d:"M4 12a8 8 0 018-8V0C5.373 0 0 5.373 0 12h4zm2 5.291A7.962 7.962 0 014 12H0c0 3.042 1.135 5.824 3 7.938l3-2.647z"}))}function l(){let c=Af(e,t),d=U5(t,[{interval:c}]),g=`/${encodeURIComponent(e.domain)}/export${d}`;return hi.default.createElement("a",{href:g,download:!0,onClick:o},hi.default.createElement("svg",{className:"absolute text-gray-700 feather dark:text-gray-300 hover:text-indigo-600 dark:hover:text-indigo-600",xmlns:"http://www.w3.org/2000/svg",viewBox:"0 0 24 24",fill:"none",stroke:"currentColor",strokeWidth:"2",strokeLinecap:"round",strokeLinejoin:"round"},hi.default.createElement("path",{d:"M21 15v4a2 2 0 0 1-2 2H5a2 2 0 0 1-2-2v-4"}),hi.default.createElement("polyline",{points:"7 10 12 15 17 10"}),hi.default.createElement("line",{x1:"12",y1:"15",x2:"12",y2:"3"})))}return hi.default.createElement("div",{className:"w-4 h-4 mx-2"},n&&a(),!n&&l())}var lg=ve(Ce());var TC=ve(zr());function x6({tooltipMessage:e,disabled:t}){let{query:n}=Fe(),r=n.with_imported,o=(0,TC.default)("mt-0.5",{"dark:text-gray-300 text-gray-700":r,"dark:text-gray-500 text-gray-400":!r});return lg.default.createElement("div",{tooltip:e,className:"w-4 h-4 mx-2"},lg.default.createElement(Zr,{disabled:t,search:i=>he(Z({},i),{with_imported:!r})},lg.default.createElement(u5,{className:o})))}var ug=ve(Ce());function w6({topStatData:e}){let t=e==null?void 0:e.samplePercent;return t&&t<100?ug.default.createElement("div",{tooltip:`Stats based on a ${t}% sample of all visitors`,className:"cursor-pointer w-4 h-4 mx-2"},ug.default.createElement("svg",{className:"absolute w-4 h-4 dark:text-gray-300 text-gray-700",xmlns:"http://www.w3.org/2000/svg",fill:"none",viewBox:"0 0 24 24",stroke:"currentColor"},ug.default.createElement("path",{strokeLinecap:"round",strokeLinejoin:"round",strokeWidth:"2",d:"M13 16h-1v-4h-1m1-4h.01M21 12a9 9 0 11-18 0 9 9 0 0118 0z"}))):null}var LC=ve(Ce());function Bo({className:e,show:t,children:n}){return LC.default.createElement("div",{className:`${e||""} ${t?"fade-enter-active":"fade-enter"}`},n)}var sd=ve(Ce());function ws(){}var qC=function(){let e=0;return function(){return e++}}();function Ht(e){return e===null||typeof e=="undefined"}function cn(e){if(Array.isArray&&Array.isArray(e))return!0;let t=Object.prototype.toString.call(e);return t.slice(0,7)==="[object"&&t.slice(-6)==="Array]"}function Lt(e){return e!==null&&Object.prototype.toString.call(e)==="[object Object]"}var Fn=e=>(typeof e=="number"||e instanceof Number)&&isFinite(+e);function jo(e,t){return Fn(e)?e:t}function Mt(e,t){return typeof e=="undefined"?t:e}var KC=(e,t)=>typeof e=="string"&&e.endsWith("%")?parseFloat(e)/100:e/t,I6=(e,t)=>typeof e=="string"&&e.endsWith("%")?parseFloat(e)/100*t:+e;function fn(e,t,n){if(e&&typeof e.call=="function")return e.apply(n,t)}function Xt(e,t,n,r){let o,i,a;if(cn(e))if(i=e.length,r)for(o=i-1;o>=0;o--)t.call(n,e[o],o);else for(o=0;o<i;o++)t.call(n,e[o],o);else if(Lt(e))for(a=Object.keys(e),i=a.length,o=0;o<i;o++)t.call(n,e[a[o]],a[o])}function Vh(e,t){let n,r,o,i;if(!e||!t||e.length!==t.length)return!1;for(n=0,r=e.length;n<r;++n)if(o=e[n],i=t[n],o.datasetIndex!==i.datasetIndex||o.index!==i.index)return!1;return!0}function mg(e){if(cn(e))return e.map(mg);if(Lt(e)){let t=Object.create(null),n=Object.keys(e),r=n.length,o=0;for(;o<r;++o)t[n[o]]=mg(e[n[o]]);return t}return e}function GC(e){return["__proto__","prototype","constructor"].indexOf(e)===-1}function XB(e,t,n,r){if(!GC(e))return;let o=t[e],i=n[e];Lt(o)&&Lt(i)?Bf(o,i,r):t[e]=mg(i)}function Bf(e,t,n){let r=cn(t)?t:[t],o=r.length;if(!Lt(e))return e;n=n||{};let i=n.merger||XB;for(let a=0;a<o;++a){if(t=r[a],!Lt(t))continue;let l=Object.keys(t);for(let c=0,d=l.length;c<d;++c)i(l[c],e,t,n)}return e}function Vf(e,t){return Bf(e,t,{merger:JB})}function JB(e,t,n){if(!GC(e))return;let r=t[e],o=n[e];Lt(r)&&Lt(o)?Vf(r,o):Object.prototype.hasOwnProperty.call(t,e)||(t[e]=mg(o))}var OC={"":e=>e,x:e=>e.x,y:e=>e.y};function ua(e,t){return(OC[t]||(OC[t]=ZB(t)))(e)}function ZB(e){let t=ez(e);return n=>{for(let r of t){if(r==="")break;n=n&&n[r]}return n}}function ez(e){let t=e.split("."),n=[],r="";for(let o of t)r+=o,r.endsWith("\\")?r=r.slice(0,-1)+".":(n.push(r),r="");return n}function _g(e){return e.charAt(0).toUpperCase()+e.slice(1)}var zo=e=>typeof e!="undefined",la=e=>typeof e=="function",D6=(e,t)=>{if(e.size!==t.size)return!1;for(let n of e)if(!t.has(n))return!1;return!0};function QC(e){return e.type==="mouseup"||e.type==="click"||e.type==="contextmenu"}var _n=Math.PI,tn=2*_n,tz=tn+_n,gg=Number.POSITIVE_INFINITY,nz=_n/180,Dn=_n/2,Rh=_n/4,NC=_n*2/3,Vo=Math.log10,Qi=Math.sign;function T6(e){let t=Math.round(e);e=Hf(e,t,e/1e3)?t:e;let n=Math.pow(10,Math.floor(Vo(e))),r=e/n;return(r<=1?1:r<=2?2:r<=5?5:10)*n}function XC(e){let t=[],n=Math.sqrt(e),r;for(r=1;r<n;r++)e%r===0&&(t.push(r),t.push(e/r));return n===(n|0)&&t.push(n),t.sort((o,i)=>o-i).pop(),t}function Iu(e){return!isNaN(parseFloat(e))&&isFinite(e)}function Hf(e,t,n){return Math.abs(e-t)<n}function JC(e,t){let n=Math.round(e);return n-t<=e&&n+t>=e}function L6(e,t,n){let r,o,i;for(r=0,o=e.length;r<o;r++)i=e[r][n],isNaN(i)||(t.min=Math.min(t.min,i),t.max=Math.max(t.max,i))}function gi(e){return e*(_n/180)}function xg(e){return e*(180/_n)}function O6(e){if(!Fn(e))return;let t=1,n=0;for(;Math.round(e*t)/t!==e;)t*=10,n++;return n}function N6(e,t){let n=t.x-e.x,r=t.y-e.y,o=Math.sqrt(n*n+r*r),i=Math.atan2(r,n);return i<-.5*_n&&(i+=tn),{angle:i,distance:o}}function yg(e,t){return Math.sqrt(Math.pow(t.x-e.x,2)+Math.pow(t.y-e.y,2))}function rz(e,t){return(e-t+tz)%tn-_n}function yo(e){return(e%tn+tn)%tn}function Uf(e,t,n,r){let o=yo(e),i=yo(t),a=yo(n),l=yo(i-o),c=yo(a-o),d=yo(o-i),g=yo(o-a);return o===i||o===a||r&&i===a||l>c&&d<g}function sr(e,t,n){return Math.max(t,Math.min(n,e))}function ZC(e){return sr(e,-32768,32767)}function bs(e,t,n,r=1e-6){return e>=Math.min(t,n)-r&&e<=Math.max(t,n)+r}function wg(e,t,n){n=n||(a=>e[a]<t);let r=e.length-1,o=0,i;for(;r-o>1;)i=o+r>>1,n(i)?o=i:r=i;return{lo:o,hi:r}}var xs=(e,t,n,r)=>wg(e,n,r?o=>e[o][t]<=n:o=>e[o][t]<n),eP=(e,t,n)=>wg(e,n,r=>e[r][t]>=n);function tP(e,t,n){let r=0,o=e.length;for(;r<o&&e[r]<t;)r++;for(;o>r&&e[o-1]>n;)o--;return r>0||o<e.length?e.slice(r,o):e}var nP=["push","pop","shift","splice","unshift"];function rP(e,t){if(e._chartjs){e._chartjs.listeners.push(t);return}Object.defineProperty(e,"_chartjs",{configurable:!0,enumerable:!1,value:{listeners:[t]}}),nP.forEach(n=>{let r="_onData"+_g(n),o=e[n];Object.defineProperty(e,n,{configurable:!0,enumerable:!1,value(...i){let a=o.apply(this,i);return e._chartjs.listeners.forEach(l=>{typeof l[r]=="function"&&l[r](...i)}),a}})})}function A6(e,t){let n=e._chartjs;if(!n)return;let r=n.listeners,o=r.indexOf(t);o!==-1&&r.splice(o,1),!(r.length>0)&&(nP.forEach(i=>{delete e[i]}),delete e._chartjs)}function F6(e){let t=new Set,n,r;for(n=0,r=e.length;n<r;++n)t.add(e[n]);return t.size===r?e:Array.from(t)}var R6=function(){return typeof window=="undefined"?function(e){return e()}:window.requestAnimationFrame}();function B6(e,t,n){let r=n||(a=>Array.prototype.slice.call(a)),o=!1,i=[];return function(...a){i=r(a),o||(o=!0,R6.call(window,()=>{o=!1,e.apply(t,i)}))}}function oP(e,t){let n;return function(...r){return t?(clearTimeout(n),n=setTimeout(e,t,r)):e.apply(this,r),t}}var bg=e=>e==="start"?"left":e==="end"?"right":"center",Cr=(e,t,n)=>e==="start"?t:e==="end"?n:(t+n)/2,iP=(e,t,n,r)=>e===(r?"left":"right")?n:e==="center"?(t+n)/2:t;function z6(e,t,n){let r=t.length,o=0,i=r;if(e._sorted){let{iScale:a,_parsed:l}=e,c=a.axis,{min:d,max:g,minDefined:v,maxDefined:w}=a.getUserBounds();v&&(o=sr(Math.min(xs(l,a.axis,d).lo,n?r:xs(t,c,a.getPixelForValue(d)).lo),0,r-1)),w?i=sr(Math.max(xs(l,a.axis,g,!0).hi+1,n?0:xs(t,c,a.getPixelForValue(g),!0).hi+1),o,r)-o:i=r-o}return{start:o,count:i}}function j6(e){let{xScale:t,yScale:n,_scaleRanges:r}=e,o={xmin:t.min,xmax:t.max,ymin:n.min,ymax:n.max};if(!r)return e._scaleRanges=o,!0;let i=r.xmin!==t.min||r.xmax!==t.max||r.ymin!==n.min||r.ymax!==n.max;return Object.assign(r,o),i}var cg=e=>e===0||e===1,AC=(e,t,n)=>-(Math.pow(2,10*(e-=1))*Math.sin((e-t)*tn/n)),FC=(e,t,n)=>Math.pow(2,-10*e)*Math.sin((e-t)*tn/n)+1,Rf={linear:e=>e,easeInQuad:e=>e*e,easeOutQuad:e=>-e*(e-2),easeInOutQuad:e=>(e/=.5)<1?.5*e*e:-.5*(--e*(e-2)-1),easeInCubic:e=>e*e*e,easeOutCubic:e=>(e-=1)*e*e+1,easeInOutCubic:e=>(e/=.5)<1?.5*e*e*e:.5*((e-=2)*e*e+2),easeInQuart:e=>e*e*e*e,easeOutQuart:e=>-((e-=1)*e*e*e-1),easeInOutQuart:e=>(e/=.5)<1?.5*e*e*e*e:-.5*((e-=2)*e*e*e-2),easeInQuint:e=>e*e*e*e*e,easeOutQuint:e=>(e-=1)*e*e*e*e+1,easeInOutQuint:e=>(e/=.5)<1?.5*e*e*e*e*e:.5*((e-=2)*e*e*e*e+2),easeInSine:e=>-Math.cos(e*Dn)+1,easeOutSine:e=>Math.sin(e*Dn),easeInOutSine:e=>-.5*(Math.cos(_n*e)-1),easeInExpo:e=>e===0?0:Math.pow(2,10*(e-1)),easeOutExpo:e=>e===1?1:-Math.pow(2,-10*e)+1,easeInOutExpo:e=>cg(e)?e:e<.5?.5*Math.pow(2,10*(e*2-1)):.5*(-Math.pow(2,-10*(e*2-1))+2),easeInCirc:e=>e>=1?e:-(Math.sqrt(1-e*e)-1),easeOutCirc:e=>Math.sqrt(1-(e-=1)*e),easeInOutCirc:e=>(e/=.5)<1?-.5*(Math.sqrt(1-e*e)-1):.5*(Math.sqrt(1-(e-=2)*e)+1),easeInElastic:e=>cg(e)?e:AC(e,.075,.3),easeOutElastic:e=>cg(e)?e:FC(e,.075,.3),easeInOutElastic(e){return cg(e)?e:e<.5?.5*AC(e*2,.1125,.45):.5+.5*FC(e*2-1,.1125,.45)},easeInBack(e){return e*e*((1.70158+1)*e-1.70158)},easeOutBack(e){return(e-=1)*e*((1.70158+1)*e+1.70158)+1},easeInOutBack(e){let t=1.70158;return(e/=.5)<1?.5*(e*e*(((t*=1.525)+1)*e-t)):.5*((e-=2)*e*(((t*=1.525)+1)*e+t)+2)},easeInBounce:e=>1-Rf.easeOutBounce(1-e),easeOutBounce(e){return e<1/2.75?7.5625*e*e:e<2/2.75?7.5625*(e-=1.5/2.75)*e+.75:e<2.5/2.75?7.5625*(e-=2.25/2.75)*e+.9375:7.5625*(e-=2.625/2.75)*e+.984375},easeInOutBounce:e=>e<.5?Rf.easeInBounce(e*2)*.5:Rf.easeOutBounce(e*2-1)*.5+.5};function Hh(e){return e+.5|0}var cl=(e,t,n)=>Math.max(Math.min(e,n),t);function Bh(e){return cl(Hh(e*2.55),0,255)}function fl(e){return cl(Hh(e*255),0,255)}function aa(e){return cl(Hh(e/2.55)/100,0,1)}function RC(e){return cl(Hh(e*100),0,100)}var mi={0:0,1:1,2:2,3:3,4:4,5:5,6:6,7:7,8:8,9:9,A:10,B:11,C:12,D:13,E:14,F:15,a:10,b:11,c:12,d:13,e:14,f:15},C6=[..."0123456789ABCDEF"],oz=e=>C6[e&15],iz=e=>C6[(e&240)>>4]+C6[e&15],fg=e=>(e&240)>>4===(e&15),sz=e=>fg(e.r)&&fg(e.g)&&fg(e.b)&&fg(e.a);function az(e){var t=e.length,n;return e[0]==="#"&&(t===4||t===5?n={r:255&mi[e[1]]*17,g:255&mi[e[2]]*17,b:255&mi[e[3]]*17,a:t===5?mi[e[4]]*17:255}:(t===7||t===9)&&(n={r:mi[e[1]]<<4|mi[e[2]],g:mi[e[3]]<<4|mi[e[4]],b:mi[e[5]]<<4|mi[e[6]],a:t===9?mi[e[7]]<<4|mi[e[8]]:255})),n}var lz=(e,t)=>e<255?t(e):"";function uz(e){var t=sz(e)?oz:iz;return e?"#"+t(e.r)+t(e.g)+t(e.b)+lz(e.a,t):void 0}var cz=/^(hsla?|hwb|hsv)\(\s*([-+.e\d]+)(?:deg)?[\s,]+([-+.e\d]+)%[\s,]+([-+.e\d]+)%(?:[\s,]+([-+.e\d]+)(%)?)?\s*\)$/;function sP(e,t,n){let r=t*Math.min(n,1-n),o=(i,a=(i+e/30)%12)=>n-r*Math.max(Math.min(a-3,9-a,1),-1);return[o(0),o(8),o(4)]}function fz(e,t,n){let r=(o,i=(o+e/60)%6)=>n-n*t*Math.max(Math.min(i,4-i,1),0);return[r(5),r(3),r(1)]}function dz(e,t,n){let r=sP(e,1,.5),o;for(t+n>1&&(o=1/(t+n),t*=o,n*=o),o=0;o<3;o++)r[o]*=1-t-n,r[o]+=t;return r}function pz(e,t,n,r,o){return e===o?(t-n)/r+(t<n?6:0):t===o?(n-e)/r+2:(e-t)/r+4}function V6(e){let n=e.r/255,r=e.g/255,o=e.b/255,i=Math.max(n,r,o),a=Math.min(n,r,o),l=(i+a)/2,c,d,g;return i!==a&&(g=i-a,d=l>.5?g/(2-i-a):g/(i+a),c=pz(n,r,o,g,i),c=c*60+.5),[c|0,d||0,l]}function H6(e,t,n,r){return(Array.isArray(t)?e(t[0],t[1],t[2]):e(t,n,r)).map(fl)}function U6(e,t,n){return H6(sP,e,t,n)}function hz(e,t,n){return H6(dz,e,t,n)}function mz(e,t,n){return H6(fz,e,t,n)}function aP(e){return(e%360+360)%360}function gz(e){let t=cz.exec(e),n=255,r;if(!t)return;t[5]!==r&&(n=t[6]?Bh(+t[5]):fl(+t[5]));let o=aP(+t[2]),i=+t[3]/100,a=+t[4]/100;return t[1]==="hwb"?r=hz(o,i,a):t[1]==="hsv"?r=mz(o,i,a):r=U6(o,i,a),{r:r[0],g:r[1],b:r[2],a:n}}function yz(e,t){var n=V6(e);n[0]=aP(n[0]+t),n=U6(n),e.r=n[0],e.g=n[1],e.b=n[2]}function vz(e){if(!e)return;let t=V6(e),n=t[0],r=RC(t[1]),o=RC(t[2]);return e.a<255?`hsla(${n}, ${r}%, ${o}%, ${aa(e.a)})`:`hsl(${n}, ${r}%, ${o}%)`}var BC={x:"dark",Z:"light",Y:"re",X:"blu",W:"gr",V:"medium",U:"slate",A:"ee",T:"ol",S:"or",B:"ra",C:"lateg",D:"ights",R:"in",Q:"turquois",E:"hi",P:"ro",O:"al",N:"le",M:"de",L:"yello",F:"en",K:"ch",G:"arks",H:"ea",I:"ightg",J:"wh"},zC={OiceXe:"f0f8ff",antiquewEte:"faebd7",aqua:"ffff",aquamarRe:"7fffd4",azuY:"f0ffff",beige:"f5f5dc",bisque:"ffe4c4",black:"0",blanKedOmond:"ffebcd",Xe:"ff",XeviTet:"8a2be2",bPwn:"a52a2a",burlywood:"deb887",caMtXe:"5f9ea0",KartYuse:"7fff00",KocTate:"d2691e",cSO:"ff7f50",cSnflowerXe:"6495ed",cSnsilk:"fff8dc",crimson:"dc143c",cyan:"ffff",xXe:"8b",xcyan:"8b8b",xgTMnPd:"b8860b",xWay:"a9a9a9",xgYF:"6400",xgYy:"a9a9a9",xkhaki:"bdb76b",xmagFta:"8b008b",xTivegYF:"556b2f",xSange:"ff8c00",xScEd:"9932cc",xYd:"8b0000",xsOmon:"e9967a",xsHgYF:"8fbc8f",xUXe:"483d8b",xUWay:"2f4f4f",xUgYy:"2f4f4f",xQe:"ced1",xviTet:"9400d3",dAppRk:"ff1493",dApskyXe:"bfff",dimWay:"696969",dimgYy:"696969",dodgerXe:"1e90ff",fiYbrick:"b22222",flSOwEte:"fffaf0",foYstWAn:"228b22",fuKsia:"ff00ff",gaRsbSo:"dcdcdc",ghostwEte:"f8f8ff",gTd:"ffd700",gTMnPd:"daa520",Way:"808080",gYF:"8000",gYFLw:"adff2f",gYy:"808080",honeyMw:"f0fff0",hotpRk:"ff69b4",RdianYd:"cd5c5c",Rdigo:"4b0082",ivSy:"fffff0",khaki:"f0e68c",lavFMr:"e6e6fa",lavFMrXsh:"fff0f5",lawngYF:"7cfc00",NmoncEffon:"fffacd",ZXe:"add8e6",ZcSO:"f08080",Zcyan:"e0ffff",ZgTMnPdLw:"fafad2",ZWay:"d3d3d3",ZgYF:"90ee90",ZgYy:"d3d3d3",ZpRk:"ffb6c1",ZsOmon:"ffa07a",ZsHgYF:"20b2aa",ZskyXe:"87cefa",ZUWay:"778899",ZUgYy:"778899",ZstAlXe:"b0c4de",ZLw:"ffffe0",lime:"ff00",limegYF:"32cd32",lRF:"faf0e6",magFta:"ff00ff",maPon:"800000",VaquamarRe:"66cdaa",VXe:"cd",VScEd:"ba55d3",VpurpN:"9370db",VsHgYF:"3cb371",VUXe:"7b68ee",VsprRggYF:"fa9a",VQe:"48d1cc",VviTetYd:"c71585",midnightXe:"191970",mRtcYam:"f5fffa",mistyPse:"ffe4e1",moccasR:"ffe4b5",navajowEte:"ffdead",navy:"80",Tdlace:"fdf5e6",Tive:"808000",TivedBb:"6b8e23",Sange:"ffa500",SangeYd:"ff4500",ScEd:"da70d6",pOegTMnPd:"eee8aa",pOegYF:"98fb98",pOeQe:"afeeee",pOeviTetYd:"db7093",papayawEp:"ffefd5",pHKpuff:"ffdab9",peru:"cd853f",pRk:"ffc0cb",plum:"dda0dd",powMrXe:"b0e0e6",purpN:"800080",YbeccapurpN:"663399",Yd:"ff0000",Psybrown:"bc8f8f",PyOXe:"4169e1",saddNbPwn:"8b4513",sOmon:"fa8072",sandybPwn:"f4a460",sHgYF:"2e8b57",sHshell:"fff5ee",siFna:"a0522d",silver:"c0c0c0",skyXe:"87ceeb",UXe:"6a5acd",UWay:"708090",UgYy:"708090",snow:"fffafa",sprRggYF:"ff7f",stAlXe:"4682b4",tan:"d2b48c",teO:"8080",tEstN:"d8bfd8",tomato:"ff6347",Qe:"40e0d0",viTet:"ee82ee",JHt:"f5deb3",wEte:"ffffff",wEtesmoke:"f5f5f5",Lw:"ffff00",LwgYF:"9acd32"};function _z(){let e={},t=Object.keys(zC),n=Object.keys(BC),r,o,i,a,l;for(r=0;r<t.length;r++){for(a=l=t[r],o=0;o<n.length;o++)i=n[o],l=l.replace(i,BC[i]);i=parseInt(zC[a],16),e[l]=[i>>16&255,i>>8&255,i&255]}return e}var dg;function xz(e){dg||(dg=_z(),dg.transparent=[0,0,0,0]);let t=dg[e.toLowerCase()];return t&&{r:t[0],g:t[1],b:t[2],a:t.length===4?t[3]:255}}var wz=/^rgba?\(\s*([-+.\d]+)(%)?[\s,]+([-+.e\d]+)(%)?[\s,]+([-+.e\d]+)(%)?(?:[\s,/]+([-+.e\d]+)(%)?)?\s*\)$/;function bz(e){let t=wz.exec(e),n=255,r,o,i;if(t){if(t[7]!==r){let a=+t[7];n=t[8]?Bh(a):cl(a*255,0,255)}return r=+t[1],o=+t[3],i=+t[5],r=255&(t[2]?Bh(r):cl(r,0,255)),o=255&(t[4]?Bh(o):cl(o,0,255)),i=255&(t[6]?Bh(i):cl(i,0,255)),{r,g:o,b:i,a:n}}}function Sz(e){return e&&(e.a<255?`rgba(${e.r}, ${e.g}, ${e.b}, ${aa(e.a)})`:`rgb(${e.r}, ${e.g}, ${e.b})`)}var b6=e=>e<=.0031308?e*12.92:Math.pow(e,1/2.4)*1.055-.055,Ff=e=>e<=.04045?e/12.92:Math.pow((e+.055)/1.055,2.4);function Mz(e,t,n){let r=Ff(aa(e.r)),o=Ff(aa(e.g)),i=Ff(aa(e.b));return{r:fl(b6(r+n*(Ff(aa(t.r))-r))),g:fl(b6(o+n*(Ff(aa(t.g))-o))),b:fl(b6(i+n*(Ff(aa(t.b))-i))),a:e.a+n*(t.a-e.a)}}function pg(e,t,n){if(e){let r=V6(e);r[t]=Math.max(0,Math.min(r[t]+r[t]*n,t===0?360:1)),r=U6(r),e.r=r[0],e.g=r[1],e.b=r[2]}}function lP(e,t){return e&&Object.assign(t||{},e)}function jC(e){var t={r:0,g:0,b:0,a:255};return Array.isArray(e)?e.length>=3&&(t={r:e[0],g:e[1],b:e[2],a:255},e.length>3&&(t.a=fl(e[3]))):(t=lP(e,{r:0,g:0,b:0,a:1}),t.a=fl(t.a)),t}function kz(e){return e.charAt(0)==="r"?bz(e):gz(e)}var P6=class e{constructor(t){if(t instanceof e)return t;let n=typeof t,r;n==="object"?r=jC(t):n==="string"&&(r=az(t)||xz(t)||kz(t)),this._rgb=r,this._valid=!!r}get valid(){return this._valid}get rgb(){var t=lP(this._rgb);return t&&(t.a=aa(t.a)),t}set rgb(t){this._rgb=jC(t)}rgbString(){return this._valid?Sz(this._rgb):void 0}hexString(){return this._valid?uz(this._rgb):void 0}hslString(){return this._valid?vz(this._rgb):void 0}mix(t,n){if(t){let r=this.rgb,o=t.rgb,i,a=n===i?.5:n,l=2*a-1,c=r.a-o.a,d=((l*c===-1?l:(l+c)/(1+l*c))+1)/2;i=1-d,r.r=255&d*r.r+i*o.r+.5,r.g=255&d*r.g+i*o.g+.5,r.b=255&d*r.b+i*o.b+.5,r.a=a*r.a+(1-a)*o.a,this.rgb=r}return this}interpolate(t,n){return t&&(this._rgb=Mz(this._rgb,t._rgb,n)),this}clone(){return new e(this.rgb)}alpha(t){return this._rgb.a=fl(t),this}clearer(t){let n=this._rgb;return n.a*=1-t,this}greyscale(){let t=this._rgb,n=Hh(t.r*.3+t.g*.59+t.b*.11);return t.r=t.g=t.b=n,this}opaquer(t){let n=this._rgb;return n.a*=1+t,this}negate(){let t=this._rgb;return t.r=255-t.r,t.g=255-t.g,t.b=255-t.b,this}lighten(t){return pg(this._rgb,2,t),this}darken(t){return pg(this._rgb,2,-t),this}saturate(t){return pg(this._rgb,1,t),this}desaturate(t){return pg(this._rgb,1,-t),this}rotate(t){return yz(this._rgb,t),this}};function uP(e){return new P6(e)}function cP(e){if(e&&typeof e=="object"){let t=e.toString();return t==="[object CanvasPattern]"||t==="[object CanvasGradient]"}return!1}function W6(e){return cP(e)?e:uP(e)}function S6(e){return cP(e)?e:uP(e).saturate(.5).darken(.1).hexString()}var dl=Object.create(null),Sg=Object.create(null);function zh(e,t){if(!t)return e;let n=t.split(".");for(let r=0,o=n.length;r<o;++r){let i=n[r];e=e[i]||(e[i]=Object.create(null))}return e}function M6(e,t,n){return typeof t=="string"?Bf(zh(e,t),n):Bf(zh(e,""),t)}var E6=class{constructor(t){this.animation=void 0,this.backgroundColor="rgba(0,0,0,0.1)",this.borderColor="rgba(0,0,0,0.1)",this.color="#666",this.datasets={},this.devicePixelRatio=n=>n.chart.platform.getDevicePixelRatio(),this.elements={},this.events=["mousemove","mouseout","click","touchstart","touchmove"],this.font={family:"'Helvetica Neue', 'Helvetica', 'Arial', sans-serif",size:12,style:"normal",lineHeight:1.2,weight:null},this.hover={},this.hoverBackgroundColor=(n,r)=>S6(r.backgroundColor),this.hoverBorderColor=(n,r)=>S6(r.borderColor),this.hoverColor=(n,r)=>S6(r.color),this.indexAxis="x",this.interaction={mode:"nearest",intersect:!0,includeInvisible:!1},this.maintainAspectRatio=!0,this.onHover=null,this.onClick=null,this.parsing=!0,this.plugins={},this.responsive=!0,this.scale=void 0,this.scales={},this.showLine=!0,this.drawActiveElementsOnTop=!0,this.describe(t)}set(t,n){return M6(this,t,n)}get(t){return zh(this,t)}describe(t,n){return M6(Sg,t,n)}override(t,n){return M6(dl,t,n)}route(t,n,r,o){let i=zh(this,t),a=zh(this,r),l="_"+n;Object.defineProperties(i,{[l]:{value:i[n],writable:!0},[n]:{enumerable:!0,get(){let c=this[l],d=a[o];return Lt(c)?Object.assign({},d,c):Mt(c,d)},set(c){this[l]=c}}})}},Ot=new E6({_scriptable:e=>!e.startsWith("on"),_indexable:e=>e!=="events",hover:{_fallback:"interaction"},interaction:{_scriptable:!1,_indexable:!1}});function Cz(e){return!e||Ht(e.size)||Ht(e.family)?null:(e.style?e.style+" ":"")+(e.weight?e.weight+" ":"")+e.size+"px "+e.family}function jh(e,t,n,r,o){let i=t[o];return i||(i=t[o]=e.measureText(o).width,n.push(o)),i>r&&(r=i),r}function fP(e,t,n,r){r=r||{};let o=r.data=r.data||{},i=r.garbageCollect=r.garbageCollect||[];r.font!==t&&(o=r.data={},i=r.garbageCollect=[],r.font=t),e.save(),e.font=t;let a=0,l=n.length,c,d,g,v,w;for(c=0;c<l;c++)if(v=n[c],v!=null&&cn(v)!==!0)a=jh(e,o,i,a,v);else if(cn(v))for(d=0,g=v.length;d<g;d++)w=v[d],w!=null&&!cn(w)&&(a=jh(e,o,i,a,w));e.restore();let S=i.length/2;if(S>n.length){for(c=0;c<S;c++)delete o[i[c]];i.splice(0,S)}return a}function pl(e,t,n){let r=e.currentDevicePixelRatio,o=n!==0?Math.max(n/2,.5):0;return Math.round((t-o)*r)/r+o}function $6(e,t){t=t||e.getContext("2d"),t.save(),t.resetTransform(),t.clearRect(0,0,e.width,e.height),t.restore()}function Mg(e,t,n,r){Y6(e,t,n,r,null)}function Y6(e,t,n,r,o){let i,a,l,c,d,g,v=t.pointStyle,w=t.rotation,S=t.radius,C=(w||0)*nz;if(v&&typeof v=="object"&&(i=v.toString(),i==="[object HTMLImageElement]"||i==="[object HTMLCanvasElement]")){e.save(),e.translate(n,r),e.rotate(C),e.drawImage(v,-v.width/2,-v.height/2,v.width,v.height),e.restore();return}if(!(isNaN(S)||S<=0)){switch(e.beginPath(),v){default:o?e.ellipse(n,r,o/2,S,0,0,tn):e.arc(n,r,S,0,tn),e.closePath();break;case"triangle":e.moveTo(n+Math.sin(C)*S,r-Math.cos(C)*S),C+=NC,e.lineTo(n+Math.sin(C)*S,r-Math.cos(C)*S),C+=NC,e.lineTo(n+Math.sin(C)*S,r-Math.cos(C)*S),e.closePath();break;case"rectRounded":d=S*.516,c=S-d,a=Math.cos(C+Rh)*c,l=Math.sin(C+Rh)*c,e.arc(n-a,r-l,d,C-_n,C-Dn),e.arc(n+l,r-a,d,C-Dn,C),e.arc(n+a,r+l,d,C,C+Dn),e.arc(n-l,r+a,d,C+Dn,C+_n),e.closePath();break;case"rect":if(!w){c=Math.SQRT1_2*S,g=o?o/2:c,e.rect(n-g,r-c,2*g,2*c);break}C+=Rh;case"rectRot":a=Math.cos(C)*S,l=Math.sin(C)*S,e.moveTo(n-a,r-l),e.lineTo(n+l,r-a),e.lineTo(n+a,r+l),e.lineTo(n-l,r+a),e.closePath();break;case"crossRot":C+=Rh;case"cross":a=Math.cos(C)*S,l=Math.sin(C)*S,e.moveTo(n-a,r-l),e.lineTo(n+a,r+l),e.moveTo(n+l,r-a),e.lineTo(n-l,r+a);break;case"star":a=Math.cos(C)*S,l=Math.sin(C)*S,e.moveTo(n-a,r-l),e.lineTo(n+a,r+l),e.moveTo(n+l,r-a),e.lineTo(n-l,r+a),C+=Rh,a=Math.cos(C)*S,l=Math.sin(C)*S,e.moveTo(n-a,r-l),e.lineTo(n+a,r+l),e.moveTo(n+l,r-a),e.lineTo(n-l,r+a);break;case"line":a=o?o/2:Math.cos(C)*S,l=Math.sin(C)*S,e.moveTo(n-a,r-l),e.lineTo(n+a,r+l);break;case"dash":e.moveTo(n,r),e.lineTo(n+Math.cos(C)*S,r+Math.sin(C)*S);break}e.fill(),t.borderWidth>0&&e.stroke()}}function zf(e,t,n){return n=n||.5,!t||e&&e.x>t.left-n&&e.x<t.right+n&&e.y>t.top-n&&e.y<t.bottom+n}function Uh(e,t){e.save(),e.beginPath(),e.rect(t.left,t.top,t.right-t.left,t.bottom-t.top),e.clip()}function Wh(e){e.restore()}function dP(e,t,n,r,o){if(!t)return e.lineTo(n.x,n.y);if(o==="middle"){let i=(t.x+n.x)/2;e.lineTo(i,t.y),e.lineTo(i,n.y)}else o==="after"!=!!r?e.lineTo(t.x,n.y):e.lineTo(n.x,t.y);e.lineTo(n.x,n.y)}function pP(e,t,n,r){if(!t)return e.lineTo(n.x,n.y);e.bezierCurveTo(r?t.cp1x:t.cp2x,r?t.cp1y:t.cp2y,r?n.cp2x:n.cp1x,r?n.cp2y:n.cp1y,n.x,n.y)}function hl(e,t,n,r,o,i={}){let a=cn(t)?t:[t],l=i.strokeWidth>0&&i.strokeColor!=="",c,d;for(e.save(),e.font=o.string,Pz(e,i),c=0;c<a.length;++c)d=a[c],l&&(i.strokeColor&&(e.strokeStyle=i.strokeColor),Ht(i.strokeWidth)||(e.lineWidth=i.strokeWidth),e.strokeText(d,n,r,i.maxWidth)),e.fillText(d,n,r,i.maxWidth),Ez(e,n,r,d,i),r+=o.lineHeight;e.restore()}function Pz(e,t){t.translation&&e.translate(t.translation[0],t.translation[1]),Ht(t.rotation)||e.rotate(t.rotation),t.color&&(e.fillStyle=t.color),t.textAlign&&(e.textAlign=t.textAlign),t.textBaseline&&(e.textBaseline=t.textBaseline)}function Ez(e,t,n,r,o){if(o.strikethrough||o.underline){let i=e.measureText(r),a=t-i.actualBoundingBoxLeft,l=t+i.actualBoundingBoxRight,c=n-i.actualBoundingBoxAscent,d=n+i.actualBoundingBoxDescent,g=o.strikethrough?(c+d)/2:d;e.strokeStyle=e.fillStyle,e.beginPath(),e.lineWidth=o.decorationWidth||2,e.moveTo(a,g),e.lineTo(l,g),e.stroke()}}function Wf(e,t){let{x:n,y:r,w:o,h:i,radius:a}=t;e.arc(n+a.topLeft,r+a.topLeft,a.topLeft,-Dn,_n,!0),e.lineTo(n,r+i-a.bottomLeft),e.arc(n+a.bottomLeft,r+i-a.bottomLeft,a.bottomLeft,_n,Dn,!0),e.lineTo(n+o-a.bottomRight,r+i),e.arc(n+o-a.bottomRight,r+i-a.bottomRight,a.bottomRight,Dn,0,!0),e.lineTo(n+o,r+a.topRight),e.arc(n+o-a.topRight,r+a.topRight,a.topRight,0,-Dn,!0),e.lineTo(n+a.topLeft,r)}var Iz=new RegExp(/^(normal|(\d+(?:\.\d+)?)(px|em|%)?)$/),Dz=new RegExp(/^(normal|italic|initial|inherit|unset|(oblique( -?[0-9]?[0-9]deg)?))$/);function Tz(e,t){let n=(""+e).match(Iz);if(!n||n[1]==="normal")return t*1.2;switch(e=+n[2],n[3]){case"px":return e;case"%":e/=100;break}return t*e}var Lz=e=>+e||0;function kg(e,t){let n={},r=Lt(t),o=r?Object.keys(t):t,i=Lt(e)?r?a=>Mt(e[a],e[t[a]]):a=>e[a]:()=>e;for(let a of o)n[a]=Lz(i(a));return n}function q6(e){return kg(e,{top:"y",right:"x",bottom:"y",left:"x"})}function ml(e){return kg(e,["topLeft","topRight","bottomLeft","bottomRight"])}function Pr(e){let t=q6(e);return t.width=t.left+t.right,t.height=t.top+t.bottom,t}function Qn(e,t){e=e||{},t=t||Ot.font;let n=Mt(e.size,t.size);typeof n=="string"&&(n=parseInt(n,10));let r=Mt(e.style,t.style);r&&!(""+r).match(Dz)&&(console.warn('Invalid font style specified: "'+r+'"'),r="");let o={family:Mt(e.family,t.family),lineHeight:Tz(Mt(e.lineHeight,t.lineHeight),n),size:n,style:r,weight:Mt(e.weight,t.weight),string:""};return o.string=Cz(o),o}function $f(e,t,n,r){let o=!0,i,a,l;for(i=0,a=e.length;i<a;++i)if(l=e[i],l!==void 0&&(t!==void 0&&typeof l=="function"&&(l=l(t),o=!1),n!==void 0&&cn(l)&&(l=l[n%l.length],o=!1),l!==void 0))return r&&!o&&(r.cacheable=!1),l}function hP(e,t,n){let{min:r,max:o}=e,i=I6(t,(o-r)/2),a=(l,c)=>n&&l===0?0:l+c;return{min:a(r,-Math.abs(i)),max:a(o,i)}}function ca(e,t){return Object.assign(Object.create(e),t)}function Cg(e,t=[""],n=e,r,o=()=>e[0]){zo(r)||(r=yP("_fallback",e));let i={[Symbol.toStringTag]:"Object",_cacheable:!0,_scopes:e,_rootScopes:n,_fallback:r,_getTarget:o,override:a=>Cg([a,...e],t,n,r)};return new Proxy(i,{deleteProperty(a,l){return delete a[l],delete a._keys,delete e[0][l],!0},get(a,l){return mP(a,l,()=>jz(l,t,e,a))},getOwnPropertyDescriptor(a,l){return Reflect.getOwnPropertyDescriptor(a._scopes[0],l)},getPrototypeOf(){return Reflect.getPrototypeOf(e[0])},has(a,l){return HC(a).includes(l)},ownKeys(a){return HC(a)},set(a,l,c){let d=a._storage||(a._storage=o());return a[l]=d[l]=c,delete a._keys,!0}})}function Eu(e,t,n,r){let o={_cacheable:!1,_proxy:e,_context:t,_subProxy:n,_stack:new Set,_descriptors:K6(e,r),setContext:i=>Eu(e,i,n,r),override:i=>Eu(e.override(i),t,n,r)};return new Proxy(o,{deleteProperty(i,a){return delete i[a],delete e[a],!0},get(i,a,l){return mP(i,a,()=>Nz(i,a,l))},getOwnPropertyDescriptor(i,a){return i._descriptors.allKeys?Reflect.has(e,a)?{enumerable:!0,configurable:!0}:void 0:Reflect.getOwnPropertyDescriptor(e,a)},getPrototypeOf(){return Reflect.getPrototypeOf(e)},has(i,a){return Reflect.has(e,a)},ownKeys(){return Reflect.ownKeys(e)},set(i,a,l){return e[a]=l,delete i[a],!0}})}function K6(e,t={scriptable:!0,indexable:!0}){let{_scriptable:n=t.scriptable,_indexable:r=t.indexable,_allKeys:o=t.allKeys}=e;return{allKeys:o,scriptable:n,indexable:r,isScriptable:la(n)?n:()=>n,isIndexable:la(r)?r:()=>r}}var Oz=(e,t)=>e?e+_g(t):t,G6=(e,t)=>Lt(t)&&e!=="adapters"&&(Object.getPrototypeOf(t)===null||t.constructor===Object);function mP(e,t,n){if(Object.prototype.hasOwnProperty.call(e,t))return e[t];let r=n();return e[t]=r,r}function Nz(e,t,n){let{_proxy:r,_context:o,_subProxy:i,_descriptors:a}=e,l=r[t];return la(l)&&a.isScriptable(t)&&(l=Az(t,l,e,n)),cn(l)&&l.length&&(l=Fz(t,l,e,a.isIndexable)),G6(t,l)&&(l=Eu(l,o,i&&i[t],a)),l}function Az(e,t,n,r){let{_proxy:o,_context:i,_subProxy:a,_stack:l}=n;if(l.has(e))throw new Error("Recursion detected: "+Array.from(l).join("->")+"->"+e);return l.add(e),t=t(i,a||r),l.delete(e),G6(e,t)&&(t=Q6(o._scopes,o,e,t)),t}function Fz(e,t,n,r){let{_proxy:o,_context:i,_subProxy:a,_descriptors:l}=n;if(zo(i.index)&&r(e))t=t[i.index%t.length];else if(Lt(t[0])){let c=t,d=o._scopes.filter(g=>g!==c);t=[];for(let g of c){let v=Q6(d,o,e,g);t.push(Eu(v,i,a&&a[e],l))}}return t}function gP(e,t,n){return la(e)?e(t,n):e}var Rz=(e,t)=>e===!0?t:typeof e=="string"?ua(t,e):void 0;function Bz(e,t,n,r,o){for(let i of t){let a=Rz(n,i);if(a){e.add(a);let l=gP(a._fallback,n,o);if(zo(l)&&l!==n&&l!==r)return l}else if(a===!1&&zo(r)&&n!==r)return null}return!1}function Q6(e,t,n,r){let o=t._rootScopes,i=gP(t._fallback,n,r),a=[...e,...o],l=new Set;l.add(r);let c=VC(l,a,n,i||n,r);return c===null||zo(i)&&i!==n&&(c=VC(l,a,i,c,r),c===null)?!1:Cg(Array.from(l),[""],o,i,()=>zz(t,n,r))}function VC(e,t,n,r,o){for(;n;)n=Bz(e,t,n,r,o);return n}function zz(e,t,n){let r=e._getTarget();t in r||(r[t]={});let o=r[t];return cn(o)&&Lt(n)?n:o}function jz(e,t,n,r){let o;for(let i of t)if(o=yP(Oz(i,e),n),zo(o))return G6(e,o)?Q6(n,r,e,o):o}function yP(e,t){for(let n of t){if(!n)continue;let r=n[e];if(zo(r))return r}}function HC(e){let t=e._keys;return t||(t=e._keys=Vz(e._scopes)),t}function Vz(e){let t=new Set;for(let n of e)for(let r of Object.keys(n).filter(o=>!o.startsWith("_")))t.add(r);return Array.from(t)}function X6(e,t,n,r){let{iScale:o}=e,{key:i="r"}=this._parsing,a=new Array(r),l,c,d,g;for(l=0,c=r;l<c;++l)d=l+n,g=t[d],a[l]={r:o.parse(ua(g,i),d)};return a}var Hz=Number.EPSILON||1e-14,jf=(e,t)=>t<e.length&&!e[t].skip&&e[t],vP=e=>e==="x"?"y":"x";function Uz(e,t,n,r){let o=e.skip?t:e,i=t,a=n.skip?t:n,l=yg(i,o),c=yg(a,i),d=l/(l+c),g=c/(l+c);d=isNaN(d)?0:d,g=isNaN(g)?0:g;let v=r*d,w=r*g;return{previous:{x:i.x-v*(a.x-o.x),y:i.y-v*(a.y-o.y)},next:{x:i.x+w*(a.x-o.x),y:i.y+w*(a.y-o.y)}}}function Wz(e,t,n){let r=e.length,o,i,a,l,c,d=jf(e,0);for(let g=0;g<r-1;++g)if(c=d,d=jf(e,g+1),!(!c||!d)){if(Hf(t[g],0,Hz)){n[g]=n[g+1]=0;continue}o=n[g]/t[g],i=n[g+1]/t[g],l=Math.pow(o,2)+Math.pow(i,2),!(l<=9)&&(a=3/Math.sqrt(l),n[g]=o*a*t[g],n[g+1]=i*a*t[g])}}function $z(e,t,n="x"){let r=vP(n),o=e.length,i,a,l,c=jf(e,0);for(let d=0;d<o;++d){if(a=l,l=c,c=jf(e,d+1),!l)continue;let g=l[n],v=l[r];a&&(i=(g-a[n])/3,l[`cp1${n}`]=g-i,l[`cp1${r}`]=v-i*t[d]),c&&(i=(c[n]-g)/3,l[`cp2${n}`]=g+i,l[`cp2${r}`]=v+i*t[d])}}function Yz(e,t="x"){let n=vP(t),r=e.length,o=Array(r).fill(0),i=Array(r),a,l,c,d=jf(e,0);for(a=0;a<r;++a)if(l=c,c=d,d=jf(e,a+1),!!c){if(d){let g=d[t]-c[t];o[a]=g!==0?(d[n]-c[n])/g:0}i[a]=l?d?Qi(o[a-1])!==Qi(o[a])?0:(o[a-1]+o[a])/2:o[a-1]:o[a]}Wz(e,o,i),$z(e,i,t)}function hg(e,t,n){return Math.max(Math.min(e,n),t)}function qz(e,t){let n,r,o,i,a,l=zf(e[0],t);for(n=0,r=e.length;n<r;++n)a=i,i=l,l=n<r-1&&zf(e[n+1],t),i&&(o=e[n],a&&(o.cp1x=hg(o.cp1x,t.left,t.right),o.cp1y=hg(o.cp1y,t.top,t.bottom)),l&&(o.cp2x=hg(o.cp2x,t.left,t.right),o.cp2y=hg(o.cp2y,t.top,t.bottom)))}function _P(e,t,n,r,o){let i,a,l,c;if(t.spanGaps&&(e=e.filter(d=>!d.skip)),t.cubicInterpolationMode==="monotone")Yz(e,o);else{let d=r?e[e.length-1]:e[0];for(i=0,a=e.length;i<a;++i)l=e[i],c=Uz(d,l,e[Math.min(i+1,a-(r?0:1))%a],t.tension),l.cp1x=c.previous.x,l.cp1y=c.previous.y,l.cp2x=c.next.x,l.cp2y=c.next.y,d=l}t.capBezierPoints&&qz(e,n)}function J6(){return typeof window!="undefined"&&typeof document!="undefined"}function Pg(e){let t=e.parentNode;return t&&t.toString()==="[object ShadowRoot]"&&(t=t.host),t}function vg(e,t,n){let r;return typeof e=="string"?(r=parseInt(e,10),e.indexOf("%")!==-1&&(r=r/100*t.parentNode[n])):r=e,r}var Eg=e=>window.getComputedStyle(e,null);function Kz(e,t){return Eg(e).getPropertyValue(t)}var Gz=["top","right","bottom","left"];function Pu(e,t,n){let r={};n=n?"-"+n:"";for(let o=0;o<4;o++){let i=Gz[o];r[i]=parseFloat(e[t+"-"+i+n])||0}return r.width=r.left+r.right,r.height=r.top+r.bottom,r}var Qz=(e,t,n)=>(e>0||t>0)&&(!n||!n.shadowRoot);function Xz(e,t){let n=e.touches,r=n&&n.length?n[0]:e,{offsetX:o,offsetY:i}=r,a=!1,l,c;if(Qz(o,i,e.target))l=o,c=i;else{let d=t.getBoundingClientRect();l=r.clientX-d.left,c=r.clientY-d.top,a=!0}return{x:l,y:c,box:a}}function gl(e,t){if("native"in e)return e;let{canvas:n,currentDevicePixelRatio:r}=t,o=Eg(n),i=o.boxSizing==="border-box",a=Pu(o,"padding"),l=Pu(o,"border","width"),{x:c,y:d,box:g}=Xz(e,n),v=a.left+(g&&l.left),w=a.top+(g&&l.top),{width:S,height:C}=t;return i&&(S-=a.width+l.width,C-=a.height+l.height),{x:Math.round((c-v)/S*n.width/r),y:Math.round((d-w)/C*n.height/r)}}function Jz(e,t,n){let r,o;if(t===void 0||n===void 0){let i=Pg(e);if(!i)t=e.clientWidth,n=e.clientHeight;else{let a=i.getBoundingClientRect(),l=Eg(i),c=Pu(l,"border","width"),d=Pu(l,"padding");t=a.width-d.width-c.width,n=a.height-d.height-c.height,r=vg(l.maxWidth,i,"clientWidth"),o=vg(l.maxHeight,i,"clientHeight")}}return{width:t,height:n,maxWidth:r||gg,maxHeight:o||gg}}var k6=e=>Math.round(e*10)/10;function xP(e,t,n,r){let o=Eg(e),i=Pu(o,"margin"),a=vg(o.maxWidth,e,"clientWidth")||gg,l=vg(o.maxHeight,e,"clientHeight")||gg,c=Jz(e,t,n),{width:d,height:g}=c;if(o.boxSizing==="content-box"){let v=Pu(o,"border","width"),w=Pu(o,"padding");d-=w.width+v.width,g-=w.height+v.height}return d=Math.max(0,d-i.width),g=Math.max(0,r?Math.floor(d/r):g-i.height),d=k6(Math.min(d,a,c.maxWidth)),g=k6(Math.min(g,l,c.maxHeight)),d&&!g&&(g=k6(d/2)),{width:d,height:g}}function Z6(e,t,n){let r=t||1,o=Math.floor(e.height*r),i=Math.floor(e.width*r);e.height=o/r,e.width=i/r;let a=e.canvas;return a.style&&(n||!a.style.height&&!a.style.width)&&(a.style.height=`${e.height}px`,a.style.width=`${e.width}px`),e.currentDevicePixelRatio!==r||a.height!==o||a.width!==i?(e.currentDevicePixelRatio=r,a.height=o,a.width=i,e.ctx.setTransform(r,0,0,r,0,0),!0):!1}var wP=function(){let e=!1;try{let t={get passive(){return e=!0,!1}};window.addEventListener("test",null,t),window.removeEventListener("test",null,t)}catch(t){}return e}();function e_(e,t){let n=Kz(e,t),r=n&&n.match(/^(\d+)(\.\d+)?px$/);return r?+r[1]:void 0}function ul(e,t,n,r){return{x:e.x+n*(t.x-e.x),y:e.y+n*(t.y-e.y)}}function bP(e,t,n,r){return{x:e.x+n*(t.x-e.x),y:r==="middle"?n<.5?e.y:t.y:r==="after"?n<1?e.y:t.y:n>0?t.y:e.y}}function SP(e,t,n,r){let o={x:e.cp2x,y:e.cp2y},i={x:t.cp1x,y:t.cp1y},a=ul(e,o,n),l=ul(o,i,n),c=ul(i,t,n),d=ul(a,l,n),g=ul(l,c,n);return ul(d,g,n)}var UC=new Map;function Zz(e,t){t=t||{};let n=e+JSON.stringify(t),r=UC.get(n);return r||(r=new Intl.NumberFormat(e,t),UC.set(n,r)),r}function Yf(e,t,n){return Zz(t,n).format(e)}var ej=function(e,t){return{x(n){return e+e+t-n},setWidth(n){t=n},textAlign(n){return n==="center"?n:n==="right"?"left":"right"},xPlus(n,r){return n-r},leftForLtr(n,r){return n-r}}},tj=function(){return{x(e){return e},setWidth(e){},textAlign(e){return e},xPlus(e,t){return e+t},leftForLtr(e,t){return e}}};function Du(e,t,n){return e?ej(t,n):tj()}function t_(e,t){let n,r;(t==="ltr"||t==="rtl")&&(n=e.canvas.style,r=[n.getPropertyValue("direction"),n.getPropertyPriority("direction")],n.setProperty("direction",t,"important"),e.prevTextDirection=r)}function n_(e,t){t!==void 0&&(delete e.prevTextDirection,e.canvas.style.setProperty("direction",t[0],t[1]))}function MP(e){return e==="angle"?{between:Uf,compare:rz,normalize:yo}:{between:bs,compare:(t,n)=>t-n,normalize:t=>t}}function WC({start:e,end:t,count:n,loop:r,style:o}){return{start:e%n,end:t%n,loop:r&&(t-e+1)%n===0,style:o}}function nj(e,t,n){let{property:r,start:o,end:i}=n,{between:a,normalize:l}=MP(r),c=t.length,{start:d,end:g,loop:v}=e,w,S;if(v){for(d+=c,g+=c,w=0,S=c;w<S&&a(l(t[d%c][r]),o,i);++w)d--,g--;d%=c,g%=c}return g<d&&(g+=c),{start:d,end:g,loop:v,style:e.style}}function r_(e,t,n){if(!n)return[e];let{property:r,start:o,end:i}=n,a=t.length,{compare:l,between:c,normalize:d}=MP(r),{start:g,end:v,loop:w,style:S}=nj(e,t,n),C=[],L=!1,k=null,M,P,D,T=()=>c(o,D,M)&&l(o,D)!==0,O=()=>l(i,M)===0||c(i,D,M),A=()=>L||T(),R=()=>!L||O();for(let B=g,Y=g;B<=v;++B)P=t[B%a],!P.skip&&(M=d(P[r]),M!==D&&(L=c(M,o,i),k===null&&A()&&(k=l(M,o)===0?B:Y),k!==null&&R()&&(C.push(WC({start:k,end:B,loop:w,count:a,style:S})),k=null),Y=B,D=M));return k!==null&&C.push(WC({start:k,end:v,loop:w,count:a,style:S})),C}function o_(e,t){let n=[],r=e.segments;for(let o=0;o<r.length;o++){let i=r_(r[o],e.points,t);i.length&&n.push(...i)}return n}function rj(e,t,n,r){let o=0,i=t-1;if(n&&!r)for(;o<t&&!e[o].skip;)o++;for(;o<t&&e[o].skip;)o++;for(o%=t,n&&(i+=o);i>o&&e[i%t].skip;)i--;return i%=t,{start:o,end:i}}function oj(e,t,n,r){let o=e.length,i=[],a=t,l=e[t],c;for(c=t+1;c<=n;++c){let d=e[c%o];d.skip||d.stop?l.skip||(r=!1,i.push({start:t%o,end:(c-1)%o,loop:r}),t=a=d.stop?c:null):(a=c,l.skip&&(t=c)),l=d}return a!==null&&i.push({start:t%o,end:a%o,loop:r}),i}function kP(e,t){let n=e.points,r=e.options.spanGaps,o=n.length;if(!o)return[];let i=!!e._loop,{start:a,end:l}=rj(n,o,i,r);if(r===!0)return $C(e,[{start:a,end:l,loop:i}],n,t);let c=l<a?l+o:l,d=!!e._fullLoop&&a===0&&l===o-1;return $C(e,oj(n,a,c,d),n,t)}function $C(e,t,n,r){return!r||!r.setContext||!n?t:ij(e,t,n,r)}function ij(e,t,n,r){let o=e._chart.getContext(),i=YC(e.options),{_datasetIndex:a,options:{spanGaps:l}}=e,c=n.length,d=[],g=i,v=t[0].start,w=v;function S(C,L,k,M){let P=l?-1:1;if(C!==L){for(C+=c;n[C%c].skip;)C-=P;for(;n[L%c].skip;)L+=P;C%c!==L%c&&(d.push({start:C%c,end:L%c,loop:k,style:M}),g=M,v=L%c)}}for(let C of t){v=l?v:C.start;let L=n[v%c],k;for(w=v+1;w<=C.end;w++){let M=n[w%c];k=YC(r.setContext(ca(o,{type:"segment",p0:L,p1:M,p0DataIndex:(w-1)%c,p1DataIndex:w%c,datasetIndex:a}))),sj(k,g)&&S(v,w-1,C.loop,g),L=M,g=k}v<w-1&&S(v,w-1,C.loop,g)}return d}function YC(e){return{backgroundColor:e.backgroundColor,borderCapStyle:e.borderCapStyle,borderDash:e.borderDash,borderDashOffset:e.borderDashOffset,borderJoinStyle:e.borderJoinStyle,borderWidth:e.borderWidth,borderColor:e.borderColor}}function sj(e,t){return t&&JSON.stringify(e)!==JSON.stringify(t)}var h_=class{constructor(){this._request=null,this._charts=new Map,this._running=!1,this._lastDate=void 0}_notify(t,n,r,o){let i=n.listeners[o],a=n.duration;i.forEach(l=>l({chart:t,initial:n.initial,numSteps:a,currentStep:Math.min(r-n.start,a)}))}_refresh(){this._request||(this._running=!0,this._request=R6.call(window,()=>{this._update(),this._request=null,this._running&&this._refresh()}))}_update(t=Date.now()){let n=0;this._charts.forEach((r,o)=>{if(!r.running||!r.items.length)return;let i=r.items,a=i.length-1,l=!1,c;for(;a>=0;--a)c=i[a],c._active?(c._total>r.duration&&(r.duration=c._total),c.tick(t),l=!0):(i[a]=i[i.length-1],i.pop());l&&(o.draw(),this._notify(o,r,t,"progress")),i.length||(r.running=!1,this._notify(o,r,t,"complete"),r.initial=!1),n+=i.length}),this._lastDate=t,n===0&&(this._running=!1)}_getAnims(t){let n=this._charts,r=n.get(t);return r||(r={running:!1,initial:!0,items:[],listeners:{complete:[],progress:[]}},n.set(t,r)),r}listen(t,n,r){this._getAnims(t).listeners[n].push(r)}add(t,n){!n||!n.length||this._getAnims(t).items.push(...n)}has(t){return this._getAnims(t).items.length>0}start(t){let n=this._charts.get(t);n&&(n.running=!0,n.start=Date.now(),n.duration=n.items.reduce((r,o)=>Math.max(r,o._duration),0),this._refresh())}running(t){if(!this._running)return!1;let n=this._charts.get(t);return!(!n||!n.running||!n.items.length)}stop(t){let n=this._charts.get(t);if(!n||!n.items.length)return;let r=n.items,o=r.length-1;for(;o>=0;--o)r[o].cancel();n.items=[],this._notify(t,n,Date.now(),"complete")}remove(t){return this._charts.delete(t)}},fa=new h_,CP="transparent",aj={boolean(e,t,n){return n>.5?t:e},color(e,t,n){let r=W6(e||CP),o=r.valid&&W6(t||CP);return o&&o.valid?o.mix(r,n).hexString():t},number(e,t,n){return e+(t-e)*n}},m_=class{constructor(t,n,r,o){let i=n[r];o=$f([t.to,o,i,t.from]);let a=$f([t.from,i,o]);this._active=!0,this._fn=t.fn||aj[t.type||typeof a],this._easing=Rf[t.easing]||Rf.linear,this._start=Math.floor(Date.now()+(t.delay||0)),this._duration=this._total=Math.floor(t.duration),this._loop=!!t.loop,this._target=n,this._prop=r,this._from=a,this._to=o,this._promises=void 0}active(){return this._active}update(t,n,r){if(this._active){this._notify(!1);let o=this._target[this._prop],i=r-this._start,a=this._duration-i;this._start=r,this._duration=Math.floor(Math.max(a,t.duration)),this._total+=i,this._loop=!!t.loop,this._to=$f([t.to,n,o,t.from]),this._from=$f([t.from,o,n])}}cancel(){this._active&&(this.tick(Date.now()),this._active=!1,this._notify(!1))}tick(t){let n=t-this._start,r=this._duration,o=this._prop,i=this._from,a=this._loop,l=this._to,c;if(this._active=i!==l&&(a||n<r),!this._active){this._target[o]=l,this._notify(!0);return}if(n<0){this._target[o]=i;return}c=n/r%2,c=a&&c>1?2-c:c,c=this._easing(Math.min(1,Math.max(0,c))),this._target[o]=this._fn(i,l,c)}wait(){let t=this._promises||(this._promises=[]);return new Promise((n,r)=>{t.push({res:n,rej:r})})}_notify(t){let n=t?"res":"rej",r=this._promises||[];for(let o=0;o<r.length;o++)r[o][n]()}},lj=["x","y","borderWidth","radius","tension"],uj=["color","borderColor","backgroundColor"];Ot.set("animation",{delay:void 0,duration:1e3,easing:"easeOutQuart",fn:void 0,from:void 0,loop:void 0,to:void 0,type:void 0});var cj=Object.keys(Ot.animation);Ot.describe("animation",{_fallback:!1,_indexable:!1,_scriptable:e=>e!=="onProgress"&&e!=="onComplete"&&e!=="fn"});Ot.set("animations",{colors:{type:"color",properties:uj},numbers:{type:"number",properties:lj}});Ot.describe("animations",{_fallback:"animation"});Ot.set("transitions",{active:{animation:{duration:400}},resize:{animation:{duration:0}},show:{animations:{colors:{from:"transparent"},visible:{type:"boolean",duration:0}}},hide:{animations:{colors:{to:"transparent"},visible:{type:"boolean",easing:"linear",fn:e=>e|0}}}});var Fg=class{constructor(t,n){this._chart=t,this._properties=new Map,this.configure(n)}configure(t){if(!Lt(t))return;let n=this._properties;Object.getOwnPropertyNames(t).forEach(r=>{let o=t[r];if(!Lt(o))return;let i={};for(let a of cj)i[a]=o[a];(cn(o.properties)&&o.properties||[r]).forEach(a=>{(a===r||!n.has(a))&&n.set(a,i)})})}_animateOptions(t,n){let r=n.options,o=dj(t,r);if(!o)return[];let i=this._createAnimations(o,r);return r.$shared&&fj(t.options.$animations,r).then(()=>{t.options=r},()=>{}),i}_createAnimations(t,n){let r=this._properties,o=[],i=t.$animations||(t.$animations={}),a=Object.keys(n),l=Date.now(),c;for(c=a.length-1;c>=0;--c){let d=a[c];if(d.charAt(0)==="$")continue;if(d==="options"){o.push(...this._animateOptions(t,n));continue}let g=n[d],v=i[d],w=r.get(d);if(v)if(w&&v.active()){v.update(w,g,l);continue}else v.cancel();if(!w||!w.duration){t[d]=g;continue}i[d]=v=new m_(w,t,d,g),o.push(v)}return o}update(t,n){if(this._properties.size===0){Object.assign(t,n);return}let r=this._createAnimations(t,n);if(r.length)return fa.add(this._chart,r),!0}};function fj(e,t){let n=[],r=Object.keys(t);for(let o=0;o<r.length;o++){let i=e[r[o]];i&&i.active()&&n.push(i.wait())}return Promise.all(n)}function dj(e,t){if(!t)return;let n=e.options;if(!n){e.options=t;return}return n.$shared&&(e.options=n=Object.assign({},n,{$shared:!1,$animations:{}})),n}function PP(e,t){let n=e&&e.options||{},r=n.reverse,o=n.min===void 0?t:0,i=n.max===void 0?t:0;return{start:r?i:o,end:r?o:i}}function pj(e,t,n){if(n===!1)return!1;let r=PP(e,n),o=PP(t,n);return{top:o.end,right:r.end,bottom:o.start,left:r.start}}function hj(e){let t,n,r,o;return Lt(e)?(t=e.top,n=e.right,r=e.bottom,o=e.left):t=n=r=o=e,{top:t,right:n,bottom:r,left:o,disabled:e===!1}}function yE(e,t){let n=[],r=e._getSortedDatasetMetas(t),o,i;for(o=0,i=r.length;o<i;++o)n.push(r[o].index);return n}function EP(e,t,n,r={}){let o=e.keys,i=r.mode==="single",a,l,c,d;if(t!==null){for(a=0,l=o.length;a<l;++a){if(c=+o[a],c===n){if(r.all)continue;break}d=e.values[c],Fn(d)&&(i||t===0||Qi(t)===Qi(d))&&(t+=d)}return t}}function mj(e){let t=Object.keys(e),n=new Array(t.length),r,o,i;for(r=0,o=t.length;r<o;++r)i=t[r],n[r]={x:i,y:e[i]};return n}function IP(e,t){let n=e&&e.options.stacked;return n||n===void 0&&t.stack!==void 0}function gj(e,t,n){return`${e.id}.${t.id}.${n.stack||n.type}`}function yj(e){let{min:t,max:n,minDefined:r,maxDefined:o}=e.getUserBounds();return{min:r?t:Number.NEGATIVE_INFINITY,max:o?n:Number.POSITIVE_INFINITY}}function vj(e,t,n){let r=e[t]||(e[t]={});return r[n]||(r[n]={})}function DP(e,t,n,r){for(let o of t.getMatchingVisibleMetas(r).reverse()){let i=e[o.index];if(n&&i>0||!n&&i<0)return o.index}return null}function TP(e,t){let{chart:n,_cachedMeta:r}=e,o=n._stacks||(n._stacks={}),{iScale:i,vScale:a,index:l}=r,c=i.axis,d=a.axis,g=gj(i,a,r),v=t.length,w;for(let S=0;S<v;++S){let C=t[S],{[c]:L,[d]:k}=C,M=C._stacks||(C._stacks={});w=M[d]=vj(o,g,L),w[l]=k,w._top=DP(w,a,!0,r.type),w._bottom=DP(w,a,!1,r.type)}}function i_(e,t){let n=e.scales;return Object.keys(n).filter(r=>n[r].axis===t).shift()}function _j(e,t){return ca(e,{active:!1,dataset:void 0,datasetIndex:t,index:t,mode:"default",type:"dataset"})}function xj(e,t,n){return ca(e,{active:!1,dataIndex:t,parsed:void 0,raw:void 0,element:n,index:t,mode:"default",type:"data"})}function $h(e,t){let n=e.controller.index,r=e.vScale&&e.vScale.axis;if(r){t=t||e._parsed;for(let o of t){let i=o._stacks;if(!i||i[r]===void 0||i[r][n]===void 0)return;delete i[r][n]}}}var s_=e=>e==="reset"||e==="none",LP=(e,t)=>t?e:Object.assign({},e),wj=(e,t,n)=>e&&!t.hidden&&t._stacked&&{keys:yE(n,!0),values:null},Ho=class{constructor(t,n){this.chart=t,this._ctx=t.ctx,this.index=n,this._cachedDataOpts={},this._cachedMeta=this.getMeta(),this._type=this._cachedMeta.type,this.options=void 0,this._parsing=!1,this._data=void 0,this._objectData=void 0,this._sharedOptions=void 0,this._drawStart=void 0,this._drawCount=void 0,this.enableOptionSharing=!1,this.supportsDecimation=!1,this.$context=void 0,this._syncList=[],this.initialize()}initialize(){let t=this._cachedMeta;this.configure(),this.linkScales(),t._stacked=IP(t.vScale,t),this.addElements()}updateIndex(t){this.index!==t&&$h(this._cachedMeta),this.index=t}linkScales(){let t=this.chart,n=this._cachedMeta,r=this.getDataset(),o=(v,w,S,C)=>v==="x"?w:v==="r"?C:S,i=n.xAxisID=Mt(r.xAxisID,i_(t,"x")),a=n.yAxisID=Mt(r.yAxisID,i_(t,"y")),l=n.rAxisID=Mt(r.rAxisID,i_(t,"r")),c=n.indexAxis,d=n.iAxisID=o(c,i,a,l),g=n.vAxisID=o(c,a,i,l);n.xScale=this.getScaleForId(i),n.yScale=this.getScaleForId(a),n.rScale=this.getScaleForId(l),n.iScale=this.getScaleForId(d),n.vScale=this.getScaleForId(g)}getDataset(){return this.chart.data.datasets[this.index]}getMeta(){return this.chart.getDatasetMeta(this.index)}getScaleForId(t){return this.chart.scales[t]}_getOtherScale(t){let n=this._cachedMeta;return t===n.iScale?n.vScale:n.iScale}reset(){this._update("reset")}_destroy(){let t=this._cachedMeta;this._data&&A6(this._data,this),t._stacked&&$h(t)}_dataCheck(){let t=this.getDataset(),n=t.data||(t.data=[]),r=this._data;if(Lt(n))this._data=mj(n);else if(r!==n){if(r){A6(r,this);let o=this._cachedMeta;$h(o),o._parsed=[]}n&&Object.isExtensible(n)&&rP(n,this),this._syncList=[],this._data=n}}addElements(){let t=this._cachedMeta;this._dataCheck(),this.datasetElementType&&(t.dataset=new this.datasetElementType)}buildOrUpdateElements(t){let n=this._cachedMeta,r=this.getDataset(),o=!1;this._dataCheck();let i=n._stacked;n._stacked=IP(n.vScale,n),n.stack!==r.stack&&(o=!0,$h(n),n.stack=r.stack),this._resyncElements(t),(o||i!==n._stacked)&&TP(this,n._parsed)}configure(){let t=this.chart.config,n=t.datasetScopeKeys(this._type),r=t.getOptionScopes(this.getDataset(),n,!0);this.options=t.createResolver(r,this.getContext()),this._parsing=this.options.parsing,this._cachedDataOpts={}}parse(t,n){let{_cachedMeta:r,_data:o}=this,{iScale:i,_stacked:a}=r,l=i.axis,c=t===0&&n===o.length?!0:r._sorted,d=t>0&&r._parsed[t-1],g,v,w;if(this._parsing===!1)r._parsed=o,r._sorted=!0,w=o;else{cn(o[t])?w=this.parseArrayData(r,o,t,n):Lt(o[t])?w=this.parseObjectData(r,o,t,n):w=this.parsePrimitiveData(r,o,t,n);let S=()=>v[l]===null||d&&v[l]<d[l];for(g=0;g<n;++g)r._parsed[g+t]=v=w[g],c&&(S()&&(c=!1),d=v);r._sorted=c}a&&TP(this,w)}parsePrimitiveData(t,n,r,o){let{iScale:i,vScale:a}=t,l=i.axis,c=a.axis,d=i.getLabels(),g=i===a,v=new Array(o),w,S,C;for(w=0,S=o;w<S;++w)C=w+r,v[w]={[l]:g||i.parse(d[C],C),[c]:a.parse(n[C],C)};return v}parseArrayData(t,n,r,o){let{xScale:i,yScale:a}=t,l=new Array(o),c,d,g,v;for(c=0,d=o;c<d;++c)g=c+r,v=n[g],l[c]={x:i.parse(v[0],g),y:a.parse(v[1],g)};return l}parseObjectData(t,n,r,o){let{xScale:i,yScale:a}=t,{xAxisKey:l="x",yAxisKey:c="y"}=this._parsing,d=new Array(o),g,v,w,S;for(g=0,v=o;g<v;++g)w=g+r,S=n[w],d[g]={x:i.parse(ua(S,l),w),y:a.parse(ua(S,c),w)};return d}getParsed(t){return this._cachedMeta._parsed[t]}getDataElement(t){return this._cachedMeta.data[t]}applyStack(t,n,r){let o=this.chart,i=this._cachedMeta,a=n[t.axis],l={keys:yE(o,!0),values:n._stacks[t.axis]};return EP(l,a,i.index,{mode:r})}updateRangeFromParsed(t,n,r,o){let i=r[n.axis],a=i===null?NaN:i,l=o&&r._stacks[n.axis];o&&l&&(o.values=l,a=EP(o,i,this._cachedMeta.index)),t.min=Math.min(t.min,a),t.max=Math.max(t.max,a)}getMinMax(t,n){let r=this._cachedMeta,o=r._parsed,i=r._sorted&&t===r.iScale,a=o.length,l=this._getOtherScale(t),c=wj(n,r,this.chart),d={min:Number.POSITIVE_INFINITY,max:Number.NEGATIVE_INFINITY},{min:g,max:v}=yj(l),w,S;function C(){S=o[w];let L=S[l.axis];return!Fn(S[t.axis])||g>L||v<L}for(w=0;w<a&&!(!C()&&(this.updateRangeFromParsed(d,t,S,c),i));++w);if(i){for(w=a-1;w>=0;--w)if(!C()){this.updateRangeFromParsed(d,t,S,c);break}}return d}getAllParsedValues(t){let n=this._cachedMeta._parsed,r=[],o,i,a;for(o=0,i=n.length;o<i;++o)a=n[o][t.axis],Fn(a)&&r.push(a);return r}getMaxOverflow(){return!1}getLabelAndValue(t){let n=this._cachedMeta,r=n.iScale,o=n.vScale,i=this.getParsed(t);return{label:r?""+r.getLabelForValue(i[r.axis]):"",value:o?""+o.getLabelForValue(i[o.axis]):""}}_update(t){let n=this._cachedMeta;this.update(t||"default"),n._clip=hj(Mt(this.options.clip,pj(n.xScale,n.yScale,this.getMaxOverflow())))}update(t){}draw(){let t=this._ctx,n=this.chart,r=this._cachedMeta,o=r.data||[],i=n.chartArea,a=[],l=this._drawStart||0,c=this._drawCount||o.length-l,d=this.options.drawActiveElementsOnTop,g;for(r.dataset&&r.dataset.draw(t,i,l,c),g=l;g<l+c;++g){let v=o[g];v.hidden||(v.active&&d?a.push(v):v.draw(t,i))}for(g=0;g<a.length;++g)a[g].draw(t,i)}getStyle(t,n){let r=n?"active":"default";return t===void 0&&this._cachedMeta.dataset?this.resolveDatasetElementOptions(r):this.resolveDataElementOptions(t||0,r)}getContext(t,n,r){let o=this.getDataset(),i;if(t>=0&&t<this._cachedMeta.data.length){let a=this._cachedMeta.data[t];i=a.$context||(a.$context=xj(this.getContext(),t,a)),i.parsed=this.getParsed(t),i.raw=o.data[t],i.index=i.dataIndex=t}else i=this.$context||(this.$context=_j(this.chart.getContext(),this.index)),i.dataset=o,i.index=i.datasetIndex=this.index;return i.active=!!n,i.mode=r,i}resolveDatasetElementOptions(t){return this._resolveElementOptions(this.datasetElementType.id,t)}resolveDataElementOptions(t,n){return this._resolveElementOptions(this.dataElementType.id,n,t)}_resolveElementOptions(t,n="default",r){let o=n==="active",i=this._cachedDataOpts,a=t+"-"+n,l=i[a],c=this.enableOptionSharing&&zo(r);if(l)return LP(l,c);let d=this.chart.config,g=d.datasetElementScopeKeys(this._type,t),v=o?[`${t}Hover`,"hover",t,""]:[t,""],w=d.getOptionScopes(this.getDataset(),g),S=Object.keys(Ot.elements[t]),C=()=>this.getContext(r,o),L=d.resolveNamedOptions(w,S,C,v);return L.$shared&&(L.$shared=c,i[a]=Object.freeze(LP(L,c))),L}_resolveAnimations(t,n,r){let o=this.chart,i=this._cachedDataOpts,a=`animation-${n}`,l=i[a];if(l)return l;let c;if(o.options.animation!==!1){let g=this.chart.config,v=g.datasetAnimationScopeKeys(this._type,n),w=g.getOptionScopes(this.getDataset(),v);c=g.createResolver(w,this.getContext(t,r,n))}let d=new Fg(o,c&&c.animations);return c&&c._cacheable&&(i[a]=Object.freeze(d)),d}getSharedOptions(t){if(t.$shared)return this._sharedOptions||(this._sharedOptions=Object.assign({},t))}includeOptions(t,n){return!n||s_(t)||this.chart._animationsDisabled}_getSharedOptions(t,n){let r=this.resolveDataElementOptions(t,n),o=this._sharedOptions,i=this.getSharedOptions(r),a=this.includeOptions(n,i)||i!==o;return this.updateSharedOptions(i,n,r),{sharedOptions:i,includeOptions:a}}updateElement(t,n,r,o){s_(o)?Object.assign(t,r):this._resolveAnimations(n,o).update(t,r)}updateSharedOptions(t,n,r){t&&!s_(n)&&this._resolveAnimations(void 0,n).update(t,r)}_setStyle(t,n,r,o){t.active=o;let i=this.getStyle(n,o);this._resolveAnimations(n,r,o).update(t,{options:!o&&this.getSharedOptions(i)||i})}removeHoverStyle(t,n,r){this._setStyle(t,r,"active",!1)}setHoverStyle(t,n,r){this._setStyle(t,r,"active",!0)}_removeDatasetHoverStyle(){let t=this._cachedMeta.dataset;t&&this._setStyle(t,void 0,"active",!1)}_setDatasetHoverStyle(){let t=this._cachedMeta.dataset;t&&this._setStyle(t,void 0,"active",!0)}_resyncElements(t){let n=this._data,r=this._cachedMeta.data;for(let[l,c,d]of this._syncList)this[l](c,d);this._syncList=[];let o=r.length,i=n.length,a=Math.min(i,o);a&&this.parse(0,a),i>o?this._insertElements(o,i-o,t):i<o&&this._removeElements(i,o-i)}_insertElements(t,n,r=!0){let o=this._cachedMeta,i=o.data,a=t+n,l,c=d=>{for(d.length+=n,l=d.length-1;l>=a;l--)d[l]=d[l-n]};for(c(i),l=t;l<a;++l)i[l]=new this.dataElementType;this._parsing&&c(o._parsed),this.parse(t,n),r&&this.updateElements(i,t,n,"reset")}updateElements(t,n,r,o){}_removeElements(t,n){let r=this._cachedMeta;if(this._parsing){let o=r._parsed.splice(t,n);r._stacked&&$h(r,o)}r.data.splice(t,n)}_sync(t){if(this._parsing)this._syncList.push(t);else{let[n,r,o]=t;this[n](r,o)}this.chart._dataChanges.push([this.index,...t])}_onDataPush(){let t=arguments.length;this._sync(["_insertElements",this.getDataset().data.length-t,t])}_onDataPop(){this._sync(["_removeElements",this._cachedMeta.data.length-1,1])}_onDataShift(){this._sync(["_removeElements",0,1])}_onDataSplice(t,n){n&&this._sync(["_removeElements",t,n]);let r=arguments.length-2;r&&this._sync(["_insertElements",t,r])}_onDataUnshift(){this._sync(["_insertElements",0,arguments.length])}};Ho.defaults={};Ho.prototype.datasetElementType=null;Ho.prototype.dataElementType=null;function bj(e,t){if(!e._cache.$bar){let n=e.getMatchingVisibleMetas(t),r=[];for(let o=0,i=n.length;o<i;o++)r=r.concat(n[o].controller.getAllParsedValues(e));e._cache.$bar=F6(r.sort((o,i)=>o-i))}return e._cache.$bar}function Sj(e){let t=e.iScale,n=bj(t,e.type),r=t._length,o,i,a,l,c=()=>{a===32767||a===-32768||(zo(l)&&(r=Math.min(r,Math.abs(a-l)||r)),l=a)};for(o=0,i=n.length;o<i;++o)a=t.getPixelForValue(n[o]),c();for(l=void 0,o=0,i=t.ticks.length;o<i;++o)a=t.getPixelForTick(o),c();return r}function Mj(e,t,n,r){let o=n.barThickness,i,a;return Ht(o)?(i=t.min*n.categoryPercentage,a=n.barPercentage):(i=o*r,a=1),{chunk:i/r,ratio:a,start:t.pixels[e]-i/2}}function kj(e,t,n,r){let o=t.pixels,i=o[e],a=e>0?o[e-1]:null,l=e<o.length-1?o[e+1]:null,c=n.categoryPercentage;a===null&&(a=i-(l===null?t.end-t.start:l-i)),l===null&&(l=i+i-a);let d=i-(i-Math.min(a,l))/2*c;return{chunk:Math.abs(l-a)/2*c/r,ratio:n.barPercentage,start:d}}function Cj(e,t,n,r){let o=n.parse(e[0],r),i=n.parse(e[1],r),a=Math.min(o,i),l=Math.max(o,i),c=a,d=l;Math.abs(a)>Math.abs(l)&&(c=l,d=a),t[n.axis]=d,t._custom={barStart:c,barEnd:d,start:o,end:i,min:a,max:l}}function vE(e,t,n,r){return cn(e)?Cj(e,t,n,r):t[n.axis]=n.parse(e,r),t}function OP(e,t,n,r){let o=e.iScale,i=e.vScale,a=o.getLabels(),l=o===i,c=[],d,g,v,w;for(d=n,g=n+r;d<g;++d)w=t[d],v={},v[o.axis]=l||o.parse(a[d],d),c.push(vE(w,v,i,d));return c}function a_(e){return e&&e.barStart!==void 0&&e.barEnd!==void 0}function Pj(e,t,n){return e!==0?Qi(e):(t.isHorizontal()?1:-1)*(t.min>=n?1:-1)}function Ej(e){let t,n,r,o,i;return e.horizontal?(t=e.base>e.x,n="left",r="right"):(t=e.base<e.y,n="bottom",r="top"),t?(o="end",i="start"):(o="start",i="end"),{start:n,end:r,reverse:t,top:o,bottom:i}}function Ij(e,t,n,r){let o=t.borderSkipped,i={};if(!o){e.borderSkipped=i;return}if(o===!0){e.borderSkipped={top:!0,right:!0,bottom:!0,left:!0};return}let{start:a,end:l,reverse:c,top:d,bottom:g}=Ej(e);o==="middle"&&n&&(e.enableBorderRadius=!0,(n._top||0)===r?o=d:(n._bottom||0)===r?o=g:(i[NP(g,a,l,c)]=!0,o=d)),i[NP(o,a,l,c)]=!0,e.borderSkipped=i}function NP(e,t,n,r){return r?(e=Dj(e,t,n),e=AP(e,n,t)):e=AP(e,t,n),e}function Dj(e,t,n){return e===t?n:e===n?t:e}function AP(e,t,n){return e==="start"?t:e==="end"?n:e}function Tj(e,{inflateAmount:t},n){e.inflateAmount=t==="auto"?n===1?.33:0:t}var Gf=class extends Ho{parsePrimitiveData(t,n,r,o){return OP(t,n,r,o)}parseArrayData(t,n,r,o){return OP(t,n,r,o)}parseObjectData(t,n,r,o){let{iScale:i,vScale:a}=t,{xAxisKey:l="x",yAxisKey:c="y"}=this._parsing,d=i.axis==="x"?l:c,g=a.axis==="x"?l:c,v=[],w,S,C,L;for(w=r,S=r+o;w<S;++w)L=n[w],C={},C[i.axis]=i.parse(ua(L,d),w),v.push(vE(ua(L,g),C,a,w));return v}updateRangeFromParsed(t,n,r,o){super.updateRangeFromParsed(t,n,r,o);let i=r._custom;i&&n===this._cachedMeta.vScale&&(t.min=Math.min(t.min,i.min),t.max=Math.max(t.max,i.max))}getMaxOverflow(){return 0}getLabelAndValue(t){let n=this._cachedMeta,{iScale:r,vScale:o}=n,i=this.getParsed(t),a=i._custom,l=a_(a)?"["+a.start+", "+a.end+"]":""+o.getLabelForValue(i[o.axis]);return{label:""+r.getLabelForValue(i[r.axis]),value:l}}initialize(){this.enableOptionSharing=!0,super.initialize();let t=this._cachedMeta;t.stack=this.getDataset().stack}update(t){let n=this._cachedMeta;this.updateElements(n.data,0,n.data.length,t)}updateElements(t,n,r,o){let i=o==="reset",{index:a,_cachedMeta:{vScale:l}}=this,c=l.getBasePixel(),d=l.isHorizontal(),g=this._getRuler(),{sharedOptions:v,includeOptions:w}=this._getSharedOptions(n,o);for(let S=n;S<n+r;S++){let C=this.getParsed(S),L=i||Ht(C[l.axis])?{base:c,head:c}:this._calculateBarValuePixels(S),k=this._calculateBarIndexPixels(S,g),M=(C._stacks||{})[l.axis],P={horizontal:d,base:L.base,enableBorderRadius:!M||a_(C._custom)||a===M._top||a===M._bottom,x:d?L.head:k.center,y:d?k.center:L.head,height:d?k.size:Math.abs(L.size),width:d?Math.abs(L.size):k.size};w&&(P.options=v||this.resolveDataElementOptions(S,t[S].active?"active":o));let D=P.options||t[S].options;Ij(P,D,M,a),Tj(P,D,g.ratio),this.updateElement(t[S],S,P,o)}}_getStacks(t,n){let{iScale:r}=this._cachedMeta,o=r.getMatchingVisibleMetas(this._type).filter(c=>c.controller.options.grouped),i=r.options.stacked,a=[],l=c=>{let d=c.controller.getParsed(n),g=d&&d[c.vScale.axis];if(Ht(g)||isNaN(g))return!0};for(let c of o)if(!(n!==void 0&&l(c))&&((i===!1||a.indexOf(c.stack)===-1||i===void 0&&c.stack===void 0)&&a.push(c.stack),c.index===t))break;return a.length||a.push(void 0),a}_getStackCount(t){return this._getStacks(void 0,t).length}_getStackIndex(t,n,r){let o=this._getStacks(t,r),i=n!==void 0?o.indexOf(n):-1;return i===-1?o.length-1:i}_getRuler(){let t=this.options,n=this._cachedMeta,r=n.iScale,o=[],i,a;for(i=0,a=n.data.length;i<a;++i)o.push(r.getPixelForValue(this.getParsed(i)[r.axis],i));let l=t.barThickness;return{min:l||Sj(n),pixels:o,start:r._startPixel,end:r._endPixel,stackCount:this._getStackCount(),scale:r,grouped:t.grouped,ratio:l?1:t.categoryPercentage*t.barPercentage}}_calculateBarValuePixels(t){let{_cachedMeta:{vScale:n,_stacked:r},options:{base:o,minBarLength:i}}=this,a=o||0,l=this.getParsed(t),c=l._custom,d=a_(c),g=l[n.axis],v=0,w=r?this.applyStack(n,l,r):g,S,C;w!==g&&(v=w-g,w=g),d&&(g=c.barStart,w=c.barEnd-c.barStart,g!==0&&Qi(g)!==Qi(c.barEnd)&&(v=0),v+=g);let L=!Ht(o)&&!d?o:v,k=n.getPixelForValue(L);if(this.chart.getDataVisibility(t)?S=n.getPixelForValue(v+w):S=k,C=S-k,Math.abs(C)<i){C=Pj(C,n,a)*i,g===a&&(k-=C/2);let M=n.getPixelForDecimal(0),P=n.getPixelForDecimal(1),D=Math.min(M,P),T=Math.max(M,P);k=Math.max(Math.min(k,T),D),S=k+C}if(k===n.getPixelForValue(a)){let M=Qi(C)*n.getLineWidthForValue(a)/2;k+=M,C-=M}return{size:C,base:k,head:S,center:S+C/2}}_calculateBarIndexPixels(t,n){let r=n.scale,o=this.options,i=o.skipNull,a=Mt(o.maxBarThickness,1/0),l,c;if(n.grouped){let d=i?this._getStackCount(t):n.stackCount,g=o.barThickness==="flex"?kj(t,n,o,d):Mj(t,n,o,d),v=this._getStackIndex(this.index,this._cachedMeta.stack,i?t:void 0);l=g.start+g.chunk*v+g.chunk/2,c=Math.min(a,g.chunk*g.ratio)}else l=r.getPixelForValue(this.getParsed(t)[r.axis],t),c=Math.min(a,n.min*n.ratio);return{base:l-c/2,head:l+c/2,center:l,size:c}}draw(){let t=this._cachedMeta,n=t.vScale,r=t.data,o=r.length,i=0;for(;i<o;++i)this.getParsed(i)[n.axis]!==null&&r[i].draw(this._ctx)}};Gf.id="bar";Gf.defaults={datasetElementType:!1,dataElementType:"bar",categoryPercentage:.8,barPercentage:.9,grouped:!0,animations:{numbers:{type:"number",properties:["x","y","base","width","height"]}}};Gf.overrides={scales:{_index_:{type:"category",offset:!0,grid:{offset:!0}},_value_:{type:"linear",beginAtZero:!0}}};var Qf=class extends Ho{initialize(){this.enableOptionSharing=!0,super.initialize()}parsePrimitiveData(t,n,r,o){let i=super.parsePrimitiveData(t,n,r,o);for(let a=0;a<i.length;a++)i[a]._custom=this.resolveDataElementOptions(a+r).radius;return i}parseArrayData(t,n,r,o){let i=super.parseArrayData(t,n,r,o);for(let a=0;a<i.length;a++){let l=n[r+a];i[a]._custom=Mt(l[2],this.resolveDataElementOptions(a+r).radius)}return i}parseObjectData(t,n,r,o){let i=super.parseObjectData(t,n,r,o);for(let a=0;a<i.length;a++){let l=n[r+a];i[a]._custom=Mt(l&&l.r&&+l.r,this.resolveDataElementOptions(a+r).radius)}return i}getMaxOverflow(){let t=this._cachedMeta.data,n=0;for(let r=t.length-1;r>=0;--r)n=Math.max(n,t[r].size(this.resolveDataElementOptions(r))/2);return n>0&&n}getLabelAndValue(t){let n=this._cachedMeta,{xScale:r,yScale:o}=n,i=this.getParsed(t),a=r.getLabelForValue(i.x),l=o.getLabelForValue(i.y),c=i._custom;return{label:n.label,value:"("+a+", "+l+(c?", "+c:"")+")"}}update(t){let n=this._cachedMeta.data;this.updateElements(n,0,n.length,t)}updateElements(t,n,r,o){let i=o==="reset",{iScale:a,vScale:l}=this._cachedMeta,{sharedOptions:c,includeOptions:d}=this._getSharedOptions(n,o),g=a.axis,v=l.axis;for(let w=n;w<n+r;w++){let S=t[w],C=!i&&this.getParsed(w),L={},k=L[g]=i?a.getPixelForDecimal(.5):a.getPixelForValue(C[g]),M=L[v]=i?l.getBasePixel():l.getPixelForValue(C[v]);L.skip=isNaN(k)||isNaN(M),d&&(L.options=c||this.resolveDataElementOptions(w,S.active?"active":o),i&&(L.options.radius=0)),this.updateElement(S,w,L,o)}}resolveDataElementOptions(t,n){let r=this.getParsed(t),o=super.resolveDataElementOptions(t,n);o.$shared&&(o=Object.assign({},o,{$shared:!1}));let i=o.radius;return n!=="active"&&(o.radius=0),o.radius+=Mt(r&&r._custom,i),o}};Qf.id="bubble";Qf.defaults={datasetElementType:!1,dataElementType:"point",animations:{numbers:{type:"number",properties:["x","y","borderWidth","radius"]}}};Qf.overrides={scales:{x:{type:"linear"},y:{type:"linear"}},plugins:{tooltip:{callbacks:{title(){return""}}}}};function Lj(e,t,n){let r=1,o=1,i=0,a=0;if(t<tn){let l=e,c=l+t,d=Math.cos(l),g=Math.sin(l),v=Math.cos(c),w=Math.sin(c),S=(D,T,O)=>Uf(D,l,c,!0)?1:Math.max(T,T*n,O,O*n),C=(D,T,O)=>Uf(D,l,c,!0)?-1:Math.min(T,T*n,O,O*n),L=S(0,d,v),k=S(Dn,g,w),M=C(_n,d,v),P=C(_n+Dn,g,w);r=(L-M)/2,o=(k-P)/2,i=-(L+M)/2,a=-(k+P)/2}return{ratioX:r,ratioY:o,offsetX:i,offsetY:a}}var _l=class extends Ho{constructor(t,n){super(t,n),this.enableOptionSharing=!0,this.innerRadius=void 0,this.outerRadius=void 0,this.offsetX=void 0,this.offsetY=void 0}linkScales(){}parse(t,n){let r=this.getDataset().data,o=this._cachedMeta;if(this._parsing===!1)o._parsed=r;else{let i=c=>+r[c];if(Lt(r[t])){let{key:c="value"}=this._parsing;i=d=>+ua(r[d],c)}let a,l;for(a=t,l=t+n;a<l;++a)o._parsed[a]=i(a)}}_getRotation(){return gi(this.options.rotation-90)}_getCircumference(){return gi(this.options.circumference)}_getRotationExtents(){let t=tn,n=-tn;for(let r=0;r<this.chart.data.datasets.length;++r)if(this.chart.isDatasetVisible(r)){let o=this.chart.getDatasetMeta(r).controller,i=o._getRotation(),a=o._getCircumference();t=Math.min(t,i),n=Math.max(n,i+a)}return{rotation:t,circumference:n-t}}update(t){let n=this.chart,{chartArea:r}=n,o=this._cachedMeta,i=o.data,a=this.getMaxBorderWidth()+this.getMaxOffset(i)+this.options.spacing,l=Math.max((Math.min(r.width,r.height)-a)/2,0),c=Math.min(KC(this.options.cutout,l),1),d=this._getRingWeight(this.index),{circumference:g,rotation:v}=this._getRotationExtents(),{ratioX:w,ratioY:S,offsetX:C,offsetY:L}=Lj(v,g,c),k=(r.width-a)/w,M=(r.height-a)/S,P=Math.max(Math.min(k,M)/2,0),D=I6(this.options.radius,P),T=Math.max(D*c,0),O=(D-T)/this._getVisibleDatasetWeightTotal();this.offsetX=C*D,this.offsetY=L*D,o.total=this.calculateTotal(),this.outerRadius=D-O*this._getRingWeightOffset(this.index),this.innerRadius=Math.max(this.outerRadius-O*d,0),this.updateElements(i,0,i.length,t)}_circumference(t,n){let r=this.options,o=this._cachedMeta,i=this._getCircumference();return n&&r.animation.animateRotate||!this.chart.getDataVisibility(t)||o._parsed[t]===null||o.data[t].hidden?0:this.calculateCircumference(o._parsed[t]*i/tn)}updateElements(t,n,r,o){let i=o==="reset",a=this.chart,l=a.chartArea,d=a.options.animation,g=(l.left+l.right)/2,v=(l.top+l.bottom)/2,w=i&&d.animateScale,S=w?0:this.innerRadius,C=w?0:this.outerRadius,{sharedOptions:L,includeOptions:k}=this._getSharedOptions(n,o),M=this._getRotation(),P;for(P=0;P<n;++P)M+=this._circumference(P,i);for(P=n;P<n+r;++P){let D=this._circumference(P,i),T=t[P],O={x:g+this.offsetX,y:v+this.offsetY,startAngle:M,endAngle:M+D,circumference:D,outerRadius:C,innerRadius:S};k&&(O.options=L||this.resolveDataElementOptions(P,T.active?"active":o)),M+=D,this.updateElement(T,P,O,o)}}calculateTotal(){let t=this._cachedMeta,n=t.data,r=0,o;for(o=0;o<n.length;o++){let i=t._parsed[o];i!==null&&!isNaN(i)&&this.chart.getDataVisibility(o)&&!n[o].hidden&&(r+=Math.abs(i))}return r}calculateCircumference(t){let n=this._cachedMeta.total;return n>0&&!isNaN(t)?tn*(Math.abs(t)/n):0}getLabelAndValue(t){let n=this._cachedMeta,r=this.chart,o=r.data.labels||[],i=Yf(n._parsed[t],r.options.locale);return{label:o[t]||"",value:i}}getMaxBorderWidth(t){let n=0,r=this.chart,o,i,a,l,c;if(!t){for(o=0,i=r.data.datasets.length;o<i;++o)if(r.isDatasetVisible(o)){a=r.getDatasetMeta(o),t=a.data,l=a.controller;break}}if(!t)return 0;for(o=0,i=t.length;o<i;++o)c=l.resolveDataElementOptions(o),c.borderAlign!=="inner"&&(n=Math.max(n,c.borderWidth||0,c.hoverBorderWidth||0));return n}getMaxOffset(t){let n=0;for(let r=0,o=t.length;r<o;++r){let i=this.resolveDataElementOptions(r);n=Math.max(n,i.offset||0,i.hoverOffset||0)}return n}_getRingWeightOffset(t){let n=0;for(let r=0;r<t;++r)this.chart.isDatasetVisible(r)&&(n+=this._getRingWeight(r));return n}_getRingWeight(t){return Math.max(Mt(this.chart.data.datasets[t].weight,1),0)}_getVisibleDatasetWeightTotal(){return this._getRingWeightOffset(this.chart.data.datasets.length)||1}};_l.id="doughnut";_l.defaults={datasetElementType:!1,dataElementType:"arc",animation:{animateRotate:!0,animateScale:!1},animations:{numbers:{type:"number",properties:["circumference","endAngle","innerRadius","outerRadius","startAngle","x","y","offset","borderWidth","spacing"]}},cutout:"50%",rotation:0,circumference:360,radius:"100%",spacing:0,indexAxis:"r"};_l.descriptors={_scriptable:e=>e!=="spacing",_indexable:e=>e!=="spacing"};_l.overrides={aspectRatio:1,plugins:{legend:{labels:{generateLabels(e){let t=e.data;if(t.labels.length&&t.datasets.length){let{labels:{pointStyle:n}}=e.legend.options;return t.labels.map((r,o)=>{let a=e.getDatasetMeta(0).controller.getStyle(o);return{text:r,fillStyle:a.backgroundColor,strokeStyle:a.borderColor,lineWidth:a.borderWidth,pointStyle:n,hidden:!e.getDataVisibility(o),index:o}})}return[]}},onClick(e,t,n){n.chart.toggleDataVisibility(t.index),n.chart.update()}},tooltip:{callbacks:{title(){return""},label(e){let t=e.label,n=": "+e.formattedValue;return cn(t)?(t=t.slice(),t[0]+=n):t+=n,t}}}}};var Xf=class extends Ho{initialize(){this.enableOptionSharing=!0,this.supportsDecimation=!0,super.initialize()}update(t){let n=this._cachedMeta,{dataset:r,data:o=[],_dataset:i}=n,a=this.chart._animationsDisabled,{start:l,count:c}=z6(n,o,a);this._drawStart=l,this._drawCount=c,j6(n)&&(l=0,c=o.length),r._chart=this.chart,r._datasetIndex=this.index,r._decimated=!!i._decimated,r.points=o;let d=this.resolveDatasetElementOptions(t);this.options.showLine||(d.borderWidth=0),d.segment=this.options.segment,this.updateElement(r,void 0,{animated:!a,options:d},t),this.updateElements(o,l,c,t)}updateElements(t,n,r,o){let i=o==="reset",{iScale:a,vScale:l,_stacked:c,_dataset:d}=this._cachedMeta,{sharedOptions:g,includeOptions:v}=this._getSharedOptions(n,o),w=a.axis,S=l.axis,{spanGaps:C,segment:L}=this.options,k=Iu(C)?C:Number.POSITIVE_INFINITY,M=this.chart._animationsDisabled||i||o==="none",P=n>0&&this.getParsed(n-1);for(let D=n;D<n+r;++D){let T=t[D],O=this.getParsed(D),A=M?T:{},R=Ht(O[S]),B=A[w]=a.getPixelForValue(O[w],D),Y=A[S]=i||R?l.getBasePixel():l.getPixelForValue(c?this.applyStack(l,O,c):O[S],D);A.skip=isNaN(B)||isNaN(Y)||R,A.stop=D>0&&Math.abs(O[w]-P[w])>k,L&&(A.parsed=O,A.raw=d.data[D]),v&&(A.options=g||this.resolveDataElementOptions(D,T.active?"active":o)),M||this.updateElement(T,D,A,o),P=O}}getMaxOverflow(){let t=this._cachedMeta,n=t.dataset,r=n.options&&n.options.borderWidth||0,o=t.data||[];if(!o.length)return r;let i=o[0].size(this.resolveDataElementOptions(0)),a=o[o.length-1].size(this.resolveDataElementOptions(o.length-1));return Math.max(r,i,a)/2}draw(){let t=this._cachedMeta;t.dataset.updateControlPoints(this.chart.chartArea,t.iScale.axis),super.draw()}};Xf.id="line";Xf.defaults={datasetElementType:"line",dataElementType:"point",showLine:!0,spanGaps:!1};Xf.overrides={scales:{_index_:{type:"category"},_value_:{type:"linear"}}};var Jf=class extends Ho{constructor(t,n){super(t,n),this.innerRadius=void 0,this.outerRadius=void 0}getLabelAndValue(t){let n=this._cachedMeta,r=this.chart,o=r.data.labels||[],i=Yf(n._parsed[t].r,r.options.locale);return{label:o[t]||"",value:i}}parseObjectData(t,n,r,o){return X6.bind(this)(t,n,r,o)}update(t){let n=this._cachedMeta.data;this._updateRadius(),this.updateElements(n,0,n.length,t)}getMinMax(){let t=this._cachedMeta,n={min:Number.POSITIVE_INFINITY,max:Number.NEGATIVE_INFINITY};return t.data.forEach((r,o)=>{let i=this.getParsed(o).r;!isNaN(i)&&this.chart.getDataVisibility(o)&&(i<n.min&&(n.min=i),i>n.max&&(n.max=i))}),n}_updateRadius(){let t=this.chart,n=t.chartArea,r=t.options,o=Math.min(n.right-n.left,n.bottom-n.top),i=Math.max(o/2,0),a=Math.max(r.cutoutPercentage?i/100*r.cutoutPercentage:1,0),l=(i-a)/t.getVisibleDatasetCount();this.outerRadius=i-l*this.index,this.innerRadius=this.outerRadius-l}updateElements(t,n,r,o){let i=o==="reset",a=this.chart,c=a.options.animation,d=this._cachedMeta.rScale,g=d.xCenter,v=d.yCenter,w=d.getIndexAngle(0)-.5*_n,S=w,C,L=360/this.countVisibleElements();for(C=0;C<n;++C)S+=this._computeAngle(C,o,L);for(C=n;C<n+r;C++){let k=t[C],M=S,P=S+this._computeAngle(C,o,L),D=a.getDataVisibility(C)?d.getDistanceFromCenterForValue(this.getParsed(C).r):0;S=P,i&&(c.animateScale&&(D=0),c.animateRotate&&(M=P=w));let T={x:g,y:v,innerRadius:0,outerRadius:D,startAngle:M,endAngle:P,options:this.resolveDataElementOptions(C,k.active?"active":o)};this.updateElement(k,C,T,o)}}countVisibleElements(){let t=this._cachedMeta,n=0;return t.data.forEach((r,o)=>{!isNaN(this.getParsed(o).r)&&this.chart.getDataVisibility(o)&&n++}),n}_computeAngle(t,n,r){return this.chart.getDataVisibility(t)?gi(this.resolveDataElementOptions(t,n).angle||r):0}};Jf.id="polarArea";Jf.defaults={dataElementType:"arc",animation:{animateRotate:!0,animateScale:!0},animations:{numbers:{type:"number",properties:["x","y","startAngle","endAngle","innerRadius","outerRadius"]}},indexAxis:"r",startAngle:0};Jf.overrides={aspectRatio:1,plugins:{legend:{labels:{generateLabels(e){let t=e.data;if(t.labels.length&&t.datasets.length){let{labels:{pointStyle:n}}=e.legend.options;return t.labels.map((r,o)=>{let a=e.getDatasetMeta(0).controller.getStyle(o);return{text:r,fillStyle:a.backgroundColor,strokeStyle:a.borderColor,lineWidth:a.borderWidth,pointStyle:n,hidden:!e.getDataVisibility(o),index:o}})}return[]}},onClick(e,t,n){n.chart.toggleDataVisibility(t.index),n.chart.update()}},tooltip:{callbacks:{title(){return""},label(e){return e.chart.data.labels[e.dataIndex]+": "+e.formattedValue}}}},scales:{r:{type:"radialLinear",angleLines:{display:!1},beginAtZero:!0,grid:{circular:!0},pointLabels:{display:!1},startAngle:0}}};var Jh=class extends _l{};Jh.id="pie";Jh.defaults={cutout:0,rotation:0,circumference:360,radius:"100%"};var Zf=class extends Ho{getLabelAndValue(t){let n=this._cachedMeta.vScale,r=this.getParsed(t);return{label:n.getLabels()[t],value:""+n.getLabelForValue(r[n.axis])}}parseObjectData(t,n,r,o){return X6.bind(this)(t,n,r,o)}update(t){let n=this._cachedMeta,r=n.dataset,o=n.data||[],i=n.iScale.getLabels();if(r.points=o,t!=="resize"){let a=this.resolveDatasetElementOptions(t);this.options.showLine||(a.borderWidth=0);let l={_loop:!0,_fullLoop:i.length===o.length,options:a};this.updateElement(r,void 0,l,t)}this.updateElements(o,0,o.length,t)}updateElements(t,n,r,o){let i=this._cachedMeta.rScale,a=o==="reset";for(let l=n;l<n+r;l++){let c=t[l],d=this.resolveDataElementOptions(l,c.active?"active":o),g=i.getPointPositionForValue(l,this.getParsed(l).r),v=a?i.xCenter:g.x,w=a?i.yCenter:g.y,S={x:v,y:w,angle:g.angle,skip:isNaN(v)||isNaN(w),options:d};this.updateElement(c,l,S,o)}}};Zf.id="radar";Zf.defaults={datasetElementType:"line",dataElementType:"point",indexAxis:"r",showLine:!0,elements:{line:{fill:"start"}}};Zf.overrides={aspectRatio:1,scales:{r:{type:"radialLinear"}}};var Uo=class{constructor(){this.x=void 0,this.y=void 0,this.active=!1,this.options=void 0,this.$animations=void 0}tooltipPosition(t){let{x:n,y:r}=this.getProps(["x","y"],t);return{x:n,y:r}}hasValue(){return Iu(this.x)&&Iu(this.y)}getProps(t,n){let r=this.$animations;if(!n||!r)return this;let o={};return t.forEach(i=>{o[i]=r[i]&&r[i].active()?r[i]._to:this[i]}),o}};Uo.defaults={};Uo.defaultRoutes=void 0;var _E={values(e){return cn(e)?e:""+e},numeric(e,t,n){if(e===0)return"0";let r=this.chart.options.locale,o,i=e;if(n.length>1){let d=Math.max(Math.abs(n[0].value),Math.abs(n[n.length-1].value));(d<1e-4||d>1e15)&&(o="scientific"),i=Oj(e,n)}let a=Vo(Math.abs(i)),l=Math.max(Math.min(-1*Math.floor(a),20),0),c={notation:o,minimumFractionDigits:l,maximumFractionDigits:l};return Object.assign(c,this.options.ticks.format),Yf(e,r,c)},logarithmic(e,t,n){if(e===0)return"0";let r=e/Math.pow(10,Math.floor(Vo(e)));return r===1||r===2||r===5?_E.numeric.call(this,e,t,n):""}};function Oj(e,t){let n=t.length>3?t[2].value-t[1].value:t[1].value-t[0].value;return Math.abs(n)>=1&&e!==Math.floor(e)&&(n=e-Math.floor(e)),n}var Hg={formatters:_E};Ot.set("scale",{display:!0,offset:!1,reverse:!1,beginAtZero:!1,bounds:"ticks",grace:0,grid:{display:!0,lineWidth:1,drawBorder:!0,drawOnChartArea:!0,drawTicks:!0,tickLength:8,tickWidth:(e,t)=>t.lineWidth,tickColor:(e,t)=>t.color,offset:!1,borderDash:[],borderDashOffset:0,borderWidth:1},title:{display:!1,text:"",padding:{top:4,bottom:4}},ticks:{minRotation:0,maxRotation:50,mirror:!1,textStrokeWidth:0,textStrokeColor:"",padding:3,display:!0,autoSkip:!0,autoSkipPadding:3,labelOffset:0,callback:Hg.formatters.values,minor:{},major:{},align:"center",crossAlign:"near",showLabelBackdrop:!1,backdropColor:"rgba(255, 255, 255, 0.75)",backdropPadding:2}});Ot.route("scale.ticks","color","","color");Ot.route("scale.grid","color","","borderColor");Ot.route("scale.grid","borderColor","","borderColor");Ot.route("scale.title","color","","color");Ot.describe("scale",{_fallback:!1,_scriptable:e=>!e.startsWith("before")&&!e.startsWith("after")&&e!=="callback"&&e!=="parser",_indexable:e=>e!=="borderDash"&&e!=="tickBorderDash"});Ot.describe("scales",{_fallback:"scale"});Ot.describe("scale.ticks",{_scriptable:e=>e!=="backdropPadding"&&e!=="callback",_indexable:e=>e!=="backdropPadding"});function Nj(e,t){let n=e.options.ticks,r=n.maxTicksLimit||Aj(e),o=n.major.enabled?Rj(t):[],i=o.length,a=o[0],l=o[i-1],c=[];if(i>r)return Bj(t,c,o,i/r),c;let d=Fj(o,t,r);if(i>0){let g,v,w=i>1?Math.round((l-a)/(i-1)):null;for(Ig(t,c,d,Ht(w)?0:a-w,a),g=0,v=i-1;g<v;g++)Ig(t,c,d,o[g],o[g+1]);return Ig(t,c,d,l,Ht(w)?t.length:l+w),c}return Ig(t,c,d),c}function Aj(e){let t=e.options.offset,n=e._tickSize(),r=e._length/n+(t?0:1),o=e._maxLength/n;return Math.floor(Math.min(r,o))}function Fj(e,t,n){let r=zj(e),o=t.length/n;if(!r)return Math.max(o,1);let i=XC(r);for(let a=0,l=i.length-1;a<l;a++){let c=i[a];if(c>o)return c}return Math.max(o,1)}function Rj(e){let t=[],n,r;for(n=0,r=e.length;n<r;n++)e[n].major&&t.push(n);return t}function Bj(e,t,n,r){let o=0,i=n[0],a;for(r=Math.ceil(r),a=0;a<e.length;a++)a===i&&(t.push(e[a]),o++,i=n[o*r])}function Ig(e,t,n,r,o){let i=Mt(r,0),a=Math.min(Mt(o,e.length),e.length),l=0,c,d,g;for(n=Math.ceil(n),o&&(c=o-r,n=c/Math.floor(c/n)),g=i;g<0;)l++,g=Math.round(i+l*n);for(d=Math.max(i,0);d<a;d++)d===g&&(t.push(e[d]),l++,g=Math.round(i+l*n))}function zj(e){let t=e.length,n,r;if(t<2)return!1;for(r=e[0],n=1;n<t;++n)if(e[n]-e[n-1]!==r)return!1;return r}var jj=e=>e==="left"?"right":e==="right"?"left":e,FP=(e,t,n)=>t==="top"||t==="left"?e[t]+n:e[t]-n;function RP(e,t){let n=[],r=e.length/t,o=e.length,i=0;for(;i<o;i+=r)n.push(e[Math.floor(i)]);return n}function Vj(e,t,n){let r=e.ticks.length,o=Math.min(t,r-1),i=e._startPixel,a=e._endPixel,l=1e-6,c=e.getPixelForTick(o),d;if(!(n&&(r===1?d=Math.max(c-i,a-c):t===0?d=(e.getPixelForTick(1)-c)/2:d=(c-e.getPixelForTick(o-1))/2,c+=o<t?d:-d,c<i-l||c>a+l)))return c}function Hj(e,t){Xt(e,n=>{let r=n.gc,o=r.length/2,i;if(o>t){for(i=0;i<o;++i)delete n.data[r[i]];r.splice(0,o)}})}function Yh(e){return e.drawTicks?e.tickLength:0}function BP(e,t){if(!e.display)return 0;let n=Qn(e.font,t),r=Pr(e.padding);return(cn(e.text)?e.text.length:1)*n.lineHeight+r.height}function Uj(e,t){return ca(e,{scale:t,type:"scale"})}function Wj(e,t,n){return ca(e,{tick:n,index:t,type:"tick"})}function $j(e,t,n){let r=bg(e);return(n&&t!=="right"||!n&&t==="right")&&(r=jj(r)),r}function Yj(e,t,n,r){let{top:o,left:i,bottom:a,right:l,chart:c}=e,{chartArea:d,scales:g}=c,v=0,w,S,C,L=a-o,k=l-i;if(e.isHorizontal()){if(S=Cr(r,i,l),Lt(n)){let M=Object.keys(n)[0],P=n[M];C=g[M].getPixelForValue(P)+L-t}else n==="center"?C=(d.bottom+d.top)/2+L-t:C=FP(e,n,t);w=l-i}else{if(Lt(n)){let M=Object.keys(n)[0],P=n[M];S=g[M].getPixelForValue(P)-k+t}else n==="center"?S=(d.left+d.right)/2-k+t:S=FP(e,n,t);C=Cr(r,a,o),v=n==="left"?-Dn:Dn}return{titleX:S,titleY:C,maxWidth:w,rotation:v}}var Lu=class e extends Uo{constructor(t){super(),this.id=t.id,this.type=t.type,this.options=void 0,this.ctx=t.ctx,this.chart=t.chart,this.top=void 0,this.bottom=void 0,this.left=void 0,this.right=void 0,this.width=void 0,this.height=void 0,this._margins={left:0,right:0,top:0,bottom:0},this.maxWidth=void 0,this.maxHeight=void 0,this.paddingTop=void 0,this.paddingBottom=void 0,this.paddingLeft=void 0,this.paddingRight=void 0,this.axis=void 0,this.labelRotation=void 0,this.min=void 0,this.max=void 0,this._range=void 0,this.ticks=[],this._gridLineItems=null,this._labelItems=null,this._labelSizes=null,this._length=0,this._maxLength=0,this._longestTextCache={},this._startPixel=void 0,this._endPixel=void 0,this._reversePixels=!1,this._userMax=void 0,this._userMin=void 0,this._suggestedMax=void 0,this._suggestedMin=void 0,this._ticksLength=0,this._borderValue=0,this._cache={},this._dataLimitsCached=!1,this.$context=void 0}init(t){this.options=t.setContext(this.getContext()),this.axis=t.axis,this._userMin=this.parse(t.min),this._userMax=this.parse(t.max),this._suggestedMin=this.parse(t.suggestedMin),this._suggestedMax=this.parse(t.suggestedMax)}parse(t,n){return t}getUserBounds(){let{_userMin:t,_userMax:n,_suggestedMin:r,_suggestedMax:o}=this;return t=jo(t,Number.POSITIVE_INFINITY),n=jo(n,Number.NEGATIVE_INFINITY),r=jo(r,Number.POSITIVE_INFINITY),o=jo(o,Number.NEGATIVE_INFINITY),{min:jo(t,r),max:jo(n,o),minDefined:Fn(t),maxDefined:Fn(n)}}getMinMax(t){let{min:n,max:r,minDefined:o,maxDefined:i}=this.getUserBounds(),a;if(o&&i)return{min:n,max:r};let l=this.getMatchingVisibleMetas();for(let c=0,d=l.length;c<d;++c)a=l[c].controller.getMinMax(this,t),o||(n=Math.min(n,a.min)),i||(r=Math.max(r,a.max));return n=i&&n>r?r:n,r=o&&n>r?n:r,{min:jo(n,jo(r,n)),max:jo(r,jo(n,r))}}getPadding(){return{left:this.paddingLeft||0,top:this.paddingTop||0,right:this.paddingRight||0,bottom:this.paddingBottom||0}}getTicks(){return this.ticks}getLabels(){let t=this.chart.data;return this.options.labels||(this.isHorizontal()?t.xLabels:t.yLabels)||t.labels||[]}beforeLayout(){this._cache={},this._dataLimitsCached=!1}beforeUpdate(){fn(this.options.beforeUpdate,[this])}update(t,n,r){let{beginAtZero:o,grace:i,ticks:a}=this.options,l=a.sampleSize;this.beforeUpdate(),this.maxWidth=t,this.maxHeight=n,this._margins=r=Object.assign({left:0,right:0,top:0,bottom:0},r),this.ticks=null,this._labelSizes=null,this._gridLineItems=null,this._labelItems=null,this.beforeSetDimensions(),this.setDimensions(),this.afterSetDimensions(),this._maxLength=this.isHorizontal()?this.width+r.left+r.right:this.height+r.top+r.bottom,this._dataLimitsCached||(this.beforeDataLimits(),this.determineDataLimits(),this.afterDataLimits(),this._range=hP(this,i,o),this._dataLimitsCached=!0),this.beforeBuildTicks(),this.ticks=this.buildTicks()||[],this.afterBuildTicks();let c=l<this.ticks.length;this._convertTicksToLabels(c?RP(this.ticks,l):this.ticks),this.configure(),this.beforeCalculateLabelRotation(),this.calculateLabelRotation(),this.afterCalculateLabelRotation(),a.display&&(a.autoSkip||a.source==="auto")&&(this.ticks=Nj(this,this.ticks),this._labelSizes=null,this.afterAutoSkip()),c&&this._convertTicksToLabels(this.ticks),this.beforeFit(),this.fit(),this.afterFit(),this.afterUpdate()}configure(){let t=this.options.reverse,n,r;this.isHorizontal()?(n=this.left,r=this.right):(n=this.top,r=this.bottom,t=!t),this._startPixel=n,this._endPixel=r,this._reversePixels=t,this._length=r-n,this._alignToPixels=this.options.alignToPixels}afterUpdate(){fn(this.options.afterUpdate,[this])}beforeSetDimensions(){fn(this.options.beforeSetDimensions,[this])}setDimensions(){this.isHorizontal()?(this.width=this.maxWidth,this.left=0,this.right=this.width):(this.height=this.maxHeight,this.top=0,this.bottom=this.height),this.paddingLeft=0,this.paddingTop=0,this.paddingRight=0,this.paddingBottom=0}afterSetDimensions(){fn(this.options.afterSetDimensions,[this])}_callHooks(t){this.chart.notifyPlugins(t,this.getContext()),fn(this.options[t],[this])}beforeDataLimits(){this._callHooks("beforeDataLimits")}determineDataLimits(){}afterDataLimits(){this._callHooks("afterDataLimits")}beforeBuildTicks(){this._callHooks("beforeBuildTicks")}buildTicks(){return[]}afterBuildTicks(){this._callHooks("afterBuildTicks")}beforeTickToLabelConversion(){fn(this.options.beforeTickToLabelConversion,[this])}generateTickLabels(t){let n=this.options.ticks,r,o,i;for(r=0,o=t.length;r<o;r++)i=t[r],i.label=fn(n.callback,[i.value,r,t],this)}afterTickToLabelConversion(){fn(this.options.afterTickToLabelConversion,[this])}beforeCalculateLabelRotation(){fn(this.options.beforeCalculateLabelRotation,[this])}calculateLabelRotation(){let t=this.options,n=t.ticks,r=this.ticks.length,o=n.minRotation||0,i=n.maxRotation,a=o,l,c,d;if(!this._isVisible()||!n.display||o>=i||r<=1||!this.isHorizontal()){this.labelRotation=o;return}let g=this._getLabelSizes(),v=g.widest.width,w=g.highest.height,S=sr(this.chart.width-v,0,this.maxWidth);l=t.offset?this.maxWidth/r:S/(r-1),v+6>l&&(l=S/(r-(t.offset?.5:1)),c=this.maxHeight-Yh(t.grid)-n.padding-BP(t.title,this.chart.options.font),d=Math.sqrt(v*v+w*w),a=xg(Math.min(Math.asin(sr((g.highest.height+6)/l,-1,1)),Math.asin(sr(c/d,-1,1))-Math.asin(sr(w/d,-1,1)))),a=Math.max(o,Math.min(i,a))),this.labelRotation=a}afterCalculateLabelRotation(){fn(this.options.afterCalculateLabelRotation,[this])}afterAutoSkip(){}beforeFit(){fn(this.options.beforeFit,[this])}fit(){let t={width:0,height:0},{chart:n,options:{ticks:r,title:o,grid:i}}=this,a=this._isVisible(),l=this.isHorizontal();if(a){let c=BP(o,n.options.font);if(l?(t.width=this.maxWidth,t.height=Yh(i)+c):(t.height=this.maxHeight,t.width=Yh(i)+c),r.display&&this.ticks.length){let{first:d,last:g,widest:v,highest:w}=this._getLabelSizes(),S=r.padding*2,C=gi(this.labelRotation),L=Math.cos(C),k=Math.sin(C);if(l){let M=r.mirror?0:k*v.width+L*w.height;t.height=Math.min(this.maxHeight,t.height+M+S)}else{let M=r.mirror?0:L*v.width+k*w.height;t.width=Math.min(this.maxWidth,t.width+M+S)}this._calculatePadding(d,g,k,L)}}this._handleMargins(),l?(this.width=this._length=n.width-this._margins.left-this._margins.right,this.height=t.height):(this.width=t.width,this.height=this._length=n.height-this._margins.top-this._margins.bottom)}_calculatePadding(t,n,r,o){let{ticks:{align:i,padding:a},position:l}=this.options,c=this.labelRotation!==0,d=l!=="top"&&this.axis==="x";if(this.isHorizontal()){let g=this.getPixelForTick(0)-this.left,v=this.right-this.getPixelForTick(this.ticks.length-1),w=0,S=0;c?d?(w=o*t.width,S=r*n.height):(w=r*t.height,S=o*n.width):i==="start"?S=n.width:i==="end"?w=t.width:i!=="inner"&&(w=t.width/2,S=n.width/2),this.paddingLeft=Math.max((w-g+a)*this.width/(this.width-g),0),this.paddingRight=Math.max((S-v+a)*this.width/(this.width-v),0)}else{let g=n.height/2,v=t.height/2;i==="start"?(g=0,v=t.height):i==="end"&&(g=n.height,v=0),this.paddingTop=g+a,this.paddingBottom=v+a}}_handleMargins(){this._margins&&(this._margins.left=Math.max(this.paddingLeft,this._margins.left),this._margins.top=Math.max(this.paddingTop,this._margins.top),this._margins.right=Math.max(this.paddingRight,this._margins.right),this._margins.bottom=Math.max(this.paddingBottom,this._margins.bottom))}afterFit(){fn(this.options.afterFit,[this])}isHorizontal(){let{axis:t,position:n}=this.options;return n==="top"||n==="bottom"||t==="x"}isFullSize(){return this.options.fullSize}_convertTicksToLabels(t){this.beforeTickToLabelConversion(),this.generateTickLabels(t);let n,r;for(n=0,r=t.length;n<r;n++)Ht(t[n].label)&&(t.splice(n,1),r--,n--);this.afterTickToLabelConversion()}_getLabelSizes(){let t=this._labelSizes;if(!t){let n=this.options.ticks.sampleSize,r=this.ticks;n<r.length&&(r=RP(r,n)),this._labelSizes=t=this._computeLabelSizes(r,r.length)}return t}_computeLabelSizes(t,n){let{ctx:r,_longestTextCache:o}=this,i=[],a=[],l=0,c=0,d,g,v,w,S,C,L,k,M,P,D;for(d=0;d<n;++d){if(w=t[d].label,S=this._resolveTickFontOptions(d),r.font=C=S.string,L=o[C]=o[C]||{data:{},gc:[]},k=S.lineHeight,M=P=0,!Ht(w)&&!cn(w))M=jh(r,L.data,L.gc,M,w),P=k;else if(cn(w))for(g=0,v=w.length;g<v;++g)D=w[g],!Ht(D)&&!cn(D)&&(M=jh(r,L.data,L.gc,M,D),P+=k);i.push(M),a.push(P),l=Math.max(M,l),c=Math.max(P,c)}Hj(o,n);let T=i.indexOf(l),O=a.indexOf(c),A=R=>({width:i[R]||0,height:a[R]||0});return{first:A(0),last:A(n-1),widest:A(T),highest:A(O),widths:i,heights:a}}getLabelForValue(t){return t}getPixelForValue(t,n){return NaN}getValueForPixel(t){}getPixelForTick(t){let n=this.ticks;return t<0||t>n.length-1?null:this.getPixelForValue(n[t].value)}getPixelForDecimal(t){this._reversePixels&&(t=1-t);let n=this._startPixel+t*this._length;return ZC(this._alignToPixels?pl(this.chart,n,0):n)}getDecimalForPixel(t){let n=(t-this._startPixel)/this._length;return this._reversePixels?1-n:n}getBasePixel(){return this.getPixelForValue(this.getBaseValue())}getBaseValue(){let{min:t,max:n}=this;return t<0&&n<0?n:t>0&&n>0?t:0}getContext(t){let n=this.ticks||[];if(t>=0&&t<n.length){let r=n[t];return r.$context||(r.$context=Wj(this.getContext(),t,r))}return this.$context||(this.$context=Uj(this.chart.getContext(),this))}_tickSize(){let t=this.options.ticks,n=gi(this.labelRotation),r=Math.abs(Math.cos(n)),o=Math.abs(Math.sin(n)),i=this._getLabelSizes(),a=t.autoSkipPadding||0,l=i?i.widest.width+a:0,c=i?i.highest.height+a:0;return this.isHorizontal()?c*r>l*o?l/r:c/o:c*o<l*r?c/r:l/o}_isVisible(){let t=this.options.display;return t!=="auto"?!!t:this.getMatchingVisibleMetas().length>0}_computeGridLineItems(t){let n=this.axis,r=this.chart,o=this.options,{grid:i,position:a}=o,l=i.offset,c=this.isHorizontal(),g=this.ticks.length+(l?1:0),v=Yh(i),w=[],S=i.setContext(this.getContext()),C=S.drawBorder?S.borderWidth:0,L=C/2,k=function(te){return pl(r,te,C)},M,P,D,T,O,A,R,B,Y,K,Q,ne;if(a==="top")M=k(this.bottom),A=this.bottom-v,B=M-L,K=k(t.top)+L,ne=t.bottom;else if(a==="bottom")M=k(this.top),K=t.top,ne=k(t.bottom)-L,A=M+L,B=this.top+v;else if(a==="left")M=k(this.right),O=this.right-v,R=M-L,Y=k(t.left)+L,Q=t.right;else if(a==="right")M=k(this.left),Y=t.left,Q=k(t.right)-L,O=M+L,R=this.left+v;else if(n==="x"){if(a==="center")M=k((t.top+t.bottom)/2+.5);else if(Lt(a)){let te=Object.keys(a)[0],z=a[te];M=k(this.chart.scales[te].getPixelForValue(z))}K=t.top,ne=t.bottom,A=M+L,B=A+v}else if(n==="y"){if(a==="center")M=k((t.left+t.right)/2);else if(Lt(a)){let te=Object.keys(a)[0],z=a[te];M=k(this.chart.scales[te].getPixelForValue(z))}O=M-L,R=O-v,Y=t.left,Q=t.right}let oe=Mt(o.ticks.maxTicksLimit,g),ie=Math.max(1,Math.ceil(g/oe));for(P=0;P<g;P+=ie){let te=i.setContext(this.getContext(P)),z=te.lineWidth,V=te.color,p=te.borderDash||[],fe=te.borderDashOffset,xe=te.tickWidth,Me=te.tickColor,Ae=te.tickBorderDash||[],qe=te.tickBorderDashOffset;D=Vj(this,P,l),D!==void 0&&(T=pl(r,D,z),c?O=R=Y=Q=T:A=B=K=ne=T,w.push({tx1:O,ty1:A,tx2:R,ty2:B,x1:Y,y1:K,x2:Q,y2:ne,width:z,color:V,borderDash:p,borderDashOffset:fe,tickWidth:xe,tickColor:Me,tickBorderDash:Ae,tickBorderDashOffset:qe}))}return this._ticksLength=g,this._borderValue=M,w}_computeLabelItems(t){let n=this.axis,r=this.options,{position:o,ticks:i}=r,a=this.isHorizontal(),l=this.ticks,{align:c,crossAlign:d,padding:g,mirror:v}=i,w=Yh(r.grid),S=w+g,C=v?-g:S,L=-gi(this.labelRotation),k=[],M,P,D,T,O,A,R,B,Y,K,Q,ne,oe="middle";if(o==="top")A=this.bottom-C,R=this._getXAxisLabelAlignment();else if(o==="bottom")A=this.top+C,R=this._getXAxisLabelAlignment();else if(o==="left"){let te=this._getYAxisLabelAlignment(w);R=te.textAlign,O=te.x}else if(o==="right"){let te=this._getYAxisLabelAlignment(w);R=te.textAlign,O=te.x}else if(n==="x"){if(o==="center")A=(t.top+t.bottom)/2+S;else if(Lt(o)){let te=Object.keys(o)[0],z=o[te];A=this.chart.scales[te].getPixelForValue(z)+S}R=this._getXAxisLabelAlignment()}else if(n==="y"){if(o==="center")O=(t.left+t.right)/2-S;else if(Lt(o)){let te=Object.keys(o)[0],z=o[te];O=this.chart.scales[te].getPixelForValue(z)}R=this._getYAxisLabelAlignment(w).textAlign}n==="y"&&(c==="start"?oe="top":c==="end"&&(oe="bottom"));let ie=this._getLabelSizes();for(M=0,P=l.length;M<P;++M){D=l[M],T=D.label;let te=i.setContext(this.getContext(M));B=this.getPixelForTick(M)+i.labelOffset,Y=this._resolveTickFontOptions(M),K=Y.lineHeight,Q=cn(T)?T.length:1;let z=Q/2,V=te.color,p=te.textStrokeColor,fe=te.textStrokeWidth,xe=R;a?(O=B,R==="inner"&&(M===P-1?xe=this.options.reverse?"left":"right":M===0?xe=this.options.reverse?"right":"left":xe="center"),o==="top"?d==="near"||L!==0?ne=-Q*K+K/2:d==="center"?ne=-ie.highest.height/2-z*K+K:ne=-ie.highest.height+K/2:d==="near"||L!==0?ne=K/2:d==="center"?ne=ie.highest.height/2-z*K:ne=ie.highest.height-Q*K,v&&(ne*=-1)):(A=B,ne=(1-Q)*K/2);let Me;if(te.showLabelBackdrop){let Ae=Pr(te.backdropPadding),qe=ie.heights[M],it=ie.widths[M],_t=A+ne-Ae.top,Je=O-Ae.left;switch(oe){case"middle":_t-=qe/2;break;case"bottom":_t-=qe;break}switch(R){case"center":Je-=it/2;break;case"right":Je-=it;break}Me={left:Je,top:_t,width:it+Ae.width,height:qe+Ae.height,color:te.backdropColor}}k.push({rotation:L,label:T,font:Y,color:V,strokeColor:p,strokeWidth:fe,textOffset:ne,textAlign:xe,textBaseline:oe,translation:[O,A],backdrop:Me})}return k}_getXAxisLabelAlignment(){let{position:t,ticks:n}=this.options;if(-gi(this.labelRotation))return t==="top"?"left":"right";let o="center";return n.align==="start"?o="left":n.align==="end"?o="right":n.align==="inner"&&(o="inner"),o}_getYAxisLabelAlignment(t){let{position:n,ticks:{crossAlign:r,mirror:o,padding:i}}=this.options,a=this._getLabelSizes(),l=t+i,c=a.widest.width,d,g;return n==="left"?o?(g=this.right+i,r==="near"?d="left":r==="center"?(d="center",g+=c/2):(d="right",g+=c)):(g=this.right-l,r==="near"?d="right":r==="center"?(d="center",g-=c/2):(d="left",g=this.left)):n==="right"?o?(g=this.left+i,r==="near"?d="right":r==="center"?(d="center",g-=c/2):(d="left",g-=c)):(g=this.left+l,r==="near"?d="left":r==="center"?(d="center",g+=c/2):(d="right",g=this.right)):d="right",{textAlign:d,x:g}}_computeLabelArea(){if(this.options.ticks.mirror)return;let t=this.chart,n=this.options.position;if(n==="left"||n==="right")return{top:0,left:this.left,bottom:t.height,right:this.right};if(n==="top"||n==="bottom")return{top:this.top,left:0,bottom:this.bottom,right:t.width}}drawBackground(){let{ctx:t,options:{backgroundColor:n},left:r,top:o,width:i,height:a}=this;n&&(t.save(),t.fillStyle=n,t.fillRect(r,o,i,a),t.restore())}getLineWidthForValue(t){let n=this.options.grid;if(!this._isVisible()||!n.display)return 0;let o=this.ticks.findIndex(i=>i.value===t);return o>=0?n.setContext(this.getContext(o)).lineWidth:0}drawGrid(t){let n=this.options.grid,r=this.ctx,o=this._gridLineItems||(this._gridLineItems=this._computeGridLineItems(t)),i,a,l=(c,d,g)=>{!g.width||!g.color||(r.save(),r.lineWidth=g.width,r.strokeStyle=g.color,r.setLineDash(g.borderDash||[]),r.lineDashOffset=g.borderDashOffset,r.beginPath(),r.moveTo(c.x,c.y),r.lineTo(d.x,d.y),r.stroke(),r.restore())};if(n.display)for(i=0,a=o.length;i<a;++i){let c=o[i];n.drawOnChartArea&&l({x:c.x1,y:c.y1},{x:c.x2,y:c.y2},c),n.drawTicks&&l({x:c.tx1,y:c.ty1},{x:c.tx2,y:c.ty2},{color:c.tickColor,width:c.tickWidth,borderDash:c.tickBorderDash,borderDashOffset:c.tickBorderDashOffset})}}drawBorder(){let{chart:t,ctx:n,options:{grid:r}}=this,o=r.setContext(this.getContext()),i=r.drawBorder?o.borderWidth:0;if(!i)return;let a=r.setContext(this.getContext(0)).lineWidth,l=this._borderValue,c,d,g,v;this.isHorizontal()?(c=pl(t,this.left,i)-i/2,d=pl(t,this.right,a)+a/2,g=v=l):(g=pl(t,this.top,i)-i/2,v=pl(t,this.bottom,a)+a/2,c=d=l),n.save(),n.lineWidth=o.borderWidth,n.strokeStyle=o.borderColor,n.beginPath(),n.moveTo(c,g),n.lineTo(d,v),n.stroke(),n.restore()}drawLabels(t){if(!this.options.ticks.display)return;let r=this.ctx,o=this._computeLabelArea();o&&Uh(r,o);let i=this._labelItems||(this._labelItems=this._computeLabelItems(t)),a,l;for(a=0,l=i.length;a<l;++a){let c=i[a],d=c.font,g=c.label;c.backdrop&&(r.fillStyle=c.backdrop.color,r.fillRect(c.backdrop.left,c.backdrop.top,c.backdrop.width,c.backdrop.height));let v=c.textOffset;hl(r,g,0,v,d,c)}o&&Wh(r)}drawTitle(){let{ctx:t,options:{position:n,title:r,reverse:o}}=this;if(!r.display)return;let i=Qn(r.font),a=Pr(r.padding),l=r.align,c=i.lineHeight/2;n==="bottom"||n==="center"||Lt(n)?(c+=a.bottom,cn(r.text)&&(c+=i.lineHeight*(r.text.length-1))):c+=a.top;let{titleX:d,titleY:g,maxWidth:v,rotation:w}=Yj(this,c,n,l);hl(t,r.text,0,0,i,{color:r.color,maxWidth:v,rotation:w,textAlign:$j(l,n,o),textBaseline:"middle",translation:[d,g]})}draw(t){this._isVisible()&&(this.drawBackground(),this.drawGrid(t),this.drawBorder(),this.drawTitle(),this.drawLabels(t))}_layers(){let t=this.options,n=t.ticks&&t.ticks.z||0,r=Mt(t.grid&&t.grid.z,-1);return!this._isVisible()||this.draw!==e.prototype.draw?[{z:n,draw:o=>{this.draw(o)}}]:[{z:r,draw:o=>{this.drawBackground(),this.drawGrid(o),this.drawTitle()}},{z:r+1,draw:()=>{this.drawBorder()}},{z:n,draw:o=>{this.drawLabels(o)}}]}getMatchingVisibleMetas(t){let n=this.chart.getSortedVisibleDatasetMetas(),r=this.axis+"AxisID",o=[],i,a;for(i=0,a=n.length;i<a;++i){let l=n[i];l[r]===this.id&&(!t||l.type===t)&&o.push(l)}return o}_resolveTickFontOptions(t){let n=this.options.ticks.setContext(this.getContext(t));return Qn(n.font)}_maxDigits(){let t=this._resolveTickFontOptions(0).lineHeight;return(this.isHorizontal()?this.width:this.height)/t}},Kf=class{constructor(t,n,r){this.type=t,this.scope=n,this.override=r,this.items=Object.create(null)}isForType(t){return Object.prototype.isPrototypeOf.call(this.type.prototype,t.prototype)}register(t){let n=Object.getPrototypeOf(t),r;Gj(n)&&(r=this.register(n));let o=this.items,i=t.id,a=this.scope+"."+i;if(!i)throw new Error("class does not have id: "+t);return i in o||(o[i]=t,qj(t,a,r),this.override&&Ot.override(t.id,t.overrides)),a}get(t){return this.items[t]}unregister(t){let n=this.items,r=t.id,o=this.scope;r in n&&delete n[r],o&&r in Ot[o]&&(delete Ot[o][r],this.override&&delete dl[r])}};function qj(e,t,n){let r=Bf(Object.create(null),[n?Ot.get(n):{},Ot.get(t),e.defaults]);Ot.set(t,r),e.defaultRoutes&&Kj(t,e.defaultRoutes),e.descriptors&&Ot.describe(t,e.descriptors)}function Kj(e,t){Object.keys(t).forEach(n=>{let r=n.split("."),o=r.pop(),i=[e].concat(r).join("."),a=t[n].split("."),l=a.pop(),c=a.join(".");Ot.route(i,o,c,l)})}function Gj(e){return"id"in e&&"defaults"in e}var g_=class{constructor(){this.controllers=new Kf(Ho,"datasets",!0),this.elements=new Kf(Uo,"elements"),this.plugins=new Kf(Object,"plugins"),this.scales=new Kf(Lu,"scales"),this._typedRegistries=[this.controllers,this.scales,this.elements]}add(...t){this._each("register",t)}remove(...t){this._each("unregister",t)}addControllers(...t){this._each("register",t,this.controllers)}addElements(...t){this._each("register",t,this.elements)}addPlugins(...t){this._each("register",t,this.plugins)}addScales(...t){this._each("register",t,this.scales)}getController(t){return this._get(t,this.controllers,"controller")}getElement(t){return this._get(t,this.elements,"element")}getPlugin(t){return this._get(t,this.plugins,"plugin")}getScale(t){return this._get(t,this.scales,"scale")}removeControllers(...t){this._each("unregister",t,this.controllers)}removeElements(...t){this._each("unregister",t,this.elements)}removePlugins(...t){this._each("unregister",t,this.plugins)}removeScales(...t){this._each("unregister",t,this.scales)}_each(t,n,r){[...n].forEach(o=>{let i=r||this._getRegistryForType(o);r||i.isForType(o)||i===this.plugins&&o.id?this._exec(t,i,o):Xt(o,a=>{let l=r||this._getRegistryForType(a);this._exec(t,l,a)})})}_exec(t,n,r){let o=_g(t);fn(r["before"+o],[],r),n[t](r),fn(r["after"+o],[],r)}_getRegistryForType(t){for(let n=0;n<this._typedRegistries.length;n++){let r=this._typedRegistries[n];if(r.isForType(t))return r}return this.plugins}_get(t,n,r){let o=n.get(t);if(o===void 0)throw new Error('"'+t+'" is not a registered '+r+".");return o}},Ms=new g_,ed=class extends Ho{update(t){let n=this._cachedMeta,{data:r=[]}=n,o=this.chart._animationsDisabled,{start:i,count:a}=z6(n,r,o);if(this._drawStart=i,this._drawCount=a,j6(n)&&(i=0,a=r.length),this.options.showLine){let{dataset:l,_dataset:c}=n;l._chart=this.chart,l._datasetIndex=this.index,l._decimated=!!c._decimated,l.points=r;let d=this.resolveDatasetElementOptions(t);d.segment=this.options.segment,this.updateElement(l,void 0,{animated:!o,options:d},t)}this.updateElements(r,i,a,t)}addElements(){let{showLine:t}=this.options;!this.datasetElementType&&t&&(this.datasetElementType=Ms.getElement("line")),super.addElements()}updateElements(t,n,r,o){let i=o==="reset",{iScale:a,vScale:l,_stacked:c,_dataset:d}=this._cachedMeta,g=this.resolveDataElementOptions(n,o),v=this.getSharedOptions(g),w=this.includeOptions(o,v),S=a.axis,C=l.axis,{spanGaps:L,segment:k}=this.options,M=Iu(L)?L:Number.POSITIVE_INFINITY,P=this.chart._animationsDisabled||i||o==="none",D=n>0&&this.getParsed(n-1);for(let T=n;T<n+r;++T){let O=t[T],A=this.getParsed(T),R=P?O:{},B=Ht(A[C]),Y=R[S]=a.getPixelForValue(A[S],T),K=R[C]=i||B?l.getBasePixel():l.getPixelForValue(c?this.applyStack(l,A,c):A[C],T);R.skip=isNaN(Y)||isNaN(K)||B,R.stop=T>0&&Math.abs(A[S]-D[S])>M,k&&(R.parsed=A,R.raw=d.data[T]),w&&(R.options=v||this.resolveDataElementOptions(T,O.active?"active":o)),P||this.updateElement(O,T,R,o),D=A}this.updateSharedOptions(v,o,g)}getMaxOverflow(){let t=this._cachedMeta,n=t.data||[];if(!this.options.showLine){let l=0;for(let c=n.length-1;c>=0;--c)l=Math.max(l,n[c].size(this.resolveDataElementOptions(c))/2);return l>0&&l}let r=t.dataset,o=r.options&&r.options.borderWidth||0;if(!n.length)return o;let i=n[0].size(this.resolveDataElementOptions(0)),a=n[n.length-1].size(this.resolveDataElementOptions(n.length-1));return Math.max(o,i,a)/2}};ed.id="scatter";ed.defaults={datasetElementType:!1,dataElementType:"point",showLine:!1,fill:!1};ed.overrides={interaction:{mode:"point"},plugins:{tooltip:{callbacks:{title(){return""},label(e){return"("+e.label+", "+e.formattedValue+")"}}}},scales:{x:{type:"linear"},y:{type:"linear"}}};var Qj=Object.freeze({__proto__:null,BarController:Gf,BubbleController:Qf,DoughnutController:_l,LineController:Xf,PolarAreaController:Jf,PieController:Jh,RadarController:Zf,ScatterController:ed});function Tu(){throw new Error("This method is not implemented: Check that a complete date adapter is provided.")}var Zh=class{constructor(t){this.options=t||{}}init(t){}formats(){return Tu()}parse(t,n){return Tu()}format(t,n){return Tu()}add(t,n,r){return Tu()}diff(t,n,r){return Tu()}startOf(t,n,r){return Tu()}endOf(t,n){return Tu()}};Zh.override=function(e){Object.assign(Zh.prototype,e)};var Xj={_date:Zh};function Jj(e,t,n,r){let{controller:o,data:i,_sorted:a}=e,l=o._cachedMeta.iScale;if(l&&t===l.axis&&t!=="r"&&a&&i.length){let c=l._reversePixels?eP:xs;if(r){if(o._sharedOptions){let d=i[0],g=typeof d.getRange=="function"&&d.getRange(t);if(g){let v=c(i,t,n-g),w=c(i,t,n+g);return{lo:v.lo,hi:w.hi}}}}else return c(i,t,n)}return{lo:0,hi:i.length-1}}function s1(e,t,n,r,o){let i=e.getSortedVisibleDatasetMetas(),a=n[t];for(let l=0,c=i.length;l<c;++l){let{index:d,data:g}=i[l],{lo:v,hi:w}=Jj(i[l],t,a,o);for(let S=v;S<=w;++S){let C=g[S];C.skip||r(C,d,S)}}}function Zj(e){let t=e.indexOf("x")!==-1,n=e.indexOf("y")!==-1;return function(r,o){let i=t?Math.abs(r.x-o.x):0,a=n?Math.abs(r.y-o.y):0;return Math.sqrt(Math.pow(i,2)+Math.pow(a,2))}}function l_(e,t,n,r,o){let i=[];return!o&&!e.isPointInArea(t)||s1(e,n,t,function(l,c,d){!o&&!zf(l,e.chartArea,0)||l.inRange(t.x,t.y,r)&&i.push({element:l,datasetIndex:c,index:d})},!0),i}function eV(e,t,n,r){let o=[];function i(a,l,c){let{startAngle:d,endAngle:g}=a.getProps(["startAngle","endAngle"],r),{angle:v}=N6(a,{x:t.x,y:t.y});Uf(v,d,g)&&o.push({element:a,datasetIndex:l,index:c})}return s1(e,n,t,i),o}function tV(e,t,n,r,o,i){let a=[],l=Zj(n),c=Number.POSITIVE_INFINITY;function d(g,v,w){let S=g.inRange(t.x,t.y,o);if(r&&!S)return;let C=g.getCenterPoint(o);if(!(!!i||e.isPointInArea(C))&&!S)return;let k=l(t,C);k<c?(a=[{element:g,datasetIndex:v,index:w}],c=k):k===c&&a.push({element:g,datasetIndex:v,index:w})}return s1(e,n,t,d),a}function u_(e,t,n,r,o,i){return!i&&!e.isPointInArea(t)?[]:n==="r"&&!r?eV(e,t,n,o):tV(e,t,n,r,o,i)}function zP(e,t,n,r,o){let i=[],a=n==="x"?"inXRange":"inYRange",l=!1;return s1(e,n,t,(c,d,g)=>{c[a](t[n],o)&&(i.push({element:c,datasetIndex:d,index:g}),l=l||c.inRange(t.x,t.y,o))}),r&&!l?[]:i}var nV={evaluateInteractionItems:s1,modes:{index(e,t,n,r){let o=gl(t,e),i=n.axis||"x",a=n.includeInvisible||!1,l=n.intersect?l_(e,o,i,r,a):u_(e,o,i,!1,r,a),c=[];return l.length?(e.getSortedVisibleDatasetMetas().forEach(d=>{let g=l[0].index,v=d.data[g];v&&!v.skip&&c.push({element:v,datasetIndex:d.index,index:g})}),c):[]},dataset(e,t,n,r){let o=gl(t,e),i=n.axis||"xy",a=n.includeInvisible||!1,l=n.intersect?l_(e,o,i,r,a):u_(e,o,i,!1,r,a);if(l.length>0){let c=l[0].datasetIndex,d=e.getDatasetMeta(c).data;l=[];for(let g=0;g<d.length;++g)l.push({element:d[g],datasetIndex:c,index:g})}return l},point(e,t,n,r){let o=gl(t,e),i=n.axis||"xy",a=n.includeInvisible||!1;return l_(e,o,i,r,a)},nearest(e,t,n,r){let o=gl(t,e),i=n.axis||"xy",a=n.includeInvisible||!1;return u_(e,o,i,n.intersect,r,a)},x(e,t,n,r){let o=gl(t,e);return zP(e,o,"x",n.intersect,r)},y(e,t,n,r){let o=gl(t,e);return zP(e,o,"y",n.intersect,r)}}},xE=["left","top","right","bottom"];function qh(e,t){return e.filter(n=>n.pos===t)}function jP(e,t){return e.filter(n=>xE.indexOf(n.pos)===-1&&n.box.axis===t)}function Kh(e,t){return e.sort((n,r)=>{let o=t?r:n,i=t?n:r;return o.weight===i.weight?o.index-i.index:o.weight-i.weight})}function rV(e){let t=[],n,r,o,i,a,l;for(n=0,r=(e||[]).length;n<r;++n)o=e[n],{position:i,options:{stack:a,stackWeight:l=1}}=o,t.push({index:n,box:o,pos:i,horizontal:o.isHorizontal(),weight:o.weight,stack:a&&i+a,stackWeight:l});return t}function oV(e){let t={};for(let n of e){let{stack:r,pos:o,stackWeight:i}=n;if(!r||!xE.includes(o))continue;let a=t[r]||(t[r]={count:0,placed:0,weight:0,size:0});a.count++,a.weight+=i}return t}function iV(e,t){let n=oV(e),{vBoxMaxWidth:r,hBoxMaxHeight:o}=t,i,a,l;for(i=0,a=e.length;i<a;++i){l=e[i];let{fullSize:c}=l.box,d=n[l.stack],g=d&&l.stackWeight/d.weight;l.horizontal?(l.width=g?g*r:c&&t.availableWidth,l.height=o):(l.width=r,l.height=g?g*o:c&&t.availableHeight)}return n}function sV(e){let t=rV(e),n=Kh(t.filter(d=>d.box.fullSize),!0),r=Kh(qh(t,"left"),!0),o=Kh(qh(t,"right")),i=Kh(qh(t,"top"),!0),a=Kh(qh(t,"bottom")),l=jP(t,"x"),c=jP(t,"y");return{fullSize:n,leftAndTop:r.concat(i),rightAndBottom:o.concat(c).concat(a).concat(l),chartArea:qh(t,"chartArea"),vertical:r.concat(o).concat(c),horizontal:i.concat(a).concat(l)}}function VP(e,t,n,r){return Math.max(e[n],t[n])+Math.max(e[r],t[r])}function wE(e,t){e.top=Math.max(e.top,t.top),e.left=Math.max(e.left,t.left),e.bottom=Math.max(e.bottom,t.bottom),e.right=Math.max(e.right,t.right)}function aV(e,t,n,r){let{pos:o,box:i}=n,a=e.maxPadding;if(!Lt(o)){n.size&&(e[o]-=n.size);let v=r[n.stack]||{size:0,count:1};v.size=Math.max(v.size,n.horizontal?i.height:i.width),n.size=v.size/v.count,e[o]+=n.size}i.getPadding&&wE(a,i.getPadding());let l=Math.max(0,t.outerWidth-VP(a,e,"left","right")),c=Math.max(0,t.outerHeight-VP(a,e,"top","bottom")),d=l!==e.w,g=c!==e.h;return e.w=l,e.h=c,n.horizontal?{same:d,other:g}:{same:g,other:d}}function lV(e){let t=e.maxPadding;function n(r){let o=Math.max(t[r]-e[r],0);return e[r]+=o,o}e.y+=n("top"),e.x+=n("left"),n("right"),n("bottom")}function uV(e,t){let n=t.maxPadding;function r(o){let i={left:0,top:0,right:0,bottom:0};return o.forEach(a=>{i[a]=Math.max(t[a],n[a])}),i}return r(e?["left","right"]:["top","bottom"])}function Qh(e,t,n,r){let o=[],i,a,l,c,d,g;for(i=0,a=e.length,d=0;i<a;++i){l=e[i],c=l.box,c.update(l.width||t.w,l.height||t.h,uV(l.horizontal,t));let{same:v,other:w}=aV(t,n,l,r);d|=v&&o.length,g=g||w,c.fullSize||o.push(l)}return d&&Qh(o,t,n,r)||g}function Dg(e,t,n,r,o){e.top=n,e.left=t,e.right=t+r,e.bottom=n+o,e.width=r,e.height=o}function HP(e,t,n,r){let o=n.padding,{x:i,y:a}=t;for(let l of e){let c=l.box,d=r[l.stack]||{count:1,placed:0,weight:1},g=l.stackWeight/d.weight||1;if(l.horizontal){let v=t.w*g,w=d.size||c.height;zo(d.start)&&(a=d.start),c.fullSize?Dg(c,o.left,a,n.outerWidth-o.right-o.left,w):Dg(c,t.left+d.placed,a,v,w),d.start=a,d.placed+=v,a=c.bottom}else{let v=t.h*g,w=d.size||c.width;zo(d.start)&&(i=d.start),c.fullSize?Dg(c,i,o.top,w,n.outerHeight-o.bottom-o.top):Dg(c,i,t.top+d.placed,w,v),d.start=i,d.placed+=v,i=c.right}}t.x=i,t.y=a}Ot.set("layout",{autoPadding:!0,padding:{top:0,right:0,bottom:0,left:0}});var jr={addBox(e,t){e.boxes||(e.boxes=[]),t.fullSize=t.fullSize||!1,t.position=t.position||"top",t.weight=t.weight||0,t._layers=t._layers||function(){return[{z:0,draw(n){t.draw(n)}}]},e.boxes.push(t)},removeBox(e,t){let n=e.boxes?e.boxes.indexOf(t):-1;n!==-1&&e.boxes.splice(n,1)},configure(e,t,n){t.fullSize=n.fullSize,t.position=n.position,t.weight=n.weight},update(e,t,n,r){if(!e)return;let o=Pr(e.options.layout.padding),i=Math.max(t-o.width,0),a=Math.max(n-o.height,0),l=sV(e.boxes),c=l.vertical,d=l.horizontal;Xt(e.boxes,L=>{typeof L.beforeLayout=="function"&&L.beforeLayout()});let g=c.reduce((L,k)=>k.box.options&&k.box.options.display===!1?L:L+1,0)||1,v=Object.freeze({outerWidth:t,outerHeight:n,padding:o,availableWidth:i,availableHeight:a,vBoxMaxWidth:i/2/g,hBoxMaxHeight:a/2}),w=Object.assign({},o);wE(w,Pr(r));let S=Object.assign({maxPadding:w,w:i,h:a,x:o.left,y:o.top},o),C=iV(c.concat(d),v);Qh(l.fullSize,S,v,C),Qh(c,S,v,C),Qh(d,S,v,C)&&Qh(c,S,v,C),lV(S),HP(l.leftAndTop,S,v,C),S.x+=S.w,S.y+=S.h,HP(l.rightAndBottom,S,v,C),e.chartArea={left:S.left,top:S.top,right:S.left+S.w,bottom:S.top+S.h,height:S.h,width:S.w},Xt(l.chartArea,L=>{let k=L.box;Object.assign(k,e.chartArea),k.update(S.w,S.h,{left:0,top:0,right:0,bottom:0})})}},Rg=class{acquireContext(t,n){}releaseContext(t){return!1}addEventListener(t,n,r){}removeEventListener(t,n,r){}getDevicePixelRatio(){return 1}getMaximumSize(t,n,r,o){return n=Math.max(0,n||t.width),r=r||t.height,{width:n,height:Math.max(0,o?Math.floor(n/o):r)}}isAttached(t){return!0}updateConfig(t){}},y_=class extends Rg{acquireContext(t){return t&&t.getContext&&t.getContext("2d")||null}updateConfig(t){t.options.animation=!1}},Ag="$chartjs",cV={touchstart:"mousedown",touchmove:"mousemove",touchend:"mouseup",pointerenter:"mouseenter",pointerdown:"mousedown",pointermove:"mousemove",pointerup:"mouseup",pointerleave:"mouseout",pointerout:"mouseout"},UP=e=>e===null||e==="";function fV(e,t){let n=e.style,r=e.getAttribute("height"),o=e.getAttribute("width");if(e[Ag]={initial:{height:r,width:o,style:{display:n.display,height:n.height,width:n.width}}},n.display=n.display||"block",n.boxSizing=n.boxSizing||"border-box",UP(o)){let i=e_(e,"width");i!==void 0&&(e.width=i)}if(UP(r))if(e.style.height==="")e.height=e.width/(t||2);else{let i=e_(e,"height");i!==void 0&&(e.height=i)}return e}var bE=wP?{passive:!0}:!1;function dV(e,t,n){e.addEventListener(t,n,bE)}function pV(e,t,n){e.canvas.removeEventListener(t,n,bE)}function hV(e,t){let n=cV[e.type]||e.type,{x:r,y:o}=gl(e,t);return{type:n,chart:t,native:e,x:r!==void 0?r:null,y:o!==void 0?o:null}}function Bg(e,t){for(let n of e)if(n===t||n.contains(t))return!0}function mV(e,t,n){let r=e.canvas,o=new MutationObserver(i=>{let a=!1;for(let l of i)a=a||Bg(l.addedNodes,r),a=a&&!Bg(l.removedNodes,r);a&&n()});return o.observe(document,{childList:!0,subtree:!0}),o}function gV(e,t,n){let r=e.canvas,o=new MutationObserver(i=>{let a=!1;for(let l of i)a=a||Bg(l.removedNodes,r),a=a&&!Bg(l.addedNodes,r);a&&n()});return o.observe(document,{childList:!0,subtree:!0}),o}var e1=new Map,WP=0;function SE(){let e=window.devicePixelRatio;e!==WP&&(WP=e,e1.forEach((t,n)=>{n.currentDevicePixelRatio!==e&&t()}))}function yV(e,t){e1.size||window.addEventListener("resize",SE),e1.set(e,t)}function vV(e){e1.delete(e),e1.size||window.removeEventListener("resize",SE)}function _V(e,t,n){let r=e.canvas,o=r&&Pg(r);if(!o)return;let i=B6((l,c)=>{let d=o.clientWidth;n(l,c),d<o.clientWidth&&n()},window),a=new ResizeObserver(l=>{let c=l[0],d=c.contentRect.width,g=c.contentRect.height;d===0&&g===0||i(d,g)});return a.observe(o),yV(e,i),a}function c_(e,t,n){n&&n.disconnect(),t==="resize"&&vV(e)}function xV(e,t,n){let r=e.canvas,o=B6(i=>{e.ctx!==null&&n(hV(i,e))},e,i=>{let a=i[0];return[a,a.offsetX,a.offsetY]});return dV(r,t,o),o}var v_=class extends Rg{acquireContext(t,n){let r=t&&t.getContext&&t.getContext("2d");return r&&r.canvas===t?(fV(t,n),r):null}releaseContext(t){let n=t.canvas;if(!n[Ag])return!1;let r=n[Ag].initial;["height","width"].forEach(i=>{let a=r[i];Ht(a)?n.removeAttribute(i):n.setAttribute(i,a)});let o=r.style||{};return Object.keys(o).forEach(i=>{n.style[i]=o[i]}),n.width=n.width,delete n[Ag],!0}addEventListener(t,n,r){this.removeEventListener(t,n);let o=t.$proxies||(t.$proxies={}),a={attach:mV,detach:gV,resize:_V}[n]||xV;o[n]=a(t,n,r)}removeEventListener(t,n){let r=t.$proxies||(t.$proxies={}),o=r[n];if(!o)return;({attach:c_,detach:c_,resize:c_}[n]||pV)(t,n,o),r[n]=void 0}getDevicePixelRatio(){return window.devicePixelRatio}getMaximumSize(t,n,r,o){return xP(t,n,r,o)}isAttached(t){let n=Pg(t);return!!(n&&n.isConnected)}};function wV(e){return!J6()||typeof OffscreenCanvas!="undefined"&&e instanceof OffscreenCanvas?y_:v_}var __=class{constructor(){this._init=[]}notify(t,n,r,o){n==="beforeInit"&&(this._init=this._createDescriptors(t,!0),this._notify(this._init,t,"install"));let i=o?this._descriptors(t).filter(o):this._descriptors(t),a=this._notify(i,t,n,r);return n==="afterDestroy"&&(this._notify(i,t,"stop"),this._notify(this._init,t,"uninstall")),a}_notify(t,n,r,o){o=o||{};for(let i of t){let a=i.plugin,l=a[r],c=[n,o,i.options];if(fn(l,c,a)===!1&&o.cancelable)return!1}return!0}invalidate(){Ht(this._cache)||(this._oldCache=this._cache,this._cache=void 0)}_descriptors(t){if(this._cache)return this._cache;let n=this._cache=this._createDescriptors(t);return this._notifyStateChanges(t),n}_createDescriptors(t,n){let r=t&&t.config,o=Mt(r.options&&r.options.plugins,{}),i=bV(r);return o===!1&&!n?[]:MV(t,i,o,n)}_notifyStateChanges(t){let n=this._oldCache||[],r=this._cache,o=(i,a)=>i.filter(l=>!a.some(c=>l.plugin.id===c.plugin.id));this._notify(o(n,r),t,"stop"),this._notify(o(r,n),t,"start")}};function bV(e){let t={},n=[],r=Object.keys(Ms.plugins.items);for(let i=0;i<r.length;i++)n.push(Ms.getPlugin(r[i]));let o=e.plugins||[];for(let i=0;i<o.length;i++){let a=o[i];n.indexOf(a)===-1&&(n.push(a),t[a.id]=!0)}return{plugins:n,localIds:t}}function SV(e,t){return!t&&e===!1?null:e===!0?{}:e}function MV(e,{plugins:t,localIds:n},r,o){let i=[],a=e.getContext();for(let l of t){let c=l.id,d=SV(r[c],o);d!==null&&i.push({plugin:l,options:kV(e.config,{plugin:l,local:n[c]},d,a)})}return i}function kV(e,{plugin:t,local:n},r,o){let i=e.pluginScopeKeys(t),a=e.getOptionScopes(r,i);return n&&t.defaults&&a.push(t.defaults),e.createResolver(a,o,[""],{scriptable:!1,indexable:!1,allKeys:!0})}function x_(e,t){let n=Ot.datasets[e]||{};return((t.datasets||{})[e]||{}).indexAxis||t.indexAxis||n.indexAxis||"x"}function CV(e,t){let n=e;return e==="_index_"?n=t:e==="_value_"&&(n=t==="x"?"y":"x"),n}function PV(e,t){return e===t?"_index_":"_value_"}function EV(e){if(e==="top"||e==="bottom")return"x";if(e==="left"||e==="right")return"y"}function w_(e,t){return e==="x"||e==="y"?e:t.axis||EV(t.position)||e.charAt(0).toLowerCase()}function IV(e,t){let n=dl[e.type]||{scales:{}},r=t.scales||{},o=x_(e.type,t),i=Object.create(null),a=Object.create(null);return Object.keys(r).forEach(l=>{let c=r[l];if(!Lt(c))return console.error(`Invalid scale configuration for scale: ${l}`);if(c._proxy)return console.warn(`Ignoring resolver passed as options for scale: ${l}`);let d=w_(l,c),g=PV(d,o),v=n.scales||{};i[d]=i[d]||l,a[l]=Vf(Object.create(null),[{axis:d},c,v[d],v[g]])}),e.data.datasets.forEach(l=>{let c=l.type||e.type,d=l.indexAxis||x_(c,t),v=(dl[c]||{}).scales||{};Object.keys(v).forEach(w=>{let S=CV(w,d),C=l[S+"AxisID"]||i[S]||S;a[C]=a[C]||Object.create(null),Vf(a[C],[{axis:S},r[C],v[w]])})}),Object.keys(a).forEach(l=>{let c=a[l];Vf(c,[Ot.scales[c.type],Ot.scale])}),a}function ME(e){let t=e.options||(e.options={});t.plugins=Mt(t.plugins,{}),t.scales=IV(e,t)}function kE(e){return e=e||{},e.datasets=e.datasets||[],e.labels=e.labels||[],e}function DV(e){return e=e||{},e.data=kE(e.data),ME(e),e}var $P=new Map,CE=new Set;function Tg(e,t){let n=$P.get(e);return n||(n=t(),$P.set(e,n),CE.add(n)),n}var Gh=(e,t,n)=>{let r=ua(t,n);r!==void 0&&e.add(r)},b_=class{constructor(t){this._config=DV(t),this._scopeCache=new Map,this._resolverCache=new Map}get platform(){return this._config.platform}get type(){return this._config.type}set type(t){this._config.type=t}get data(){return this._config.data}set data(t){this._config.data=kE(t)}get options(){return this._config.options}set options(t){this._config.options=t}get plugins(){return this._config.plugins}update(){let t=this._config;this.clearCache(),ME(t)}clearCache(){this._scopeCache.clear(),this._resolverCache.clear()}datasetScopeKeys(t){return Tg(t,()=>[[`datasets.${t}`,""]])}datasetAnimationScopeKeys(t,n){return Tg(`${t}.transition.${n}`,()=>[[`datasets.${t}.transitions.${n}`,`transitions.${n}`],[`datasets.${t}`,""]])}datasetElementScopeKeys(t,n){return Tg(`${t}-${n}`,()=>[[`datasets.${t}.elements.${n}`,`datasets.${t}`,`elements.${n}`,""]])}pluginScopeKeys(t){let n=t.id,r=this.type;return Tg(`${r}-plugin-${n}`,()=>[[`plugins.${n}`,...t.additionalOptionScopes||[]]])}_cachedScopes(t,n){let r=this._scopeCache,o=r.get(t);return(!o||n)&&(o=new Map,r.set(t,o)),o}getOptionScopes(t,n,r){let{options:o,type:i}=this,a=this._cachedScopes(t,r),l=a.get(n);if(l)return l;let c=new Set;n.forEach(g=>{t&&(c.add(t),g.forEach(v=>Gh(c,t,v))),g.forEach(v=>Gh(c,o,v)),g.forEach(v=>Gh(c,dl[i]||{},v)),g.forEach(v=>Gh(c,Ot,v)),g.forEach(v=>Gh(c,Sg,v))});let d=Array.from(c);return d.length===0&&d.push(Object.create(null)),CE.has(n)&&a.set(n,d),d}chartOptionScopes(){let{options:t,type:n}=this;return[t,dl[n]||{},Ot.datasets[n]||{},{type:n},Ot,Sg]}resolveNamedOptions(t,n,r,o=[""]){let i={$shared:!0},{resolver:a,subPrefixes:l}=YP(this._resolverCache,t,o),c=a;if(LV(a,n)){i.$shared=!1,r=la(r)?r():r;let d=this.createResolver(t,r,l);c=Eu(a,r,d)}for(let d of n)i[d]=c[d];return i}createResolver(t,n,r=[""],o){let{resolver:i}=YP(this._resolverCache,t,r);return Lt(n)?Eu(i,n,void 0,o):i}};function YP(e,t,n){let r=e.get(t);r||(r=new Map,e.set(t,r));let o=n.join(),i=r.get(o);return i||(i={resolver:Cg(t,n),subPrefixes:n.filter(l=>!l.toLowerCase().includes("hover"))},r.set(o,i)),i}var TV=e=>Lt(e)&&Object.getOwnPropertyNames(e).reduce((t,n)=>t||la(e[n]),!1);function LV(e,t){let{isScriptable:n,isIndexable:r}=K6(e);for(let o of t){let i=n(o),a=r(o),l=(a||i)&&e[o];if(i&&(la(l)||TV(l))||a&&cn(l))return!0}return!1}var OV="3.9.1",NV=["top","bottom","left","right","chartArea"];function qP(e,t){return e==="top"||e==="bottom"||NV.indexOf(e)===-1&&t==="x"}function KP(e,t){return function(n,r){return n[e]===r[e]?n[t]-r[t]:n[e]-r[e]}}function GP(e){let t=e.chart,n=t.options.animation;t.notifyPlugins("afterRender"),fn(n&&n.onComplete,[e],t)}function AV(e){let t=e.chart,n=t.options.animation;fn(n&&n.onProgress,[e],t)}function PE(e){return J6()&&typeof e=="string"?e=document.getElementById(e):e&&e.length&&(e=e[0]),e&&e.canvas&&(e=e.canvas),e}var zg={},EE=e=>{let t=PE(e);return Object.values(zg).filter(n=>n.canvas===t).pop()};function FV(e,t,n){let r=Object.keys(e);for(let o of r){let i=+o;if(i>=t){let a=e[o];delete e[o],(n>0||i>t)&&(e[i+n]=a)}}}function RV(e,t,n,r){return!n||e.type==="mouseout"?null:r?t:e}var Ou=class{constructor(t,n){let r=this.config=new b_(n),o=PE(t),i=EE(o);if(i)throw new Error("Canvas is already in use. Chart with ID '"+i.id+"' must be destroyed before the canvas with ID '"+i.canvas.id+"' can be reused.");let a=r.createResolver(r.chartOptionScopes(),this.getContext());this.platform=new(r.platform||wV(o)),this.platform.updateConfig(r);let l=this.platform.acquireContext(o,a.aspectRatio),c=l&&l.canvas,d=c&&c.height,g=c&&c.width;if(this.id=qC(),this.ctx=l,this.canvas=c,this.width=g,this.height=d,this._options=a,this._aspectRatio=this.aspectRatio,this._layers=[],this._metasets=[],this._stacks=void 0,this.boxes=[],this.currentDevicePixelRatio=void 0,this.chartArea=void 0,this._active=[],this._lastEvent=void 0,this._listeners={},this._responsiveListeners=void 0,this._sortedMetasets=[],this.scales={},this._plugins=new __,this.$proxies={},this._hiddenIndices={},this.attached=!1,this._animationsDisabled=void 0,this.$context=void 0,this._doResize=oP(v=>this.update(v),a.resizeDelay||0),this._dataChanges=[],zg[this.id]=this,!l||!c){console.error("Failed to create chart: can't acquire context from the given item");return}fa.listen(this,"complete",GP),fa.listen(this,"progress",AV),this._initialize(),this.attached&&this.update()}get aspectRatio(){let{options:{aspectRatio:t,maintainAspectRatio:n},width:r,height:o,_aspectRatio:i}=this;return Ht(t)?n&&i?i:o?r/o:null:t}get data(){return this.config.data}set data(t){this.config.data=t}get options(){return this._options}set options(t){this.config.options=t}_initialize(){return this.notifyPlugins("beforeInit"),this.options.responsive?this.resize():Z6(this,this.options.devicePixelRatio),this.bindEvents(),this.notifyPlugins("afterInit"),this}clear(){return $6(this.canvas,this.ctx),this}stop(){return fa.stop(this),this}resize(t,n){fa.running(this)?this._resizeBeforeDraw={width:t,height:n}:this._resize(t,n)}_resize(t,n){let r=this.options,o=this.canvas,i=r.maintainAspectRatio&&this.aspectRatio,a=this.platform.getMaximumSize(o,t,n,i),l=r.devicePixelRatio||this.platform.getDevicePixelRatio(),c=this.width?"resize":"attach";this.width=a.width,this.height=a.height,this._aspectRatio=this.aspectRatio,Z6(this,l,!0)&&(this.notifyPlugins("resize",{size:a}),fn(r.onResize,[this,a],this),this.attached&&this._doResize(c)&&this.render())}ensureScalesHaveIDs(){let n=this.options.scales||{};Xt(n,(r,o)=>{r.id=o})}buildOrUpdateScales(){let t=this.options,n=t.scales,r=this.scales,o=Object.keys(r).reduce((a,l)=>(a[l]=!1,a),{}),i=[];n&&(i=i.concat(Object.keys(n).map(a=>{let l=n[a],c=w_(a,l),d=c==="r",g=c==="x";return{options:l,dposition:d?"chartArea":g?"bottom":"left",dtype:d?"radialLinear":g?"category":"linear"}}))),Xt(i,a=>{let l=a.options,c=l.id,d=w_(c,l),g=Mt(l.type,a.dtype);(l.position===void 0||qP(l.position,d)!==qP(a.dposition))&&(l.position=a.dposition),o[c]=!0;let v=null;if(c in r&&r[c].type===g)v=r[c];else{let w=Ms.getScale(g);v=new w({id:c,type:g,ctx:this.ctx,chart:this}),r[v.id]=v}v.init(l,t)}),Xt(o,(a,l)=>{a||delete r[l]}),Xt(r,a=>{jr.configure(this,a,a.options),jr.addBox(this,a)})}_updateMetasets(){let t=this._metasets,n=this.data.datasets.length,r=t.length;if(t.sort((o,i)=>o.index-i.index),r>n){for(let o=n;o<r;++o)this._destroyDatasetMeta(o);t.splice(n,r-n)}this._sortedMetasets=t.slice(0).sort(KP("order","index"))}_removeUnreferencedMetasets(){let{_metasets:t,data:{datasets:n}}=this;t.length>n.length&&delete this._stacks,t.forEach((r,o)=>{n.filter(i=>i===r._dataset).length===0&&this._destroyDatasetMeta(o)})}buildOrUpdateControllers(){let t=[],n=this.data.datasets,r,o;for(this._removeUnreferencedMetasets(),r=0,o=n.length;r<o;r++){let i=n[r],a=this.getDatasetMeta(r),l=i.type||this.config.type;if(a.type&&a.type!==l&&(this._destroyDatasetMeta(r),a=this.getDatasetMeta(r)),a.type=l,a.indexAxis=i.indexAxis||x_(l,this.options),a.order=i.order||0,a.index=r,a.label=""+i.label,a.visible=this.isDatasetVisible(r),a.controller)a.controller.updateIndex(r),a.controller.linkScales();else{let c=Ms.getController(l),{datasetElementType:d,dataElementType:g}=Ot.datasets[l];Object.assign(c.prototype,{dataElementType:Ms.getElement(g),datasetElementType:d&&Ms.getElement(d)}),a.controller=new c(this,r),t.push(a.controller)}}return this._updateMetasets(),t}_resetElements(){Xt(this.data.datasets,(t,n)=>{this.getDatasetMeta(n).controller.reset()},this)}reset(){this._resetElements(),this.notifyPlugins("reset")}update(t){let n=this.config;n.update();let r=this._options=n.createResolver(n.chartOptionScopes(),this.getContext()),o=this._animationsDisabled=!r.animation;if(this._updateScales(),this._checkEventBindings(),this._updateHiddenIndices(),this._plugins.invalidate(),this.notifyPlugins("beforeUpdate",{mode:t,cancelable:!0})===!1)return;let i=this.buildOrUpdateControllers();this.notifyPlugins("beforeElementsUpdate");let a=0;for(let d=0,g=this.data.datasets.length;d<g;d++){let{controller:v}=this.getDatasetMeta(d),w=!o&&i.indexOf(v)===-1;v.buildOrUpdateElements(w),a=Math.max(+v.getMaxOverflow(),a)}a=this._minPadding=r.layout.autoPadding?a:0,this._updateLayout(a),o||Xt(i,d=>{d.reset()}),this._updateDatasets(t),this.notifyPlugins("afterUpdate",{mode:t}),this._layers.sort(KP("z","_idx"));let{_active:l,_lastEvent:c}=this;c?this._eventHandler(c,!0):l.length&&this._updateHoverStyles(l,l,!0),this.render()}_updateScales(){Xt(this.scales,t=>{jr.removeBox(this,t)}),this.ensureScalesHaveIDs(),this.buildOrUpdateScales()}_checkEventBindings(){let t=this.options,n=new Set(Object.keys(this._listeners)),r=new Set(t.events);(!D6(n,r)||!!this._responsiveListeners!==t.responsive)&&(this.unbindEvents(),this.bindEvents())}_updateHiddenIndices(){let{_hiddenIndices:t}=this,n=this._getUniformDataChanges()||[];for(let{method:r,start:o,count:i}of n){let a=r==="_removeElements"?-i:i;FV(t,o,a)}}_getUniformDataChanges(){let t=this._dataChanges;if(!t||!t.length)return;this._dataChanges=[];let n=this.data.datasets.length,r=i=>new Set(t.filter(a=>a[0]===i).map((a,l)=>l+","+a.splice(1).join(","))),o=r(0);for(let i=1;i<n;i++)if(!D6(o,r(i)))return;return Array.from(o).map(i=>i.split(",")).map(i=>({method:i[1],start:+i[2],count:+i[3]}))}_updateLayout(t){if(this.notifyPlugins("beforeLayout",{cancelable:!0})===!1)return;jr.update(this,this.width,this.height,t);let n=this.chartArea,r=n.width<=0||n.height<=0;this._layers=[],Xt(this.boxes,o=>{r&&o.position==="chartArea"||(o.configure&&o.configure(),this._layers.push(...o._layers()))},this),this._layers.forEach((o,i)=>{o._idx=i}),this.notifyPlugins("afterLayout")}_updateDatasets(t){if(this.notifyPlugins("beforeDatasetsUpdate",{mode:t,cancelable:!0})!==!1){for(let n=0,r=this.data.datasets.length;n<r;++n)this.getDatasetMeta(n).controller.configure();for(let n=0,r=this.data.datasets.length;n<r;++n)this._updateDataset(n,la(t)?t({datasetIndex:n}):t);this.notifyPlugins("afterDatasetsUpdate",{mode:t})}}_updateDataset(t,n){let r=this.getDatasetMeta(t),o={meta:r,index:t,mode:n,cancelable:!0};this.notifyPlugins("beforeDatasetUpdate",o)!==!1&&(r.controller._update(n),o.cancelable=!1,this.notifyPlugins("afterDatasetUpdate",o))}render(){this.notifyPlugins("beforeRender",{cancelable:!0})!==!1&&(fa.has(this)?this.attached&&!fa.running(this)&&fa.start(this):(this.draw(),GP({chart:this})))}draw(){let t;if(this._resizeBeforeDraw){let{width:r,height:o}=this._resizeBeforeDraw;this._resize(r,o),this._resizeBeforeDraw=null}if(this.clear(),this.width<=0||this.height<=0||this.notifyPlugins("beforeDraw",{cancelable:!0})===!1)return;let n=this._layers;for(t=0;t<n.length&&n[t].z<=0;++t)n[t].draw(this.chartArea);for(this._drawDatasets();t<n.length;++t)n[t].draw(this.chartArea);this.notifyPlugins("afterDraw")}_getSortedDatasetMetas(t){let n=this._sortedMetasets,r=[],o,i;for(o=0,i=n.length;o<i;++o){let a=n[o];(!t||a.visible)&&r.push(a)}return r}getSortedVisibleDatasetMetas(){return this._getSortedDatasetMetas(!0)}_drawDatasets(){if(this.notifyPlugins("beforeDatasetsDraw",{cancelable:!0})===!1)return;let t=this.getSortedVisibleDatasetMetas();for(let n=t.length-1;n>=0;--n)this._drawDataset(t[n]);this.notifyPlugins("afterDatasetsDraw")}_drawDataset(t){let n=this.ctx,r=t._clip,o=!r.disabled,i=this.chartArea,a={meta:t,index:t.index,cancelable:!0};this.notifyPlugins("beforeDatasetDraw",a)!==!1&&(o&&Uh(n,{left:r.left===!1?0:i.left-r.left,right:r.right===!1?this.width:i.right+r.right,top:r.top===!1?0:i.top-r.top,bottom:r.bottom===!1?this.height:i.bottom+r.bottom}),t.controller.draw(),o&&Wh(n),a.cancelable=!1,this.notifyPlugins("afterDatasetDraw",a))}isPointInArea(t){return zf(t,this.chartArea,this._minPadding)}getElementsAtEventForMode(t,n,r,o){let i=nV.modes[n];return typeof i=="function"?i(this,t,r,o):[]}getDatasetMeta(t){let n=this.data.datasets[t],r=this._metasets,o=r.filter(i=>i&&i._dataset===n).pop();return o||(o={type:null,data:[],dataset:null,controller:null,hidden:null,xAxisID:null,yAxisID:null,order:n&&n.order||0,index:t,_dataset:n,_parsed:[],_sorted:!1},r.push(o)),o}getContext(){return this.$context||(this.$context=ca(null,{chart:this,type:"chart"}))}getVisibleDatasetCount(){return this.getSortedVisibleDatasetMetas().length}isDatasetVisible(t){let n=this.data.datasets[t];if(!n)return!1;let r=this.getDatasetMeta(t);return typeof r.hidden=="boolean"?!r.hidden:!n.hidden}setDatasetVisibility(t,n){let r=this.getDatasetMeta(t);r.hidden=!n}toggleDataVisibility(t){this._hiddenIndices[t]=!this._hiddenIndices[t]}getDataVisibility(t){return!this._hiddenIndices[t]}_updateVisibility(t,n,r){let o=r?"show":"hide",i=this.getDatasetMeta(t),a=i.controller._resolveAnimations(void 0,o);zo(n)?(i.data[n].hidden=!r,this.update()):(this.setDatasetVisibility(t,r),a.update(i,{visible:r}),this.update(l=>l.datasetIndex===t?o:void 0))}hide(t,n){this._updateVisibility(t,n,!1)}show(t,n){this._updateVisibility(t,n,!0)}_destroyDatasetMeta(t){let n=this._metasets[t];n&&n.controller&&n.controller._destroy(),delete this._metasets[t]}_stop(){let t,n;for(this.stop(),fa.remove(this),t=0,n=this.data.datasets.length;t<n;++t)this._destroyDatasetMeta(t)}destroy(){this.notifyPlugins("beforeDestroy");let{canvas:t,ctx:n}=this;this._stop(),this.config.clearCache(),t&&(this.unbindEvents(),$6(t,n),this.platform.releaseContext(n),this.canvas=null,this.ctx=null),this.notifyPlugins("destroy"),delete zg[this.id],this.notifyPlugins("afterDestroy")}toBase64Image(...t){return this.canvas.toDataURL(...t)}bindEvents(){this.bindUserEvents(),this.options.responsive?this.bindResponsiveEvents():this.attached=!0}bindUserEvents(){let t=this._listeners,n=this.platform,r=(i,a)=>{n.addEventListener(this,i,a),t[i]=a},o=(i,a,l)=>{i.offsetX=a,i.offsetY=l,this._eventHandler(i)};Xt(this.options.events,i=>r(i,o))}bindResponsiveEvents(){this._responsiveListeners||(this._responsiveListeners={});let t=this._responsiveListeners,n=this.platform,r=(c,d)=>{n.addEventListener(this,c,d),t[c]=d},o=(c,d)=>{t[c]&&(n.removeEventListener(this,c,d),delete t[c])},i=(c,d)=>{this.canvas&&this.resize(c,d)},a,l=()=>{o("attach",l),this.attached=!0,this.resize(),r("resize",i),r("detach",a)};a=()=>{this.attached=!1,o("resize",i),this._stop(),this._resize(0,0),r("attach",l)},n.isAttached(this.canvas)?l():a()}unbindEvents(){Xt(this._listeners,(t,n)=>{this.platform.removeEventListener(this,n,t)}),this._listeners={},Xt(this._responsiveListeners,(t,n)=>{this.platform.removeEventListener(this,n,t)}),this._responsiveListeners=void 0}updateHoverStyle(t,n,r){let o=r?"set":"remove",i,a,l,c;for(n==="dataset"&&(i=this.getDatasetMeta(t[0].datasetIndex),i.controller["_"+o+"DatasetHoverStyle"]()),l=0,c=t.length;l<c;++l){a=t[l];let d=a&&this.getDatasetMeta(a.datasetIndex).controller;d&&d[o+"HoverStyle"](a.element,a.datasetIndex,a.index)}}getActiveElements(){return this._active||[]}setActiveElements(t){let n=this._active||[],r=t.map(({datasetIndex:i,index:a})=>{let l=this.getDatasetMeta(i);if(!l)throw new Error("No dataset found at index "+i);return{datasetIndex:i,element:l.data[a],index:a}});!Vh(r,n)&&(this._active=r,this._lastEvent=null,this._updateHoverStyles(r,n))}notifyPlugins(t,n,r){return this._plugins.notify(this,t,n,r)}_updateHoverStyles(t,n,r){let o=this.options.hover,i=(c,d)=>c.filter(g=>!d.some(v=>g.datasetIndex===v.datasetIndex&&g.index===v.index)),a=i(n,t),l=r?t:i(t,n);a.length&&this.updateHoverStyle(a,o.mode,!1),l.length&&o.mode&&this.updateHoverStyle(l,o.mode,!0)}_eventHandler(t,n){let r={event:t,replay:n,cancelable:!0,inChartArea:this.isPointInArea(t)},o=a=>(a.options.events||this.options.events).includes(t.native.type);if(this.notifyPlugins("beforeEvent",r,o)===!1)return;let i=this._handleEvent(t,n,r.inChartArea);return r.cancelable=!1,this.notifyPlugins("afterEvent",r,o),(i||r.changed)&&this.render(),this}_handleEvent(t,n,r){let{_active:o=[],options:i}=this,a=n,l=this._getActiveElements(t,o,r,a),c=QC(t),d=RV(t,this._lastEvent,r,c);r&&(this._lastEvent=null,fn(i.onHover,[t,l,this],this),c&&fn(i.onClick,[t,l,this],this));let g=!Vh(l,o);return(g||n)&&(this._active=l,this._updateHoverStyles(l,o,n)),this._lastEvent=d,g}_getActiveElements(t,n,r,o){if(t.type==="mouseout")return[];if(!r)return n;let i=this.options.hover;return this.getElementsAtEventForMode(t,i.mode,i,o)}},QP=()=>Xt(Ou.instances,e=>e._plugins.invalidate()),yl=!0;Object.defineProperties(Ou,{defaults:{enumerable:yl,value:Ot},instances:{enumerable:yl,value:zg},overrides:{enumerable:yl,value:dl},registry:{enumerable:yl,value:Ms},version:{enumerable:yl,value:OV},getChart:{enumerable:yl,value:EE},register:{enumerable:yl,value:(...e)=>{Ms.add(...e),QP()}},unregister:{enumerable:yl,value:(...e)=>{Ms.remove(...e),QP()}}});function IE(e,t,n){let{startAngle:r,pixelMargin:o,x:i,y:a,outerRadius:l,innerRadius:c}=t,d=o/l;e.beginPath(),e.arc(i,a,l,r-d,n+d),c>o?(d=o/c,e.arc(i,a,c,n+d,r-d,!0)):e.arc(i,a,o,n+Dn,r-Dn),e.closePath(),e.clip()}function BV(e){return kg(e,["outerStart","outerEnd","innerStart","innerEnd"])}function zV(e,t,n,r){let o=BV(e.options.borderRadius),i=(n-t)/2,a=Math.min(i,r*t/2),l=c=>{let d=(n-Math.min(i,c))*r/2;return sr(c,0,Math.min(i,d))};return{outerStart:l(o.outerStart),outerEnd:l(o.outerEnd),innerStart:sr(o.innerStart,0,a),innerEnd:sr(o.innerEnd,0,a)}}function qf(e,t,n,r){return{x:n+e*Math.cos(t),y:r+e*Math.sin(t)}}function S_(e,t,n,r,o,i){let{x:a,y:l,startAngle:c,pixelMargin:d,innerRadius:g}=t,v=Math.max(t.outerRadius+r+n-d,0),w=g>0?g+r+n+d:0,S=0,C=o-c;if(r){let te=g>0?g-r:0,z=v>0?v-r:0,V=(te+z)/2,p=V!==0?C*V/(V+r):C;S=(C-p)/2}let L=Math.max(.001,C*v-n/_n)/v,k=(C-L)/2,M=c+k+S,P=o-k-S,{outerStart:D,outerEnd:T,innerStart:O,innerEnd:A}=zV(t,w,v,P-M),R=v-D,B=v-T,Y=M+D/R,K=P-T/B,Q=w+O,ne=w+A,oe=M+O/Q,ie=P-A/ne;if(e.beginPath(),i){if(e.arc(a,l,v,Y,K),T>0){let V=qf(B,K,a,l);e.arc(V.x,V.y,T,K,P+Dn)}let te=qf(ne,P,a,l);if(e.lineTo(te.x,te.y),A>0){let V=qf(ne,ie,a,l);e.arc(V.x,V.y,A,P+Dn,ie+Math.PI)}if(e.arc(a,l,w,P-A/w,M+O/w,!0),O>0){let V=qf(Q,oe,a,l);e.arc(V.x,V.y,O,oe+Math.PI,M-Dn)}let z=qf(R,M,a,l);if(e.lineTo(z.x,z.y),D>0){let V=qf(R,Y,a,l);e.arc(V.x,V.y,D,M-Dn,Y)}}else{e.moveTo(a,l);let te=Math.cos(Y)*v+a,z=Math.sin(Y)*v+l;e.lineTo(te,z);let V=Math.cos(K)*v+a,p=Math.sin(K)*v+l;e.lineTo(V,p)}e.closePath()}function jV(e,t,n,r,o){let{fullCircles:i,startAngle:a,circumference:l}=t,c=t.endAngle;if(i){S_(e,t,n,r,a+tn,o);for(let d=0;d<i;++d)e.fill();isNaN(l)||(c=a+l%tn,l%tn===0&&(c+=tn))}return S_(e,t,n,r,c,o),e.fill(),c}function VV(e,t,n){let{x:r,y:o,startAngle:i,pixelMargin:a,fullCircles:l}=t,c=Math.max(t.outerRadius-a,0),d=t.innerRadius+a,g;for(n&&IE(e,t,i+tn),e.beginPath(),e.arc(r,o,d,i+tn,i,!0),g=0;g<l;++g)e.stroke();for(e.beginPath(),e.arc(r,o,c,i,i+tn),g=0;g<l;++g)e.stroke()}function HV(e,t,n,r,o,i){let{options:a}=t,{borderWidth:l,borderJoinStyle:c}=a,d=a.borderAlign==="inner";l&&(d?(e.lineWidth=l*2,e.lineJoin=c||"round"):(e.lineWidth=l,e.lineJoin=c||"bevel"),t.fullCircles&&VV(e,t,d),d&&IE(e,t,o),S_(e,t,n,r,o,i),e.stroke())}var td=class extends Uo{constructor(t){super(),this.options=void 0,this.circumference=void 0,this.startAngle=void 0,this.endAngle=void 0,this.innerRadius=void 0,this.outerRadius=void 0,this.pixelMargin=0,this.fullCircles=0,t&&Object.assign(this,t)}inRange(t,n,r){let o=this.getProps(["x","y"],r),{angle:i,distance:a}=N6(o,{x:t,y:n}),{startAngle:l,endAngle:c,innerRadius:d,outerRadius:g,circumference:v}=this.getProps(["startAngle","endAngle","innerRadius","outerRadius","circumference"],r),w=this.options.spacing/2,C=Mt(v,c-l)>=tn||Uf(i,l,c),L=bs(a,d+w,g+w);return C&&L}getCenterPoint(t){let{x:n,y:r,startAngle:o,endAngle:i,innerRadius:a,outerRadius:l}=this.getProps(["x","y","startAngle","endAngle","innerRadius","outerRadius","circumference"],t),{offset:c,spacing:d}=this.options,g=(o+i)/2,v=(a+l+d+c)/2;return{x:n+Math.cos(g)*v,y:r+Math.sin(g)*v}}tooltipPosition(t){return this.getCenterPoint(t)}draw(t){let{options:n,circumference:r}=this,o=(n.offset||0)/2,i=(n.spacing||0)/2,a=n.circular;if(this.pixelMargin=n.borderAlign==="inner"?.33:0,this.fullCircles=r>tn?Math.floor(r/tn):0,r===0||this.innerRadius<0||this.outerRadius<0)return;t.save();let l=0;if(o){l=o/2;let d=(this.startAngle+this.endAngle)/2;t.translate(Math.cos(d)*l,Math.sin(d)*l),this.circumference>=_n&&(l=o)}t.fillStyle=n.backgroundColor,t.strokeStyle=n.borderColor;let c=jV(t,this,l,i,a);HV(t,this,l,i,c,a),t.restore()}};td.id="arc";td.defaults={borderAlign:"center",borderColor:"#fff",borderJoinStyle:void 0,borderRadius:0,borderWidth:2,offset:0,spacing:0,angle:void 0,circular:!0};td.defaultRoutes={backgroundColor:"backgroundColor"};function DE(e,t,n=t){e.lineCap=Mt(n.borderCapStyle,t.borderCapStyle),e.setLineDash(Mt(n.borderDash,t.borderDash)),e.lineDashOffset=Mt(n.borderDashOffset,t.borderDashOffset),e.lineJoin=Mt(n.borderJoinStyle,t.borderJoinStyle),e.lineWidth=Mt(n.borderWidth,t.borderWidth),e.strokeStyle=Mt(n.borderColor,t.borderColor)}function UV(e,t,n){e.lineTo(n.x,n.y)}function WV(e){return e.stepped?dP:e.tension||e.cubicInterpolationMode==="monotone"?pP:UV}function TE(e,t,n={}){let r=e.length,{start:o=0,end:i=r-1}=n,{start:a,end:l}=t,c=Math.max(o,a),d=Math.min(i,l),g=o<a&&i<a||o>l&&i>l;return{count:r,start:c,loop:t.loop,ilen:d<c&&!g?r+d-c:d-c}}function $V(e,t,n,r){let{points:o,options:i}=t,{count:a,start:l,loop:c,ilen:d}=TE(o,n,r),g=WV(i),{move:v=!0,reverse:w}=r||{},S,C,L;for(S=0;S<=d;++S)C=o[(l+(w?d-S:S))%a],!C.skip&&(v?(e.moveTo(C.x,C.y),v=!1):g(e,L,C,w,i.stepped),L=C);return c&&(C=o[(l+(w?d:0))%a],g(e,L,C,w,i.stepped)),!!c}function YV(e,t,n,r){let o=t.points,{count:i,start:a,ilen:l}=TE(o,n,r),{move:c=!0,reverse:d}=r||{},g=0,v=0,w,S,C,L,k,M,P=T=>(a+(d?l-T:T))%i,D=()=>{L!==k&&(e.lineTo(g,k),e.lineTo(g,L),e.lineTo(g,M))};for(c&&(S=o[P(0)],e.moveTo(S.x,S.y)),w=0;w<=l;++w){if(S=o[P(w)],S.skip)continue;let T=S.x,O=S.y,A=T|0;A===C?(O<L?L=O:O>k&&(k=O),g=(v*g+T)/++v):(D(),e.lineTo(T,O),C=A,v=0,L=k=O),M=O}D()}function M_(e){let t=e.options,n=t.borderDash&&t.borderDash.length;return!e._decimated&&!e._loop&&!t.tension&&t.cubicInterpolationMode!=="monotone"&&!t.stepped&&!n?YV:$V}function qV(e){return e.stepped?bP:e.tension||e.cubicInterpolationMode==="monotone"?SP:ul}function KV(e,t,n,r){let o=t._path;o||(o=t._path=new Path2D,t.path(o,n,r)&&o.closePath()),DE(e,t.options),e.stroke(o)}function GV(e,t,n,r){let{segments:o,options:i}=t,a=M_(t);for(let l of o)DE(e,i,l.style),e.beginPath(),a(e,t,l,{start:n,end:n+r-1})&&e.closePath(),e.stroke()}var QV=typeof Path2D=="function";function XV(e,t,n,r){QV&&!t.options.segment?KV(e,t,n,r):GV(e,t,n,r)}var ks=class extends Uo{constructor(t){super(),this.animated=!0,this.options=void 0,this._chart=void 0,this._loop=void 0,this._fullLoop=void 0,this._path=void 0,this._points=void 0,this._segments=void 0,this._decimated=!1,this._pointsUpdated=!1,this._datasetIndex=void 0,t&&Object.assign(this,t)}updateControlPoints(t,n){let r=this.options;if((r.tension||r.cubicInterpolationMode==="monotone")&&!r.stepped&&!this._pointsUpdated){let o=r.spanGaps?this._loop:this._fullLoop;_P(this._points,r,t,o,n),this._pointsUpdated=!0}}set points(t){this._points=t,delete this._segments,delete this._path,this._pointsUpdated=!1}get points(){return this._points}get segments(){return this._segments||(this._segments=kP(this,this.options.segment))}first(){let t=this.segments,n=this.points;return t.length&&n[t[0].start]}last(){let t=this.segments,n=this.points,r=t.length;return r&&n[t[r-1].end]}interpolate(t,n){let r=this.options,o=t[n],i=this.points,a=o_(this,{property:n,start:o,end:o});if(!a.length)return;let l=[],c=qV(r),d,g;for(d=0,g=a.length;d<g;++d){let{start:v,end:w}=a[d],S=i[v],C=i[w];if(S===C){l.push(S);continue}let L=Math.abs((o-S[n])/(C[n]-S[n])),k=c(S,C,L,r.stepped);k[n]=t[n],l.push(k)}return l.length===1?l[0]:l}pathSegment(t,n,r){return M_(this)(t,this,n,r)}path(t,n,r){let o=this.segments,i=M_(this),a=this._loop;n=n||0,r=r||this.points.length-n;for(let l of o)a&=i(t,this,l,{start:n,end:n+r-1});return!!a}draw(t,n,r,o){let i=this.options||{};(this.points||[]).length&&i.borderWidth&&(t.save(),XV(t,this,r,o),t.restore()),this.animated&&(this._pointsUpdated=!1,this._path=void 0)}};ks.id="line";ks.defaults={borderCapStyle:"butt",borderDash:[],borderDashOffset:0,borderJoinStyle:"miter",borderWidth:3,capBezierPoints:!0,cubicInterpolationMode:"default",fill:!1,spanGaps:!1,stepped:!1,tension:0};ks.defaultRoutes={backgroundColor:"backgroundColor",borderColor:"borderColor"};ks.descriptors={_scriptable:!0,_indexable:e=>e!=="borderDash"&&e!=="fill"};function XP(e,t,n,r){let o=e.options,{[n]:i}=e.getProps([n],r);return Math.abs(t-i)<o.radius+o.hitRadius}var nd=class extends Uo{constructor(t){super(),this.options=void 0,this.parsed=void 0,this.skip=void 0,this.stop=void 0,t&&Object.assign(this,t)}inRange(t,n,r){let o=this.options,{x:i,y:a}=this.getProps(["x","y"],r);return Math.pow(t-i,2)+Math.pow(n-a,2)<Math.pow(o.hitRadius+o.radius,2)}inXRange(t,n){return XP(this,t,"x",n)}inYRange(t,n){return XP(this,t,"y",n)}getCenterPoint(t){let{x:n,y:r}=this.getProps(["x","y"],t);return{x:n,y:r}}size(t){t=t||this.options||{};let n=t.radius||0;n=Math.max(n,n&&t.hoverRadius||0);let r=n&&t.borderWidth||0;return(n+r)*2}draw(t,n){let r=this.options;this.skip||r.radius<.1||!zf(this,n,this.size(r)/2)||(t.strokeStyle=r.borderColor,t.lineWidth=r.borderWidth,t.fillStyle=r.backgroundColor,Mg(t,r,this.x,this.y))}getRange(){let t=this.options||{};return t.radius+t.hitRadius}};nd.id="point";nd.defaults={borderWidth:1,hitRadius:1,hoverBorderWidth:1,hoverRadius:4,pointStyle:"circle",radius:3,rotation:0};nd.defaultRoutes={backgroundColor:"backgroundColor",borderColor:"borderColor"};function LE(e,t){let{x:n,y:r,base:o,width:i,height:a}=e.getProps(["x","y","base","width","height"],t),l,c,d,g,v;return e.horizontal?(v=a/2,l=Math.min(n,o),c=Math.max(n,o),d=r-v,g=r+v):(v=i/2,l=n-v,c=n+v,d=Math.min(r,o),g=Math.max(r,o)),{left:l,top:d,right:c,bottom:g}}function vl(e,t,n,r){return e?0:sr(t,n,r)}function JV(e,t,n){let r=e.options.borderWidth,o=e.borderSkipped,i=q6(r);return{t:vl(o.top,i.top,0,n),r:vl(o.right,i.right,0,t),b:vl(o.bottom,i.bottom,0,n),l:vl(o.left,i.left,0,t)}}function ZV(e,t,n){let{enableBorderRadius:r}=e.getProps(["enableBorderRadius"]),o=e.options.borderRadius,i=ml(o),a=Math.min(t,n),l=e.borderSkipped,c=r||Lt(o);return{topLeft:vl(!c||l.top||l.left,i.topLeft,0,a),topRight:vl(!c||l.top||l.right,i.topRight,0,a),bottomLeft:vl(!c||l.bottom||l.left,i.bottomLeft,0,a),bottomRight:vl(!c||l.bottom||l.right,i.bottomRight,0,a)}}function eH(e){let t=LE(e),n=t.right-t.left,r=t.bottom-t.top,o=JV(e,n/2,r/2),i=ZV(e,n/2,r/2);return{outer:{x:t.left,y:t.top,w:n,h:r,radius:i},inner:{x:t.left+o.l,y:t.top+o.t,w:n-o.l-o.r,h:r-o.t-o.b,radius:{topLeft:Math.max(0,i.topLeft-Math.max(o.t,o.l)),topRight:Math.max(0,i.topRight-Math.max(o.t,o.r)),bottomLeft:Math.max(0,i.bottomLeft-Math.max(o.b,o.l)),bottomRight:Math.max(0,i.bottomRight-Math.max(o.b,o.r))}}}}function f_(e,t,n,r){let o=t===null,i=n===null,l=e&&!(o&&i)&&LE(e,r);return l&&(o||bs(t,l.left,l.right))&&(i||bs(n,l.top,l.bottom))}function tH(e){return e.topLeft||e.topRight||e.bottomLeft||e.bottomRight}function nH(e,t){e.rect(t.x,t.y,t.w,t.h)}function d_(e,t,n={}){let r=e.x!==n.x?-t:0,o=e.y!==n.y?-t:0,i=(e.x+e.w!==n.x+n.w?t:0)-r,a=(e.y+e.h!==n.y+n.h?t:0)-o;return{x:e.x+r,y:e.y+o,w:e.w+i,h:e.h+a,radius:e.radius}}var rd=class extends Uo{constructor(t){super(),this.options=void 0,this.horizontal=void 0,this.base=void 0,this.width=void 0,this.height=void 0,this.inflateAmount=void 0,t&&Object.assign(this,t)}draw(t){let{inflateAmount:n,options:{borderColor:r,backgroundColor:o}}=this,{inner:i,outer:a}=eH(this),l=tH(a.radius)?Wf:nH;t.save(),(a.w!==i.w||a.h!==i.h)&&(t.beginPath(),l(t,d_(a,n,i)),t.clip(),l(t,d_(i,-n,a)),t.fillStyle=r,t.fill("evenodd")),t.beginPath(),l(t,d_(i,n)),t.fillStyle=o,t.fill(),t.restore()}inRange(t,n,r){return f_(this,t,n,r)}inXRange(t,n){return f_(this,t,null,n)}inYRange(t,n){return f_(this,null,t,n)}getCenterPoint(t){let{x:n,y:r,base:o,horizontal:i}=this.getProps(["x","y","base","horizontal"],t);return{x:i?(n+o)/2:n,y:i?r:(r+o)/2}}getRange(t){return t==="x"?this.width/2:this.height/2}};rd.id="bar";rd.defaults={borderSkipped:"start",borderWidth:0,borderRadius:0,inflateAmount:"auto",pointStyle:void 0};rd.defaultRoutes={backgroundColor:"backgroundColor",borderColor:"borderColor"};var rH=Object.freeze({__proto__:null,ArcElement:td,LineElement:ks,PointElement:nd,BarElement:rd});function oH(e,t,n,r,o){let i=o.samples||r;if(i>=n)return e.slice(t,t+n);let a=[],l=(n-2)/(i-2),c=0,d=t+n-1,g=t,v,w,S,C,L;for(a[c++]=e[g],v=0;v<i-2;v++){let k=0,M=0,P,D=Math.floor((v+1)*l)+1+t,T=Math.min(Math.floor((v+2)*l)+1,n)+t,O=T-D;for(P=D;P<T;P++)k+=e[P].x,M+=e[P].y;k/=O,M/=O;let A=Math.floor(v*l)+1+t,R=Math.min(Math.floor((v+1)*l)+1,n)+t,{x:B,y:Y}=e[g];for(S=C=-1,P=A;P<R;P++)C=.5*Math.abs((B-k)*(e[P].y-Y)-(B-e[P].x)*(M-Y)),C>S&&(S=C,w=e[P],L=P);a[c++]=w,g=L}return a[c++]=e[d],a}function iH(e,t,n,r){let o=0,i=0,a,l,c,d,g,v,w,S,C,L,k=[],M=t+n-1,P=e[t].x,T=e[M].x-P;for(a=t;a<t+n;++a){l=e[a],c=(l.x-P)/T*r,d=l.y;let O=c|0;if(O===g)d<C?(C=d,v=a):d>L&&(L=d,w=a),o=(i*o+l.x)/++i;else{let A=a-1;if(!Ht(v)&&!Ht(w)){let R=Math.min(v,w),B=Math.max(v,w);R!==S&&R!==A&&k.push(he(Z({},e[R]),{x:o})),B!==S&&B!==A&&k.push(he(Z({},e[B]),{x:o}))}a>0&&A!==S&&k.push(e[A]),k.push(l),g=O,i=0,C=L=d,v=w=S=a}}return k}function OE(e){if(e._decimated){let t=e._data;delete e._decimated,delete e._data,Object.defineProperty(e,"data",{value:t})}}function JP(e){e.data.datasets.forEach(t=>{OE(t)})}function sH(e,t){let n=t.length,r=0,o,{iScale:i}=e,{min:a,max:l,minDefined:c,maxDefined:d}=i.getUserBounds();return c&&(r=sr(xs(t,i.axis,a).lo,0,n-1)),d?o=sr(xs(t,i.axis,l).hi+1,r,n)-r:o=n-r,{start:r,count:o}}var aH={id:"decimation",defaults:{algorithm:"min-max",enabled:!1},beforeElementsUpdate:(e,t,n)=>{if(!n.enabled){JP(e);return}let r=e.width;e.data.datasets.forEach((o,i)=>{let{_data:a,indexAxis:l}=o,c=e.getDatasetMeta(i),d=a||o.data;if($f([l,e.options.indexAxis])==="y"||!c.controller.supportsDecimation)return;let g=e.scales[c.xAxisID];if(g.type!=="linear"&&g.type!=="time"||e.options.parsing)return;let{start:v,count:w}=sH(c,d),S=n.threshold||4*r;if(w<=S){OE(o);return}Ht(a)&&(o._data=d,delete o.data,Object.defineProperty(o,"data",{configurable:!0,enumerable:!0,get:function(){return this._decimated},set:function(L){this._data=L}}));let C;switch(n.algorithm){case"lttb":C=oH(d,v,w,r,n);break;case"min-max":C=iH(d,v,w,r);break;default:throw new Error(`Unsupported decimation algorithm '${n.algorithm}'`)}o._decimated=C})},destroy(e){JP(e)}};function lH(e,t,n){let r=e.segments,o=e.points,i=t.points,a=[];for(let l of r){let{start:c,end:d}=l;d=P_(c,d,o);let g=k_(n,o[c],o[d],l.loop);if(!t.segments){a.push({source:l,target:g,start:o[c],end:o[d]});continue}let v=o_(t,g);for(let w of v){let S=k_(n,i[w.start],i[w.end],w.loop),C=r_(l,o,S);for(let L of C)a.push({source:L,target:w,start:{[n]:ZP(g,S,"start",Math.max)},end:{[n]:ZP(g,S,"end",Math.min)}})}}return a}function k_(e,t,n,r){if(r)return;let o=t[e],i=n[e];return e==="angle"&&(o=yo(o),i=yo(i)),{property:e,start:o,end:i}}function uH(e,t){let{x:n=null,y:r=null}=e||{},o=t.points,i=[];return t.segments.forEach(({start:a,end:l})=>{l=P_(a,l,o);let c=o[a],d=o[l];r!==null?(i.push({x:c.x,y:r}),i.push({x:d.x,y:r})):n!==null&&(i.push({x:n,y:c.y}),i.push({x:n,y:d.y}))}),i}function P_(e,t,n){for(;t>e;t--){let r=n[t];if(!isNaN(r.x)&&!isNaN(r.y))break}return t}function ZP(e,t,n,r){return e&&t?r(e[n],t[n]):e?e[n]:t?t[n]:0}function NE(e,t){let n=[],r=!1;return cn(e)?(r=!0,n=e):n=uH(e,t),n.length?new ks({points:n,options:{tension:0},_loop:r,_fullLoop:r}):null}function eE(e){return e&&e.fill!==!1}function cH(e,t,n){let o=e[t].fill,i=[t],a;if(!n)return o;for(;o!==!1&&i.indexOf(o)===-1;){if(!Fn(o))return o;if(a=e[o],!a)return!1;if(a.visible)return o;i.push(o),o=a.fill}return!1}function fH(e,t,n){let r=mH(e);if(Lt(r))return isNaN(r.value)?!1:r;let o=parseFloat(r);return Fn(o)&&Math.floor(o)===o?dH(r[0],t,o,n):["origin","start","end","stack","shape"].indexOf(r)>=0&&r}function dH(e,t,n,r){return(e==="-"||e==="+")&&(n=t+n),n===t||n<0||n>=r?!1:n}function pH(e,t){let n=null;return e==="start"?n=t.bottom:e==="end"?n=t.top:Lt(e)?n=t.getPixelForValue(e.value):t.getBasePixel&&(n=t.getBasePixel()),n}function hH(e,t,n){let r;return e==="start"?r=n:e==="end"?r=t.options.reverse?t.min:t.max:Lt(e)?r=e.value:r=t.getBaseValue(),r}function mH(e){let t=e.options,n=t.fill,r=Mt(n&&n.target,n);return r===void 0&&(r=!!t.backgroundColor),r===!1||r===null?!1:r===!0?"origin":r}function gH(e){let{scale:t,index:n,line:r}=e,o=[],i=r.segments,a=r.points,l=yH(t,n);l.push(NE({x:null,y:t.bottom},r));for(let c=0;c<i.length;c++){let d=i[c];for(let g=d.start;g<=d.end;g++)vH(o,a[g],l)}return new ks({points:o,options:{}})}function yH(e,t){let n=[],r=e.getMatchingVisibleMetas("line");for(let o=0;o<r.length;o++){let i=r[o];if(i.index===t)break;i.hidden||n.unshift(i.dataset)}return n}function vH(e,t,n){let r=[];for(let o=0;o<n.length;o++){let i=n[o],{first:a,last:l,point:c}=_H(i,t,"x");if(!(!c||a&&l)){if(a)r.unshift(c);else if(e.push(c),!l)break}}e.push(...r)}function _H(e,t,n){let r=e.interpolate(t,n);if(!r)return{};let o=r[n],i=e.segments,a=e.points,l=!1,c=!1;for(let d=0;d<i.length;d++){let g=i[d],v=a[g.start][n],w=a[g.end][n];if(bs(o,v,w)){l=o===v,c=o===w;break}}return{first:l,last:c,point:r}}var jg=class{constructor(t){this.x=t.x,this.y=t.y,this.radius=t.radius}pathSegment(t,n,r){let{x:o,y:i,radius:a}=this;return n=n||{start:0,end:tn},t.arc(o,i,a,n.end,n.start,!0),!r.bounds}interpolate(t){let{x:n,y:r,radius:o}=this,i=t.angle;return{x:n+Math.cos(i)*o,y:r+Math.sin(i)*o,angle:i}}};function xH(e){let{chart:t,fill:n,line:r}=e;if(Fn(n))return wH(t,n);if(n==="stack")return gH(e);if(n==="shape")return!0;let o=bH(e);return o instanceof jg?o:NE(o,r)}function wH(e,t){let n=e.getDatasetMeta(t);return n&&e.isDatasetVisible(t)?n.dataset:null}function bH(e){return(e.scale||{}).getPointPositionForValue?MH(e):SH(e)}function SH(e){let{scale:t={},fill:n}=e,r=pH(n,t);if(Fn(r)){let o=t.isHorizontal();return{x:o?r:null,y:o?null:r}}return null}function MH(e){let{scale:t,fill:n}=e,r=t.options,o=t.getLabels().length,i=r.reverse?t.max:t.min,a=hH(n,t,i),l=[];if(r.grid.circular){let c=t.getPointPositionForValue(0,i);return new jg({x:c.x,y:c.y,radius:t.getDistanceFromCenterForValue(a)})}for(let c=0;c<o;++c)l.push(t.getPointPositionForValue(c,a));return l}function p_(e,t,n){let r=xH(t),{line:o,scale:i,axis:a}=t,l=o.options,c=l.fill,d=l.backgroundColor,{above:g=d,below:v=d}=c||{};r&&o.points.length&&(Uh(e,n),kH(e,{line:o,target:r,above:g,below:v,area:n,scale:i,axis:a}),Wh(e))}function kH(e,t){let{line:n,target:r,above:o,below:i,area:a,scale:l}=t,c=n._loop?"angle":t.axis;e.save(),c==="x"&&i!==o&&(tE(e,r,a.top),nE(e,{line:n,target:r,color:o,scale:l,property:c}),e.restore(),e.save(),tE(e,r,a.bottom)),nE(e,{line:n,target:r,color:i,scale:l,property:c}),e.restore()}function tE(e,t,n){let{segments:r,points:o}=t,i=!0,a=!1;e.beginPath();for(let l of r){let{start:c,end:d}=l,g=o[c],v=o[P_(c,d,o)];i?(e.moveTo(g.x,g.y),i=!1):(e.lineTo(g.x,n),e.lineTo(g.x,g.y)),a=!!t.pathSegment(e,l,{move:a}),a?e.closePath():e.lineTo(v.x,n)}e.lineTo(t.first().x,n),e.closePath(),e.clip()}function nE(e,t){let{line:n,target:r,property:o,color:i,scale:a}=t,l=lH(n,r,o);for(let{source:c,target:d,start:g,end:v}of l){let{style:{backgroundColor:w=i}={}}=c,S=r!==!0;e.save(),e.fillStyle=w,CH(e,a,S&&k_(o,g,v)),e.beginPath();let C=!!n.pathSegment(e,c),L;if(S){C?e.closePath():rE(e,r,v,o);let k=!!r.pathSegment(e,d,{move:C,reverse:!0});L=C&&k,L||rE(e,r,g,o)}e.closePath(),e.fill(L?"evenodd":"nonzero"),e.restore()}}function CH(e,t,n){let{top:r,bottom:o}=t.chart.chartArea,{property:i,start:a,end:l}=n||{};i==="x"&&(e.beginPath(),e.rect(a,r,l-a,o-r),e.clip())}function rE(e,t,n,r){let o=t.interpolate(n,r);o&&e.lineTo(o.x,o.y)}var PH={id:"filler",afterDatasetsUpdate(e,t,n){let r=(e.data.datasets||[]).length,o=[],i,a,l,c;for(a=0;a<r;++a)i=e.getDatasetMeta(a),l=i.dataset,c=null,l&&l.options&&l instanceof ks&&(c={visible:e.isDatasetVisible(a),index:a,fill:fH(l,a,r),chart:e,axis:i.controller.options.indexAxis,scale:i.vScale,line:l}),i.$filler=c,o.push(c);for(a=0;a<r;++a)c=o[a],!(!c||c.fill===!1)&&(c.fill=cH(o,a,n.propagate))},beforeDraw(e,t,n){let r=n.drawTime==="beforeDraw",o=e.getSortedVisibleDatasetMetas(),i=e.chartArea;for(let a=o.length-1;a>=0;--a){let l=o[a].$filler;l&&(l.line.updateControlPoints(i,l.axis),r&&l.fill&&p_(e.ctx,l,i))}},beforeDatasetsDraw(e,t,n){if(n.drawTime!=="beforeDatasetsDraw")return;let r=e.getSortedVisibleDatasetMetas();for(let o=r.length-1;o>=0;--o){let i=r[o].$filler;eE(i)&&p_(e.ctx,i,e.chartArea)}},beforeDatasetDraw(e,t,n){let r=t.meta.$filler;!eE(r)||n.drawTime!=="beforeDatasetDraw"||p_(e.ctx,r,e.chartArea)},defaults:{propagate:!0,drawTime:"beforeDatasetDraw"}},oE=(e,t)=>{let{boxHeight:n=t,boxWidth:r=t}=e;return e.usePointStyle&&(n=Math.min(n,t),r=e.pointStyleWidth||Math.min(r,t)),{boxWidth:r,boxHeight:n,itemHeight:Math.max(t,n)}},EH=(e,t)=>e!==null&&t!==null&&e.datasetIndex===t.datasetIndex&&e.index===t.index,Vg=class extends Uo{constructor(t){super(),this._added=!1,this.legendHitBoxes=[],this._hoveredItem=null,this.doughnutMode=!1,this.chart=t.chart,this.options=t.options,this.ctx=t.ctx,this.legendItems=void 0,this.columnSizes=void 0,this.lineWidths=void 0,this.maxHeight=void 0,this.maxWidth=void 0,this.top=void 0,this.bottom=void 0,this.left=void 0,this.right=void 0,this.height=void 0,this.width=void 0,this._margins=void 0,this.position=void 0,this.weight=void 0,this.fullSize=void 0}update(t,n,r){this.maxWidth=t,this.maxHeight=n,this._margins=r,this.setDimensions(),this.buildLabels(),this.fit()}setDimensions(){this.isHorizontal()?(this.width=this.maxWidth,this.left=this._margins.left,this.right=this.width):(this.height=this.maxHeight,this.top=this._margins.top,this.bottom=this.height)}buildLabels(){let t=this.options.labels||{},n=fn(t.generateLabels,[this.chart],this)||[];t.filter&&(n=n.filter(r=>t.filter(r,this.chart.data))),t.sort&&(n=n.sort((r,o)=>t.sort(r,o,this.chart.data))),this.options.reverse&&n.reverse(),this.legendItems=n}fit(){let{options:t,ctx:n}=this;if(!t.display){this.width=this.height=0;return}let r=t.labels,o=Qn(r.font),i=o.size,a=this._computeTitleHeight(),{boxWidth:l,itemHeight:c}=oE(r,i),d,g;n.font=o.string,this.isHorizontal()?(d=this.maxWidth,g=this._fitRows(a,i,l,c)+10):(g=this.maxHeight,d=this._fitCols(a,i,l,c)+10),this.width=Math.min(d,t.maxWidth||this.maxWidth),this.height=Math.min(g,t.maxHeight||this.maxHeight)}_fitRows(t,n,r,o){let{ctx:i,maxWidth:a,options:{labels:{padding:l}}}=this,c=this.legendHitBoxes=[],d=this.lineWidths=[0],g=o+l,v=t;i.textAlign="left",i.textBaseline="middle";let w=-1,S=-g;return this.legendItems.forEach((C,L)=>{let k=r+n/2+i.measureText(C.text).width;(L===0||d[d.length-1]+k+2*l>a)&&(v+=g,d[d.length-(L>0?0:1)]=0,S+=g,w++),c[L]={left:0,top:S,row:w,width:k,height:o},d[d.length-1]+=k+l}),v}_fitCols(t,n,r,o){let{ctx:i,maxHeight:a,options:{labels:{padding:l}}}=this,c=this.legendHitBoxes=[],d=this.columnSizes=[],g=a-t,v=l,w=0,S=0,C=0,L=0;return this.legendItems.forEach((k,M)=>{let P=r+n/2+i.measureText(k.text).width;M>0&&S+o+2*l>g&&(v+=w+l,d.push({width:w,height:S}),C+=w+l,L++,w=S=0),c[M]={left:C,top:S,col:L,width:P,height:o},w=Math.max(w,P),S+=o+l}),v+=w,d.push({width:w,height:S}),v}adjustHitBoxes(){if(!this.options.display)return;let t=this._computeTitleHeight(),{legendHitBoxes:n,options:{align:r,labels:{padding:o},rtl:i}}=this,a=Du(i,this.left,this.width);if(this.isHorizontal()){let l=0,c=Cr(r,this.left+o,this.right-this.lineWidths[l]);for(let d of n)l!==d.row&&(l=d.row,c=Cr(r,this.left+o,this.right-this.lineWidths[l])),d.top+=this.top+t+o,d.left=a.leftForLtr(a.x(c),d.width),c+=d.width+o}else{let l=0,c=Cr(r,this.top+t+o,this.bottom-this.columnSizes[l].height);for(let d of n)d.col!==l&&(l=d.col,c=Cr(r,this.top+t+o,this.bottom-this.columnSizes[l].height)),d.top=c,d.left+=this.left+o,d.left=a.leftForLtr(a.x(d.left),d.width),c+=d.height+o}}isHorizontal(){return this.options.position==="top"||this.options.position==="bottom"}draw(){if(this.options.display){let t=this.ctx;Uh(t,this),this._draw(),Wh(t)}}_draw(){let{options:t,columnSizes:n,lineWidths:r,ctx:o}=this,{align:i,labels:a}=t,l=Ot.color,c=Du(t.rtl,this.left,this.width),d=Qn(a.font),{color:g,padding:v}=a,w=d.size,S=w/2,C;this.drawTitle(),o.textAlign=c.textAlign("left"),o.textBaseline="middle",o.lineWidth=.5,o.font=d.string;let{boxWidth:L,boxHeight:k,itemHeight:M}=oE(a,w),P=function(R,B,Y){if(isNaN(L)||L<=0||isNaN(k)||k<0)return;o.save();let K=Mt(Y.lineWidth,1);if(o.fillStyle=Mt(Y.fillStyle,l),o.lineCap=Mt(Y.lineCap,"butt"),o.lineDashOffset=Mt(Y.lineDashOffset,0),o.lineJoin=Mt(Y.lineJoin,"miter"),o.lineWidth=K,o.strokeStyle=Mt(Y.strokeStyle,l),o.setLineDash(Mt(Y.lineDash,[])),a.usePointStyle){let Q={radius:k*Math.SQRT2/2,pointStyle:Y.pointStyle,rotation:Y.rotation,borderWidth:K},ne=c.xPlus(R,L/2),oe=B+S;Y6(o,Q,ne,oe,a.pointStyleWidth&&L)}else{let Q=B+Math.max((w-k)/2,0),ne=c.leftForLtr(R,L),oe=ml(Y.borderRadius);o.beginPath(),Object.values(oe).some(ie=>ie!==0)?Wf(o,{x:ne,y:Q,w:L,h:k,radius:oe}):o.rect(ne,Q,L,k),o.fill(),K!==0&&o.stroke()}o.restore()},D=function(R,B,Y){hl(o,Y.text,R,B+M/2,d,{strikethrough:Y.hidden,textAlign:c.textAlign(Y.textAlign)})},T=this.isHorizontal(),O=this._computeTitleHeight();T?C={x:Cr(i,this.left+v,this.right-r[0]),y:this.top+v+O,line:0}:C={x:this.left+v,y:Cr(i,this.top+O+v,this.bottom-n[0].height),line:0},t_(this.ctx,t.textDirection);let A=M+v;this.legendItems.forEach((R,B)=>{o.strokeStyle=R.fontColor||g,o.fillStyle=R.fontColor||g;let Y=o.measureText(R.text).width,K=c.textAlign(R.textAlign||(R.textAlign=a.textAlign)),Q=L+S+Y,ne=C.x,oe=C.y;c.setWidth(this.width),T?B>0&&ne+Q+v>this.right&&(oe=C.y+=A,C.line++,ne=C.x=Cr(i,this.left+v,this.right-r[C.line])):B>0&&oe+A>this.bottom&&(ne=C.x=ne+n[C.line].width+v,C.line++,oe=C.y=Cr(i,this.top+O+v,this.bottom-n[C.line].height));let ie=c.x(ne);P(ie,oe,R),ne=iP(K,ne+L+S,T?ne+Q:this.right,t.rtl),D(c.x(ne),oe,R),T?C.x+=Q+v:C.y+=A}),n_(this.ctx,t.textDirection)}drawTitle(){let t=this.options,n=t.title,r=Qn(n.font),o=Pr(n.padding);if(!n.display)return;let i=Du(t.rtl,this.left,this.width),a=this.ctx,l=n.position,c=r.size/2,d=o.top+c,g,v=this.left,w=this.width;if(this.isHorizontal())w=Math.max(...this.lineWidths),g=this.top+d,v=Cr(t.align,v,this.right-w);else{let C=this.columnSizes.reduce((L,k)=>Math.max(L,k.height),0);g=d+Cr(t.align,this.top,this.bottom-C-t.labels.padding-this._computeTitleHeight())}let S=Cr(l,v,v+w);a.textAlign=i.textAlign(bg(l)),a.textBaseline="middle",a.strokeStyle=n.color,a.fillStyle=n.color,a.font=r.string,hl(a,n.text,S,g,r)}_computeTitleHeight(){let t=this.options.title,n=Qn(t.font),r=Pr(t.padding);return t.display?n.lineHeight+r.height:0}_getLegendItemAt(t,n){let r,o,i;if(bs(t,this.left,this.right)&&bs(n,this.top,this.bottom)){for(i=this.legendHitBoxes,r=0;r<i.length;++r)if(o=i[r],bs(t,o.left,o.left+o.width)&&bs(n,o.top,o.top+o.height))return this.legendItems[r]}return null}handleEvent(t){let n=this.options;if(!IH(t.type,n))return;let r=this._getLegendItemAt(t.x,t.y);if(t.type==="mousemove"||t.type==="mouseout"){let o=this._hoveredItem,i=EH(o,r);o&&!i&&fn(n.onLeave,[t,o,this],this),this._hoveredItem=r,r&&!i&&fn(n.onHover,[t,r,this],this)}else r&&fn(n.onClick,[t,r,this],this)}};function IH(e,t){return!!((e==="mousemove"||e==="mouseout")&&(t.onHover||t.onLeave)||t.onClick&&(e==="click"||e==="mouseup"))}var DH={id:"legend",_element:Vg,start(e,t,n){let r=e.legend=new Vg({ctx:e.ctx,options:n,chart:e});jr.configure(e,r,n),jr.addBox(e,r)},stop(e){jr.removeBox(e,e.legend),delete e.legend},beforeUpdate(e,t,n){let r=e.legend;jr.configure(e,r,n),r.options=n},afterUpdate(e){let t=e.legend;t.buildLabels(),t.adjustHitBoxes()},afterEvent(e,t){t.replay||e.legend.handleEvent(t.event)},defaults:{display:!0,position:"top",align:"center",fullSize:!0,reverse:!1,weight:1e3,onClick(e,t,n){let r=t.datasetIndex,o=n.chart;o.isDatasetVisible(r)?(o.hide(r),t.hidden=!0):(o.show(r),t.hidden=!1)},onHover:null,onLeave:null,labels:{color:e=>e.chart.options.color,boxWidth:40,padding:10,generateLabels(e){let t=e.data.datasets,{labels:{usePointStyle:n,pointStyle:r,textAlign:o,color:i}}=e.legend.options;return e._getSortedDatasetMetas().map(a=>{let l=a.controller.getStyle(n?0:void 0),c=Pr(l.borderWidth);return{text:t[a.index].label,fillStyle:l.backgroundColor,fontColor:i,hidden:!a.visible,lineCap:l.borderCapStyle,lineDash:l.borderDash,lineDashOffset:l.borderDashOffset,lineJoin:l.borderJoinStyle,lineWidth:(c.width+c.height)/4,strokeStyle:l.borderColor,pointStyle:r||l.pointStyle,rotation:l.rotation,textAlign:o||l.textAlign,borderRadius:0,datasetIndex:a.index}},this)}},title:{color:e=>e.chart.options.color,display:!1,position:"center",text:""}},descriptors:{_scriptable:e=>!e.startsWith("on"),labels:{_scriptable:e=>!["generateLabels","filter","sort"].includes(e)}}},t1=class extends Uo{constructor(t){super(),this.chart=t.chart,this.options=t.options,this.ctx=t.ctx,this._padding=void 0,this.top=void 0,this.bottom=void 0,this.left=void 0,this.right=void 0,this.width=void 0,this.height=void 0,this.position=void 0,this.weight=void 0,this.fullSize=void 0}update(t,n){let r=this.options;if(this.left=0,this.top=0,!r.display){this.width=this.height=this.right=this.bottom=0;return}this.width=this.right=t,this.height=this.bottom=n;let o=cn(r.text)?r.text.length:1;this._padding=Pr(r.padding);let i=o*Qn(r.font).lineHeight+this._padding.height;this.isHorizontal()?this.height=i:this.width=i}isHorizontal(){let t=this.options.position;return t==="top"||t==="bottom"}_drawArgs(t){let{top:n,left:r,bottom:o,right:i,options:a}=this,l=a.align,c=0,d,g,v;return this.isHorizontal()?(g=Cr(l,r,i),v=n+t,d=i-r):(a.position==="left"?(g=r+t,v=Cr(l,o,n),c=_n*-.5):(g=i-t,v=Cr(l,n,o),c=_n*.5),d=o-n),{titleX:g,titleY:v,maxWidth:d,rotation:c}}draw(){let t=this.ctx,n=this.options;if(!n.display)return;let r=Qn(n.font),i=r.lineHeight/2+this._padding.top,{titleX:a,titleY:l,maxWidth:c,rotation:d}=this._drawArgs(i);hl(t,n.text,0,0,r,{color:n.color,maxWidth:c,rotation:d,textAlign:bg(n.align),textBaseline:"middle",translation:[a,l]})}};function TH(e,t){let n=new t1({ctx:e.ctx,options:t,chart:e});jr.configure(e,n,t),jr.addBox(e,n),e.titleBlock=n}var LH={id:"title",_element:t1,start(e,t,n){TH(e,n)},stop(e){let t=e.titleBlock;jr.removeBox(e,t),delete e.titleBlock},beforeUpdate(e,t,n){let r=e.titleBlock;jr.configure(e,r,n),r.options=n},defaults:{align:"center",display:!1,font:{weight:"bold"},fullSize:!0,padding:10,position:"top",text:"",weight:2e3},defaultRoutes:{color:"color"},descriptors:{_scriptable:!0,_indexable:!1}},Lg=new WeakMap,OH={id:"subtitle",start(e,t,n){let r=new t1({ctx:e.ctx,options:n,chart:e});jr.configure(e,r,n),jr.addBox(e,r),Lg.set(e,r)},stop(e){jr.removeBox(e,Lg.get(e)),Lg.delete(e)},beforeUpdate(e,t,n){let r=Lg.get(e);jr.configure(e,r,n),r.options=n},defaults:{align:"center",display:!1,font:{weight:"normal"},fullSize:!0,padding:0,position:"top",text:"",weight:1500},defaultRoutes:{color:"color"},descriptors:{_scriptable:!0,_indexable:!1}},Xh={average(e){if(!e.length)return!1;let t,n,r=0,o=0,i=0;for(t=0,n=e.length;t<n;++t){let a=e[t].element;if(a&&a.hasValue()){let l=a.tooltipPosition();r+=l.x,o+=l.y,++i}}return{x:r/i,y:o/i}},nearest(e,t){if(!e.length)return!1;let n=t.x,r=t.y,o=Number.POSITIVE_INFINITY,i,a,l;for(i=0,a=e.length;i<a;++i){let c=e[i].element;if(c&&c.hasValue()){let d=c.getCenterPoint(),g=yg(t,d);g<o&&(o=g,l=c)}}if(l){let c=l.tooltipPosition();n=c.x,r=c.y}return{x:n,y:r}}};function Ss(e,t){return t&&(cn(t)?Array.prototype.push.apply(e,t):e.push(t)),e}function da(e){return(typeof e=="string"||e instanceof String)&&e.indexOf(`
+                  font-medium text-gray-800 dark:text-gray-200`},ot.default.createElement(nB,{wrapped:n}))))))}function S(){return n!==ra.wrapped?ot.default.createElement("div",{id:"filters",className:"flex flex-wrap"},t.filters.map((C,L)=>d(L,C))):null}return ot.default.createElement(ot.default.Fragment,null,S(),w())}var q2=rB;var Mr=ve(Ce());var _s=ve(Ce());var K2=ve(Ce());var J5=function(t){return t.reduce(function(n,r){var o=r[0],i=r[1];return n[o]=i,n},{})},Z5=typeof window!="undefined"&&window.document&&window.document.createElement?K2.useLayoutEffect:K2.useEffect;var al=ve(Ce()),wC=ve(Qc());var An="top",or="bottom",Kn="right",jn="left",G2="auto",il=[An,or,Kn,jn],oa="start",_u="end",Jk="clippingParents",Q2="viewport",Df="popper",Zk="reference",e6=il.reduce(function(e,t){return e.concat([t+"-"+oa,t+"-"+_u])},[]),X2=[].concat(il,[G2]).reduce(function(e,t){return e.concat([t,t+"-"+oa,t+"-"+_u])},[]),oB="beforeRead",iB="read",sB="afterRead",aB="beforeMain",lB="main",uB="afterMain",cB="beforeWrite",fB="write",dB="afterWrite",eC=[oB,iB,sB,aB,lB,uB,cB,fB,dB];function mr(e){return e?(e.nodeName||"").toLowerCase():null}function In(e){if(e==null)return window;if(e.toString()!=="[object Window]"){var t=e.ownerDocument;return t&&t.defaultView||window}return e}function fi(e){var t=In(e).Element;return e instanceof t||e instanceof Element}function ir(e){var t=In(e).HTMLElement;return e instanceof t||e instanceof HTMLElement}function Tf(e){if(typeof ShadowRoot=="undefined")return!1;var t=In(e).ShadowRoot;return e instanceof t||e instanceof ShadowRoot}function pB(e){var t=e.state;Object.keys(t.elements).forEach(function(n){var r=t.styles[n]||{},o=t.attributes[n]||{},i=t.elements[n];!ir(i)||!mr(i)||(Object.assign(i.style,r),Object.keys(o).forEach(function(a){var l=o[a];l===!1?i.removeAttribute(a):i.setAttribute(a,l===!0?"":l)}))})}function hB(e){var t=e.state,n={popper:{position:t.options.strategy,left:"0",top:"0",margin:"0"},arrow:{position:"absolute"},reference:{}};return Object.assign(t.elements.popper.style,n.popper),t.styles=n,t.elements.arrow&&Object.assign(t.elements.arrow.style,n.arrow),function(){Object.keys(t.elements).forEach(function(r){var o=t.elements[r],i=t.attributes[r]||{},a=Object.keys(t.styles.hasOwnProperty(r)?t.styles[r]:n[r]),l=a.reduce(function(c,d){return c[d]="",c},{});!ir(o)||!mr(o)||(Object.assign(o.style,l),Object.keys(i).forEach(function(c){o.removeAttribute(c)}))})}}var tC={name:"applyStyles",enabled:!0,phase:"write",fn:pB,effect:hB,requires:["computeStyles"]};function gr(e){return e.split("-")[0]}var qi=Math.max,xu=Math.min,ia=Math.round;function Lf(){var e=navigator.userAgentData;return e!=null&&e.brands?e.brands.map(function(t){return t.brand+"/"+t.version}).join(" "):navigator.userAgent}function Ih(){return!/^((?!chrome|android).)*safari/i.test(Lf())}function di(e,t,n){t===void 0&&(t=!1),n===void 0&&(n=!1);var r=e.getBoundingClientRect(),o=1,i=1;t&&ir(e)&&(o=e.offsetWidth>0&&ia(r.width)/e.offsetWidth||1,i=e.offsetHeight>0&&ia(r.height)/e.offsetHeight||1);var a=fi(e)?In(e):window,l=a.visualViewport,c=!Ih()&&n,d=(r.left+(c&&l?l.offsetLeft:0))/o,g=(r.top+(c&&l?l.offsetTop:0))/i,v=r.width/o,w=r.height/i;return{width:v,height:w,top:g,right:d+v,bottom:g+w,left:d,x:d,y:g}}function wu(e){var t=di(e),n=e.offsetWidth,r=e.offsetHeight;return Math.abs(t.width-n)<=1&&(n=t.width),Math.abs(t.height-r)<=1&&(r=t.height),{x:e.offsetLeft,y:e.offsetTop,width:n,height:r}}function Dh(e,t){var n=t.getRootNode&&t.getRootNode();if(e.contains(t))return!0;if(n&&Tf(n)){var r=t;do{if(r&&e.isSameNode(r))return!0;r=r.parentNode||r.host}while(r)}return!1}function to(e){return In(e).getComputedStyle(e)}function t6(e){return["table","td","th"].indexOf(mr(e))>=0}function Sr(e){return((fi(e)?e.ownerDocument:e.document)||window.document).documentElement}function sa(e){return mr(e)==="html"?e:e.assignedSlot||e.parentNode||(Tf(e)?e.host:null)||Sr(e)}function nC(e){return!ir(e)||to(e).position==="fixed"?null:e.offsetParent}function mB(e){var t=/firefox/i.test(Lf()),n=/Trident/i.test(Lf());if(n&&ir(e)){var r=to(e);if(r.position==="fixed")return null}var o=sa(e);for(Tf(o)&&(o=o.host);ir(o)&&["html","body"].indexOf(mr(o))<0;){var i=to(o);if(i.transform!=="none"||i.perspective!=="none"||i.contain==="paint"||["transform","perspective"].indexOf(i.willChange)!==-1||t&&i.willChange==="filter"||t&&i.filter&&i.filter!=="none")return o;o=o.parentNode}return null}function Ki(e){for(var t=In(e),n=nC(e);n&&t6(n)&&to(n).position==="static";)n=nC(n);return n&&(mr(n)==="html"||mr(n)==="body"&&to(n).position==="static")?t:n||mB(e)||t}function bu(e){return["top","bottom"].indexOf(e)>=0?"x":"y"}function Su(e,t,n){return qi(e,xu(t,n))}function rC(e,t,n){var r=Su(e,t,n);return r>n?n:r}function Th(){return{top:0,right:0,bottom:0,left:0}}function Lh(e){return Object.assign({},Th(),e)}function Oh(e,t){return t.reduce(function(n,r){return n[r]=e,n},{})}var gB=function(t,n){return t=typeof t=="function"?t(Object.assign({},n.rects,{placement:n.placement})):t,Lh(typeof t!="number"?t:Oh(t,il))};function yB(e){var t,n=e.state,r=e.name,o=e.options,i=n.elements.arrow,a=n.modifiersData.popperOffsets,l=gr(n.placement),c=bu(l),d=[jn,Kn].indexOf(l)>=0,g=d?"height":"width";if(!(!i||!a)){var v=gB(o.padding,n),w=wu(i),S=c==="y"?An:jn,C=c==="y"?or:Kn,L=n.rects.reference[g]+n.rects.reference[c]-a[c]-n.rects.popper[g],k=a[c]-n.rects.reference[c],M=Ki(i),P=M?c==="y"?M.clientHeight||0:M.clientWidth||0:0,D=L/2-k/2,T=v[S],O=P-w[g]-v[C],A=P/2-w[g]/2+D,R=Su(T,A,O),B=c;n.modifiersData[r]=(t={},t[B]=R,t.centerOffset=R-A,t)}}function vB(e){var t=e.state,n=e.options,r=n.element,o=r===void 0?"[data-popper-arrow]":r;o!=null&&(typeof o=="string"&&(o=t.elements.popper.querySelector(o),!o)||Dh(t.elements.popper,o)&&(t.elements.arrow=o))}var oC={name:"arrow",enabled:!0,phase:"main",fn:yB,effect:vB,requires:["popperOffsets"],requiresIfExists:["preventOverflow"]};function pi(e){return e.split("-")[1]}var _B={top:"auto",right:"auto",bottom:"auto",left:"auto"};function xB(e){var t=e.x,n=e.y,r=window,o=r.devicePixelRatio||1;return{x:ia(t*o)/o||0,y:ia(n*o)/o||0}}function iC(e){var t,n=e.popper,r=e.popperRect,o=e.placement,i=e.variation,a=e.offsets,l=e.position,c=e.gpuAcceleration,d=e.adaptive,g=e.roundOffsets,v=e.isFixed,w=a.x,S=w===void 0?0:w,C=a.y,L=C===void 0?0:C,k=typeof g=="function"?g({x:S,y:L}):{x:S,y:L};S=k.x,L=k.y;var M=a.hasOwnProperty("x"),P=a.hasOwnProperty("y"),D=jn,T=An,O=window;if(d){var A=Ki(n),R="clientHeight",B="clientWidth";if(A===In(n)&&(A=Sr(n),to(A).position!=="static"&&l==="absolute"&&(R="scrollHeight",B="scrollWidth")),A=A,o===An||(o===jn||o===Kn)&&i===_u){T=or;var Y=v&&A===O&&O.visualViewport?O.visualViewport.height:A[R];L-=Y-r.height,L*=c?1:-1}if(o===jn||(o===An||o===or)&&i===_u){D=Kn;var K=v&&A===O&&O.visualViewport?O.visualViewport.width:A[B];S-=K-r.width,S*=c?1:-1}}var Q=Object.assign({position:l},d&&_B),ne=g===!0?xB({x:S,y:L}):{x:S,y:L};if(S=ne.x,L=ne.y,c){var oe;return Object.assign({},Q,(oe={},oe[T]=P?"0":"",oe[D]=M?"0":"",oe.transform=(O.devicePixelRatio||1)<=1?"translate("+S+"px, "+L+"px)":"translate3d("+S+"px, "+L+"px, 0)",oe))}return Object.assign({},Q,(t={},t[T]=P?L+"px":"",t[D]=M?S+"px":"",t.transform="",t))}function wB(e){var t=e.state,n=e.options,r=n.gpuAcceleration,o=r===void 0?!0:r,i=n.adaptive,a=i===void 0?!0:i,l=n.roundOffsets,c=l===void 0?!0:l;if(0)var d;var g={placement:gr(t.placement),variation:pi(t.placement),popper:t.elements.popper,popperRect:t.rects.popper,gpuAcceleration:o,isFixed:t.options.strategy==="fixed"};t.modifiersData.popperOffsets!=null&&(t.styles.popper=Object.assign({},t.styles.popper,iC(Object.assign({},g,{offsets:t.modifiersData.popperOffsets,position:t.options.strategy,adaptive:a,roundOffsets:c})))),t.modifiersData.arrow!=null&&(t.styles.arrow=Object.assign({},t.styles.arrow,iC(Object.assign({},g,{offsets:t.modifiersData.arrow,position:"absolute",adaptive:!1,roundOffsets:c})))),t.attributes.popper=Object.assign({},t.attributes.popper,{"data-popper-placement":t.placement})}var sC={name:"computeStyles",enabled:!0,phase:"beforeWrite",fn:wB,data:{}};var J2={passive:!0};function bB(e){var t=e.state,n=e.instance,r=e.options,o=r.scroll,i=o===void 0?!0:o,a=r.resize,l=a===void 0?!0:a,c=In(t.elements.popper),d=[].concat(t.scrollParents.reference,t.scrollParents.popper);return i&&d.forEach(function(g){g.addEventListener("scroll",n.update,J2)}),l&&c.addEventListener("resize",n.update,J2),function(){i&&d.forEach(function(g){g.removeEventListener("scroll",n.update,J2)}),l&&c.removeEventListener("resize",n.update,J2)}}var aC={name:"eventListeners",enabled:!0,phase:"write",fn:function(){},effect:bB,data:{}};var SB={left:"right",right:"left",bottom:"top",top:"bottom"};function Of(e){return e.replace(/left|right|bottom|top/g,function(t){return SB[t]})}var MB={start:"end",end:"start"};function Z2(e){return e.replace(/start|end/g,function(t){return MB[t]})}function Mu(e){var t=In(e),n=t.pageXOffset,r=t.pageYOffset;return{scrollLeft:n,scrollTop:r}}function ku(e){return di(Sr(e)).left+Mu(e).scrollLeft}function n6(e,t){var n=In(e),r=Sr(e),o=n.visualViewport,i=r.clientWidth,a=r.clientHeight,l=0,c=0;if(o){i=o.width,a=o.height;var d=Ih();(d||!d&&t==="fixed")&&(l=o.offsetLeft,c=o.offsetTop)}return{width:i,height:a,x:l+ku(e),y:c}}function r6(e){var t,n=Sr(e),r=Mu(e),o=(t=e.ownerDocument)==null?void 0:t.body,i=qi(n.scrollWidth,n.clientWidth,o?o.scrollWidth:0,o?o.clientWidth:0),a=qi(n.scrollHeight,n.clientHeight,o?o.scrollHeight:0,o?o.clientHeight:0),l=-r.scrollLeft+ku(e),c=-r.scrollTop;return to(o||n).direction==="rtl"&&(l+=qi(n.clientWidth,o?o.clientWidth:0)-i),{width:i,height:a,x:l,y:c}}function Cu(e){var t=to(e),n=t.overflow,r=t.overflowX,o=t.overflowY;return/auto|scroll|overlay|hidden/.test(n+o+r)}function eg(e){return["html","body","#document"].indexOf(mr(e))>=0?e.ownerDocument.body:ir(e)&&Cu(e)?e:eg(sa(e))}function sl(e,t){var n;t===void 0&&(t=[]);var r=eg(e),o=r===((n=e.ownerDocument)==null?void 0:n.body),i=In(r),a=o?[i].concat(i.visualViewport||[],Cu(r)?r:[]):r,l=t.concat(a);return o?l:l.concat(sl(sa(a)))}function Nf(e){return Object.assign({},e,{left:e.x,top:e.y,right:e.x+e.width,bottom:e.y+e.height})}function kB(e,t){var n=di(e,!1,t==="fixed");return n.top=n.top+e.clientTop,n.left=n.left+e.clientLeft,n.bottom=n.top+e.clientHeight,n.right=n.left+e.clientWidth,n.width=e.clientWidth,n.height=e.clientHeight,n.x=n.left,n.y=n.top,n}function lC(e,t,n){return t===Q2?Nf(n6(e,n)):fi(t)?kB(t,n):Nf(r6(Sr(e)))}function CB(e){var t=sl(sa(e)),n=["absolute","fixed"].indexOf(to(e).position)>=0,r=n&&ir(e)?Ki(e):e;return fi(r)?t.filter(function(o){return fi(o)&&Dh(o,r)&&mr(o)!=="body"}):[]}function o6(e,t,n,r){var o=t==="clippingParents"?CB(e):[].concat(t),i=[].concat(o,[n]),a=i[0],l=i.reduce(function(c,d){var g=lC(e,d,r);return c.top=qi(g.top,c.top),c.right=xu(g.right,c.right),c.bottom=xu(g.bottom,c.bottom),c.left=qi(g.left,c.left),c},lC(e,a,r));return l.width=l.right-l.left,l.height=l.bottom-l.top,l.x=l.left,l.y=l.top,l}function Nh(e){var t=e.reference,n=e.element,r=e.placement,o=r?gr(r):null,i=r?pi(r):null,a=t.x+t.width/2-n.width/2,l=t.y+t.height/2-n.height/2,c;switch(o){case An:c={x:a,y:t.y-n.height};break;case or:c={x:a,y:t.y+t.height};break;case Kn:c={x:t.x+t.width,y:l};break;case jn:c={x:t.x-n.width,y:l};break;default:c={x:t.x,y:t.y}}var d=o?bu(o):null;if(d!=null){var g=d==="y"?"height":"width";switch(i){case oa:c[d]=c[d]-(t[g]/2-n[g]/2);break;case _u:c[d]=c[d]+(t[g]/2-n[g]/2);break;default:}}return c}function Gi(e,t){t===void 0&&(t={});var n=t,r=n.placement,o=r===void 0?e.placement:r,i=n.strategy,a=i===void 0?e.strategy:i,l=n.boundary,c=l===void 0?Jk:l,d=n.rootBoundary,g=d===void 0?Q2:d,v=n.elementContext,w=v===void 0?Df:v,S=n.altBoundary,C=S===void 0?!1:S,L=n.padding,k=L===void 0?0:L,M=Lh(typeof k!="number"?k:Oh(k,il)),P=w===Df?Zk:Df,D=e.rects.popper,T=e.elements[C?P:w],O=o6(fi(T)?T:T.contextElement||Sr(e.elements.popper),c,g,a),A=di(e.elements.reference),R=Nh({reference:A,element:D,strategy:"absolute",placement:o}),B=Nf(Object.assign({},D,R)),Y=w===Df?B:A,K={top:O.top-Y.top+M.top,bottom:Y.bottom-O.bottom+M.bottom,left:O.left-Y.left+M.left,right:Y.right-O.right+M.right},Q=e.modifiersData.offset;if(w===Df&&Q){var ne=Q[o];Object.keys(K).forEach(function(oe){var ie=[Kn,or].indexOf(oe)>=0?1:-1,te=[An,or].indexOf(oe)>=0?"y":"x";K[oe]+=ne[te]*ie})}return K}function i6(e,t){t===void 0&&(t={});var n=t,r=n.placement,o=n.boundary,i=n.rootBoundary,a=n.padding,l=n.flipVariations,c=n.allowedAutoPlacements,d=c===void 0?X2:c,g=pi(r),v=g?l?e6:e6.filter(function(C){return pi(C)===g}):il,w=v.filter(function(C){return d.indexOf(C)>=0});w.length===0&&(w=v);var S=w.reduce(function(C,L){return C[L]=Gi(e,{placement:L,boundary:o,rootBoundary:i,padding:a})[gr(L)],C},{});return Object.keys(S).sort(function(C,L){return S[C]-S[L]})}function PB(e){if(gr(e)===G2)return[];var t=Of(e);return[Z2(e),t,Z2(t)]}function EB(e){var t=e.state,n=e.options,r=e.name;if(!t.modifiersData[r]._skip){for(var o=n.mainAxis,i=o===void 0?!0:o,a=n.altAxis,l=a===void 0?!0:a,c=n.fallbackPlacements,d=n.padding,g=n.boundary,v=n.rootBoundary,w=n.altBoundary,S=n.flipVariations,C=S===void 0?!0:S,L=n.allowedAutoPlacements,k=t.options.placement,M=gr(k),P=M===k,D=c||(P||!C?[Of(k)]:PB(k)),T=[k].concat(D).reduce(function(it,_t){return it.concat(gr(_t)===G2?i6(t,{placement:_t,boundary:g,rootBoundary:v,padding:d,flipVariations:C,allowedAutoPlacements:L}):_t)},[]),O=t.rects.reference,A=t.rects.popper,R=new Map,B=!0,Y=T[0],K=0;K<T.length;K++){var Q=T[K],ne=gr(Q),oe=pi(Q)===oa,ie=[An,or].indexOf(ne)>=0,te=ie?"width":"height",z=Gi(t,{placement:Q,boundary:g,rootBoundary:v,altBoundary:w,padding:d}),V=ie?oe?Kn:jn:oe?or:An;O[te]>A[te]&&(V=Of(V));var p=Of(V),fe=[];if(i&&fe.push(z[ne]<=0),l&&fe.push(z[V]<=0,z[p]<=0),fe.every(function(it){return it})){Y=Q,B=!1;break}R.set(Q,fe)}if(B)for(var xe=C?3:1,Me=function(_t){var Je=T.find(function(Te){var je=R.get(Te);if(je)return je.slice(0,_t).every(function(st){return st})});if(Je)return Y=Je,"break"},Ae=xe;Ae>0;Ae--){var qe=Me(Ae);if(qe==="break")break}t.placement!==Y&&(t.modifiersData[r]._skip=!0,t.placement=Y,t.reset=!0)}}var uC={name:"flip",enabled:!0,phase:"main",fn:EB,requiresIfExists:["offset"],data:{_skip:!1}};function cC(e,t,n){return n===void 0&&(n={x:0,y:0}),{top:e.top-t.height-n.y,right:e.right-t.width+n.x,bottom:e.bottom-t.height+n.y,left:e.left-t.width-n.x}}function fC(e){return[An,Kn,or,jn].some(function(t){return e[t]>=0})}function IB(e){var t=e.state,n=e.name,r=t.rects.reference,o=t.rects.popper,i=t.modifiersData.preventOverflow,a=Gi(t,{elementContext:"reference"}),l=Gi(t,{altBoundary:!0}),c=cC(a,r),d=cC(l,o,i),g=fC(c),v=fC(d);t.modifiersData[n]={referenceClippingOffsets:c,popperEscapeOffsets:d,isReferenceHidden:g,hasPopperEscaped:v},t.attributes.popper=Object.assign({},t.attributes.popper,{"data-popper-reference-hidden":g,"data-popper-escaped":v})}var dC={name:"hide",enabled:!0,phase:"main",requiresIfExists:["preventOverflow"],fn:IB};function DB(e,t,n){var r=gr(e),o=[jn,An].indexOf(r)>=0?-1:1,i=typeof n=="function"?n(Object.assign({},t,{placement:e})):n,a=i[0],l=i[1];return a=a||0,l=(l||0)*o,[jn,Kn].indexOf(r)>=0?{x:l,y:a}:{x:a,y:l}}function TB(e){var t=e.state,n=e.options,r=e.name,o=n.offset,i=o===void 0?[0,0]:o,a=X2.reduce(function(g,v){return g[v]=DB(v,t.rects,i),g},{}),l=a[t.placement],c=l.x,d=l.y;t.modifiersData.popperOffsets!=null&&(t.modifiersData.popperOffsets.x+=c,t.modifiersData.popperOffsets.y+=d),t.modifiersData[r]=a}var pC={name:"offset",enabled:!0,phase:"main",requires:["popperOffsets"],fn:TB};function LB(e){var t=e.state,n=e.name;t.modifiersData[n]=Nh({reference:t.rects.reference,element:t.rects.popper,strategy:"absolute",placement:t.placement})}var hC={name:"popperOffsets",enabled:!0,phase:"read",fn:LB,data:{}};function s6(e){return e==="x"?"y":"x"}function OB(e){var t=e.state,n=e.options,r=e.name,o=n.mainAxis,i=o===void 0?!0:o,a=n.altAxis,l=a===void 0?!1:a,c=n.boundary,d=n.rootBoundary,g=n.altBoundary,v=n.padding,w=n.tether,S=w===void 0?!0:w,C=n.tetherOffset,L=C===void 0?0:C,k=Gi(t,{boundary:c,rootBoundary:d,padding:v,altBoundary:g}),M=gr(t.placement),P=pi(t.placement),D=!P,T=bu(M),O=s6(T),A=t.modifiersData.popperOffsets,R=t.rects.reference,B=t.rects.popper,Y=typeof L=="function"?L(Object.assign({},t.rects,{placement:t.placement})):L,K=typeof Y=="number"?{mainAxis:Y,altAxis:Y}:Object.assign({mainAxis:0,altAxis:0},Y),Q=t.modifiersData.offset?t.modifiersData.offset[t.placement]:null,ne={x:0,y:0};if(A){if(i){var oe,ie=T==="y"?An:jn,te=T==="y"?or:Kn,z=T==="y"?"height":"width",V=A[T],p=V+k[ie],fe=V-k[te],xe=S?-B[z]/2:0,Me=P===oa?R[z]:B[z],Ae=P===oa?-B[z]:-R[z],qe=t.elements.arrow,it=S&&qe?wu(qe):{width:0,height:0},_t=t.modifiersData["arrow#persistent"]?t.modifiersData["arrow#persistent"].padding:Th(),Je=_t[ie],Te=_t[te],je=Su(0,R[z],it[z]),st=D?R[z]/2-xe-je-Je-K.mainAxis:Me-je-Je-K.mainAxis,Ft=D?-R[z]/2+xe+je+Te+K.mainAxis:Ae+je+Te+K.mainAxis,ze=t.elements.arrow&&Ki(t.elements.arrow),Ze=ze?T==="y"?ze.clientTop||0:ze.clientLeft||0:0,bt=(oe=Q==null?void 0:Q[T])!=null?oe:0,_e=V+st-bt-Ze,re=V+Ft-bt,ge=Su(S?xu(p,_e):p,V,S?qi(fe,re):fe);A[T]=ge,ne[T]=ge-V}if(l){var ce,Ie=T==="x"?An:jn,tt=T==="x"?or:Kn,nt=A[O],ft=O==="y"?"height":"width",Ge=nt+k[Ie],yt=nt-k[tt],Ye=[An,jn].indexOf(M)!==-1,Kt=(ce=Q==null?void 0:Q[O])!=null?ce:0,Ko=Ye?Ge:nt-R[ft]-B[ft]-Kt+K.altAxis,Jn=Ye?nt+R[ft]+B[ft]-Kt-K.altAxis:yt,Ju=S&&Ye?rC(Ko,nt,Jn):Su(S?Ko:Ge,nt,S?Jn:yt);A[O]=Ju,ne[O]=Ju-nt}t.modifiersData[r]=ne}}var mC={name:"preventOverflow",enabled:!0,phase:"main",fn:OB,requiresIfExists:["offset"]};function a6(e){return{scrollLeft:e.scrollLeft,scrollTop:e.scrollTop}}function l6(e){return e===In(e)||!ir(e)?Mu(e):a6(e)}function NB(e){var t=e.getBoundingClientRect(),n=ia(t.width)/e.offsetWidth||1,r=ia(t.height)/e.offsetHeight||1;return n!==1||r!==1}function u6(e,t,n){n===void 0&&(n=!1);var r=ir(t),o=ir(t)&&NB(t),i=Sr(t),a=di(e,o,n),l={scrollLeft:0,scrollTop:0},c={x:0,y:0};return(r||!r&&!n)&&((mr(t)!=="body"||Cu(i))&&(l=l6(t)),ir(t)?(c=di(t,!0),c.x+=t.clientLeft,c.y+=t.clientTop):i&&(c.x=ku(i))),{x:a.left+l.scrollLeft-c.x,y:a.top+l.scrollTop-c.y,width:a.width,height:a.height}}function AB(e){var t=new Map,n=new Set,r=[];e.forEach(function(i){t.set(i.name,i)});function o(i){n.add(i.name);var a=[].concat(i.requires||[],i.requiresIfExists||[]);a.forEach(function(l){if(!n.has(l)){var c=t.get(l);c&&o(c)}}),r.push(i)}return e.forEach(function(i){n.has(i.name)||o(i)}),r}function c6(e){var t=AB(e);return eC.reduce(function(n,r){return n.concat(t.filter(function(o){return o.phase===r}))},[])}function f6(e){var t;return function(){return t||(t=new Promise(function(n){Promise.resolve().then(function(){t=void 0,n(e())})})),t}}function d6(e){var t=e.reduce(function(n,r){var o=n[r.name];return n[r.name]=o?Object.assign({},o,r,{options:Object.assign({},o.options,r.options),data:Object.assign({},o.data,r.data)}):r,n},{});return Object.keys(t).map(function(n){return t[n]})}var gC={placement:"bottom",modifiers:[],strategy:"absolute"};function yC(){for(var e=arguments.length,t=new Array(e),n=0;n<e;n++)t[n]=arguments[n];return!t.some(function(r){return!(r&&typeof r.getBoundingClientRect=="function")})}function vC(e){e===void 0&&(e={});var t=e,n=t.defaultModifiers,r=n===void 0?[]:n,o=t.defaultOptions,i=o===void 0?gC:o;return function(l,c,d){d===void 0&&(d=i);var g={placement:"bottom",orderedModifiers:[],options:Object.assign({},gC,i),modifiersData:{},elements:{reference:l,popper:c},attributes:{},styles:{}},v=[],w=!1,S={state:g,setOptions:function(M){var P=typeof M=="function"?M(g.options):M;L(),g.options=Object.assign({},i,g.options,P),g.scrollParents={reference:fi(l)?sl(l):l.contextElement?sl(l.contextElement):[],popper:sl(c)};var D=c6(d6([].concat(r,g.options.modifiers)));if(g.orderedModifiers=D.filter(function(Q){return Q.enabled}),0){var T;if(getBasePlacement(g.options.placement)===auto)var O;var A,R,B,Y,K}return C(),S.update()},forceUpdate:function(){if(!w){var M=g.elements,P=M.reference,D=M.popper;if(yC(P,D)){g.rects={reference:u6(P,Ki(D),g.options.strategy==="fixed"),popper:wu(D)},g.reset=!1,g.placement=g.options.placement,g.orderedModifiers.forEach(function(Q){return g.modifiersData[Q.name]=Object.assign({},Q.data)});for(var T=0,O=0;O<g.orderedModifiers.length;O++){if(g.reset===!0){g.reset=!1,O=-1;continue}var A=g.orderedModifiers[O],R=A.fn,B=A.options,Y=B===void 0?{}:B,K=A.name;typeof R=="function"&&(g=R({state:g,options:Y,name:K,instance:S})||g)}}}},update:f6(function(){return new Promise(function(k){S.forceUpdate(),k(g)})}),destroy:function(){L(),w=!0}};if(!yC(l,c))return S;S.setOptions(d).then(function(k){!w&&d.onFirstUpdate&&d.onFirstUpdate(k)});function C(){g.orderedModifiers.forEach(function(k){var M=k.name,P=k.options,D=P===void 0?{}:P,T=k.effect;if(typeof T=="function"){var O=T({state:g,name:M,instance:S,options:D}),A=function(){};v.push(O||A)}})}function L(){v.forEach(function(k){return k()}),v=[]}return S}}var FB=[aC,hC,sC,tC,pC,uC,mC,oC,dC],p6=vC({defaultModifiers:FB});var bC=ve(xC());var VB=[],h6=function(t,n,r){r===void 0&&(r={});var o=al.useRef(null),i={onFirstUpdate:r.onFirstUpdate,placement:r.placement||"bottom",strategy:r.strategy||"absolute",modifiers:r.modifiers||VB},a=al.useState({styles:{popper:{position:i.strategy,left:"0",top:"0"},arrow:{position:"absolute"}},attributes:{}}),l=a[0],c=a[1],d=al.useMemo(function(){return{name:"updateState",enabled:!0,phase:"write",fn:function(S){var C=S.state,L=Object.keys(C.elements);wC.flushSync(function(){c({styles:J5(L.map(function(k){return[k,C.styles[k]||{}]})),attributes:J5(L.map(function(k){return[k,C.attributes[k]]}))})})},requires:["computeStyles"]}},[]),g=al.useMemo(function(){var w={onFirstUpdate:i.onFirstUpdate,placement:i.placement,strategy:i.strategy,modifiers:[].concat(i.modifiers,[d,{name:"applyStyles",enabled:!1}])};return(0,bC.default)(o.current,w)?o.current||w:(o.current=w,w)},[i.onFirstUpdate,i.placement,i.strategy,i.modifiers,d]),v=al.useRef();return Z5(function(){v.current&&v.current.setOptions(g)},[g]),Z5(function(){if(!(t==null||n==null)){var w=r.createPopper||p6,S=w(t,n,g);return v.current=S,function(){S.destroy(),v.current=null}}},[t,n,r.createPopper]),{state:v.current?v.current.state:null,styles:l.styles,attributes:l.attributes,update:v.current?v.current.update:null,forceUpdate:v.current?v.current.forceUpdate:null}};var SC=ve(zr());function ng({children:e,info:t,className:n,onClick:r,boundary:o}){let[i,a]=(0,_s.useState)(!1),[l,c]=(0,_s.useState)(null),[d,g]=(0,_s.useState)(null),[v,w]=(0,_s.useState)(null),{styles:S,attributes:C}=h6(l,d,{placement:"top",modifiers:[{name:"arrow",options:{element:v}},{name:"offset",options:{offset:[0,4]}},o&&{name:"preventOverflow",options:{boundary:o}}]});return _s.default.createElement("div",{className:(0,SC.default)("relative",n)},_s.default.createElement("div",{ref:c,onMouseEnter:()=>a(!0),onMouseLeave:()=>a(!1),onClick:r},e),t&&i&&_s.default.createElement("div",he(Z({ref:g,style:S.popper},C.popper),{className:"z-50 p-2 rounded text-sm text-gray-100 font-bold popper-tooltip",role:"tooltip"}),t,_s.default.createElement("div",{ref:w,style:S.arrow,className:"tooltip-arrow"})))}var rg=ve(Ce());function og({lastLoadTimestamp:e}){let[t,n]=(0,rg.useState)(new Date);return(0,rg.useEffect)(()=>{let r=setInterval(()=>n(new Date),1e3);return()=>clearInterval(r)},[]),Math.round(Math.abs(e-t)/1e3)}function m6({tooltipBoundary:e}){let{query:t,lastLoadTimestamp:n}=Fe(),r=Ue(),[o,i]=(0,Mr.useState)(null),a=(0,Mr.useCallback)(()=>{It(`/api/stats/${encodeURIComponent(r.domain)}/current-visitors`).then(c=>i(c))},[r.domain]);(0,Mr.useEffect)(()=>(document.addEventListener("tick",a),()=>{document.removeEventListener("tick",a)}),[a]),(0,Mr.useEffect)(()=>{a()},[t,a]);function l(){return Mr.default.createElement("div",null,Mr.default.createElement("p",{className:"whitespace-nowrap text-small"},"Last updated ",Mr.default.createElement(og,{lastLoadTimestamp:n}),"s ago"),Mr.default.createElement("p",{className:"whitespace-nowrap font-normal text-xs"},"Click to view realtime dashboard"))}return o!==null&&t.filters.length===0?Mr.default.createElement(ng,{info:l(),boundary:e},Mr.default.createElement(Zr,{search:c=>he(Z({},c),{period:"realtime"}),className:"block ml-1 md:ml-2 mr-auto text-xs md:text-sm font-bold text-gray-500 dark:text-gray-300"},Mr.default.createElement("svg",{className:"inline w-2 mr-1 md:mr-2 text-green-500 fill-current",viewBox:"0 0 16 16",xmlns:"http://www.w3.org/2000/svg"},Mr.default.createElement("circle",{cx:"8",cy:"8",r:"8"})),o," ",Mr.default.createElement("span",{className:"hidden sm:inline-block"},"current visitor",o===1?"":"s"))):null}var nn=ve(Ce());function Gn(e){if(e>=1e3&&e<1e6){let t=e/1e3;return t===Math.floor(t)||e>=1e5?Math.floor(t)+"k":Math.floor(t*10)/10+"k"}else if(e>=1e6&&e<1e9){let t=e/1e6;return t===Math.floor(t)||e>=1e8?Math.floor(t)+"M":Math.floor(t*10)/10+"M"}else if(e>=1e9&&e<1e12){let t=e/1e9;return t===Math.floor(t)||e>=1e11?Math.floor(t)+"B":Math.floor(t*10)/10+"B"}else return e}function HB(e,t){return("000"+e).slice(t*-1)}function ll(e){let t=Math.floor(e/60/60),n=Math.floor(e/60)%60,r=Math.floor(e-n*60-t*60*60);return t>0?`${t}h ${n}m ${r}s`:n>0?`${n}m ${HB(r,2)}s`:`${r}s`}function Ah(e){return typeof e=="number"?e+"%":"-"}function ig(e,t){let n=e.period==="realtime",r=ct(e),o=Ro(e,"page").length>0;return n&&r?["visitors"]:n?["visitors","pageviews"]:r&&Uk(e,t)?["visitors","events","average_revenue","total_revenue","conversion_rate"]:r?["visitors","events","conversion_rate"]:o?["visitors","visits","pageviews","bounce_rate","time_on_page"]:["visitors","visits","pageviews","views_per_visit","bounce_rate","visit_duration"]}var sg={visitors:"Visitors",pageviews:"Pageviews",events:"Total Conversions",views_per_visit:"Views per Visit",visits:"Visits",bounce_rate:"Bounce Rate",visit_duration:"Visit Duration",conversions:"Converted Visitors",conversion_rate:"Conversion Rate",average_revenue:"Average Revenue",total_revenue:"Total Revenue"},ag={visitors:Gn,pageviews:Gn,events:Gn,visits:Gn,views_per_visit:e=>e,bounce_rate:e=>`${e}%`,visit_duration:ll,conversions:Gn,conversion_rate:e=>`${e}%`,total_revenue:Gn,average_revenue:Gn},UB=function(e){return e?[{data:e,borderColor:"rgba(60,70,110,0.2)",pointBackgroundColor:"rgba(60,70,110,0.2)",pointHoverBackgroundColor:"rgba(60, 70, 110)",yAxisID:"yComparison"}]:[]},WB=function(e,t){if(!t)return[];let n=e.slice(t-1,t+1);return[{data:new Array(t-1).concat(n),borderDash:[3,3],borderColor:"rgba(101,116,205)",pointHoverBackgroundColor:"rgba(71, 87, 193)",yAxisID:"y"}]},$B=function(e,t){return[{data:t?e.slice(0,t):e,borderColor:"rgba(101,116,205)",pointBackgroundColor:"rgba(101,116,205)",pointHoverBackgroundColor:"rgba(71, 87, 193)",yAxisID:"y"}]},MC=(e,t,n,r,o)=>{var i=r.createLinearGradient(0,0,0,300),a=r.createLinearGradient(0,0,0,300);i.addColorStop(0,"rgba(101,116,205, 0.2)"),i.addColorStop(1,"rgba(101,116,205, 0)"),a.addColorStop(0,"rgba(101,116,205, 0.075)"),a.addColorStop(1,"rgba(101,116,205, 0)");let l={label:o,borderWidth:3,pointBorderColor:"transparent",pointHoverRadius:4,backgroundColor:i,fill:!0};return[...$B(e,n),...WB(e,n),...UB(t)].map(d=>Object.assign(d,l))};var un=ve(Ce());var Fh=ve(zr());function g6({condition:e,children:t}){return e?t:null}function kC(e,t,n=!1){let r=Gn(Math.abs(t)),o=(0,Fh.default)({"pl-2 text-xs dark:text-gray-100":!n,"pl-2 text-xs text-gray-100":n}),i=(0,Fh.default)({"pl-2 text-xs text-gray-700 dark:text-gray-300":!n,"pl-2 text-xs text-gray-300":n});return t>0?un.default.createElement("span",{className:o},un.default.createElement("span",{className:(e==="Bounce rate"?"text-red-400":"text-green-500")+" font-bold"},"\u2191")," ",r,"%"):t<0?un.default.createElement("span",{className:o},un.default.createElement("span",{className:(e==="Bounce rate"?"text-green-500":"text-red-400")+" font-bold"},"\u2193")," ",r,"%"):t===0?un.default.createElement("span",{className:i},"\u3030 0%"):null}function CC(e,t){return["visit duration","time on page"].includes(e.toLowerCase())?ll(t):["bounce rate","conversion rate"].includes(e.toLowerCase())?t+"%":["average revenue","total revenue"].includes(e.toLowerCase())?t==null?void 0:t.short:Gn(t)}function y6(e,t){return["visit duration","time on page"].includes(e.toLowerCase())?ll(t):["bounce rate","conversion rate"].includes(e.toLowerCase())?t+"%":["average revenue","total revenue"].includes(e.toLowerCase())?t==null?void 0:t.long:(t||0).toLocaleString()}function v6({data:e,onMetricUpdate:t,tooltipBoundary:n}){let{query:r,lastLoadTimestamp:o}=Fe(),i=Ue();function a(C){let L=C.name.toLowerCase();return L=C.value===1?L.slice(0,-1):L,un.default.createElement("div",null,r.comparison&&un.default.createElement("div",{className:"whitespace-nowrap"},y6(C.name,C.value)," vs. ",y6(C.name,C.comparison_value)," ",L,un.default.createElement("span",{className:"ml-2"},kC(C.name,C.change,!0))),!r.comparison&&un.default.createElement("div",{className:"whitespace-nowrap"},y6(C.name,C.value)," ",L),C.name==="Current visitors"&&un.default.createElement("p",{className:"font-normal text-xs"},"Last updated ",un.default.createElement(og,{lastLoadTimestamp:o}),"s ago"))}function l(C){let L=ig(r,i);return C.graph_metric&&L.includes(C.graph_metric)}function c(C){l(C)&&(an(`metric__${i.domain}`,C.graph_metric),t(C.graph_metric))}function d(){return un.default.createElement("div",{key:"dot",className:"block pulsating-circle",style:{left:"125px",top:"52px"}})}function g(){return ln(`metric__${i.domain}`)}function v(C){let L=C.graph_metric===g(),[k,M]=C.name.split(/(\(.+\))/g),P=(0,Fh.default)("text-xs font-bold tracking-wide text-gray-500 uppercase dark:text-gray-400 whitespace-nowrap flex w-content border-b",{"text-indigo-700 dark:text-indigo-500 border-indigo-700 dark:border-indigo-500":L,"group-hover:text-indigo-700 dark:group-hover:text-indigo-500 border-transparent":!L});return un.default.createElement("div",{className:P},k,M&&un.default.createElement("span",{className:"hidden sm:inline-block ml-1"},M))}function w(C,L){let k=(0,Fh.default)("px-4 md:px-6 w-1/2 my-4 lg:w-auto group select-none",{"cursor-pointer":l(C),"lg:border-l border-gray-300":L>0,"border-r lg:border-r-0":L%2===0});return un.default.createElement(ng,{key:C.name,info:a(C,r),className:k,onClick:()=>{c(C)},boundary:n},v(C),un.default.createElement("div",{className:"my-1 space-y-2"},un.default.createElement("div",null,un.default.createElement("span",{className:"flex items-center justify-between whitespace-nowrap"},un.default.createElement("p",{className:"font-bold text-xl dark:text-gray-100",id:C.graph_metric},CC(C.name,C.value)),un.default.createElement(g6,{condition:!r.comparison},kC(C.name,C.change))),un.default.createElement(g6,{condition:r.comparison},un.default.createElement("p",{className:"text-xs dark:text-gray-100"},mu(i,e.from,e.to)))),un.default.createElement(g6,{condition:r.comparison},un.default.createElement("div",null,un.default.createElement("p",{className:"font-bold text-xl text-gray-500 dark:text-gray-400"},CC(C.name,C.comparison_value)),un.default.createElement("p",{className:"text-xs text-gray-500 dark:text-gray-400"},mu(i,e.comparing_from,e.comparing_to))))))}let S=e&&e.top_stats.map(w);return S&&r.period==="realtime"&&S.push(d()),S||null}var kr=ve(Ce()),EC=ve(zr());var PC={minute:"Minutes",hour:"Hours",date:"Days",week:"Weeks",month:"Months"};function IC(e,t){return t.period==="custom"?t.to.diff(t.from,"days")<7?["date"]:t.to.diff(t.from,"months")<1?["date","week"]:t.to.diff(t.from,"months")<12?["date","week","month"]:["week","month"]:e.validIntervalsByPeriod[t.period]}function YB(e,t){let n={day:"hour","7d":"date","6mo":"month","12mo":"month",year:"month"};return e.period==="custom"?qB(e.from,e.to):n[e.period]||t[0]}function qB(e,t){return t.diff(e,"days")<30?"date":t.diff(e,"months")<6?"week":"month"}function KB(e,t){return ln(`interval__${e}__${t}`)}function GB(e,t,n){an(`interval__${e}__${t}`,n)}function QB(e){let t=(0,kr.useCallback)(n=>{var r;$k(n,"i")&&((r=e.current)==null||r.click())},[]);(0,kr.useEffect)(()=>(document.addEventListener("keydown",t),()=>document.removeEventListener("keydown",t)),[t])}var Af=function(e,t){let n=IC(e,t),r=KB(t.period,e.domain),o=YB(t,n);return r&&n.includes(r)?r:o};function DC({onIntervalUpdate:e}){let{query:t}=Fe(),n=Ue();if(t.period=="realtime")return null;let r=kr.default.useRef(null),o=IC(n,t),i=Af(n,t);QB(r);function a(c){GB(t.period,n.domain,c),e(c)}function l(c){return kr.default.createElement(Vt.Item,{onClick:()=>a(c),key:c,disabled:c==i},({active:d})=>kr.default.createElement("span",{className:(0,EC.default)({"bg-gray-100 dark:bg-gray-900 text-gray-900 dark:text-gray-200 cursor-pointer":d,"text-gray-700 dark:text-gray-200":!d,"font-bold cursor-none select-none":c==i},"block px-4 py-2 text-sm")},PC[c]))}return kr.default.createElement(Vt,{as:"div",className:"relative inline-block pl-2"},({open:c})=>kr.default.createElement(kr.default.Fragment,null,kr.default.createElement(Vt.Button,{ref:r,className:"text-sm inline-flex focus:outline-none text-gray-700 dark:text-gray-300 hover:text-indigo-600 dark:hover:text-indigo-600 items-center"},PC[i],kr.default.createElement(br,{className:"ml-1 h-4 w-4","aria-hidden":"true"})),kr.default.createElement(pr,{as:kr.Fragment,show:c,enter:"transition ease-out duration-100",enterFrom:"opacity-0 scale-95",enterTo:"opacity-100 scale-100",leave:"transition ease-in duration-75",leaveFrom:"opacity-100 scale-100",leaveTo:"opacity-0 scale-95"},kr.default.createElement(Vt.Items,{className:"py-1 text-left origin-top-right absolute right-0 mt-2 w-56 rounded-md shadow-lg bg-white dark:bg-gray-800 ring-1 ring-black ring-opacity-5 focus:outline-none z-10",static:!0},o.map(l)))))}var hi=ve(Ce());function _6(){let e=Ue(),{query:t}=Fe(),[n,r]=(0,hi.useState)(!1);function o(){r(!0),document.cookie="exporting=",i()}function i(){document.cookie.includes("exporting")?setTimeout(i,1e3):r(!1)}function a(){return hi.default.createElement("svg",{className:"animate-spin h-4 w-4 text-indigo-500",xmlns:"http://www.w3.org/2000/svg",fill:"none",viewBox:"0 0 24 24"},hi.default.createElement("circle",{className:"opacity-25",cx:"12",cy:"12",r:"10",stroke:"currentColor",strokeWidth:"4"}),hi.default.createElement("path",{className:"opacity-75",fill:"currentColor",d:"M4 12a8 8 0 018-8V0C5.373 0 0 5.373 0 12h4zm2 5.291A7.962 7.962 0 014 12H0c0 3.042 1.135 5.824 3 7.938l3-2.647z"}))}function l(){let c=Af(e,t),d=U5(t,[{interval:c}]),g=`/api/stats/${encodeURIComponent(e.domain)}/export${d}`;return hi.default.createElement("a",{href:g,download:!0,onClick:o},hi.default.createElement("svg",{className:"absolute text-gray-700 feather dark:text-gray-300 hover:text-indigo-600 dark:hover:text-indigo-600",xmlns:"http://www.w3.org/2000/svg",viewBox:"0 0 24 24",fill:"none",stroke:"currentColor",strokeWidth:"2",strokeLinecap:"round",strokeLinejoin:"round"},hi.default.createElement("path",{d:"M21 15v4a2 2 0 0 1-2 2H5a2 2 0 0 1-2-2v-4"}),hi.default.createElement("polyline",{points:"7 10 12 15 17 10"}),hi.default.createElement("line",{x1:"12",y1:"15",x2:"12",y2:"3"})))}return hi.default.createElement("div",{className:"w-4 h-4 mx-2"},n&&a(),!n&&l())}var lg=ve(Ce());var TC=ve(zr());function x6({tooltipMessage:e,disabled:t}){let{query:n}=Fe(),r=n.with_imported,o=(0,TC.default)("mt-0.5",{"dark:text-gray-300 text-gray-700":r,"dark:text-gray-500 text-gray-400":!r});return lg.default.createElement("div",{tooltip:e,className:"w-4 h-4 mx-2"},lg.default.createElement(Zr,{disabled:t,search:i=>he(Z({},i),{with_imported:!r})},lg.default.createElement(u5,{className:o})))}var ug=ve(Ce());function w6({topStatData:e}){let t=e==null?void 0:e.samplePercent;return t&&t<100?ug.default.createElement("div",{tooltip:`Stats based on a ${t}% sample of all visitors`,className:"cursor-pointer w-4 h-4 mx-2"},ug.default.createElement("svg",{className:"absolute w-4 h-4 dark:text-gray-300 text-gray-700",xmlns:"http://www.w3.org/2000/svg",fill:"none",viewBox:"0 0 24 24",stroke:"currentColor"},ug.default.createElement("path",{strokeLinecap:"round",strokeLinejoin:"round",strokeWidth:"2",d:"M13 16h-1v-4h-1m1-4h.01M21 12a9 9 0 11-18 0 9 9 0 0118 0z"}))):null}var LC=ve(Ce());function Bo({className:e,show:t,children:n}){return LC.default.createElement("div",{className:`${e||""} ${t?"fade-enter-active":"fade-enter"}`},n)}var sd=ve(Ce());function ws(){}var qC=function(){let e=0;return function(){return e++}}();function Ht(e){return e===null||typeof e=="undefined"}function cn(e){if(Array.isArray&&Array.isArray(e))return!0;let t=Object.prototype.toString.call(e);return t.slice(0,7)==="[object"&&t.slice(-6)==="Array]"}function Lt(e){return e!==null&&Object.prototype.toString.call(e)==="[object Object]"}var Fn=e=>(typeof e=="number"||e instanceof Number)&&isFinite(+e);function jo(e,t){return Fn(e)?e:t}function Mt(e,t){return typeof e=="undefined"?t:e}var KC=(e,t)=>typeof e=="string"&&e.endsWith("%")?parseFloat(e)/100:e/t,I6=(e,t)=>typeof e=="string"&&e.endsWith("%")?parseFloat(e)/100*t:+e;function fn(e,t,n){if(e&&typeof e.call=="function")return e.apply(n,t)}function Xt(e,t,n,r){let o,i,a;if(cn(e))if(i=e.length,r)for(o=i-1;o>=0;o--)t.call(n,e[o],o);else for(o=0;o<i;o++)t.call(n,e[o],o);else if(Lt(e))for(a=Object.keys(e),i=a.length,o=0;o<i;o++)t.call(n,e[a[o]],a[o])}function Vh(e,t){let n,r,o,i;if(!e||!t||e.length!==t.length)return!1;for(n=0,r=e.length;n<r;++n)if(o=e[n],i=t[n],o.datasetIndex!==i.datasetIndex||o.index!==i.index)return!1;return!0}function mg(e){if(cn(e))return e.map(mg);if(Lt(e)){let t=Object.create(null),n=Object.keys(e),r=n.length,o=0;for(;o<r;++o)t[n[o]]=mg(e[n[o]]);return t}return e}function GC(e){return["__proto__","prototype","constructor"].indexOf(e)===-1}function XB(e,t,n,r){if(!GC(e))return;let o=t[e],i=n[e];Lt(o)&&Lt(i)?Bf(o,i,r):t[e]=mg(i)}function Bf(e,t,n){let r=cn(t)?t:[t],o=r.length;if(!Lt(e))return e;n=n||{};let i=n.merger||XB;for(let a=0;a<o;++a){if(t=r[a],!Lt(t))continue;let l=Object.keys(t);for(let c=0,d=l.length;c<d;++c)i(l[c],e,t,n)}return e}function Vf(e,t){return Bf(e,t,{merger:JB})}function JB(e,t,n){if(!GC(e))return;let r=t[e],o=n[e];Lt(r)&&Lt(o)?Vf(r,o):Object.prototype.hasOwnProperty.call(t,e)||(t[e]=mg(o))}var OC={"":e=>e,x:e=>e.x,y:e=>e.y};function ua(e,t){return(OC[t]||(OC[t]=ZB(t)))(e)}function ZB(e){let t=ez(e);return n=>{for(let r of t){if(r==="")break;n=n&&n[r]}return n}}function ez(e){let t=e.split("."),n=[],r="";for(let o of t)r+=o,r.endsWith("\\")?r=r.slice(0,-1)+".":(n.push(r),r="");return n}function _g(e){return e.charAt(0).toUpperCase()+e.slice(1)}var zo=e=>typeof e!="undefined",la=e=>typeof e=="function",D6=(e,t)=>{if(e.size!==t.size)return!1;for(let n of e)if(!t.has(n))return!1;return!0};function QC(e){return e.type==="mouseup"||e.type==="click"||e.type==="contextmenu"}var _n=Math.PI,tn=2*_n,tz=tn+_n,gg=Number.POSITIVE_INFINITY,nz=_n/180,Dn=_n/2,Rh=_n/4,NC=_n*2/3,Vo=Math.log10,Qi=Math.sign;function T6(e){let t=Math.round(e);e=Hf(e,t,e/1e3)?t:e;let n=Math.pow(10,Math.floor(Vo(e))),r=e/n;return(r<=1?1:r<=2?2:r<=5?5:10)*n}function XC(e){let t=[],n=Math.sqrt(e),r;for(r=1;r<n;r++)e%r===0&&(t.push(r),t.push(e/r));return n===(n|0)&&t.push(n),t.sort((o,i)=>o-i).pop(),t}function Iu(e){return!isNaN(parseFloat(e))&&isFinite(e)}function Hf(e,t,n){return Math.abs(e-t)<n}function JC(e,t){let n=Math.round(e);return n-t<=e&&n+t>=e}function L6(e,t,n){let r,o,i;for(r=0,o=e.length;r<o;r++)i=e[r][n],isNaN(i)||(t.min=Math.min(t.min,i),t.max=Math.max(t.max,i))}function gi(e){return e*(_n/180)}function xg(e){return e*(180/_n)}function O6(e){if(!Fn(e))return;let t=1,n=0;for(;Math.round(e*t)/t!==e;)t*=10,n++;return n}function N6(e,t){let n=t.x-e.x,r=t.y-e.y,o=Math.sqrt(n*n+r*r),i=Math.atan2(r,n);return i<-.5*_n&&(i+=tn),{angle:i,distance:o}}function yg(e,t){return Math.sqrt(Math.pow(t.x-e.x,2)+Math.pow(t.y-e.y,2))}function rz(e,t){return(e-t+tz)%tn-_n}function yo(e){return(e%tn+tn)%tn}function Uf(e,t,n,r){let o=yo(e),i=yo(t),a=yo(n),l=yo(i-o),c=yo(a-o),d=yo(o-i),g=yo(o-a);return o===i||o===a||r&&i===a||l>c&&d<g}function sr(e,t,n){return Math.max(t,Math.min(n,e))}function ZC(e){return sr(e,-32768,32767)}function bs(e,t,n,r=1e-6){return e>=Math.min(t,n)-r&&e<=Math.max(t,n)+r}function wg(e,t,n){n=n||(a=>e[a]<t);let r=e.length-1,o=0,i;for(;r-o>1;)i=o+r>>1,n(i)?o=i:r=i;return{lo:o,hi:r}}var xs=(e,t,n,r)=>wg(e,n,r?o=>e[o][t]<=n:o=>e[o][t]<n),eP=(e,t,n)=>wg(e,n,r=>e[r][t]>=n);function tP(e,t,n){let r=0,o=e.length;for(;r<o&&e[r]<t;)r++;for(;o>r&&e[o-1]>n;)o--;return r>0||o<e.length?e.slice(r,o):e}var nP=["push","pop","shift","splice","unshift"];function rP(e,t){if(e._chartjs){e._chartjs.listeners.push(t);return}Object.defineProperty(e,"_chartjs",{configurable:!0,enumerable:!1,value:{listeners:[t]}}),nP.forEach(n=>{let r="_onData"+_g(n),o=e[n];Object.defineProperty(e,n,{configurable:!0,enumerable:!1,value(...i){let a=o.apply(this,i);return e._chartjs.listeners.forEach(l=>{typeof l[r]=="function"&&l[r](...i)}),a}})})}function A6(e,t){let n=e._chartjs;if(!n)return;let r=n.listeners,o=r.indexOf(t);o!==-1&&r.splice(o,1),!(r.length>0)&&(nP.forEach(i=>{delete e[i]}),delete e._chartjs)}function F6(e){let t=new Set,n,r;for(n=0,r=e.length;n<r;++n)t.add(e[n]);return t.size===r?e:Array.from(t)}var R6=function(){return typeof window=="undefined"?function(e){return e()}:window.requestAnimationFrame}();function B6(e,t,n){let r=n||(a=>Array.prototype.slice.call(a)),o=!1,i=[];return function(...a){i=r(a),o||(o=!0,R6.call(window,()=>{o=!1,e.apply(t,i)}))}}function oP(e,t){let n;return function(...r){return t?(clearTimeout(n),n=setTimeout(e,t,r)):e.apply(this,r),t}}var bg=e=>e==="start"?"left":e==="end"?"right":"center",Cr=(e,t,n)=>e==="start"?t:e==="end"?n:(t+n)/2,iP=(e,t,n,r)=>e===(r?"left":"right")?n:e==="center"?(t+n)/2:t;function z6(e,t,n){let r=t.length,o=0,i=r;if(e._sorted){let{iScale:a,_parsed:l}=e,c=a.axis,{min:d,max:g,minDefined:v,maxDefined:w}=a.getUserBounds();v&&(o=sr(Math.min(xs(l,a.axis,d).lo,n?r:xs(t,c,a.getPixelForValue(d)).lo),0,r-1)),w?i=sr(Math.max(xs(l,a.axis,g,!0).hi+1,n?0:xs(t,c,a.getPixelForValue(g),!0).hi+1),o,r)-o:i=r-o}return{start:o,count:i}}function j6(e){let{xScale:t,yScale:n,_scaleRanges:r}=e,o={xmin:t.min,xmax:t.max,ymin:n.min,ymax:n.max};if(!r)return e._scaleRanges=o,!0;let i=r.xmin!==t.min||r.xmax!==t.max||r.ymin!==n.min||r.ymax!==n.max;return Object.assign(r,o),i}var cg=e=>e===0||e===1,AC=(e,t,n)=>-(Math.pow(2,10*(e-=1))*Math.sin((e-t)*tn/n)),FC=(e,t,n)=>Math.pow(2,-10*e)*Math.sin((e-t)*tn/n)+1,Rf={linear:e=>e,easeInQuad:e=>e*e,easeOutQuad:e=>-e*(e-2),easeInOutQuad:e=>(e/=.5)<1?.5*e*e:-.5*(--e*(e-2)-1),easeInCubic:e=>e*e*e,easeOutCubic:e=>(e-=1)*e*e+1,easeInOutCubic:e=>(e/=.5)<1?.5*e*e*e:.5*((e-=2)*e*e+2),easeInQuart:e=>e*e*e*e,easeOutQuart:e=>-((e-=1)*e*e*e-1),easeInOutQuart:e=>(e/=.5)<1?.5*e*e*e*e:-.5*((e-=2)*e*e*e-2),easeInQuint:e=>e*e*e*e*e,easeOutQuint:e=>(e-=1)*e*e*e*e+1,easeInOutQuint:e=>(e/=.5)<1?.5*e*e*e*e*e:.5*((e-=2)*e*e*e*e+2),easeInSine:e=>-Math.cos(e*Dn)+1,easeOutSine:e=>Math.sin(e*Dn),easeInOutSine:e=>-.5*(Math.cos(_n*e)-1),easeInExpo:e=>e===0?0:Math.pow(2,10*(e-1)),easeOutExpo:e=>e===1?1:-Math.pow(2,-10*e)+1,easeInOutExpo:e=>cg(e)?e:e<.5?.5*Math.pow(2,10*(e*2-1)):.5*(-Math.pow(2,-10*(e*2-1))+2),easeInCirc:e=>e>=1?e:-(Math.sqrt(1-e*e)-1),easeOutCirc:e=>Math.sqrt(1-(e-=1)*e),easeInOutCirc:e=>(e/=.5)<1?-.5*(Math.sqrt(1-e*e)-1):.5*(Math.sqrt(1-(e-=2)*e)+1),easeInElastic:e=>cg(e)?e:AC(e,.075,.3),easeOutElastic:e=>cg(e)?e:FC(e,.075,.3),easeInOutElastic(e){return cg(e)?e:e<.5?.5*AC(e*2,.1125,.45):.5+.5*FC(e*2-1,.1125,.45)},easeInBack(e){return e*e*((1.70158+1)*e-1.70158)},easeOutBack(e){return(e-=1)*e*((1.70158+1)*e+1.70158)+1},easeInOutBack(e){let t=1.70158;return(e/=.5)<1?.5*(e*e*(((t*=1.525)+1)*e-t)):.5*((e-=2)*e*(((t*=1.525)+1)*e+t)+2)},easeInBounce:e=>1-Rf.easeOutBounce(1-e),easeOutBounce(e){return e<1/2.75?7.5625*e*e:e<2/2.75?7.5625*(e-=1.5/2.75)*e+.75:e<2.5/2.75?7.5625*(e-=2.25/2.75)*e+.9375:7.5625*(e-=2.625/2.75)*e+.984375},easeInOutBounce:e=>e<.5?Rf.easeInBounce(e*2)*.5:Rf.easeOutBounce(e*2-1)*.5+.5};function Hh(e){return e+.5|0}var cl=(e,t,n)=>Math.max(Math.min(e,n),t);function Bh(e){return cl(Hh(e*2.55),0,255)}function fl(e){return cl(Hh(e*255),0,255)}function aa(e){return cl(Hh(e/2.55)/100,0,1)}function RC(e){return cl(Hh(e*100),0,100)}var mi={0:0,1:1,2:2,3:3,4:4,5:5,6:6,7:7,8:8,9:9,A:10,B:11,C:12,D:13,E:14,F:15,a:10,b:11,c:12,d:13,e:14,f:15},C6=[..."0123456789ABCDEF"],oz=e=>C6[e&15],iz=e=>C6[(e&240)>>4]+C6[e&15],fg=e=>(e&240)>>4===(e&15),sz=e=>fg(e.r)&&fg(e.g)&&fg(e.b)&&fg(e.a);function az(e){var t=e.length,n;return e[0]==="#"&&(t===4||t===5?n={r:255&mi[e[1]]*17,g:255&mi[e[2]]*17,b:255&mi[e[3]]*17,a:t===5?mi[e[4]]*17:255}:(t===7||t===9)&&(n={r:mi[e[1]]<<4|mi[e[2]],g:mi[e[3]]<<4|mi[e[4]],b:mi[e[5]]<<4|mi[e[6]],a:t===9?mi[e[7]]<<4|mi[e[8]]:255})),n}var lz=(e,t)=>e<255?t(e):"";function uz(e){var t=sz(e)?oz:iz;return e?"#"+t(e.r)+t(e.g)+t(e.b)+lz(e.a,t):void 0}var cz=/^(hsla?|hwb|hsv)\(\s*([-+.e\d]+)(?:deg)?[\s,]+([-+.e\d]+)%[\s,]+([-+.e\d]+)%(?:[\s,]+([-+.e\d]+)(%)?)?\s*\)$/;function sP(e,t,n){let r=t*Math.min(n,1-n),o=(i,a=(i+e/30)%12)=>n-r*Math.max(Math.min(a-3,9-a,1),-1);return[o(0),o(8),o(4)]}function fz(e,t,n){let r=(o,i=(o+e/60)%6)=>n-n*t*Math.max(Math.min(i,4-i,1),0);return[r(5),r(3),r(1)]}function dz(e,t,n){let r=sP(e,1,.5),o;for(t+n>1&&(o=1/(t+n),t*=o,n*=o),o=0;o<3;o++)r[o]*=1-t-n,r[o]+=t;return r}function pz(e,t,n,r,o){return e===o?(t-n)/r+(t<n?6:0):t===o?(n-e)/r+2:(e-t)/r+4}function V6(e){let n=e.r/255,r=e.g/255,o=e.b/255,i=Math.max(n,r,o),a=Math.min(n,r,o),l=(i+a)/2,c,d,g;return i!==a&&(g=i-a,d=l>.5?g/(2-i-a):g/(i+a),c=pz(n,r,o,g,i),c=c*60+.5),[c|0,d||0,l]}function H6(e,t,n,r){return(Array.isArray(t)?e(t[0],t[1],t[2]):e(t,n,r)).map(fl)}function U6(e,t,n){return H6(sP,e,t,n)}function hz(e,t,n){return H6(dz,e,t,n)}function mz(e,t,n){return H6(fz,e,t,n)}function aP(e){return(e%360+360)%360}function gz(e){let t=cz.exec(e),n=255,r;if(!t)return;t[5]!==r&&(n=t[6]?Bh(+t[5]):fl(+t[5]));let o=aP(+t[2]),i=+t[3]/100,a=+t[4]/100;return t[1]==="hwb"?r=hz(o,i,a):t[1]==="hsv"?r=mz(o,i,a):r=U6(o,i,a),{r:r[0],g:r[1],b:r[2],a:n}}function yz(e,t){var n=V6(e);n[0]=aP(n[0]+t),n=U6(n),e.r=n[0],e.g=n[1],e.b=n[2]}function vz(e){if(!e)return;let t=V6(e),n=t[0],r=RC(t[1]),o=RC(t[2]);return e.a<255?`hsla(${n}, ${r}%, ${o}%, ${aa(e.a)})`:`hsl(${n}, ${r}%, ${o}%)`}var BC={x:"dark",Z:"light",Y:"re",X:"blu",W:"gr",V:"medium",U:"slate",A:"ee",T:"ol",S:"or",B:"ra",C:"lateg",D:"ights",R:"in",Q:"turquois",E:"hi",P:"ro",O:"al",N:"le",M:"de",L:"yello",F:"en",K:"ch",G:"arks",H:"ea",I:"ightg",J:"wh"},zC={OiceXe:"f0f8ff",antiquewEte:"faebd7",aqua:"ffff",aquamarRe:"7fffd4",azuY:"f0ffff",beige:"f5f5dc",bisque:"ffe4c4",black:"0",blanKedOmond:"ffebcd",Xe:"ff",XeviTet:"8a2be2",bPwn:"a52a2a",burlywood:"deb887",caMtXe:"5f9ea0",KartYuse:"7fff00",KocTate:"d2691e",cSO:"ff7f50",cSnflowerXe:"6495ed",cSnsilk:"fff8dc",crimson:"dc143c",cyan:"ffff",xXe:"8b",xcyan:"8b8b",xgTMnPd:"b8860b",xWay:"a9a9a9",xgYF:"6400",xgYy:"a9a9a9",xkhaki:"bdb76b",xmagFta:"8b008b",xTivegYF:"556b2f",xSange:"ff8c00",xScEd:"9932cc",xYd:"8b0000",xsOmon:"e9967a",xsHgYF:"8fbc8f",xUXe:"483d8b",xUWay:"2f4f4f",xUgYy:"2f4f4f",xQe:"ced1",xviTet:"9400d3",dAppRk:"ff1493",dApskyXe:"bfff",dimWay:"696969",dimgYy:"696969",dodgerXe:"1e90ff",fiYbrick:"b22222",flSOwEte:"fffaf0",foYstWAn:"228b22",fuKsia:"ff00ff",gaRsbSo:"dcdcdc",ghostwEte:"f8f8ff",gTd:"ffd700",gTMnPd:"daa520",Way:"808080",gYF:"8000",gYFLw:"adff2f",gYy:"808080",honeyMw:"f0fff0",hotpRk:"ff69b4",RdianYd:"cd5c5c",Rdigo:"4b0082",ivSy:"fffff0",khaki:"f0e68c",lavFMr:"e6e6fa",lavFMrXsh:"fff0f5",lawngYF:"7cfc00",NmoncEffon:"fffacd",ZXe:"add8e6",ZcSO:"f08080",Zcyan:"e0ffff",ZgTMnPdLw:"fafad2",ZWay:"d3d3d3",ZgYF:"90ee90",ZgYy:"d3d3d3",ZpRk:"ffb6c1",ZsOmon:"ffa07a",ZsHgYF:"20b2aa",ZskyXe:"87cefa",ZUWay:"778899",ZUgYy:"778899",ZstAlXe:"b0c4de",ZLw:"ffffe0",lime:"ff00",limegYF:"32cd32",lRF:"faf0e6",magFta:"ff00ff",maPon:"800000",VaquamarRe:"66cdaa",VXe:"cd",VScEd:"ba55d3",VpurpN:"9370db",VsHgYF:"3cb371",VUXe:"7b68ee",VsprRggYF:"fa9a",VQe:"48d1cc",VviTetYd:"c71585",midnightXe:"191970",mRtcYam:"f5fffa",mistyPse:"ffe4e1",moccasR:"ffe4b5",navajowEte:"ffdead",navy:"80",Tdlace:"fdf5e6",Tive:"808000",TivedBb:"6b8e23",Sange:"ffa500",SangeYd:"ff4500",ScEd:"da70d6",pOegTMnPd:"eee8aa",pOegYF:"98fb98",pOeQe:"afeeee",pOeviTetYd:"db7093",papayawEp:"ffefd5",pHKpuff:"ffdab9",peru:"cd853f",pRk:"ffc0cb",plum:"dda0dd",powMrXe:"b0e0e6",purpN:"800080",YbeccapurpN:"663399",Yd:"ff0000",Psybrown:"bc8f8f",PyOXe:"4169e1",saddNbPwn:"8b4513",sOmon:"fa8072",sandybPwn:"f4a460",sHgYF:"2e8b57",sHshell:"fff5ee",siFna:"a0522d",silver:"c0c0c0",skyXe:"87ceeb",UXe:"6a5acd",UWay:"708090",UgYy:"708090",snow:"fffafa",sprRggYF:"ff7f",stAlXe:"4682b4",tan:"d2b48c",teO:"8080",tEstN:"d8bfd8",tomato:"ff6347",Qe:"40e0d0",viTet:"ee82ee",JHt:"f5deb3",wEte:"ffffff",wEtesmoke:"f5f5f5",Lw:"ffff00",LwgYF:"9acd32"};function _z(){let e={},t=Object.keys(zC),n=Object.keys(BC),r,o,i,a,l;for(r=0;r<t.length;r++){for(a=l=t[r],o=0;o<n.length;o++)i=n[o],l=l.replace(i,BC[i]);i=parseInt(zC[a],16),e[l]=[i>>16&255,i>>8&255,i&255]}return e}var dg;function xz(e){dg||(dg=_z(),dg.transparent=[0,0,0,0]);let t=dg[e.toLowerCase()];return t&&{r:t[0],g:t[1],b:t[2],a:t.length===4?t[3]:255}}var wz=/^rgba?\(\s*([-+.\d]+)(%)?[\s,]+([-+.e\d]+)(%)?[\s,]+([-+.e\d]+)(%)?(?:[\s,/]+([-+.e\d]+)(%)?)?\s*\)$/;function bz(e){let t=wz.exec(e),n=255,r,o,i;if(t){if(t[7]!==r){let a=+t[7];n=t[8]?Bh(a):cl(a*255,0,255)}return r=+t[1],o=+t[3],i=+t[5],r=255&(t[2]?Bh(r):cl(r,0,255)),o=255&(t[4]?Bh(o):cl(o,0,255)),i=255&(t[6]?Bh(i):cl(i,0,255)),{r,g:o,b:i,a:n}}}function Sz(e){return e&&(e.a<255?`rgba(${e.r}, ${e.g}, ${e.b}, ${aa(e.a)})`:`rgb(${e.r}, ${e.g}, ${e.b})`)}var b6=e=>e<=.0031308?e*12.92:Math.pow(e,1/2.4)*1.055-.055,Ff=e=>e<=.04045?e/12.92:Math.pow((e+.055)/1.055,2.4);function Mz(e,t,n){let r=Ff(aa(e.r)),o=Ff(aa(e.g)),i=Ff(aa(e.b));return{r:fl(b6(r+n*(Ff(aa(t.r))-r))),g:fl(b6(o+n*(Ff(aa(t.g))-o))),b:fl(b6(i+n*(Ff(aa(t.b))-i))),a:e.a+n*(t.a-e.a)}}function pg(e,t,n){if(e){let r=V6(e);r[t]=Math.max(0,Math.min(r[t]+r[t]*n,t===0?360:1)),r=U6(r),e.r=r[0],e.g=r[1],e.b=r[2]}}function lP(e,t){return e&&Object.assign(t||{},e)}function jC(e){var t={r:0,g:0,b:0,a:255};return Array.isArray(e)?e.length>=3&&(t={r:e[0],g:e[1],b:e[2],a:255},e.length>3&&(t.a=fl(e[3]))):(t=lP(e,{r:0,g:0,b:0,a:1}),t.a=fl(t.a)),t}function kz(e){return e.charAt(0)==="r"?bz(e):gz(e)}var P6=class e{constructor(t){if(t instanceof e)return t;let n=typeof t,r;n==="object"?r=jC(t):n==="string"&&(r=az(t)||xz(t)||kz(t)),this._rgb=r,this._valid=!!r}get valid(){return this._valid}get rgb(){var t=lP(this._rgb);return t&&(t.a=aa(t.a)),t}set rgb(t){this._rgb=jC(t)}rgbString(){return this._valid?Sz(this._rgb):void 0}hexString(){return this._valid?uz(this._rgb):void 0}hslString(){return this._valid?vz(this._rgb):void 0}mix(t,n){if(t){let r=this.rgb,o=t.rgb,i,a=n===i?.5:n,l=2*a-1,c=r.a-o.a,d=((l*c===-1?l:(l+c)/(1+l*c))+1)/2;i=1-d,r.r=255&d*r.r+i*o.r+.5,r.g=255&d*r.g+i*o.g+.5,r.b=255&d*r.b+i*o.b+.5,r.a=a*r.a+(1-a)*o.a,this.rgb=r}return this}interpolate(t,n){return t&&(this._rgb=Mz(this._rgb,t._rgb,n)),this}clone(){return new e(this.rgb)}alpha(t){return this._rgb.a=fl(t),this}clearer(t){let n=this._rgb;return n.a*=1-t,this}greyscale(){let t=this._rgb,n=Hh(t.r*.3+t.g*.59+t.b*.11);return t.r=t.g=t.b=n,this}opaquer(t){let n=this._rgb;return n.a*=1+t,this}negate(){let t=this._rgb;return t.r=255-t.r,t.g=255-t.g,t.b=255-t.b,this}lighten(t){return pg(this._rgb,2,t),this}darken(t){return pg(this._rgb,2,-t),this}saturate(t){return pg(this._rgb,1,t),this}desaturate(t){return pg(this._rgb,1,-t),this}rotate(t){return yz(this._rgb,t),this}};function uP(e){return new P6(e)}function cP(e){if(e&&typeof e=="object"){let t=e.toString();return t==="[object CanvasPattern]"||t==="[object CanvasGradient]"}return!1}function W6(e){return cP(e)?e:uP(e)}function S6(e){return cP(e)?e:uP(e).saturate(.5).darken(.1).hexString()}var dl=Object.create(null),Sg=Object.create(null);function zh(e,t){if(!t)return e;let n=t.split(".");for(let r=0,o=n.length;r<o;++r){let i=n[r];e=e[i]||(e[i]=Object.create(null))}return e}function M6(e,t,n){return typeof t=="string"?Bf(zh(e,t),n):Bf(zh(e,""),t)}var E6=class{constructor(t){this.animation=void 0,this.backgroundColor="rgba(0,0,0,0.1)",this.borderColor="rgba(0,0,0,0.1)",this.color="#666",this.datasets={},this.devicePixelRatio=n=>n.chart.platform.getDevicePixelRatio(),this.elements={},this.events=["mousemove","mouseout","click","touchstart","touchmove"],this.font={family:"'Helvetica Neue', 'Helvetica', 'Arial', sans-serif",size:12,style:"normal",lineHeight:1.2,weight:null},this.hover={},this.hoverBackgroundColor=(n,r)=>S6(r.backgroundColor),this.hoverBorderColor=(n,r)=>S6(r.borderColor),this.hoverColor=(n,r)=>S6(r.color),this.indexAxis="x",this.interaction={mode:"nearest",intersect:!0,includeInvisible:!1},this.maintainAspectRatio=!0,this.onHover=null,this.onClick=null,this.parsing=!0,this.plugins={},this.responsive=!0,this.scale=void 0,this.scales={},this.showLine=!0,this.drawActiveElementsOnTop=!0,this.describe(t)}set(t,n){return M6(this,t,n)}get(t){return zh(this,t)}describe(t,n){return M6(Sg,t,n)}override(t,n){return M6(dl,t,n)}route(t,n,r,o){let i=zh(this,t),a=zh(this,r),l="_"+n;Object.defineProperties(i,{[l]:{value:i[n],writable:!0},[n]:{enumerable:!0,get(){let c=this[l],d=a[o];return Lt(c)?Object.assign({},d,c):Mt(c,d)},set(c){this[l]=c}}})}},Ot=new E6({_scriptable:e=>!e.startsWith("on"),_indexable:e=>e!=="events",hover:{_fallback:"interaction"},interaction:{_scriptable:!1,_indexable:!1}});function Cz(e){return!e||Ht(e.size)||Ht(e.family)?null:(e.style?e.style+" ":"")+(e.weight?e.weight+" ":"")+e.size+"px "+e.family}function jh(e,t,n,r,o){let i=t[o];return i||(i=t[o]=e.measureText(o).width,n.push(o)),i>r&&(r=i),r}function fP(e,t,n,r){r=r||{};let o=r.data=r.data||{},i=r.garbageCollect=r.garbageCollect||[];r.font!==t&&(o=r.data={},i=r.garbageCollect=[],r.font=t),e.save(),e.font=t;let a=0,l=n.length,c,d,g,v,w;for(c=0;c<l;c++)if(v=n[c],v!=null&&cn(v)!==!0)a=jh(e,o,i,a,v);else if(cn(v))for(d=0,g=v.length;d<g;d++)w=v[d],w!=null&&!cn(w)&&(a=jh(e,o,i,a,w));e.restore();let S=i.length/2;if(S>n.length){for(c=0;c<S;c++)delete o[i[c]];i.splice(0,S)}return a}function pl(e,t,n){let r=e.currentDevicePixelRatio,o=n!==0?Math.max(n/2,.5):0;return Math.round((t-o)*r)/r+o}function $6(e,t){t=t||e.getContext("2d"),t.save(),t.resetTransform(),t.clearRect(0,0,e.width,e.height),t.restore()}function Mg(e,t,n,r){Y6(e,t,n,r,null)}function Y6(e,t,n,r,o){let i,a,l,c,d,g,v=t.pointStyle,w=t.rotation,S=t.radius,C=(w||0)*nz;if(v&&typeof v=="object"&&(i=v.toString(),i==="[object HTMLImageElement]"||i==="[object HTMLCanvasElement]")){e.save(),e.translate(n,r),e.rotate(C),e.drawImage(v,-v.width/2,-v.height/2,v.width,v.height),e.restore();return}if(!(isNaN(S)||S<=0)){switch(e.beginPath(),v){default:o?e.ellipse(n,r,o/2,S,0,0,tn):e.arc(n,r,S,0,tn),e.closePath();break;case"triangle":e.moveTo(n+Math.sin(C)*S,r-Math.cos(C)*S),C+=NC,e.lineTo(n+Math.sin(C)*S,r-Math.cos(C)*S),C+=NC,e.lineTo(n+Math.sin(C)*S,r-Math.cos(C)*S),e.closePath();break;case"rectRounded":d=S*.516,c=S-d,a=Math.cos(C+Rh)*c,l=Math.sin(C+Rh)*c,e.arc(n-a,r-l,d,C-_n,C-Dn),e.arc(n+l,r-a,d,C-Dn,C),e.arc(n+a,r+l,d,C,C+Dn),e.arc(n-l,r+a,d,C+Dn,C+_n),e.closePath();break;case"rect":if(!w){c=Math.SQRT1_2*S,g=o?o/2:c,e.rect(n-g,r-c,2*g,2*c);break}C+=Rh;case"rectRot":a=Math.cos(C)*S,l=Math.sin(C)*S,e.moveTo(n-a,r-l),e.lineTo(n+l,r-a),e.lineTo(n+a,r+l),e.lineTo(n-l,r+a),e.closePath();break;case"crossRot":C+=Rh;case"cross":a=Math.cos(C)*S,l=Math.sin(C)*S,e.moveTo(n-a,r-l),e.lineTo(n+a,r+l),e.moveTo(n+l,r-a),e.lineTo(n-l,r+a);break;case"star":a=Math.cos(C)*S,l=Math.sin(C)*S,e.moveTo(n-a,r-l),e.lineTo(n+a,r+l),e.moveTo(n+l,r-a),e.lineTo(n-l,r+a),C+=Rh,a=Math.cos(C)*S,l=Math.sin(C)*S,e.moveTo(n-a,r-l),e.lineTo(n+a,r+l),e.moveTo(n+l,r-a),e.lineTo(n-l,r+a);break;case"line":a=o?o/2:Math.cos(C)*S,l=Math.sin(C)*S,e.moveTo(n-a,r-l),e.lineTo(n+a,r+l);break;case"dash":e.moveTo(n,r),e.lineTo(n+Math.cos(C)*S,r+Math.sin(C)*S);break}e.fill(),t.borderWidth>0&&e.stroke()}}function zf(e,t,n){return n=n||.5,!t||e&&e.x>t.left-n&&e.x<t.right+n&&e.y>t.top-n&&e.y<t.bottom+n}function Uh(e,t){e.save(),e.beginPath(),e.rect(t.left,t.top,t.right-t.left,t.bottom-t.top),e.clip()}function Wh(e){e.restore()}function dP(e,t,n,r,o){if(!t)return e.lineTo(n.x,n.y);if(o==="middle"){let i=(t.x+n.x)/2;e.lineTo(i,t.y),e.lineTo(i,n.y)}else o==="after"!=!!r?e.lineTo(t.x,n.y):e.lineTo(n.x,t.y);e.lineTo(n.x,n.y)}function pP(e,t,n,r){if(!t)return e.lineTo(n.x,n.y);e.bezierCurveTo(r?t.cp1x:t.cp2x,r?t.cp1y:t.cp2y,r?n.cp2x:n.cp1x,r?n.cp2y:n.cp1y,n.x,n.y)}function hl(e,t,n,r,o,i={}){let a=cn(t)?t:[t],l=i.strokeWidth>0&&i.strokeColor!=="",c,d;for(e.save(),e.font=o.string,Pz(e,i),c=0;c<a.length;++c)d=a[c],l&&(i.strokeColor&&(e.strokeStyle=i.strokeColor),Ht(i.strokeWidth)||(e.lineWidth=i.strokeWidth),e.strokeText(d,n,r,i.maxWidth)),e.fillText(d,n,r,i.maxWidth),Ez(e,n,r,d,i),r+=o.lineHeight;e.restore()}function Pz(e,t){t.translation&&e.translate(t.translation[0],t.translation[1]),Ht(t.rotation)||e.rotate(t.rotation),t.color&&(e.fillStyle=t.color),t.textAlign&&(e.textAlign=t.textAlign),t.textBaseline&&(e.textBaseline=t.textBaseline)}function Ez(e,t,n,r,o){if(o.strikethrough||o.underline){let i=e.measureText(r),a=t-i.actualBoundingBoxLeft,l=t+i.actualBoundingBoxRight,c=n-i.actualBoundingBoxAscent,d=n+i.actualBoundingBoxDescent,g=o.strikethrough?(c+d)/2:d;e.strokeStyle=e.fillStyle,e.beginPath(),e.lineWidth=o.decorationWidth||2,e.moveTo(a,g),e.lineTo(l,g),e.stroke()}}function Wf(e,t){let{x:n,y:r,w:o,h:i,radius:a}=t;e.arc(n+a.topLeft,r+a.topLeft,a.topLeft,-Dn,_n,!0),e.lineTo(n,r+i-a.bottomLeft),e.arc(n+a.bottomLeft,r+i-a.bottomLeft,a.bottomLeft,_n,Dn,!0),e.lineTo(n+o-a.bottomRight,r+i),e.arc(n+o-a.bottomRight,r+i-a.bottomRight,a.bottomRight,Dn,0,!0),e.lineTo(n+o,r+a.topRight),e.arc(n+o-a.topRight,r+a.topRight,a.topRight,0,-Dn,!0),e.lineTo(n+a.topLeft,r)}var Iz=new RegExp(/^(normal|(\d+(?:\.\d+)?)(px|em|%)?)$/),Dz=new RegExp(/^(normal|italic|initial|inherit|unset|(oblique( -?[0-9]?[0-9]deg)?))$/);function Tz(e,t){let n=(""+e).match(Iz);if(!n||n[1]==="normal")return t*1.2;switch(e=+n[2],n[3]){case"px":return e;case"%":e/=100;break}return t*e}var Lz=e=>+e||0;function kg(e,t){let n={},r=Lt(t),o=r?Object.keys(t):t,i=Lt(e)?r?a=>Mt(e[a],e[t[a]]):a=>e[a]:()=>e;for(let a of o)n[a]=Lz(i(a));return n}function q6(e){return kg(e,{top:"y",right:"x",bottom:"y",left:"x"})}function ml(e){return kg(e,["topLeft","topRight","bottomLeft","bottomRight"])}function Pr(e){let t=q6(e);return t.width=t.left+t.right,t.height=t.top+t.bottom,t}function Qn(e,t){e=e||{},t=t||Ot.font;let n=Mt(e.size,t.size);typeof n=="string"&&(n=parseInt(n,10));let r=Mt(e.style,t.style);r&&!(""+r).match(Dz)&&(console.warn('Invalid font style specified: "'+r+'"'),r="");let o={family:Mt(e.family,t.family),lineHeight:Tz(Mt(e.lineHeight,t.lineHeight),n),size:n,style:r,weight:Mt(e.weight,t.weight),string:""};return o.string=Cz(o),o}function $f(e,t,n,r){let o=!0,i,a,l;for(i=0,a=e.length;i<a;++i)if(l=e[i],l!==void 0&&(t!==void 0&&typeof l=="function"&&(l=l(t),o=!1),n!==void 0&&cn(l)&&(l=l[n%l.length],o=!1),l!==void 0))return r&&!o&&(r.cacheable=!1),l}function hP(e,t,n){let{min:r,max:o}=e,i=I6(t,(o-r)/2),a=(l,c)=>n&&l===0?0:l+c;return{min:a(r,-Math.abs(i)),max:a(o,i)}}function ca(e,t){return Object.assign(Object.create(e),t)}function Cg(e,t=[""],n=e,r,o=()=>e[0]){zo(r)||(r=yP("_fallback",e));let i={[Symbol.toStringTag]:"Object",_cacheable:!0,_scopes:e,_rootScopes:n,_fallback:r,_getTarget:o,override:a=>Cg([a,...e],t,n,r)};return new Proxy(i,{deleteProperty(a,l){return delete a[l],delete a._keys,delete e[0][l],!0},get(a,l){return mP(a,l,()=>jz(l,t,e,a))},getOwnPropertyDescriptor(a,l){return Reflect.getOwnPropertyDescriptor(a._scopes[0],l)},getPrototypeOf(){return Reflect.getPrototypeOf(e[0])},has(a,l){return HC(a).includes(l)},ownKeys(a){return HC(a)},set(a,l,c){let d=a._storage||(a._storage=o());return a[l]=d[l]=c,delete a._keys,!0}})}function Eu(e,t,n,r){let o={_cacheable:!1,_proxy:e,_context:t,_subProxy:n,_stack:new Set,_descriptors:K6(e,r),setContext:i=>Eu(e,i,n,r),override:i=>Eu(e.override(i),t,n,r)};return new Proxy(o,{deleteProperty(i,a){return delete i[a],delete e[a],!0},get(i,a,l){return mP(i,a,()=>Nz(i,a,l))},getOwnPropertyDescriptor(i,a){return i._descriptors.allKeys?Reflect.has(e,a)?{enumerable:!0,configurable:!0}:void 0:Reflect.getOwnPropertyDescriptor(e,a)},getPrototypeOf(){return Reflect.getPrototypeOf(e)},has(i,a){return Reflect.has(e,a)},ownKeys(){return Reflect.ownKeys(e)},set(i,a,l){return e[a]=l,delete i[a],!0}})}function K6(e,t={scriptable:!0,indexable:!0}){let{_scriptable:n=t.scriptable,_indexable:r=t.indexable,_allKeys:o=t.allKeys}=e;return{allKeys:o,scriptable:n,indexable:r,isScriptable:la(n)?n:()=>n,isIndexable:la(r)?r:()=>r}}var Oz=(e,t)=>e?e+_g(t):t,G6=(e,t)=>Lt(t)&&e!=="adapters"&&(Object.getPrototypeOf(t)===null||t.constructor===Object);function mP(e,t,n){if(Object.prototype.hasOwnProperty.call(e,t))return e[t];let r=n();return e[t]=r,r}function Nz(e,t,n){let{_proxy:r,_context:o,_subProxy:i,_descriptors:a}=e,l=r[t];return la(l)&&a.isScriptable(t)&&(l=Az(t,l,e,n)),cn(l)&&l.length&&(l=Fz(t,l,e,a.isIndexable)),G6(t,l)&&(l=Eu(l,o,i&&i[t],a)),l}function Az(e,t,n,r){let{_proxy:o,_context:i,_subProxy:a,_stack:l}=n;if(l.has(e))throw new Error("Recursion detected: "+Array.from(l).join("->")+"->"+e);return l.add(e),t=t(i,a||r),l.delete(e),G6(e,t)&&(t=Q6(o._scopes,o,e,t)),t}function Fz(e,t,n,r){let{_proxy:o,_context:i,_subProxy:a,_descriptors:l}=n;if(zo(i.index)&&r(e))t=t[i.index%t.length];else if(Lt(t[0])){let c=t,d=o._scopes.filter(g=>g!==c);t=[];for(let g of c){let v=Q6(d,o,e,g);t.push(Eu(v,i,a&&a[e],l))}}return t}function gP(e,t,n){return la(e)?e(t,n):e}var Rz=(e,t)=>e===!0?t:typeof e=="string"?ua(t,e):void 0;function Bz(e,t,n,r,o){for(let i of t){let a=Rz(n,i);if(a){e.add(a);let l=gP(a._fallback,n,o);if(zo(l)&&l!==n&&l!==r)return l}else if(a===!1&&zo(r)&&n!==r)return null}return!1}function Q6(e,t,n,r){let o=t._rootScopes,i=gP(t._fallback,n,r),a=[...e,...o],l=new Set;l.add(r);let c=VC(l,a,n,i||n,r);return c===null||zo(i)&&i!==n&&(c=VC(l,a,i,c,r),c===null)?!1:Cg(Array.from(l),[""],o,i,()=>zz(t,n,r))}function VC(e,t,n,r,o){for(;n;)n=Bz(e,t,n,r,o);return n}function zz(e,t,n){let r=e._getTarget();t in r||(r[t]={});let o=r[t];return cn(o)&&Lt(n)?n:o}function jz(e,t,n,r){let o;for(let i of t)if(o=yP(Oz(i,e),n),zo(o))return G6(e,o)?Q6(n,r,e,o):o}function yP(e,t){for(let n of t){if(!n)continue;let r=n[e];if(zo(r))return r}}function HC(e){let t=e._keys;return t||(t=e._keys=Vz(e._scopes)),t}function Vz(e){let t=new Set;for(let n of e)for(let r of Object.keys(n).filter(o=>!o.startsWith("_")))t.add(r);return Array.from(t)}function X6(e,t,n,r){let{iScale:o}=e,{key:i="r"}=this._parsing,a=new Array(r),l,c,d,g;for(l=0,c=r;l<c;++l)d=l+n,g=t[d],a[l]={r:o.parse(ua(g,i),d)};return a}var Hz=Number.EPSILON||1e-14,jf=(e,t)=>t<e.length&&!e[t].skip&&e[t],vP=e=>e==="x"?"y":"x";function Uz(e,t,n,r){let o=e.skip?t:e,i=t,a=n.skip?t:n,l=yg(i,o),c=yg(a,i),d=l/(l+c),g=c/(l+c);d=isNaN(d)?0:d,g=isNaN(g)?0:g;let v=r*d,w=r*g;return{previous:{x:i.x-v*(a.x-o.x),y:i.y-v*(a.y-o.y)},next:{x:i.x+w*(a.x-o.x),y:i.y+w*(a.y-o.y)}}}function Wz(e,t,n){let r=e.length,o,i,a,l,c,d=jf(e,0);for(let g=0;g<r-1;++g)if(c=d,d=jf(e,g+1),!(!c||!d)){if(Hf(t[g],0,Hz)){n[g]=n[g+1]=0;continue}o=n[g]/t[g],i=n[g+1]/t[g],l=Math.pow(o,2)+Math.pow(i,2),!(l<=9)&&(a=3/Math.sqrt(l),n[g]=o*a*t[g],n[g+1]=i*a*t[g])}}function $z(e,t,n="x"){let r=vP(n),o=e.length,i,a,l,c=jf(e,0);for(let d=0;d<o;++d){if(a=l,l=c,c=jf(e,d+1),!l)continue;let g=l[n],v=l[r];a&&(i=(g-a[n])/3,l[`cp1${n}`]=g-i,l[`cp1${r}`]=v-i*t[d]),c&&(i=(c[n]-g)/3,l[`cp2${n}`]=g+i,l[`cp2${r}`]=v+i*t[d])}}function Yz(e,t="x"){let n=vP(t),r=e.length,o=Array(r).fill(0),i=Array(r),a,l,c,d=jf(e,0);for(a=0;a<r;++a)if(l=c,c=d,d=jf(e,a+1),!!c){if(d){let g=d[t]-c[t];o[a]=g!==0?(d[n]-c[n])/g:0}i[a]=l?d?Qi(o[a-1])!==Qi(o[a])?0:(o[a-1]+o[a])/2:o[a-1]:o[a]}Wz(e,o,i),$z(e,i,t)}function hg(e,t,n){return Math.max(Math.min(e,n),t)}function qz(e,t){let n,r,o,i,a,l=zf(e[0],t);for(n=0,r=e.length;n<r;++n)a=i,i=l,l=n<r-1&&zf(e[n+1],t),i&&(o=e[n],a&&(o.cp1x=hg(o.cp1x,t.left,t.right),o.cp1y=hg(o.cp1y,t.top,t.bottom)),l&&(o.cp2x=hg(o.cp2x,t.left,t.right),o.cp2y=hg(o.cp2y,t.top,t.bottom)))}function _P(e,t,n,r,o){let i,a,l,c;if(t.spanGaps&&(e=e.filter(d=>!d.skip)),t.cubicInterpolationMode==="monotone")Yz(e,o);else{let d=r?e[e.length-1]:e[0];for(i=0,a=e.length;i<a;++i)l=e[i],c=Uz(d,l,e[Math.min(i+1,a-(r?0:1))%a],t.tension),l.cp1x=c.previous.x,l.cp1y=c.previous.y,l.cp2x=c.next.x,l.cp2y=c.next.y,d=l}t.capBezierPoints&&qz(e,n)}function J6(){return typeof window!="undefined"&&typeof document!="undefined"}function Pg(e){let t=e.parentNode;return t&&t.toString()==="[object ShadowRoot]"&&(t=t.host),t}function vg(e,t,n){let r;return typeof e=="string"?(r=parseInt(e,10),e.indexOf("%")!==-1&&(r=r/100*t.parentNode[n])):r=e,r}var Eg=e=>window.getComputedStyle(e,null);function Kz(e,t){return Eg(e).getPropertyValue(t)}var Gz=["top","right","bottom","left"];function Pu(e,t,n){let r={};n=n?"-"+n:"";for(let o=0;o<4;o++){let i=Gz[o];r[i]=parseFloat(e[t+"-"+i+n])||0}return r.width=r.left+r.right,r.height=r.top+r.bottom,r}var Qz=(e,t,n)=>(e>0||t>0)&&(!n||!n.shadowRoot);function Xz(e,t){let n=e.touches,r=n&&n.length?n[0]:e,{offsetX:o,offsetY:i}=r,a=!1,l,c;if(Qz(o,i,e.target))l=o,c=i;else{let d=t.getBoundingClientRect();l=r.clientX-d.left,c=r.clientY-d.top,a=!0}return{x:l,y:c,box:a}}function gl(e,t){if("native"in e)return e;let{canvas:n,currentDevicePixelRatio:r}=t,o=Eg(n),i=o.boxSizing==="border-box",a=Pu(o,"padding"),l=Pu(o,"border","width"),{x:c,y:d,box:g}=Xz(e,n),v=a.left+(g&&l.left),w=a.top+(g&&l.top),{width:S,height:C}=t;return i&&(S-=a.width+l.width,C-=a.height+l.height),{x:Math.round((c-v)/S*n.width/r),y:Math.round((d-w)/C*n.height/r)}}function Jz(e,t,n){let r,o;if(t===void 0||n===void 0){let i=Pg(e);if(!i)t=e.clientWidth,n=e.clientHeight;else{let a=i.getBoundingClientRect(),l=Eg(i),c=Pu(l,"border","width"),d=Pu(l,"padding");t=a.width-d.width-c.width,n=a.height-d.height-c.height,r=vg(l.maxWidth,i,"clientWidth"),o=vg(l.maxHeight,i,"clientHeight")}}return{width:t,height:n,maxWidth:r||gg,maxHeight:o||gg}}var k6=e=>Math.round(e*10)/10;function xP(e,t,n,r){let o=Eg(e),i=Pu(o,"margin"),a=vg(o.maxWidth,e,"clientWidth")||gg,l=vg(o.maxHeight,e,"clientHeight")||gg,c=Jz(e,t,n),{width:d,height:g}=c;if(o.boxSizing==="content-box"){let v=Pu(o,"border","width"),w=Pu(o,"padding");d-=w.width+v.width,g-=w.height+v.height}return d=Math.max(0,d-i.width),g=Math.max(0,r?Math.floor(d/r):g-i.height),d=k6(Math.min(d,a,c.maxWidth)),g=k6(Math.min(g,l,c.maxHeight)),d&&!g&&(g=k6(d/2)),{width:d,height:g}}function Z6(e,t,n){let r=t||1,o=Math.floor(e.height*r),i=Math.floor(e.width*r);e.height=o/r,e.width=i/r;let a=e.canvas;return a.style&&(n||!a.style.height&&!a.style.width)&&(a.style.height=`${e.height}px`,a.style.width=`${e.width}px`),e.currentDevicePixelRatio!==r||a.height!==o||a.width!==i?(e.currentDevicePixelRatio=r,a.height=o,a.width=i,e.ctx.setTransform(r,0,0,r,0,0),!0):!1}var wP=function(){let e=!1;try{let t={get passive(){return e=!0,!1}};window.addEventListener("test",null,t),window.removeEventListener("test",null,t)}catch(t){}return e}();function e_(e,t){let n=Kz(e,t),r=n&&n.match(/^(\d+)(\.\d+)?px$/);return r?+r[1]:void 0}function ul(e,t,n,r){return{x:e.x+n*(t.x-e.x),y:e.y+n*(t.y-e.y)}}function bP(e,t,n,r){return{x:e.x+n*(t.x-e.x),y:r==="middle"?n<.5?e.y:t.y:r==="after"?n<1?e.y:t.y:n>0?t.y:e.y}}function SP(e,t,n,r){let o={x:e.cp2x,y:e.cp2y},i={x:t.cp1x,y:t.cp1y},a=ul(e,o,n),l=ul(o,i,n),c=ul(i,t,n),d=ul(a,l,n),g=ul(l,c,n);return ul(d,g,n)}var UC=new Map;function Zz(e,t){t=t||{};let n=e+JSON.stringify(t),r=UC.get(n);return r||(r=new Intl.NumberFormat(e,t),UC.set(n,r)),r}function Yf(e,t,n){return Zz(t,n).format(e)}var ej=function(e,t){return{x(n){return e+e+t-n},setWidth(n){t=n},textAlign(n){return n==="center"?n:n==="right"?"left":"right"},xPlus(n,r){return n-r},leftForLtr(n,r){return n-r}}},tj=function(){return{x(e){return e},setWidth(e){},textAlign(e){return e},xPlus(e,t){return e+t},leftForLtr(e,t){return e}}};function Du(e,t,n){return e?ej(t,n):tj()}function t_(e,t){let n,r;(t==="ltr"||t==="rtl")&&(n=e.canvas.style,r=[n.getPropertyValue("direction"),n.getPropertyPriority("direction")],n.setProperty("direction",t,"important"),e.prevTextDirection=r)}function n_(e,t){t!==void 0&&(delete e.prevTextDirection,e.canvas.style.setProperty("direction",t[0],t[1]))}function MP(e){return e==="angle"?{between:Uf,compare:rz,normalize:yo}:{between:bs,compare:(t,n)=>t-n,normalize:t=>t}}function WC({start:e,end:t,count:n,loop:r,style:o}){return{start:e%n,end:t%n,loop:r&&(t-e+1)%n===0,style:o}}function nj(e,t,n){let{property:r,start:o,end:i}=n,{between:a,normalize:l}=MP(r),c=t.length,{start:d,end:g,loop:v}=e,w,S;if(v){for(d+=c,g+=c,w=0,S=c;w<S&&a(l(t[d%c][r]),o,i);++w)d--,g--;d%=c,g%=c}return g<d&&(g+=c),{start:d,end:g,loop:v,style:e.style}}function r_(e,t,n){if(!n)return[e];let{property:r,start:o,end:i}=n,a=t.length,{compare:l,between:c,normalize:d}=MP(r),{start:g,end:v,loop:w,style:S}=nj(e,t,n),C=[],L=!1,k=null,M,P,D,T=()=>c(o,D,M)&&l(o,D)!==0,O=()=>l(i,M)===0||c(i,D,M),A=()=>L||T(),R=()=>!L||O();for(let B=g,Y=g;B<=v;++B)P=t[B%a],!P.skip&&(M=d(P[r]),M!==D&&(L=c(M,o,i),k===null&&A()&&(k=l(M,o)===0?B:Y),k!==null&&R()&&(C.push(WC({start:k,end:B,loop:w,count:a,style:S})),k=null),Y=B,D=M));return k!==null&&C.push(WC({start:k,end:v,loop:w,count:a,style:S})),C}function o_(e,t){let n=[],r=e.segments;for(let o=0;o<r.length;o++){let i=r_(r[o],e.points,t);i.length&&n.push(...i)}return n}function rj(e,t,n,r){let o=0,i=t-1;if(n&&!r)for(;o<t&&!e[o].skip;)o++;for(;o<t&&e[o].skip;)o++;for(o%=t,n&&(i+=o);i>o&&e[i%t].skip;)i--;return i%=t,{start:o,end:i}}function oj(e,t,n,r){let o=e.length,i=[],a=t,l=e[t],c;for(c=t+1;c<=n;++c){let d=e[c%o];d.skip||d.stop?l.skip||(r=!1,i.push({start:t%o,end:(c-1)%o,loop:r}),t=a=d.stop?c:null):(a=c,l.skip&&(t=c)),l=d}return a!==null&&i.push({start:t%o,end:a%o,loop:r}),i}function kP(e,t){let n=e.points,r=e.options.spanGaps,o=n.length;if(!o)return[];let i=!!e._loop,{start:a,end:l}=rj(n,o,i,r);if(r===!0)return $C(e,[{start:a,end:l,loop:i}],n,t);let c=l<a?l+o:l,d=!!e._fullLoop&&a===0&&l===o-1;return $C(e,oj(n,a,c,d),n,t)}function $C(e,t,n,r){return!r||!r.setContext||!n?t:ij(e,t,n,r)}function ij(e,t,n,r){let o=e._chart.getContext(),i=YC(e.options),{_datasetIndex:a,options:{spanGaps:l}}=e,c=n.length,d=[],g=i,v=t[0].start,w=v;function S(C,L,k,M){let P=l?-1:1;if(C!==L){for(C+=c;n[C%c].skip;)C-=P;for(;n[L%c].skip;)L+=P;C%c!==L%c&&(d.push({start:C%c,end:L%c,loop:k,style:M}),g=M,v=L%c)}}for(let C of t){v=l?v:C.start;let L=n[v%c],k;for(w=v+1;w<=C.end;w++){let M=n[w%c];k=YC(r.setContext(ca(o,{type:"segment",p0:L,p1:M,p0DataIndex:(w-1)%c,p1DataIndex:w%c,datasetIndex:a}))),sj(k,g)&&S(v,w-1,C.loop,g),L=M,g=k}v<w-1&&S(v,w-1,C.loop,g)}return d}function YC(e){return{backgroundColor:e.backgroundColor,borderCapStyle:e.borderCapStyle,borderDash:e.borderDash,borderDashOffset:e.borderDashOffset,borderJoinStyle:e.borderJoinStyle,borderWidth:e.borderWidth,borderColor:e.borderColor}}function sj(e,t){return t&&JSON.stringify(e)!==JSON.stringify(t)}var h_=class{constructor(){this._request=null,this._charts=new Map,this._running=!1,this._lastDate=void 0}_notify(t,n,r,o){let i=n.listeners[o],a=n.duration;i.forEach(l=>l({chart:t,initial:n.initial,numSteps:a,currentStep:Math.min(r-n.start,a)}))}_refresh(){this._request||(this._running=!0,this._request=R6.call(window,()=>{this._update(),this._request=null,this._running&&this._refresh()}))}_update(t=Date.now()){let n=0;this._charts.forEach((r,o)=>{if(!r.running||!r.items.length)return;let i=r.items,a=i.length-1,l=!1,c;for(;a>=0;--a)c=i[a],c._active?(c._total>r.duration&&(r.duration=c._total),c.tick(t),l=!0):(i[a]=i[i.length-1],i.pop());l&&(o.draw(),this._notify(o,r,t,"progress")),i.length||(r.running=!1,this._notify(o,r,t,"complete"),r.initial=!1),n+=i.length}),this._lastDate=t,n===0&&(this._running=!1)}_getAnims(t){let n=this._charts,r=n.get(t);return r||(r={running:!1,initial:!0,items:[],listeners:{complete:[],progress:[]}},n.set(t,r)),r}listen(t,n,r){this._getAnims(t).listeners[n].push(r)}add(t,n){!n||!n.length||this._getAnims(t).items.push(...n)}has(t){return this._getAnims(t).items.length>0}start(t){let n=this._charts.get(t);n&&(n.running=!0,n.start=Date.now(),n.duration=n.items.reduce((r,o)=>Math.max(r,o._duration),0),this._refresh())}running(t){if(!this._running)return!1;let n=this._charts.get(t);return!(!n||!n.running||!n.items.length)}stop(t){let n=this._charts.get(t);if(!n||!n.items.length)return;let r=n.items,o=r.length-1;for(;o>=0;--o)r[o].cancel();n.items=[],this._notify(t,n,Date.now(),"complete")}remove(t){return this._charts.delete(t)}},fa=new h_,CP="transparent",aj={boolean(e,t,n){return n>.5?t:e},color(e,t,n){let r=W6(e||CP),o=r.valid&&W6(t||CP);return o&&o.valid?o.mix(r,n).hexString():t},number(e,t,n){return e+(t-e)*n}},m_=class{constructor(t,n,r,o){let i=n[r];o=$f([t.to,o,i,t.from]);let a=$f([t.from,i,o]);this._active=!0,this._fn=t.fn||aj[t.type||typeof a],this._easing=Rf[t.easing]||Rf.linear,this._start=Math.floor(Date.now()+(t.delay||0)),this._duration=this._total=Math.floor(t.duration),this._loop=!!t.loop,this._target=n,this._prop=r,this._from=a,this._to=o,this._promises=void 0}active(){return this._active}update(t,n,r){if(this._active){this._notify(!1);let o=this._target[this._prop],i=r-this._start,a=this._duration-i;this._start=r,this._duration=Math.floor(Math.max(a,t.duration)),this._total+=i,this._loop=!!t.loop,this._to=$f([t.to,n,o,t.from]),this._from=$f([t.from,o,n])}}cancel(){this._active&&(this.tick(Date.now()),this._active=!1,this._notify(!1))}tick(t){let n=t-this._start,r=this._duration,o=this._prop,i=this._from,a=this._loop,l=this._to,c;if(this._active=i!==l&&(a||n<r),!this._active){this._target[o]=l,this._notify(!0);return}if(n<0){this._target[o]=i;return}c=n/r%2,c=a&&c>1?2-c:c,c=this._easing(Math.min(1,Math.max(0,c))),this._target[o]=this._fn(i,l,c)}wait(){let t=this._promises||(this._promises=[]);return new Promise((n,r)=>{t.push({res:n,rej:r})})}_notify(t){let n=t?"res":"rej",r=this._promises||[];for(let o=0;o<r.length;o++)r[o][n]()}},lj=["x","y","borderWidth","radius","tension"],uj=["color","borderColor","backgroundColor"];Ot.set("animation",{delay:void 0,duration:1e3,easing:"easeOutQuart",fn:void 0,from:void 0,loop:void 0,to:void 0,type:void 0});var cj=Object.keys(Ot.animation);Ot.describe("animation",{_fallback:!1,_indexable:!1,_scriptable:e=>e!=="onProgress"&&e!=="onComplete"&&e!=="fn"});Ot.set("animations",{colors:{type:"color",properties:uj},numbers:{type:"number",properties:lj}});Ot.describe("animations",{_fallback:"animation"});Ot.set("transitions",{active:{animation:{duration:400}},resize:{animation:{duration:0}},show:{animations:{colors:{from:"transparent"},visible:{type:"boolean",duration:0}}},hide:{animations:{colors:{to:"transparent"},visible:{type:"boolean",easing:"linear",fn:e=>e|0}}}});var Fg=class{constructor(t,n){this._chart=t,this._properties=new Map,this.configure(n)}configure(t){if(!Lt(t))return;let n=this._properties;Object.getOwnPropertyNames(t).forEach(r=>{let o=t[r];if(!Lt(o))return;let i={};for(let a of cj)i[a]=o[a];(cn(o.properties)&&o.properties||[r]).forEach(a=>{(a===r||!n.has(a))&&n.set(a,i)})})}_animateOptions(t,n){let r=n.options,o=dj(t,r);if(!o)return[];let i=this._createAnimations(o,r);return r.$shared&&fj(t.options.$animations,r).then(()=>{t.options=r},()=>{}),i}_createAnimations(t,n){let r=this._properties,o=[],i=t.$animations||(t.$animations={}),a=Object.keys(n),l=Date.now(),c;for(c=a.length-1;c>=0;--c){let d=a[c];if(d.charAt(0)==="$")continue;if(d==="options"){o.push(...this._animateOptions(t,n));continue}let g=n[d],v=i[d],w=r.get(d);if(v)if(w&&v.active()){v.update(w,g,l);continue}else v.cancel();if(!w||!w.duration){t[d]=g;continue}i[d]=v=new m_(w,t,d,g),o.push(v)}return o}update(t,n){if(this._properties.size===0){Object.assign(t,n);return}let r=this._createAnimations(t,n);if(r.length)return fa.add(this._chart,r),!0}};function fj(e,t){let n=[],r=Object.keys(t);for(let o=0;o<r.length;o++){let i=e[r[o]];i&&i.active()&&n.push(i.wait())}return Promise.all(n)}function dj(e,t){if(!t)return;let n=e.options;if(!n){e.options=t;return}return n.$shared&&(e.options=n=Object.assign({},n,{$shared:!1,$animations:{}})),n}function PP(e,t){let n=e&&e.options||{},r=n.reverse,o=n.min===void 0?t:0,i=n.max===void 0?t:0;return{start:r?i:o,end:r?o:i}}function pj(e,t,n){if(n===!1)return!1;let r=PP(e,n),o=PP(t,n);return{top:o.end,right:r.end,bottom:o.start,left:r.start}}function hj(e){let t,n,r,o;return Lt(e)?(t=e.top,n=e.right,r=e.bottom,o=e.left):t=n=r=o=e,{top:t,right:n,bottom:r,left:o,disabled:e===!1}}function yE(e,t){let n=[],r=e._getSortedDatasetMetas(t),o,i;for(o=0,i=r.length;o<i;++o)n.push(r[o].index);return n}function EP(e,t,n,r={}){let o=e.keys,i=r.mode==="single",a,l,c,d;if(t!==null){for(a=0,l=o.length;a<l;++a){if(c=+o[a],c===n){if(r.all)continue;break}d=e.values[c],Fn(d)&&(i||t===0||Qi(t)===Qi(d))&&(t+=d)}return t}}function mj(e){let t=Object.keys(e),n=new Array(t.length),r,o,i;for(r=0,o=t.length;r<o;++r)i=t[r],n[r]={x:i,y:e[i]};return n}function IP(e,t){let n=e&&e.options.stacked;return n||n===void 0&&t.stack!==void 0}function gj(e,t,n){return`${e.id}.${t.id}.${n.stack||n.type}`}function yj(e){let{min:t,max:n,minDefined:r,maxDefined:o}=e.getUserBounds();return{min:r?t:Number.NEGATIVE_INFINITY,max:o?n:Number.POSITIVE_INFINITY}}function vj(e,t,n){let r=e[t]||(e[t]={});return r[n]||(r[n]={})}function DP(e,t,n,r){for(let o of t.getMatchingVisibleMetas(r).reverse()){let i=e[o.index];if(n&&i>0||!n&&i<0)return o.index}return null}function TP(e,t){let{chart:n,_cachedMeta:r}=e,o=n._stacks||(n._stacks={}),{iScale:i,vScale:a,index:l}=r,c=i.axis,d=a.axis,g=gj(i,a,r),v=t.length,w;for(let S=0;S<v;++S){let C=t[S],{[c]:L,[d]:k}=C,M=C._stacks||(C._stacks={});w=M[d]=vj(o,g,L),w[l]=k,w._top=DP(w,a,!0,r.type),w._bottom=DP(w,a,!1,r.type)}}function i_(e,t){let n=e.scales;return Object.keys(n).filter(r=>n[r].axis===t).shift()}function _j(e,t){return ca(e,{active:!1,dataset:void 0,datasetIndex:t,index:t,mode:"default",type:"dataset"})}function xj(e,t,n){return ca(e,{active:!1,dataIndex:t,parsed:void 0,raw:void 0,element:n,index:t,mode:"default",type:"data"})}function $h(e,t){let n=e.controller.index,r=e.vScale&&e.vScale.axis;if(r){t=t||e._parsed;for(let o of t){let i=o._stacks;if(!i||i[r]===void 0||i[r][n]===void 0)return;delete i[r][n]}}}var s_=e=>e==="reset"||e==="none",LP=(e,t)=>t?e:Object.assign({},e),wj=(e,t,n)=>e&&!t.hidden&&t._stacked&&{keys:yE(n,!0),values:null},Ho=class{constructor(t,n){this.chart=t,this._ctx=t.ctx,this.index=n,this._cachedDataOpts={},this._cachedMeta=this.getMeta(),this._type=this._cachedMeta.type,this.options=void 0,this._parsing=!1,this._data=void 0,this._objectData=void 0,this._sharedOptions=void 0,this._drawStart=void 0,this._drawCount=void 0,this.enableOptionSharing=!1,this.supportsDecimation=!1,this.$context=void 0,this._syncList=[],this.initialize()}initialize(){let t=this._cachedMeta;this.configure(),this.linkScales(),t._stacked=IP(t.vScale,t),this.addElements()}updateIndex(t){this.index!==t&&$h(this._cachedMeta),this.index=t}linkScales(){let t=this.chart,n=this._cachedMeta,r=this.getDataset(),o=(v,w,S,C)=>v==="x"?w:v==="r"?C:S,i=n.xAxisID=Mt(r.xAxisID,i_(t,"x")),a=n.yAxisID=Mt(r.yAxisID,i_(t,"y")),l=n.rAxisID=Mt(r.rAxisID,i_(t,"r")),c=n.indexAxis,d=n.iAxisID=o(c,i,a,l),g=n.vAxisID=o(c,a,i,l);n.xScale=this.getScaleForId(i),n.yScale=this.getScaleForId(a),n.rScale=this.getScaleForId(l),n.iScale=this.getScaleForId(d),n.vScale=this.getScaleForId(g)}getDataset(){return this.chart.data.datasets[this.index]}getMeta(){return this.chart.getDatasetMeta(this.index)}getScaleForId(t){return this.chart.scales[t]}_getOtherScale(t){let n=this._cachedMeta;return t===n.iScale?n.vScale:n.iScale}reset(){this._update("reset")}_destroy(){let t=this._cachedMeta;this._data&&A6(this._data,this),t._stacked&&$h(t)}_dataCheck(){let t=this.getDataset(),n=t.data||(t.data=[]),r=this._data;if(Lt(n))this._data=mj(n);else if(r!==n){if(r){A6(r,this);let o=this._cachedMeta;$h(o),o._parsed=[]}n&&Object.isExtensible(n)&&rP(n,this),this._syncList=[],this._data=n}}addElements(){let t=this._cachedMeta;this._dataCheck(),this.datasetElementType&&(t.dataset=new this.datasetElementType)}buildOrUpdateElements(t){let n=this._cachedMeta,r=this.getDataset(),o=!1;this._dataCheck();let i=n._stacked;n._stacked=IP(n.vScale,n),n.stack!==r.stack&&(o=!0,$h(n),n.stack=r.stack),this._resyncElements(t),(o||i!==n._stacked)&&TP(this,n._parsed)}configure(){let t=this.chart.config,n=t.datasetScopeKeys(this._type),r=t.getOptionScopes(this.getDataset(),n,!0);this.options=t.createResolver(r,this.getContext()),this._parsing=this.options.parsing,this._cachedDataOpts={}}parse(t,n){let{_cachedMeta:r,_data:o}=this,{iScale:i,_stacked:a}=r,l=i.axis,c=t===0&&n===o.length?!0:r._sorted,d=t>0&&r._parsed[t-1],g,v,w;if(this._parsing===!1)r._parsed=o,r._sorted=!0,w=o;else{cn(o[t])?w=this.parseArrayData(r,o,t,n):Lt(o[t])?w=this.parseObjectData(r,o,t,n):w=this.parsePrimitiveData(r,o,t,n);let S=()=>v[l]===null||d&&v[l]<d[l];for(g=0;g<n;++g)r._parsed[g+t]=v=w[g],c&&(S()&&(c=!1),d=v);r._sorted=c}a&&TP(this,w)}parsePrimitiveData(t,n,r,o){let{iScale:i,vScale:a}=t,l=i.axis,c=a.axis,d=i.getLabels(),g=i===a,v=new Array(o),w,S,C;for(w=0,S=o;w<S;++w)C=w+r,v[w]={[l]:g||i.parse(d[C],C),[c]:a.parse(n[C],C)};return v}parseArrayData(t,n,r,o){let{xScale:i,yScale:a}=t,l=new Array(o),c,d,g,v;for(c=0,d=o;c<d;++c)g=c+r,v=n[g],l[c]={x:i.parse(v[0],g),y:a.parse(v[1],g)};return l}parseObjectData(t,n,r,o){let{xScale:i,yScale:a}=t,{xAxisKey:l="x",yAxisKey:c="y"}=this._parsing,d=new Array(o),g,v,w,S;for(g=0,v=o;g<v;++g)w=g+r,S=n[w],d[g]={x:i.parse(ua(S,l),w),y:a.parse(ua(S,c),w)};return d}getParsed(t){return this._cachedMeta._parsed[t]}getDataElement(t){return this._cachedMeta.data[t]}applyStack(t,n,r){let o=this.chart,i=this._cachedMeta,a=n[t.axis],l={keys:yE(o,!0),values:n._stacks[t.axis]};return EP(l,a,i.index,{mode:r})}updateRangeFromParsed(t,n,r,o){let i=r[n.axis],a=i===null?NaN:i,l=o&&r._stacks[n.axis];o&&l&&(o.values=l,a=EP(o,i,this._cachedMeta.index)),t.min=Math.min(t.min,a),t.max=Math.max(t.max,a)}getMinMax(t,n){let r=this._cachedMeta,o=r._parsed,i=r._sorted&&t===r.iScale,a=o.length,l=this._getOtherScale(t),c=wj(n,r,this.chart),d={min:Number.POSITIVE_INFINITY,max:Number.NEGATIVE_INFINITY},{min:g,max:v}=yj(l),w,S;function C(){S=o[w];let L=S[l.axis];return!Fn(S[t.axis])||g>L||v<L}for(w=0;w<a&&!(!C()&&(this.updateRangeFromParsed(d,t,S,c),i));++w);if(i){for(w=a-1;w>=0;--w)if(!C()){this.updateRangeFromParsed(d,t,S,c);break}}return d}getAllParsedValues(t){let n=this._cachedMeta._parsed,r=[],o,i,a;for(o=0,i=n.length;o<i;++o)a=n[o][t.axis],Fn(a)&&r.push(a);return r}getMaxOverflow(){return!1}getLabelAndValue(t){let n=this._cachedMeta,r=n.iScale,o=n.vScale,i=this.getParsed(t);return{label:r?""+r.getLabelForValue(i[r.axis]):"",value:o?""+o.getLabelForValue(i[o.axis]):""}}_update(t){let n=this._cachedMeta;this.update(t||"default"),n._clip=hj(Mt(this.options.clip,pj(n.xScale,n.yScale,this.getMaxOverflow())))}update(t){}draw(){let t=this._ctx,n=this.chart,r=this._cachedMeta,o=r.data||[],i=n.chartArea,a=[],l=this._drawStart||0,c=this._drawCount||o.length-l,d=this.options.drawActiveElementsOnTop,g;for(r.dataset&&r.dataset.draw(t,i,l,c),g=l;g<l+c;++g){let v=o[g];v.hidden||(v.active&&d?a.push(v):v.draw(t,i))}for(g=0;g<a.length;++g)a[g].draw(t,i)}getStyle(t,n){let r=n?"active":"default";return t===void 0&&this._cachedMeta.dataset?this.resolveDatasetElementOptions(r):this.resolveDataElementOptions(t||0,r)}getContext(t,n,r){let o=this.getDataset(),i;if(t>=0&&t<this._cachedMeta.data.length){let a=this._cachedMeta.data[t];i=a.$context||(a.$context=xj(this.getContext(),t,a)),i.parsed=this.getParsed(t),i.raw=o.data[t],i.index=i.dataIndex=t}else i=this.$context||(this.$context=_j(this.chart.getContext(),this.index)),i.dataset=o,i.index=i.datasetIndex=this.index;return i.active=!!n,i.mode=r,i}resolveDatasetElementOptions(t){return this._resolveElementOptions(this.datasetElementType.id,t)}resolveDataElementOptions(t,n){return this._resolveElementOptions(this.dataElementType.id,n,t)}_resolveElementOptions(t,n="default",r){let o=n==="active",i=this._cachedDataOpts,a=t+"-"+n,l=i[a],c=this.enableOptionSharing&&zo(r);if(l)return LP(l,c);let d=this.chart.config,g=d.datasetElementScopeKeys(this._type,t),v=o?[`${t}Hover`,"hover",t,""]:[t,""],w=d.getOptionScopes(this.getDataset(),g),S=Object.keys(Ot.elements[t]),C=()=>this.getContext(r,o),L=d.resolveNamedOptions(w,S,C,v);return L.$shared&&(L.$shared=c,i[a]=Object.freeze(LP(L,c))),L}_resolveAnimations(t,n,r){let o=this.chart,i=this._cachedDataOpts,a=`animation-${n}`,l=i[a];if(l)return l;let c;if(o.options.animation!==!1){let g=this.chart.config,v=g.datasetAnimationScopeKeys(this._type,n),w=g.getOptionScopes(this.getDataset(),v);c=g.createResolver(w,this.getContext(t,r,n))}let d=new Fg(o,c&&c.animations);return c&&c._cacheable&&(i[a]=Object.freeze(d)),d}getSharedOptions(t){if(t.$shared)return this._sharedOptions||(this._sharedOptions=Object.assign({},t))}includeOptions(t,n){return!n||s_(t)||this.chart._animationsDisabled}_getSharedOptions(t,n){let r=this.resolveDataElementOptions(t,n),o=this._sharedOptions,i=this.getSharedOptions(r),a=this.includeOptions(n,i)||i!==o;return this.updateSharedOptions(i,n,r),{sharedOptions:i,includeOptions:a}}updateElement(t,n,r,o){s_(o)?Object.assign(t,r):this._resolveAnimations(n,o).update(t,r)}updateSharedOptions(t,n,r){t&&!s_(n)&&this._resolveAnimations(void 0,n).update(t,r)}_setStyle(t,n,r,o){t.active=o;let i=this.getStyle(n,o);this._resolveAnimations(n,r,o).update(t,{options:!o&&this.getSharedOptions(i)||i})}removeHoverStyle(t,n,r){this._setStyle(t,r,"active",!1)}setHoverStyle(t,n,r){this._setStyle(t,r,"active",!0)}_removeDatasetHoverStyle(){let t=this._cachedMeta.dataset;t&&this._setStyle(t,void 0,"active",!1)}_setDatasetHoverStyle(){let t=this._cachedMeta.dataset;t&&this._setStyle(t,void 0,"active",!0)}_resyncElements(t){let n=this._data,r=this._cachedMeta.data;for(let[l,c,d]of this._syncList)this[l](c,d);this._syncList=[];let o=r.length,i=n.length,a=Math.min(i,o);a&&this.parse(0,a),i>o?this._insertElements(o,i-o,t):i<o&&this._removeElements(i,o-i)}_insertElements(t,n,r=!0){let o=this._cachedMeta,i=o.data,a=t+n,l,c=d=>{for(d.length+=n,l=d.length-1;l>=a;l--)d[l]=d[l-n]};for(c(i),l=t;l<a;++l)i[l]=new this.dataElementType;this._parsing&&c(o._parsed),this.parse(t,n),r&&this.updateElements(i,t,n,"reset")}updateElements(t,n,r,o){}_removeElements(t,n){let r=this._cachedMeta;if(this._parsing){let o=r._parsed.splice(t,n);r._stacked&&$h(r,o)}r.data.splice(t,n)}_sync(t){if(this._parsing)this._syncList.push(t);else{let[n,r,o]=t;this[n](r,o)}this.chart._dataChanges.push([this.index,...t])}_onDataPush(){let t=arguments.length;this._sync(["_insertElements",this.getDataset().data.length-t,t])}_onDataPop(){this._sync(["_removeElements",this._cachedMeta.data.length-1,1])}_onDataShift(){this._sync(["_removeElements",0,1])}_onDataSplice(t,n){n&&this._sync(["_removeElements",t,n]);let r=arguments.length-2;r&&this._sync(["_insertElements",t,r])}_onDataUnshift(){this._sync(["_insertElements",0,arguments.length])}};Ho.defaults={};Ho.prototype.datasetElementType=null;Ho.prototype.dataElementType=null;function bj(e,t){if(!e._cache.$bar){let n=e.getMatchingVisibleMetas(t),r=[];for(let o=0,i=n.length;o<i;o++)r=r.concat(n[o].controller.getAllParsedValues(e));e._cache.$bar=F6(r.sort((o,i)=>o-i))}return e._cache.$bar}function Sj(e){let t=e.iScale,n=bj(t,e.type),r=t._length,o,i,a,l,c=()=>{a===32767||a===-32768||(zo(l)&&(r=Math.min(r,Math.abs(a-l)||r)),l=a)};for(o=0,i=n.length;o<i;++o)a=t.getPixelForValue(n[o]),c();for(l=void 0,o=0,i=t.ticks.length;o<i;++o)a=t.getPixelForTick(o),c();return r}function Mj(e,t,n,r){let o=n.barThickness,i,a;return Ht(o)?(i=t.min*n.categoryPercentage,a=n.barPercentage):(i=o*r,a=1),{chunk:i/r,ratio:a,start:t.pixels[e]-i/2}}function kj(e,t,n,r){let o=t.pixels,i=o[e],a=e>0?o[e-1]:null,l=e<o.length-1?o[e+1]:null,c=n.categoryPercentage;a===null&&(a=i-(l===null?t.end-t.start:l-i)),l===null&&(l=i+i-a);let d=i-(i-Math.min(a,l))/2*c;return{chunk:Math.abs(l-a)/2*c/r,ratio:n.barPercentage,start:d}}function Cj(e,t,n,r){let o=n.parse(e[0],r),i=n.parse(e[1],r),a=Math.min(o,i),l=Math.max(o,i),c=a,d=l;Math.abs(a)>Math.abs(l)&&(c=l,d=a),t[n.axis]=d,t._custom={barStart:c,barEnd:d,start:o,end:i,min:a,max:l}}function vE(e,t,n,r){return cn(e)?Cj(e,t,n,r):t[n.axis]=n.parse(e,r),t}function OP(e,t,n,r){let o=e.iScale,i=e.vScale,a=o.getLabels(),l=o===i,c=[],d,g,v,w;for(d=n,g=n+r;d<g;++d)w=t[d],v={},v[o.axis]=l||o.parse(a[d],d),c.push(vE(w,v,i,d));return c}function a_(e){return e&&e.barStart!==void 0&&e.barEnd!==void 0}function Pj(e,t,n){return e!==0?Qi(e):(t.isHorizontal()?1:-1)*(t.min>=n?1:-1)}function Ej(e){let t,n,r,o,i;return e.horizontal?(t=e.base>e.x,n="left",r="right"):(t=e.base<e.y,n="bottom",r="top"),t?(o="end",i="start"):(o="start",i="end"),{start:n,end:r,reverse:t,top:o,bottom:i}}function Ij(e,t,n,r){let o=t.borderSkipped,i={};if(!o){e.borderSkipped=i;return}if(o===!0){e.borderSkipped={top:!0,right:!0,bottom:!0,left:!0};return}let{start:a,end:l,reverse:c,top:d,bottom:g}=Ej(e);o==="middle"&&n&&(e.enableBorderRadius=!0,(n._top||0)===r?o=d:(n._bottom||0)===r?o=g:(i[NP(g,a,l,c)]=!0,o=d)),i[NP(o,a,l,c)]=!0,e.borderSkipped=i}function NP(e,t,n,r){return r?(e=Dj(e,t,n),e=AP(e,n,t)):e=AP(e,t,n),e}function Dj(e,t,n){return e===t?n:e===n?t:e}function AP(e,t,n){return e==="start"?t:e==="end"?n:e}function Tj(e,{inflateAmount:t},n){e.inflateAmount=t==="auto"?n===1?.33:0:t}var Gf=class extends Ho{parsePrimitiveData(t,n,r,o){return OP(t,n,r,o)}parseArrayData(t,n,r,o){return OP(t,n,r,o)}parseObjectData(t,n,r,o){let{iScale:i,vScale:a}=t,{xAxisKey:l="x",yAxisKey:c="y"}=this._parsing,d=i.axis==="x"?l:c,g=a.axis==="x"?l:c,v=[],w,S,C,L;for(w=r,S=r+o;w<S;++w)L=n[w],C={},C[i.axis]=i.parse(ua(L,d),w),v.push(vE(ua(L,g),C,a,w));return v}updateRangeFromParsed(t,n,r,o){super.updateRangeFromParsed(t,n,r,o);let i=r._custom;i&&n===this._cachedMeta.vScale&&(t.min=Math.min(t.min,i.min),t.max=Math.max(t.max,i.max))}getMaxOverflow(){return 0}getLabelAndValue(t){let n=this._cachedMeta,{iScale:r,vScale:o}=n,i=this.getParsed(t),a=i._custom,l=a_(a)?"["+a.start+", "+a.end+"]":""+o.getLabelForValue(i[o.axis]);return{label:""+r.getLabelForValue(i[r.axis]),value:l}}initialize(){this.enableOptionSharing=!0,super.initialize();let t=this._cachedMeta;t.stack=this.getDataset().stack}update(t){let n=this._cachedMeta;this.updateElements(n.data,0,n.data.length,t)}updateElements(t,n,r,o){let i=o==="reset",{index:a,_cachedMeta:{vScale:l}}=this,c=l.getBasePixel(),d=l.isHorizontal(),g=this._getRuler(),{sharedOptions:v,includeOptions:w}=this._getSharedOptions(n,o);for(let S=n;S<n+r;S++){let C=this.getParsed(S),L=i||Ht(C[l.axis])?{base:c,head:c}:this._calculateBarValuePixels(S),k=this._calculateBarIndexPixels(S,g),M=(C._stacks||{})[l.axis],P={horizontal:d,base:L.base,enableBorderRadius:!M||a_(C._custom)||a===M._top||a===M._bottom,x:d?L.head:k.center,y:d?k.center:L.head,height:d?k.size:Math.abs(L.size),width:d?Math.abs(L.size):k.size};w&&(P.options=v||this.resolveDataElementOptions(S,t[S].active?"active":o));let D=P.options||t[S].options;Ij(P,D,M,a),Tj(P,D,g.ratio),this.updateElement(t[S],S,P,o)}}_getStacks(t,n){let{iScale:r}=this._cachedMeta,o=r.getMatchingVisibleMetas(this._type).filter(c=>c.controller.options.grouped),i=r.options.stacked,a=[],l=c=>{let d=c.controller.getParsed(n),g=d&&d[c.vScale.axis];if(Ht(g)||isNaN(g))return!0};for(let c of o)if(!(n!==void 0&&l(c))&&((i===!1||a.indexOf(c.stack)===-1||i===void 0&&c.stack===void 0)&&a.push(c.stack),c.index===t))break;return a.length||a.push(void 0),a}_getStackCount(t){return this._getStacks(void 0,t).length}_getStackIndex(t,n,r){let o=this._getStacks(t,r),i=n!==void 0?o.indexOf(n):-1;return i===-1?o.length-1:i}_getRuler(){let t=this.options,n=this._cachedMeta,r=n.iScale,o=[],i,a;for(i=0,a=n.data.length;i<a;++i)o.push(r.getPixelForValue(this.getParsed(i)[r.axis],i));let l=t.barThickness;return{min:l||Sj(n),pixels:o,start:r._startPixel,end:r._endPixel,stackCount:this._getStackCount(),scale:r,grouped:t.grouped,ratio:l?1:t.categoryPercentage*t.barPercentage}}_calculateBarValuePixels(t){let{_cachedMeta:{vScale:n,_stacked:r},options:{base:o,minBarLength:i}}=this,a=o||0,l=this.getParsed(t),c=l._custom,d=a_(c),g=l[n.axis],v=0,w=r?this.applyStack(n,l,r):g,S,C;w!==g&&(v=w-g,w=g),d&&(g=c.barStart,w=c.barEnd-c.barStart,g!==0&&Qi(g)!==Qi(c.barEnd)&&(v=0),v+=g);let L=!Ht(o)&&!d?o:v,k=n.getPixelForValue(L);if(this.chart.getDataVisibility(t)?S=n.getPixelForValue(v+w):S=k,C=S-k,Math.abs(C)<i){C=Pj(C,n,a)*i,g===a&&(k-=C/2);let M=n.getPixelForDecimal(0),P=n.getPixelForDecimal(1),D=Math.min(M,P),T=Math.max(M,P);k=Math.max(Math.min(k,T),D),S=k+C}if(k===n.getPixelForValue(a)){let M=Qi(C)*n.getLineWidthForValue(a)/2;k+=M,C-=M}return{size:C,base:k,head:S,center:S+C/2}}_calculateBarIndexPixels(t,n){let r=n.scale,o=this.options,i=o.skipNull,a=Mt(o.maxBarThickness,1/0),l,c;if(n.grouped){let d=i?this._getStackCount(t):n.stackCount,g=o.barThickness==="flex"?kj(t,n,o,d):Mj(t,n,o,d),v=this._getStackIndex(this.index,this._cachedMeta.stack,i?t:void 0);l=g.start+g.chunk*v+g.chunk/2,c=Math.min(a,g.chunk*g.ratio)}else l=r.getPixelForValue(this.getParsed(t)[r.axis],t),c=Math.min(a,n.min*n.ratio);return{base:l-c/2,head:l+c/2,center:l,size:c}}draw(){let t=this._cachedMeta,n=t.vScale,r=t.data,o=r.length,i=0;for(;i<o;++i)this.getParsed(i)[n.axis]!==null&&r[i].draw(this._ctx)}};Gf.id="bar";Gf.defaults={datasetElementType:!1,dataElementType:"bar",categoryPercentage:.8,barPercentage:.9,grouped:!0,animations:{numbers:{type:"number",properties:["x","y","base","width","height"]}}};Gf.overrides={scales:{_index_:{type:"category",offset:!0,grid:{offset:!0}},_value_:{type:"linear",beginAtZero:!0}}};var Qf=class extends Ho{initialize(){this.enableOptionSharing=!0,super.initialize()}parsePrimitiveData(t,n,r,o){let i=super.parsePrimitiveData(t,n,r,o);for(let a=0;a<i.length;a++)i[a]._custom=this.resolveDataElementOptions(a+r).radius;return i}parseArrayData(t,n,r,o){let i=super.parseArrayData(t,n,r,o);for(let a=0;a<i.length;a++){let l=n[r+a];i[a]._custom=Mt(l[2],this.resolveDataElementOptions(a+r).radius)}return i}parseObjectData(t,n,r,o){let i=super.parseObjectData(t,n,r,o);for(let a=0;a<i.length;a++){let l=n[r+a];i[a]._custom=Mt(l&&l.r&&+l.r,this.resolveDataElementOptions(a+r).radius)}return i}getMaxOverflow(){let t=this._cachedMeta.data,n=0;for(let r=t.length-1;r>=0;--r)n=Math.max(n,t[r].size(this.resolveDataElementOptions(r))/2);return n>0&&n}getLabelAndValue(t){let n=this._cachedMeta,{xScale:r,yScale:o}=n,i=this.getParsed(t),a=r.getLabelForValue(i.x),l=o.getLabelForValue(i.y),c=i._custom;return{label:n.label,value:"("+a+", "+l+(c?", "+c:"")+")"}}update(t){let n=this._cachedMeta.data;this.updateElements(n,0,n.length,t)}updateElements(t,n,r,o){let i=o==="reset",{iScale:a,vScale:l}=this._cachedMeta,{sharedOptions:c,includeOptions:d}=this._getSharedOptions(n,o),g=a.axis,v=l.axis;for(let w=n;w<n+r;w++){let S=t[w],C=!i&&this.getParsed(w),L={},k=L[g]=i?a.getPixelForDecimal(.5):a.getPixelForValue(C[g]),M=L[v]=i?l.getBasePixel():l.getPixelForValue(C[v]);L.skip=isNaN(k)||isNaN(M),d&&(L.options=c||this.resolveDataElementOptions(w,S.active?"active":o),i&&(L.options.radius=0)),this.updateElement(S,w,L,o)}}resolveDataElementOptions(t,n){let r=this.getParsed(t),o=super.resolveDataElementOptions(t,n);o.$shared&&(o=Object.assign({},o,{$shared:!1}));let i=o.radius;return n!=="active"&&(o.radius=0),o.radius+=Mt(r&&r._custom,i),o}};Qf.id="bubble";Qf.defaults={datasetElementType:!1,dataElementType:"point",animations:{numbers:{type:"number",properties:["x","y","borderWidth","radius"]}}};Qf.overrides={scales:{x:{type:"linear"},y:{type:"linear"}},plugins:{tooltip:{callbacks:{title(){return""}}}}};function Lj(e,t,n){let r=1,o=1,i=0,a=0;if(t<tn){let l=e,c=l+t,d=Math.cos(l),g=Math.sin(l),v=Math.cos(c),w=Math.sin(c),S=(D,T,O)=>Uf(D,l,c,!0)?1:Math.max(T,T*n,O,O*n),C=(D,T,O)=>Uf(D,l,c,!0)?-1:Math.min(T,T*n,O,O*n),L=S(0,d,v),k=S(Dn,g,w),M=C(_n,d,v),P=C(_n+Dn,g,w);r=(L-M)/2,o=(k-P)/2,i=-(L+M)/2,a=-(k+P)/2}return{ratioX:r,ratioY:o,offsetX:i,offsetY:a}}var _l=class extends Ho{constructor(t,n){super(t,n),this.enableOptionSharing=!0,this.innerRadius=void 0,this.outerRadius=void 0,this.offsetX=void 0,this.offsetY=void 0}linkScales(){}parse(t,n){let r=this.getDataset().data,o=this._cachedMeta;if(this._parsing===!1)o._parsed=r;else{let i=c=>+r[c];if(Lt(r[t])){let{key:c="value"}=this._parsing;i=d=>+ua(r[d],c)}let a,l;for(a=t,l=t+n;a<l;++a)o._parsed[a]=i(a)}}_getRotation(){return gi(this.options.rotation-90)}_getCircumference(){return gi(this.options.circumference)}_getRotationExtents(){let t=tn,n=-tn;for(let r=0;r<this.chart.data.datasets.length;++r)if(this.chart.isDatasetVisible(r)){let o=this.chart.getDatasetMeta(r).controller,i=o._getRotation(),a=o._getCircumference();t=Math.min(t,i),n=Math.max(n,i+a)}return{rotation:t,circumference:n-t}}update(t){let n=this.chart,{chartArea:r}=n,o=this._cachedMeta,i=o.data,a=this.getMaxBorderWidth()+this.getMaxOffset(i)+this.options.spacing,l=Math.max((Math.min(r.width,r.height)-a)/2,0),c=Math.min(KC(this.options.cutout,l),1),d=this._getRingWeight(this.index),{circumference:g,rotation:v}=this._getRotationExtents(),{ratioX:w,ratioY:S,offsetX:C,offsetY:L}=Lj(v,g,c),k=(r.width-a)/w,M=(r.height-a)/S,P=Math.max(Math.min(k,M)/2,0),D=I6(this.options.radius,P),T=Math.max(D*c,0),O=(D-T)/this._getVisibleDatasetWeightTotal();this.offsetX=C*D,this.offsetY=L*D,o.total=this.calculateTotal(),this.outerRadius=D-O*this._getRingWeightOffset(this.index),this.innerRadius=Math.max(this.outerRadius-O*d,0),this.updateElements(i,0,i.length,t)}_circumference(t,n){let r=this.options,o=this._cachedMeta,i=this._getCircumference();return n&&r.animation.animateRotate||!this.chart.getDataVisibility(t)||o._parsed[t]===null||o.data[t].hidden?0:this.calculateCircumference(o._parsed[t]*i/tn)}updateElements(t,n,r,o){let i=o==="reset",a=this.chart,l=a.chartArea,d=a.options.animation,g=(l.left+l.right)/2,v=(l.top+l.bottom)/2,w=i&&d.animateScale,S=w?0:this.innerRadius,C=w?0:this.outerRadius,{sharedOptions:L,includeOptions:k}=this._getSharedOptions(n,o),M=this._getRotation(),P;for(P=0;P<n;++P)M+=this._circumference(P,i);for(P=n;P<n+r;++P){let D=this._circumference(P,i),T=t[P],O={x:g+this.offsetX,y:v+this.offsetY,startAngle:M,endAngle:M+D,circumference:D,outerRadius:C,innerRadius:S};k&&(O.options=L||this.resolveDataElementOptions(P,T.active?"active":o)),M+=D,this.updateElement(T,P,O,o)}}calculateTotal(){let t=this._cachedMeta,n=t.data,r=0,o;for(o=0;o<n.length;o++){let i=t._parsed[o];i!==null&&!isNaN(i)&&this.chart.getDataVisibility(o)&&!n[o].hidden&&(r+=Math.abs(i))}return r}calculateCircumference(t){let n=this._cachedMeta.total;return n>0&&!isNaN(t)?tn*(Math.abs(t)/n):0}getLabelAndValue(t){let n=this._cachedMeta,r=this.chart,o=r.data.labels||[],i=Yf(n._parsed[t],r.options.locale);return{label:o[t]||"",value:i}}getMaxBorderWidth(t){let n=0,r=this.chart,o,i,a,l,c;if(!t){for(o=0,i=r.data.datasets.length;o<i;++o)if(r.isDatasetVisible(o)){a=r.getDatasetMeta(o),t=a.data,l=a.controller;break}}if(!t)return 0;for(o=0,i=t.length;o<i;++o)c=l.resolveDataElementOptions(o),c.borderAlign!=="inner"&&(n=Math.max(n,c.borderWidth||0,c.hoverBorderWidth||0));return n}getMaxOffset(t){let n=0;for(let r=0,o=t.length;r<o;++r){let i=this.resolveDataElementOptions(r);n=Math.max(n,i.offset||0,i.hoverOffset||0)}return n}_getRingWeightOffset(t){let n=0;for(let r=0;r<t;++r)this.chart.isDatasetVisible(r)&&(n+=this._getRingWeight(r));return n}_getRingWeight(t){return Math.max(Mt(this.chart.data.datasets[t].weight,1),0)}_getVisibleDatasetWeightTotal(){return this._getRingWeightOffset(this.chart.data.datasets.length)||1}};_l.id="doughnut";_l.defaults={datasetElementType:!1,dataElementType:"arc",animation:{animateRotate:!0,animateScale:!1},animations:{numbers:{type:"number",properties:["circumference","endAngle","innerRadius","outerRadius","startAngle","x","y","offset","borderWidth","spacing"]}},cutout:"50%",rotation:0,circumference:360,radius:"100%",spacing:0,indexAxis:"r"};_l.descriptors={_scriptable:e=>e!=="spacing",_indexable:e=>e!=="spacing"};_l.overrides={aspectRatio:1,plugins:{legend:{labels:{generateLabels(e){let t=e.data;if(t.labels.length&&t.datasets.length){let{labels:{pointStyle:n}}=e.legend.options;return t.labels.map((r,o)=>{let a=e.getDatasetMeta(0).controller.getStyle(o);return{text:r,fillStyle:a.backgroundColor,strokeStyle:a.borderColor,lineWidth:a.borderWidth,pointStyle:n,hidden:!e.getDataVisibility(o),index:o}})}return[]}},onClick(e,t,n){n.chart.toggleDataVisibility(t.index),n.chart.update()}},tooltip:{callbacks:{title(){return""},label(e){let t=e.label,n=": "+e.formattedValue;return cn(t)?(t=t.slice(),t[0]+=n):t+=n,t}}}}};var Xf=class extends Ho{initialize(){this.enableOptionSharing=!0,this.supportsDecimation=!0,super.initialize()}update(t){let n=this._cachedMeta,{dataset:r,data:o=[],_dataset:i}=n,a=this.chart._animationsDisabled,{start:l,count:c}=z6(n,o,a);this._drawStart=l,this._drawCount=c,j6(n)&&(l=0,c=o.length),r._chart=this.chart,r._datasetIndex=this.index,r._decimated=!!i._decimated,r.points=o;let d=this.resolveDatasetElementOptions(t);this.options.showLine||(d.borderWidth=0),d.segment=this.options.segment,this.updateElement(r,void 0,{animated:!a,options:d},t),this.updateElements(o,l,c,t)}updateElements(t,n,r,o){let i=o==="reset",{iScale:a,vScale:l,_stacked:c,_dataset:d}=this._cachedMeta,{sharedOptions:g,includeOptions:v}=this._getSharedOptions(n,o),w=a.axis,S=l.axis,{spanGaps:C,segment:L}=this.options,k=Iu(C)?C:Number.POSITIVE_INFINITY,M=this.chart._animationsDisabled||i||o==="none",P=n>0&&this.getParsed(n-1);for(let D=n;D<n+r;++D){let T=t[D],O=this.getParsed(D),A=M?T:{},R=Ht(O[S]),B=A[w]=a.getPixelForValue(O[w],D),Y=A[S]=i||R?l.getBasePixel():l.getPixelForValue(c?this.applyStack(l,O,c):O[S],D);A.skip=isNaN(B)||isNaN(Y)||R,A.stop=D>0&&Math.abs(O[w]-P[w])>k,L&&(A.parsed=O,A.raw=d.data[D]),v&&(A.options=g||this.resolveDataElementOptions(D,T.active?"active":o)),M||this.updateElement(T,D,A,o),P=O}}getMaxOverflow(){let t=this._cachedMeta,n=t.dataset,r=n.options&&n.options.borderWidth||0,o=t.data||[];if(!o.length)return r;let i=o[0].size(this.resolveDataElementOptions(0)),a=o[o.length-1].size(this.resolveDataElementOptions(o.length-1));return Math.max(r,i,a)/2}draw(){let t=this._cachedMeta;t.dataset.updateControlPoints(this.chart.chartArea,t.iScale.axis),super.draw()}};Xf.id="line";Xf.defaults={datasetElementType:"line",dataElementType:"point",showLine:!0,spanGaps:!1};Xf.overrides={scales:{_index_:{type:"category"},_value_:{type:"linear"}}};var Jf=class extends Ho{constructor(t,n){super(t,n),this.innerRadius=void 0,this.outerRadius=void 0}getLabelAndValue(t){let n=this._cachedMeta,r=this.chart,o=r.data.labels||[],i=Yf(n._parsed[t].r,r.options.locale);return{label:o[t]||"",value:i}}parseObjectData(t,n,r,o){return X6.bind(this)(t,n,r,o)}update(t){let n=this._cachedMeta.data;this._updateRadius(),this.updateElements(n,0,n.length,t)}getMinMax(){let t=this._cachedMeta,n={min:Number.POSITIVE_INFINITY,max:Number.NEGATIVE_INFINITY};return t.data.forEach((r,o)=>{let i=this.getParsed(o).r;!isNaN(i)&&this.chart.getDataVisibility(o)&&(i<n.min&&(n.min=i),i>n.max&&(n.max=i))}),n}_updateRadius(){let t=this.chart,n=t.chartArea,r=t.options,o=Math.min(n.right-n.left,n.bottom-n.top),i=Math.max(o/2,0),a=Math.max(r.cutoutPercentage?i/100*r.cutoutPercentage:1,0),l=(i-a)/t.getVisibleDatasetCount();this.outerRadius=i-l*this.index,this.innerRadius=this.outerRadius-l}updateElements(t,n,r,o){let i=o==="reset",a=this.chart,c=a.options.animation,d=this._cachedMeta.rScale,g=d.xCenter,v=d.yCenter,w=d.getIndexAngle(0)-.5*_n,S=w,C,L=360/this.countVisibleElements();for(C=0;C<n;++C)S+=this._computeAngle(C,o,L);for(C=n;C<n+r;C++){let k=t[C],M=S,P=S+this._computeAngle(C,o,L),D=a.getDataVisibility(C)?d.getDistanceFromCenterForValue(this.getParsed(C).r):0;S=P,i&&(c.animateScale&&(D=0),c.animateRotate&&(M=P=w));let T={x:g,y:v,innerRadius:0,outerRadius:D,startAngle:M,endAngle:P,options:this.resolveDataElementOptions(C,k.active?"active":o)};this.updateElement(k,C,T,o)}}countVisibleElements(){let t=this._cachedMeta,n=0;return t.data.forEach((r,o)=>{!isNaN(this.getParsed(o).r)&&this.chart.getDataVisibility(o)&&n++}),n}_computeAngle(t,n,r){return this.chart.getDataVisibility(t)?gi(this.resolveDataElementOptions(t,n).angle||r):0}};Jf.id="polarArea";Jf.defaults={dataElementType:"arc",animation:{animateRotate:!0,animateScale:!0},animations:{numbers:{type:"number",properties:["x","y","startAngle","endAngle","innerRadius","outerRadius"]}},indexAxis:"r",startAngle:0};Jf.overrides={aspectRatio:1,plugins:{legend:{labels:{generateLabels(e){let t=e.data;if(t.labels.length&&t.datasets.length){let{labels:{pointStyle:n}}=e.legend.options;return t.labels.map((r,o)=>{let a=e.getDatasetMeta(0).controller.getStyle(o);return{text:r,fillStyle:a.backgroundColor,strokeStyle:a.borderColor,lineWidth:a.borderWidth,pointStyle:n,hidden:!e.getDataVisibility(o),index:o}})}return[]}},onClick(e,t,n){n.chart.toggleDataVisibility(t.index),n.chart.update()}},tooltip:{callbacks:{title(){return""},label(e){return e.chart.data.labels[e.dataIndex]+": "+e.formattedValue}}}},scales:{r:{type:"radialLinear",angleLines:{display:!1},beginAtZero:!0,grid:{circular:!0},pointLabels:{display:!1},startAngle:0}}};var Jh=class extends _l{};Jh.id="pie";Jh.defaults={cutout:0,rotation:0,circumference:360,radius:"100%"};var Zf=class extends Ho{getLabelAndValue(t){let n=this._cachedMeta.vScale,r=this.getParsed(t);return{label:n.getLabels()[t],value:""+n.getLabelForValue(r[n.axis])}}parseObjectData(t,n,r,o){return X6.bind(this)(t,n,r,o)}update(t){let n=this._cachedMeta,r=n.dataset,o=n.data||[],i=n.iScale.getLabels();if(r.points=o,t!=="resize"){let a=this.resolveDatasetElementOptions(t);this.options.showLine||(a.borderWidth=0);let l={_loop:!0,_fullLoop:i.length===o.length,options:a};this.updateElement(r,void 0,l,t)}this.updateElements(o,0,o.length,t)}updateElements(t,n,r,o){let i=this._cachedMeta.rScale,a=o==="reset";for(let l=n;l<n+r;l++){let c=t[l],d=this.resolveDataElementOptions(l,c.active?"active":o),g=i.getPointPositionForValue(l,this.getParsed(l).r),v=a?i.xCenter:g.x,w=a?i.yCenter:g.y,S={x:v,y:w,angle:g.angle,skip:isNaN(v)||isNaN(w),options:d};this.updateElement(c,l,S,o)}}};Zf.id="radar";Zf.defaults={datasetElementType:"line",dataElementType:"point",indexAxis:"r",showLine:!0,elements:{line:{fill:"start"}}};Zf.overrides={aspectRatio:1,scales:{r:{type:"radialLinear"}}};var Uo=class{constructor(){this.x=void 0,this.y=void 0,this.active=!1,this.options=void 0,this.$animations=void 0}tooltipPosition(t){let{x:n,y:r}=this.getProps(["x","y"],t);return{x:n,y:r}}hasValue(){return Iu(this.x)&&Iu(this.y)}getProps(t,n){let r=this.$animations;if(!n||!r)return this;let o={};return t.forEach(i=>{o[i]=r[i]&&r[i].active()?r[i]._to:this[i]}),o}};Uo.defaults={};Uo.defaultRoutes=void 0;var _E={values(e){return cn(e)?e:""+e},numeric(e,t,n){if(e===0)return"0";let r=this.chart.options.locale,o,i=e;if(n.length>1){let d=Math.max(Math.abs(n[0].value),Math.abs(n[n.length-1].value));(d<1e-4||d>1e15)&&(o="scientific"),i=Oj(e,n)}let a=Vo(Math.abs(i)),l=Math.max(Math.min(-1*Math.floor(a),20),0),c={notation:o,minimumFractionDigits:l,maximumFractionDigits:l};return Object.assign(c,this.options.ticks.format),Yf(e,r,c)},logarithmic(e,t,n){if(e===0)return"0";let r=e/Math.pow(10,Math.floor(Vo(e)));return r===1||r===2||r===5?_E.numeric.call(this,e,t,n):""}};function Oj(e,t){let n=t.length>3?t[2].value-t[1].value:t[1].value-t[0].value;return Math.abs(n)>=1&&e!==Math.floor(e)&&(n=e-Math.floor(e)),n}var Hg={formatters:_E};Ot.set("scale",{display:!0,offset:!1,reverse:!1,beginAtZero:!1,bounds:"ticks",grace:0,grid:{display:!0,lineWidth:1,drawBorder:!0,drawOnChartArea:!0,drawTicks:!0,tickLength:8,tickWidth:(e,t)=>t.lineWidth,tickColor:(e,t)=>t.color,offset:!1,borderDash:[],borderDashOffset:0,borderWidth:1},title:{display:!1,text:"",padding:{top:4,bottom:4}},ticks:{minRotation:0,maxRotation:50,mirror:!1,textStrokeWidth:0,textStrokeColor:"",padding:3,display:!0,autoSkip:!0,autoSkipPadding:3,labelOffset:0,callback:Hg.formatters.values,minor:{},major:{},align:"center",crossAlign:"near",showLabelBackdrop:!1,backdropColor:"rgba(255, 255, 255, 0.75)",backdropPadding:2}});Ot.route("scale.ticks","color","","color");Ot.route("scale.grid","color","","borderColor");Ot.route("scale.grid","borderColor","","borderColor");Ot.route("scale.title","color","","color");Ot.describe("scale",{_fallback:!1,_scriptable:e=>!e.startsWith("before")&&!e.startsWith("after")&&e!=="callback"&&e!=="parser",_indexable:e=>e!=="borderDash"&&e!=="tickBorderDash"});Ot.describe("scales",{_fallback:"scale"});Ot.describe("scale.ticks",{_scriptable:e=>e!=="backdropPadding"&&e!=="callback",_indexable:e=>e!=="backdropPadding"});function Nj(e,t){let n=e.options.ticks,r=n.maxTicksLimit||Aj(e),o=n.major.enabled?Rj(t):[],i=o.length,a=o[0],l=o[i-1],c=[];if(i>r)return Bj(t,c,o,i/r),c;let d=Fj(o,t,r);if(i>0){let g,v,w=i>1?Math.round((l-a)/(i-1)):null;for(Ig(t,c,d,Ht(w)?0:a-w,a),g=0,v=i-1;g<v;g++)Ig(t,c,d,o[g],o[g+1]);return Ig(t,c,d,l,Ht(w)?t.length:l+w),c}return Ig(t,c,d),c}function Aj(e){let t=e.options.offset,n=e._tickSize(),r=e._length/n+(t?0:1),o=e._maxLength/n;return Math.floor(Math.min(r,o))}function Fj(e,t,n){let r=zj(e),o=t.length/n;if(!r)return Math.max(o,1);let i=XC(r);for(let a=0,l=i.length-1;a<l;a++){let c=i[a];if(c>o)return c}return Math.max(o,1)}function Rj(e){let t=[],n,r;for(n=0,r=e.length;n<r;n++)e[n].major&&t.push(n);return t}function Bj(e,t,n,r){let o=0,i=n[0],a;for(r=Math.ceil(r),a=0;a<e.length;a++)a===i&&(t.push(e[a]),o++,i=n[o*r])}function Ig(e,t,n,r,o){let i=Mt(r,0),a=Math.min(Mt(o,e.length),e.length),l=0,c,d,g;for(n=Math.ceil(n),o&&(c=o-r,n=c/Math.floor(c/n)),g=i;g<0;)l++,g=Math.round(i+l*n);for(d=Math.max(i,0);d<a;d++)d===g&&(t.push(e[d]),l++,g=Math.round(i+l*n))}function zj(e){let t=e.length,n,r;if(t<2)return!1;for(r=e[0],n=1;n<t;++n)if(e[n]-e[n-1]!==r)return!1;return r}var jj=e=>e==="left"?"right":e==="right"?"left":e,FP=(e,t,n)=>t==="top"||t==="left"?e[t]+n:e[t]-n;function RP(e,t){let n=[],r=e.length/t,o=e.length,i=0;for(;i<o;i+=r)n.push(e[Math.floor(i)]);return n}function Vj(e,t,n){let r=e.ticks.length,o=Math.min(t,r-1),i=e._startPixel,a=e._endPixel,l=1e-6,c=e.getPixelForTick(o),d;if(!(n&&(r===1?d=Math.max(c-i,a-c):t===0?d=(e.getPixelForTick(1)-c)/2:d=(c-e.getPixelForTick(o-1))/2,c+=o<t?d:-d,c<i-l||c>a+l)))return c}function Hj(e,t){Xt(e,n=>{let r=n.gc,o=r.length/2,i;if(o>t){for(i=0;i<o;++i)delete n.data[r[i]];r.splice(0,o)}})}function Yh(e){return e.drawTicks?e.tickLength:0}function BP(e,t){if(!e.display)return 0;let n=Qn(e.font,t),r=Pr(e.padding);return(cn(e.text)?e.text.length:1)*n.lineHeight+r.height}function Uj(e,t){return ca(e,{scale:t,type:"scale"})}function Wj(e,t,n){return ca(e,{tick:n,index:t,type:"tick"})}function $j(e,t,n){let r=bg(e);return(n&&t!=="right"||!n&&t==="right")&&(r=jj(r)),r}function Yj(e,t,n,r){let{top:o,left:i,bottom:a,right:l,chart:c}=e,{chartArea:d,scales:g}=c,v=0,w,S,C,L=a-o,k=l-i;if(e.isHorizontal()){if(S=Cr(r,i,l),Lt(n)){let M=Object.keys(n)[0],P=n[M];C=g[M].getPixelForValue(P)+L-t}else n==="center"?C=(d.bottom+d.top)/2+L-t:C=FP(e,n,t);w=l-i}else{if(Lt(n)){let M=Object.keys(n)[0],P=n[M];S=g[M].getPixelForValue(P)-k+t}else n==="center"?S=(d.left+d.right)/2-k+t:S=FP(e,n,t);C=Cr(r,a,o),v=n==="left"?-Dn:Dn}return{titleX:S,titleY:C,maxWidth:w,rotation:v}}var Lu=class e extends Uo{constructor(t){super(),this.id=t.id,this.type=t.type,this.options=void 0,this.ctx=t.ctx,this.chart=t.chart,this.top=void 0,this.bottom=void 0,this.left=void 0,this.right=void 0,this.width=void 0,this.height=void 0,this._margins={left:0,right:0,top:0,bottom:0},this.maxWidth=void 0,this.maxHeight=void 0,this.paddingTop=void 0,this.paddingBottom=void 0,this.paddingLeft=void 0,this.paddingRight=void 0,this.axis=void 0,this.labelRotation=void 0,this.min=void 0,this.max=void 0,this._range=void 0,this.ticks=[],this._gridLineItems=null,this._labelItems=null,this._labelSizes=null,this._length=0,this._maxLength=0,this._longestTextCache={},this._startPixel=void 0,this._endPixel=void 0,this._reversePixels=!1,this._userMax=void 0,this._userMin=void 0,this._suggestedMax=void 0,this._suggestedMin=void 0,this._ticksLength=0,this._borderValue=0,this._cache={},this._dataLimitsCached=!1,this.$context=void 0}init(t){this.options=t.setContext(this.getContext()),this.axis=t.axis,this._userMin=this.parse(t.min),this._userMax=this.parse(t.max),this._suggestedMin=this.parse(t.suggestedMin),this._suggestedMax=this.parse(t.suggestedMax)}parse(t,n){return t}getUserBounds(){let{_userMin:t,_userMax:n,_suggestedMin:r,_suggestedMax:o}=this;return t=jo(t,Number.POSITIVE_INFINITY),n=jo(n,Number.NEGATIVE_INFINITY),r=jo(r,Number.POSITIVE_INFINITY),o=jo(o,Number.NEGATIVE_INFINITY),{min:jo(t,r),max:jo(n,o),minDefined:Fn(t),maxDefined:Fn(n)}}getMinMax(t){let{min:n,max:r,minDefined:o,maxDefined:i}=this.getUserBounds(),a;if(o&&i)return{min:n,max:r};let l=this.getMatchingVisibleMetas();for(let c=0,d=l.length;c<d;++c)a=l[c].controller.getMinMax(this,t),o||(n=Math.min(n,a.min)),i||(r=Math.max(r,a.max));return n=i&&n>r?r:n,r=o&&n>r?n:r,{min:jo(n,jo(r,n)),max:jo(r,jo(n,r))}}getPadding(){return{left:this.paddingLeft||0,top:this.paddingTop||0,right:this.paddingRight||0,bottom:this.paddingBottom||0}}getTicks(){return this.ticks}getLabels(){let t=this.chart.data;return this.options.labels||(this.isHorizontal()?t.xLabels:t.yLabels)||t.labels||[]}beforeLayout(){this._cache={},this._dataLimitsCached=!1}beforeUpdate(){fn(this.options.beforeUpdate,[this])}update(t,n,r){let{beginAtZero:o,grace:i,ticks:a}=this.options,l=a.sampleSize;this.beforeUpdate(),this.maxWidth=t,this.maxHeight=n,this._margins=r=Object.assign({left:0,right:0,top:0,bottom:0},r),this.ticks=null,this._labelSizes=null,this._gridLineItems=null,this._labelItems=null,this.beforeSetDimensions(),this.setDimensions(),this.afterSetDimensions(),this._maxLength=this.isHorizontal()?this.width+r.left+r.right:this.height+r.top+r.bottom,this._dataLimitsCached||(this.beforeDataLimits(),this.determineDataLimits(),this.afterDataLimits(),this._range=hP(this,i,o),this._dataLimitsCached=!0),this.beforeBuildTicks(),this.ticks=this.buildTicks()||[],this.afterBuildTicks();let c=l<this.ticks.length;this._convertTicksToLabels(c?RP(this.ticks,l):this.ticks),this.configure(),this.beforeCalculateLabelRotation(),this.calculateLabelRotation(),this.afterCalculateLabelRotation(),a.display&&(a.autoSkip||a.source==="auto")&&(this.ticks=Nj(this,this.ticks),this._labelSizes=null,this.afterAutoSkip()),c&&this._convertTicksToLabels(this.ticks),this.beforeFit(),this.fit(),this.afterFit(),this.afterUpdate()}configure(){let t=this.options.reverse,n,r;this.isHorizontal()?(n=this.left,r=this.right):(n=this.top,r=this.bottom,t=!t),this._startPixel=n,this._endPixel=r,this._reversePixels=t,this._length=r-n,this._alignToPixels=this.options.alignToPixels}afterUpdate(){fn(this.options.afterUpdate,[this])}beforeSetDimensions(){fn(this.options.beforeSetDimensions,[this])}setDimensions(){this.isHorizontal()?(this.width=this.maxWidth,this.left=0,this.right=this.width):(this.height=this.maxHeight,this.top=0,this.bottom=this.height),this.paddingLeft=0,this.paddingTop=0,this.paddingRight=0,this.paddingBottom=0}afterSetDimensions(){fn(this.options.afterSetDimensions,[this])}_callHooks(t){this.chart.notifyPlugins(t,this.getContext()),fn(this.options[t],[this])}beforeDataLimits(){this._callHooks("beforeDataLimits")}determineDataLimits(){}afterDataLimits(){this._callHooks("afterDataLimits")}beforeBuildTicks(){this._callHooks("beforeBuildTicks")}buildTicks(){return[]}afterBuildTicks(){this._callHooks("afterBuildTicks")}beforeTickToLabelConversion(){fn(this.options.beforeTickToLabelConversion,[this])}generateTickLabels(t){let n=this.options.ticks,r,o,i;for(r=0,o=t.length;r<o;r++)i=t[r],i.label=fn(n.callback,[i.value,r,t],this)}afterTickToLabelConversion(){fn(this.options.afterTickToLabelConversion,[this])}beforeCalculateLabelRotation(){fn(this.options.beforeCalculateLabelRotation,[this])}calculateLabelRotation(){let t=this.options,n=t.ticks,r=this.ticks.length,o=n.minRotation||0,i=n.maxRotation,a=o,l,c,d;if(!this._isVisible()||!n.display||o>=i||r<=1||!this.isHorizontal()){this.labelRotation=o;return}let g=this._getLabelSizes(),v=g.widest.width,w=g.highest.height,S=sr(this.chart.width-v,0,this.maxWidth);l=t.offset?this.maxWidth/r:S/(r-1),v+6>l&&(l=S/(r-(t.offset?.5:1)),c=this.maxHeight-Yh(t.grid)-n.padding-BP(t.title,this.chart.options.font),d=Math.sqrt(v*v+w*w),a=xg(Math.min(Math.asin(sr((g.highest.height+6)/l,-1,1)),Math.asin(sr(c/d,-1,1))-Math.asin(sr(w/d,-1,1)))),a=Math.max(o,Math.min(i,a))),this.labelRotation=a}afterCalculateLabelRotation(){fn(this.options.afterCalculateLabelRotation,[this])}afterAutoSkip(){}beforeFit(){fn(this.options.beforeFit,[this])}fit(){let t={width:0,height:0},{chart:n,options:{ticks:r,title:o,grid:i}}=this,a=this._isVisible(),l=this.isHorizontal();if(a){let c=BP(o,n.options.font);if(l?(t.width=this.maxWidth,t.height=Yh(i)+c):(t.height=this.maxHeight,t.width=Yh(i)+c),r.display&&this.ticks.length){let{first:d,last:g,widest:v,highest:w}=this._getLabelSizes(),S=r.padding*2,C=gi(this.labelRotation),L=Math.cos(C),k=Math.sin(C);if(l){let M=r.mirror?0:k*v.width+L*w.height;t.height=Math.min(this.maxHeight,t.height+M+S)}else{let M=r.mirror?0:L*v.width+k*w.height;t.width=Math.min(this.maxWidth,t.width+M+S)}this._calculatePadding(d,g,k,L)}}this._handleMargins(),l?(this.width=this._length=n.width-this._margins.left-this._margins.right,this.height=t.height):(this.width=t.width,this.height=this._length=n.height-this._margins.top-this._margins.bottom)}_calculatePadding(t,n,r,o){let{ticks:{align:i,padding:a},position:l}=this.options,c=this.labelRotation!==0,d=l!=="top"&&this.axis==="x";if(this.isHorizontal()){let g=this.getPixelForTick(0)-this.left,v=this.right-this.getPixelForTick(this.ticks.length-1),w=0,S=0;c?d?(w=o*t.width,S=r*n.height):(w=r*t.height,S=o*n.width):i==="start"?S=n.width:i==="end"?w=t.width:i!=="inner"&&(w=t.width/2,S=n.width/2),this.paddingLeft=Math.max((w-g+a)*this.width/(this.width-g),0),this.paddingRight=Math.max((S-v+a)*this.width/(this.width-v),0)}else{let g=n.height/2,v=t.height/2;i==="start"?(g=0,v=t.height):i==="end"&&(g=n.height,v=0),this.paddingTop=g+a,this.paddingBottom=v+a}}_handleMargins(){this._margins&&(this._margins.left=Math.max(this.paddingLeft,this._margins.left),this._margins.top=Math.max(this.paddingTop,this._margins.top),this._margins.right=Math.max(this.paddingRight,this._margins.right),this._margins.bottom=Math.max(this.paddingBottom,this._margins.bottom))}afterFit(){fn(this.options.afterFit,[this])}isHorizontal(){let{axis:t,position:n}=this.options;return n==="top"||n==="bottom"||t==="x"}isFullSize(){return this.options.fullSize}_convertTicksToLabels(t){this.beforeTickToLabelConversion(),this.generateTickLabels(t);let n,r;for(n=0,r=t.length;n<r;n++)Ht(t[n].label)&&(t.splice(n,1),r--,n--);this.afterTickToLabelConversion()}_getLabelSizes(){let t=this._labelSizes;if(!t){let n=this.options.ticks.sampleSize,r=this.ticks;n<r.length&&(r=RP(r,n)),this._labelSizes=t=this._computeLabelSizes(r,r.length)}return t}_computeLabelSizes(t,n){let{ctx:r,_longestTextCache:o}=this,i=[],a=[],l=0,c=0,d,g,v,w,S,C,L,k,M,P,D;for(d=0;d<n;++d){if(w=t[d].label,S=this._resolveTickFontOptions(d),r.font=C=S.string,L=o[C]=o[C]||{data:{},gc:[]},k=S.lineHeight,M=P=0,!Ht(w)&&!cn(w))M=jh(r,L.data,L.gc,M,w),P=k;else if(cn(w))for(g=0,v=w.length;g<v;++g)D=w[g],!Ht(D)&&!cn(D)&&(M=jh(r,L.data,L.gc,M,D),P+=k);i.push(M),a.push(P),l=Math.max(M,l),c=Math.max(P,c)}Hj(o,n);let T=i.indexOf(l),O=a.indexOf(c),A=R=>({width:i[R]||0,height:a[R]||0});return{first:A(0),last:A(n-1),widest:A(T),highest:A(O),widths:i,heights:a}}getLabelForValue(t){return t}getPixelForValue(t,n){return NaN}getValueForPixel(t){}getPixelForTick(t){let n=this.ticks;return t<0||t>n.length-1?null:this.getPixelForValue(n[t].value)}getPixelForDecimal(t){this._reversePixels&&(t=1-t);let n=this._startPixel+t*this._length;return ZC(this._alignToPixels?pl(this.chart,n,0):n)}getDecimalForPixel(t){let n=(t-this._startPixel)/this._length;return this._reversePixels?1-n:n}getBasePixel(){return this.getPixelForValue(this.getBaseValue())}getBaseValue(){let{min:t,max:n}=this;return t<0&&n<0?n:t>0&&n>0?t:0}getContext(t){let n=this.ticks||[];if(t>=0&&t<n.length){let r=n[t];return r.$context||(r.$context=Wj(this.getContext(),t,r))}return this.$context||(this.$context=Uj(this.chart.getContext(),this))}_tickSize(){let t=this.options.ticks,n=gi(this.labelRotation),r=Math.abs(Math.cos(n)),o=Math.abs(Math.sin(n)),i=this._getLabelSizes(),a=t.autoSkipPadding||0,l=i?i.widest.width+a:0,c=i?i.highest.height+a:0;return this.isHorizontal()?c*r>l*o?l/r:c/o:c*o<l*r?c/r:l/o}_isVisible(){let t=this.options.display;return t!=="auto"?!!t:this.getMatchingVisibleMetas().length>0}_computeGridLineItems(t){let n=this.axis,r=this.chart,o=this.options,{grid:i,position:a}=o,l=i.offset,c=this.isHorizontal(),g=this.ticks.length+(l?1:0),v=Yh(i),w=[],S=i.setContext(this.getContext()),C=S.drawBorder?S.borderWidth:0,L=C/2,k=function(te){return pl(r,te,C)},M,P,D,T,O,A,R,B,Y,K,Q,ne;if(a==="top")M=k(this.bottom),A=this.bottom-v,B=M-L,K=k(t.top)+L,ne=t.bottom;else if(a==="bottom")M=k(this.top),K=t.top,ne=k(t.bottom)-L,A=M+L,B=this.top+v;else if(a==="left")M=k(this.right),O=this.right-v,R=M-L,Y=k(t.left)+L,Q=t.right;else if(a==="right")M=k(this.left),Y=t.left,Q=k(t.right)-L,O=M+L,R=this.left+v;else if(n==="x"){if(a==="center")M=k((t.top+t.bottom)/2+.5);else if(Lt(a)){let te=Object.keys(a)[0],z=a[te];M=k(this.chart.scales[te].getPixelForValue(z))}K=t.top,ne=t.bottom,A=M+L,B=A+v}else if(n==="y"){if(a==="center")M=k((t.left+t.right)/2);else if(Lt(a)){let te=Object.keys(a)[0],z=a[te];M=k(this.chart.scales[te].getPixelForValue(z))}O=M-L,R=O-v,Y=t.left,Q=t.right}let oe=Mt(o.ticks.maxTicksLimit,g),ie=Math.max(1,Math.ceil(g/oe));for(P=0;P<g;P+=ie){let te=i.setContext(this.getContext(P)),z=te.lineWidth,V=te.color,p=te.borderDash||[],fe=te.borderDashOffset,xe=te.tickWidth,Me=te.tickColor,Ae=te.tickBorderDash||[],qe=te.tickBorderDashOffset;D=Vj(this,P,l),D!==void 0&&(T=pl(r,D,z),c?O=R=Y=Q=T:A=B=K=ne=T,w.push({tx1:O,ty1:A,tx2:R,ty2:B,x1:Y,y1:K,x2:Q,y2:ne,width:z,color:V,borderDash:p,borderDashOffset:fe,tickWidth:xe,tickColor:Me,tickBorderDash:Ae,tickBorderDashOffset:qe}))}return this._ticksLength=g,this._borderValue=M,w}_computeLabelItems(t){let n=this.axis,r=this.options,{position:o,ticks:i}=r,a=this.isHorizontal(),l=this.ticks,{align:c,crossAlign:d,padding:g,mirror:v}=i,w=Yh(r.grid),S=w+g,C=v?-g:S,L=-gi(this.labelRotation),k=[],M,P,D,T,O,A,R,B,Y,K,Q,ne,oe="middle";if(o==="top")A=this.bottom-C,R=this._getXAxisLabelAlignment();else if(o==="bottom")A=this.top+C,R=this._getXAxisLabelAlignment();else if(o==="left"){let te=this._getYAxisLabelAlignment(w);R=te.textAlign,O=te.x}else if(o==="right"){let te=this._getYAxisLabelAlignment(w);R=te.textAlign,O=te.x}else if(n==="x"){if(o==="center")A=(t.top+t.bottom)/2+S;else if(Lt(o)){let te=Object.keys(o)[0],z=o[te];A=this.chart.scales[te].getPixelForValue(z)+S}R=this._getXAxisLabelAlignment()}else if(n==="y"){if(o==="center")O=(t.left+t.right)/2-S;else if(Lt(o)){let te=Object.keys(o)[0],z=o[te];O=this.chart.scales[te].getPixelForValue(z)}R=this._getYAxisLabelAlignment(w).textAlign}n==="y"&&(c==="start"?oe="top":c==="end"&&(oe="bottom"));let ie=this._getLabelSizes();for(M=0,P=l.length;M<P;++M){D=l[M],T=D.label;let te=i.setContext(this.getContext(M));B=this.getPixelForTick(M)+i.labelOffset,Y=this._resolveTickFontOptions(M),K=Y.lineHeight,Q=cn(T)?T.length:1;let z=Q/2,V=te.color,p=te.textStrokeColor,fe=te.textStrokeWidth,xe=R;a?(O=B,R==="inner"&&(M===P-1?xe=this.options.reverse?"left":"right":M===0?xe=this.options.reverse?"right":"left":xe="center"),o==="top"?d==="near"||L!==0?ne=-Q*K+K/2:d==="center"?ne=-ie.highest.height/2-z*K+K:ne=-ie.highest.height+K/2:d==="near"||L!==0?ne=K/2:d==="center"?ne=ie.highest.height/2-z*K:ne=ie.highest.height-Q*K,v&&(ne*=-1)):(A=B,ne=(1-Q)*K/2);let Me;if(te.showLabelBackdrop){let Ae=Pr(te.backdropPadding),qe=ie.heights[M],it=ie.widths[M],_t=A+ne-Ae.top,Je=O-Ae.left;switch(oe){case"middle":_t-=qe/2;break;case"bottom":_t-=qe;break}switch(R){case"center":Je-=it/2;break;case"right":Je-=it;break}Me={left:Je,top:_t,width:it+Ae.width,height:qe+Ae.height,color:te.backdropColor}}k.push({rotation:L,label:T,font:Y,color:V,strokeColor:p,strokeWidth:fe,textOffset:ne,textAlign:xe,textBaseline:oe,translation:[O,A],backdrop:Me})}return k}_getXAxisLabelAlignment(){let{position:t,ticks:n}=this.options;if(-gi(this.labelRotation))return t==="top"?"left":"right";let o="center";return n.align==="start"?o="left":n.align==="end"?o="right":n.align==="inner"&&(o="inner"),o}_getYAxisLabelAlignment(t){let{position:n,ticks:{crossAlign:r,mirror:o,padding:i}}=this.options,a=this._getLabelSizes(),l=t+i,c=a.widest.width,d,g;return n==="left"?o?(g=this.right+i,r==="near"?d="left":r==="center"?(d="center",g+=c/2):(d="right",g+=c)):(g=this.right-l,r==="near"?d="right":r==="center"?(d="center",g-=c/2):(d="left",g=this.left)):n==="right"?o?(g=this.left+i,r==="near"?d="right":r==="center"?(d="center",g-=c/2):(d="left",g-=c)):(g=this.left+l,r==="near"?d="left":r==="center"?(d="center",g+=c/2):(d="right",g=this.right)):d="right",{textAlign:d,x:g}}_computeLabelArea(){if(this.options.ticks.mirror)return;let t=this.chart,n=this.options.position;if(n==="left"||n==="right")return{top:0,left:this.left,bottom:t.height,right:this.right};if(n==="top"||n==="bottom")return{top:this.top,left:0,bottom:this.bottom,right:t.width}}drawBackground(){let{ctx:t,options:{backgroundColor:n},left:r,top:o,width:i,height:a}=this;n&&(t.save(),t.fillStyle=n,t.fillRect(r,o,i,a),t.restore())}getLineWidthForValue(t){let n=this.options.grid;if(!this._isVisible()||!n.display)return 0;let o=this.ticks.findIndex(i=>i.value===t);return o>=0?n.setContext(this.getContext(o)).lineWidth:0}drawGrid(t){let n=this.options.grid,r=this.ctx,o=this._gridLineItems||(this._gridLineItems=this._computeGridLineItems(t)),i,a,l=(c,d,g)=>{!g.width||!g.color||(r.save(),r.lineWidth=g.width,r.strokeStyle=g.color,r.setLineDash(g.borderDash||[]),r.lineDashOffset=g.borderDashOffset,r.beginPath(),r.moveTo(c.x,c.y),r.lineTo(d.x,d.y),r.stroke(),r.restore())};if(n.display)for(i=0,a=o.length;i<a;++i){let c=o[i];n.drawOnChartArea&&l({x:c.x1,y:c.y1},{x:c.x2,y:c.y2},c),n.drawTicks&&l({x:c.tx1,y:c.ty1},{x:c.tx2,y:c.ty2},{color:c.tickColor,width:c.tickWidth,borderDash:c.tickBorderDash,borderDashOffset:c.tickBorderDashOffset})}}drawBorder(){let{chart:t,ctx:n,options:{grid:r}}=this,o=r.setContext(this.getContext()),i=r.drawBorder?o.borderWidth:0;if(!i)return;let a=r.setContext(this.getContext(0)).lineWidth,l=this._borderValue,c,d,g,v;this.isHorizontal()?(c=pl(t,this.left,i)-i/2,d=pl(t,this.right,a)+a/2,g=v=l):(g=pl(t,this.top,i)-i/2,v=pl(t,this.bottom,a)+a/2,c=d=l),n.save(),n.lineWidth=o.borderWidth,n.strokeStyle=o.borderColor,n.beginPath(),n.moveTo(c,g),n.lineTo(d,v),n.stroke(),n.restore()}drawLabels(t){if(!this.options.ticks.display)return;let r=this.ctx,o=this._computeLabelArea();o&&Uh(r,o);let i=this._labelItems||(this._labelItems=this._computeLabelItems(t)),a,l;for(a=0,l=i.length;a<l;++a){let c=i[a],d=c.font,g=c.label;c.backdrop&&(r.fillStyle=c.backdrop.color,r.fillRect(c.backdrop.left,c.backdrop.top,c.backdrop.width,c.backdrop.height));let v=c.textOffset;hl(r,g,0,v,d,c)}o&&Wh(r)}drawTitle(){let{ctx:t,options:{position:n,title:r,reverse:o}}=this;if(!r.display)return;let i=Qn(r.font),a=Pr(r.padding),l=r.align,c=i.lineHeight/2;n==="bottom"||n==="center"||Lt(n)?(c+=a.bottom,cn(r.text)&&(c+=i.lineHeight*(r.text.length-1))):c+=a.top;let{titleX:d,titleY:g,maxWidth:v,rotation:w}=Yj(this,c,n,l);hl(t,r.text,0,0,i,{color:r.color,maxWidth:v,rotation:w,textAlign:$j(l,n,o),textBaseline:"middle",translation:[d,g]})}draw(t){this._isVisible()&&(this.drawBackground(),this.drawGrid(t),this.drawBorder(),this.drawTitle(),this.drawLabels(t))}_layers(){let t=this.options,n=t.ticks&&t.ticks.z||0,r=Mt(t.grid&&t.grid.z,-1);return!this._isVisible()||this.draw!==e.prototype.draw?[{z:n,draw:o=>{this.draw(o)}}]:[{z:r,draw:o=>{this.drawBackground(),this.drawGrid(o),this.drawTitle()}},{z:r+1,draw:()=>{this.drawBorder()}},{z:n,draw:o=>{this.drawLabels(o)}}]}getMatchingVisibleMetas(t){let n=this.chart.getSortedVisibleDatasetMetas(),r=this.axis+"AxisID",o=[],i,a;for(i=0,a=n.length;i<a;++i){let l=n[i];l[r]===this.id&&(!t||l.type===t)&&o.push(l)}return o}_resolveTickFontOptions(t){let n=this.options.ticks.setContext(this.getContext(t));return Qn(n.font)}_maxDigits(){let t=this._resolveTickFontOptions(0).lineHeight;return(this.isHorizontal()?this.width:this.height)/t}},Kf=class{constructor(t,n,r){this.type=t,this.scope=n,this.override=r,this.items=Object.create(null)}isForType(t){return Object.prototype.isPrototypeOf.call(this.type.prototype,t.prototype)}register(t){let n=Object.getPrototypeOf(t),r;Gj(n)&&(r=this.register(n));let o=this.items,i=t.id,a=this.scope+"."+i;if(!i)throw new Error("class does not have id: "+t);return i in o||(o[i]=t,qj(t,a,r),this.override&&Ot.override(t.id,t.overrides)),a}get(t){return this.items[t]}unregister(t){let n=this.items,r=t.id,o=this.scope;r in n&&delete n[r],o&&r in Ot[o]&&(delete Ot[o][r],this.override&&delete dl[r])}};function qj(e,t,n){let r=Bf(Object.create(null),[n?Ot.get(n):{},Ot.get(t),e.defaults]);Ot.set(t,r),e.defaultRoutes&&Kj(t,e.defaultRoutes),e.descriptors&&Ot.describe(t,e.descriptors)}function Kj(e,t){Object.keys(t).forEach(n=>{let r=n.split("."),o=r.pop(),i=[e].concat(r).join("."),a=t[n].split("."),l=a.pop(),c=a.join(".");Ot.route(i,o,c,l)})}function Gj(e){return"id"in e&&"defaults"in e}var g_=class{constructor(){this.controllers=new Kf(Ho,"datasets",!0),this.elements=new Kf(Uo,"elements"),this.plugins=new Kf(Object,"plugins"),this.scales=new Kf(Lu,"scales"),this._typedRegistries=[this.controllers,this.scales,this.elements]}add(...t){this._each("register",t)}remove(...t){this._each("unregister",t)}addControllers(...t){this._each("register",t,this.controllers)}addElements(...t){this._each("register",t,this.elements)}addPlugins(...t){this._each("register",t,this.plugins)}addScales(...t){this._each("register",t,this.scales)}getController(t){return this._get(t,this.controllers,"controller")}getElement(t){return this._get(t,this.elements,"element")}getPlugin(t){return this._get(t,this.plugins,"plugin")}getScale(t){return this._get(t,this.scales,"scale")}removeControllers(...t){this._each("unregister",t,this.controllers)}removeElements(...t){this._each("unregister",t,this.elements)}removePlugins(...t){this._each("unregister",t,this.plugins)}removeScales(...t){this._each("unregister",t,this.scales)}_each(t,n,r){[...n].forEach(o=>{let i=r||this._getRegistryForType(o);r||i.isForType(o)||i===this.plugins&&o.id?this._exec(t,i,o):Xt(o,a=>{let l=r||this._getRegistryForType(a);this._exec(t,l,a)})})}_exec(t,n,r){let o=_g(t);fn(r["before"+o],[],r),n[t](r),fn(r["after"+o],[],r)}_getRegistryForType(t){for(let n=0;n<this._typedRegistries.length;n++){let r=this._typedRegistries[n];if(r.isForType(t))return r}return this.plugins}_get(t,n,r){let o=n.get(t);if(o===void 0)throw new Error('"'+t+'" is not a registered '+r+".");return o}},Ms=new g_,ed=class extends Ho{update(t){let n=this._cachedMeta,{data:r=[]}=n,o=this.chart._animationsDisabled,{start:i,count:a}=z6(n,r,o);if(this._drawStart=i,this._drawCount=a,j6(n)&&(i=0,a=r.length),this.options.showLine){let{dataset:l,_dataset:c}=n;l._chart=this.chart,l._datasetIndex=this.index,l._decimated=!!c._decimated,l.points=r;let d=this.resolveDatasetElementOptions(t);d.segment=this.options.segment,this.updateElement(l,void 0,{animated:!o,options:d},t)}this.updateElements(r,i,a,t)}addElements(){let{showLine:t}=this.options;!this.datasetElementType&&t&&(this.datasetElementType=Ms.getElement("line")),super.addElements()}updateElements(t,n,r,o){let i=o==="reset",{iScale:a,vScale:l,_stacked:c,_dataset:d}=this._cachedMeta,g=this.resolveDataElementOptions(n,o),v=this.getSharedOptions(g),w=this.includeOptions(o,v),S=a.axis,C=l.axis,{spanGaps:L,segment:k}=this.options,M=Iu(L)?L:Number.POSITIVE_INFINITY,P=this.chart._animationsDisabled||i||o==="none",D=n>0&&this.getParsed(n-1);for(let T=n;T<n+r;++T){let O=t[T],A=this.getParsed(T),R=P?O:{},B=Ht(A[C]),Y=R[S]=a.getPixelForValue(A[S],T),K=R[C]=i||B?l.getBasePixel():l.getPixelForValue(c?this.applyStack(l,A,c):A[C],T);R.skip=isNaN(Y)||isNaN(K)||B,R.stop=T>0&&Math.abs(A[S]-D[S])>M,k&&(R.parsed=A,R.raw=d.data[T]),w&&(R.options=v||this.resolveDataElementOptions(T,O.active?"active":o)),P||this.updateElement(O,T,R,o),D=A}this.updateSharedOptions(v,o,g)}getMaxOverflow(){let t=this._cachedMeta,n=t.data||[];if(!this.options.showLine){let l=0;for(let c=n.length-1;c>=0;--c)l=Math.max(l,n[c].size(this.resolveDataElementOptions(c))/2);return l>0&&l}let r=t.dataset,o=r.options&&r.options.borderWidth||0;if(!n.length)return o;let i=n[0].size(this.resolveDataElementOptions(0)),a=n[n.length-1].size(this.resolveDataElementOptions(n.length-1));return Math.max(o,i,a)/2}};ed.id="scatter";ed.defaults={datasetElementType:!1,dataElementType:"point",showLine:!1,fill:!1};ed.overrides={interaction:{mode:"point"},plugins:{tooltip:{callbacks:{title(){return""},label(e){return"("+e.label+", "+e.formattedValue+")"}}}},scales:{x:{type:"linear"},y:{type:"linear"}}};var Qj=Object.freeze({__proto__:null,BarController:Gf,BubbleController:Qf,DoughnutController:_l,LineController:Xf,PolarAreaController:Jf,PieController:Jh,RadarController:Zf,ScatterController:ed});function Tu(){throw new Error("This method is not implemented: Check that a complete date adapter is provided.")}var Zh=class{constructor(t){this.options=t||{}}init(t){}formats(){return Tu()}parse(t,n){return Tu()}format(t,n){return Tu()}add(t,n,r){return Tu()}diff(t,n,r){return Tu()}startOf(t,n,r){return Tu()}endOf(t,n){return Tu()}};Zh.override=function(e){Object.assign(Zh.prototype,e)};var Xj={_date:Zh};function Jj(e,t,n,r){let{controller:o,data:i,_sorted:a}=e,l=o._cachedMeta.iScale;if(l&&t===l.axis&&t!=="r"&&a&&i.length){let c=l._reversePixels?eP:xs;if(r){if(o._sharedOptions){let d=i[0],g=typeof d.getRange=="function"&&d.getRange(t);if(g){let v=c(i,t,n-g),w=c(i,t,n+g);return{lo:v.lo,hi:w.hi}}}}else return c(i,t,n)}return{lo:0,hi:i.length-1}}function s1(e,t,n,r,o){let i=e.getSortedVisibleDatasetMetas(),a=n[t];for(let l=0,c=i.length;l<c;++l){let{index:d,data:g}=i[l],{lo:v,hi:w}=Jj(i[l],t,a,o);for(let S=v;S<=w;++S){let C=g[S];C.skip||r(C,d,S)}}}function Zj(e){let t=e.indexOf("x")!==-1,n=e.indexOf("y")!==-1;return function(r,o){let i=t?Math.abs(r.x-o.x):0,a=n?Math.abs(r.y-o.y):0;return Math.sqrt(Math.pow(i,2)+Math.pow(a,2))}}function l_(e,t,n,r,o){let i=[];return!o&&!e.isPointInArea(t)||s1(e,n,t,function(l,c,d){!o&&!zf(l,e.chartArea,0)||l.inRange(t.x,t.y,r)&&i.push({element:l,datasetIndex:c,index:d})},!0),i}function eV(e,t,n,r){let o=[];function i(a,l,c){let{startAngle:d,endAngle:g}=a.getProps(["startAngle","endAngle"],r),{angle:v}=N6(a,{x:t.x,y:t.y});Uf(v,d,g)&&o.push({element:a,datasetIndex:l,index:c})}return s1(e,n,t,i),o}function tV(e,t,n,r,o,i){let a=[],l=Zj(n),c=Number.POSITIVE_INFINITY;function d(g,v,w){let S=g.inRange(t.x,t.y,o);if(r&&!S)return;let C=g.getCenterPoint(o);if(!(!!i||e.isPointInArea(C))&&!S)return;let k=l(t,C);k<c?(a=[{element:g,datasetIndex:v,index:w}],c=k):k===c&&a.push({element:g,datasetIndex:v,index:w})}return s1(e,n,t,d),a}function u_(e,t,n,r,o,i){return!i&&!e.isPointInArea(t)?[]:n==="r"&&!r?eV(e,t,n,o):tV(e,t,n,r,o,i)}function zP(e,t,n,r,o){let i=[],a=n==="x"?"inXRange":"inYRange",l=!1;return s1(e,n,t,(c,d,g)=>{c[a](t[n],o)&&(i.push({element:c,datasetIndex:d,index:g}),l=l||c.inRange(t.x,t.y,o))}),r&&!l?[]:i}var nV={evaluateInteractionItems:s1,modes:{index(e,t,n,r){let o=gl(t,e),i=n.axis||"x",a=n.includeInvisible||!1,l=n.intersect?l_(e,o,i,r,a):u_(e,o,i,!1,r,a),c=[];return l.length?(e.getSortedVisibleDatasetMetas().forEach(d=>{let g=l[0].index,v=d.data[g];v&&!v.skip&&c.push({element:v,datasetIndex:d.index,index:g})}),c):[]},dataset(e,t,n,r){let o=gl(t,e),i=n.axis||"xy",a=n.includeInvisible||!1,l=n.intersect?l_(e,o,i,r,a):u_(e,o,i,!1,r,a);if(l.length>0){let c=l[0].datasetIndex,d=e.getDatasetMeta(c).data;l=[];for(let g=0;g<d.length;++g)l.push({element:d[g],datasetIndex:c,index:g})}return l},point(e,t,n,r){let o=gl(t,e),i=n.axis||"xy",a=n.includeInvisible||!1;return l_(e,o,i,r,a)},nearest(e,t,n,r){let o=gl(t,e),i=n.axis||"xy",a=n.includeInvisible||!1;return u_(e,o,i,n.intersect,r,a)},x(e,t,n,r){let o=gl(t,e);return zP(e,o,"x",n.intersect,r)},y(e,t,n,r){let o=gl(t,e);return zP(e,o,"y",n.intersect,r)}}},xE=["left","top","right","bottom"];function qh(e,t){return e.filter(n=>n.pos===t)}function jP(e,t){return e.filter(n=>xE.indexOf(n.pos)===-1&&n.box.axis===t)}function Kh(e,t){return e.sort((n,r)=>{let o=t?r:n,i=t?n:r;return o.weight===i.weight?o.index-i.index:o.weight-i.weight})}function rV(e){let t=[],n,r,o,i,a,l;for(n=0,r=(e||[]).length;n<r;++n)o=e[n],{position:i,options:{stack:a,stackWeight:l=1}}=o,t.push({index:n,box:o,pos:i,horizontal:o.isHorizontal(),weight:o.weight,stack:a&&i+a,stackWeight:l});return t}function oV(e){let t={};for(let n of e){let{stack:r,pos:o,stackWeight:i}=n;if(!r||!xE.includes(o))continue;let a=t[r]||(t[r]={count:0,placed:0,weight:0,size:0});a.count++,a.weight+=i}return t}function iV(e,t){let n=oV(e),{vBoxMaxWidth:r,hBoxMaxHeight:o}=t,i,a,l;for(i=0,a=e.length;i<a;++i){l=e[i];let{fullSize:c}=l.box,d=n[l.stack],g=d&&l.stackWeight/d.weight;l.horizontal?(l.width=g?g*r:c&&t.availableWidth,l.height=o):(l.width=r,l.height=g?g*o:c&&t.availableHeight)}return n}function sV(e){let t=rV(e),n=Kh(t.filter(d=>d.box.fullSize),!0),r=Kh(qh(t,"left"),!0),o=Kh(qh(t,"right")),i=Kh(qh(t,"top"),!0),a=Kh(qh(t,"bottom")),l=jP(t,"x"),c=jP(t,"y");return{fullSize:n,leftAndTop:r.concat(i),rightAndBottom:o.concat(c).concat(a).concat(l),chartArea:qh(t,"chartArea"),vertical:r.concat(o).concat(c),horizontal:i.concat(a).concat(l)}}function VP(e,t,n,r){return Math.max(e[n],t[n])+Math.max(e[r],t[r])}function wE(e,t){e.top=Math.max(e.top,t.top),e.left=Math.max(e.left,t.left),e.bottom=Math.max(e.bottom,t.bottom),e.right=Math.max(e.right,t.right)}function aV(e,t,n,r){let{pos:o,box:i}=n,a=e.maxPadding;if(!Lt(o)){n.size&&(e[o]-=n.size);let v=r[n.stack]||{size:0,count:1};v.size=Math.max(v.size,n.horizontal?i.height:i.width),n.size=v.size/v.count,e[o]+=n.size}i.getPadding&&wE(a,i.getPadding());let l=Math.max(0,t.outerWidth-VP(a,e,"left","right")),c=Math.max(0,t.outerHeight-VP(a,e,"top","bottom")),d=l!==e.w,g=c!==e.h;return e.w=l,e.h=c,n.horizontal?{same:d,other:g}:{same:g,other:d}}function lV(e){let t=e.maxPadding;function n(r){let o=Math.max(t[r]-e[r],0);return e[r]+=o,o}e.y+=n("top"),e.x+=n("left"),n("right"),n("bottom")}function uV(e,t){let n=t.maxPadding;function r(o){let i={left:0,top:0,right:0,bottom:0};return o.forEach(a=>{i[a]=Math.max(t[a],n[a])}),i}return r(e?["left","right"]:["top","bottom"])}function Qh(e,t,n,r){let o=[],i,a,l,c,d,g;for(i=0,a=e.length,d=0;i<a;++i){l=e[i],c=l.box,c.update(l.width||t.w,l.height||t.h,uV(l.horizontal,t));let{same:v,other:w}=aV(t,n,l,r);d|=v&&o.length,g=g||w,c.fullSize||o.push(l)}return d&&Qh(o,t,n,r)||g}function Dg(e,t,n,r,o){e.top=n,e.left=t,e.right=t+r,e.bottom=n+o,e.width=r,e.height=o}function HP(e,t,n,r){let o=n.padding,{x:i,y:a}=t;for(let l of e){let c=l.box,d=r[l.stack]||{count:1,placed:0,weight:1},g=l.stackWeight/d.weight||1;if(l.horizontal){let v=t.w*g,w=d.size||c.height;zo(d.start)&&(a=d.start),c.fullSize?Dg(c,o.left,a,n.outerWidth-o.right-o.left,w):Dg(c,t.left+d.placed,a,v,w),d.start=a,d.placed+=v,a=c.bottom}else{let v=t.h*g,w=d.size||c.width;zo(d.start)&&(i=d.start),c.fullSize?Dg(c,i,o.top,w,n.outerHeight-o.bottom-o.top):Dg(c,i,t.top+d.placed,w,v),d.start=i,d.placed+=v,i=c.right}}t.x=i,t.y=a}Ot.set("layout",{autoPadding:!0,padding:{top:0,right:0,bottom:0,left:0}});var jr={addBox(e,t){e.boxes||(e.boxes=[]),t.fullSize=t.fullSize||!1,t.position=t.position||"top",t.weight=t.weight||0,t._layers=t._layers||function(){return[{z:0,draw(n){t.draw(n)}}]},e.boxes.push(t)},removeBox(e,t){let n=e.boxes?e.boxes.indexOf(t):-1;n!==-1&&e.boxes.splice(n,1)},configure(e,t,n){t.fullSize=n.fullSize,t.position=n.position,t.weight=n.weight},update(e,t,n,r){if(!e)return;let o=Pr(e.options.layout.padding),i=Math.max(t-o.width,0),a=Math.max(n-o.height,0),l=sV(e.boxes),c=l.vertical,d=l.horizontal;Xt(e.boxes,L=>{typeof L.beforeLayout=="function"&&L.beforeLayout()});let g=c.reduce((L,k)=>k.box.options&&k.box.options.display===!1?L:L+1,0)||1,v=Object.freeze({outerWidth:t,outerHeight:n,padding:o,availableWidth:i,availableHeight:a,vBoxMaxWidth:i/2/g,hBoxMaxHeight:a/2}),w=Object.assign({},o);wE(w,Pr(r));let S=Object.assign({maxPadding:w,w:i,h:a,x:o.left,y:o.top},o),C=iV(c.concat(d),v);Qh(l.fullSize,S,v,C),Qh(c,S,v,C),Qh(d,S,v,C)&&Qh(c,S,v,C),lV(S),HP(l.leftAndTop,S,v,C),S.x+=S.w,S.y+=S.h,HP(l.rightAndBottom,S,v,C),e.chartArea={left:S.left,top:S.top,right:S.left+S.w,bottom:S.top+S.h,height:S.h,width:S.w},Xt(l.chartArea,L=>{let k=L.box;Object.assign(k,e.chartArea),k.update(S.w,S.h,{left:0,top:0,right:0,bottom:0})})}},Rg=class{acquireContext(t,n){}releaseContext(t){return!1}addEventListener(t,n,r){}removeEventListener(t,n,r){}getDevicePixelRatio(){return 1}getMaximumSize(t,n,r,o){return n=Math.max(0,n||t.width),r=r||t.height,{width:n,height:Math.max(0,o?Math.floor(n/o):r)}}isAttached(t){return!0}updateConfig(t){}},y_=class extends Rg{acquireContext(t){return t&&t.getContext&&t.getContext("2d")||null}updateConfig(t){t.options.animation=!1}},Ag="$chartjs",cV={touchstart:"mousedown",touchmove:"mousemove",touchend:"mouseup",pointerenter:"mouseenter",pointerdown:"mousedown",pointermove:"mousemove",pointerup:"mouseup",pointerleave:"mouseout",pointerout:"mouseout"},UP=e=>e===null||e==="";function fV(e,t){let n=e.style,r=e.getAttribute("height"),o=e.getAttribute("width");if(e[Ag]={initial:{height:r,width:o,style:{display:n.display,height:n.height,width:n.width}}},n.display=n.display||"block",n.boxSizing=n.boxSizing||"border-box",UP(o)){let i=e_(e,"width");i!==void 0&&(e.width=i)}if(UP(r))if(e.style.height==="")e.height=e.width/(t||2);else{let i=e_(e,"height");i!==void 0&&(e.height=i)}return e}var bE=wP?{passive:!0}:!1;function dV(e,t,n){e.addEventListener(t,n,bE)}function pV(e,t,n){e.canvas.removeEventListener(t,n,bE)}function hV(e,t){let n=cV[e.type]||e.type,{x:r,y:o}=gl(e,t);return{type:n,chart:t,native:e,x:r!==void 0?r:null,y:o!==void 0?o:null}}function Bg(e,t){for(let n of e)if(n===t||n.contains(t))return!0}function mV(e,t,n){let r=e.canvas,o=new MutationObserver(i=>{let a=!1;for(let l of i)a=a||Bg(l.addedNodes,r),a=a&&!Bg(l.removedNodes,r);a&&n()});return o.observe(document,{childList:!0,subtree:!0}),o}function gV(e,t,n){let r=e.canvas,o=new MutationObserver(i=>{let a=!1;for(let l of i)a=a||Bg(l.removedNodes,r),a=a&&!Bg(l.addedNodes,r);a&&n()});return o.observe(document,{childList:!0,subtree:!0}),o}var e1=new Map,WP=0;function SE(){let e=window.devicePixelRatio;e!==WP&&(WP=e,e1.forEach((t,n)=>{n.currentDevicePixelRatio!==e&&t()}))}function yV(e,t){e1.size||window.addEventListener("resize",SE),e1.set(e,t)}function vV(e){e1.delete(e),e1.size||window.removeEventListener("resize",SE)}function _V(e,t,n){let r=e.canvas,o=r&&Pg(r);if(!o)return;let i=B6((l,c)=>{let d=o.clientWidth;n(l,c),d<o.clientWidth&&n()},window),a=new ResizeObserver(l=>{let c=l[0],d=c.contentRect.width,g=c.contentRect.height;d===0&&g===0||i(d,g)});return a.observe(o),yV(e,i),a}function c_(e,t,n){n&&n.disconnect(),t==="resize"&&vV(e)}function xV(e,t,n){let r=e.canvas,o=B6(i=>{e.ctx!==null&&n(hV(i,e))},e,i=>{let a=i[0];return[a,a.offsetX,a.offsetY]});return dV(r,t,o),o}var v_=class extends Rg{acquireContext(t,n){let r=t&&t.getContext&&t.getContext("2d");return r&&r.canvas===t?(fV(t,n),r):null}releaseContext(t){let n=t.canvas;if(!n[Ag])return!1;let r=n[Ag].initial;["height","width"].forEach(i=>{let a=r[i];Ht(a)?n.removeAttribute(i):n.setAttribute(i,a)});let o=r.style||{};return Object.keys(o).forEach(i=>{n.style[i]=o[i]}),n.width=n.width,delete n[Ag],!0}addEventListener(t,n,r){this.removeEventListener(t,n);let o=t.$proxies||(t.$proxies={}),a={attach:mV,detach:gV,resize:_V}[n]||xV;o[n]=a(t,n,r)}removeEventListener(t,n){let r=t.$proxies||(t.$proxies={}),o=r[n];if(!o)return;({attach:c_,detach:c_,resize:c_}[n]||pV)(t,n,o),r[n]=void 0}getDevicePixelRatio(){return window.devicePixelRatio}getMaximumSize(t,n,r,o){return xP(t,n,r,o)}isAttached(t){let n=Pg(t);return!!(n&&n.isConnected)}};function wV(e){return!J6()||typeof OffscreenCanvas!="undefined"&&e instanceof OffscreenCanvas?y_:v_}var __=class{constructor(){this._init=[]}notify(t,n,r,o){n==="beforeInit"&&(this._init=this._createDescriptors(t,!0),this._notify(this._init,t,"install"));let i=o?this._descriptors(t).filter(o):this._descriptors(t),a=this._notify(i,t,n,r);return n==="afterDestroy"&&(this._notify(i,t,"stop"),this._notify(this._init,t,"uninstall")),a}_notify(t,n,r,o){o=o||{};for(let i of t){let a=i.plugin,l=a[r],c=[n,o,i.options];if(fn(l,c,a)===!1&&o.cancelable)return!1}return!0}invalidate(){Ht(this._cache)||(this._oldCache=this._cache,this._cache=void 0)}_descriptors(t){if(this._cache)return this._cache;let n=this._cache=this._createDescriptors(t);return this._notifyStateChanges(t),n}_createDescriptors(t,n){let r=t&&t.config,o=Mt(r.options&&r.options.plugins,{}),i=bV(r);return o===!1&&!n?[]:MV(t,i,o,n)}_notifyStateChanges(t){let n=this._oldCache||[],r=this._cache,o=(i,a)=>i.filter(l=>!a.some(c=>l.plugin.id===c.plugin.id));this._notify(o(n,r),t,"stop"),this._notify(o(r,n),t,"start")}};function bV(e){let t={},n=[],r=Object.keys(Ms.plugins.items);for(let i=0;i<r.length;i++)n.push(Ms.getPlugin(r[i]));let o=e.plugins||[];for(let i=0;i<o.length;i++){let a=o[i];n.indexOf(a)===-1&&(n.push(a),t[a.id]=!0)}return{plugins:n,localIds:t}}function SV(e,t){return!t&&e===!1?null:e===!0?{}:e}function MV(e,{plugins:t,localIds:n},r,o){let i=[],a=e.getContext();for(let l of t){let c=l.id,d=SV(r[c],o);d!==null&&i.push({plugin:l,options:kV(e.config,{plugin:l,local:n[c]},d,a)})}return i}function kV(e,{plugin:t,local:n},r,o){let i=e.pluginScopeKeys(t),a=e.getOptionScopes(r,i);return n&&t.defaults&&a.push(t.defaults),e.createResolver(a,o,[""],{scriptable:!1,indexable:!1,allKeys:!0})}function x_(e,t){let n=Ot.datasets[e]||{};return((t.datasets||{})[e]||{}).indexAxis||t.indexAxis||n.indexAxis||"x"}function CV(e,t){let n=e;return e==="_index_"?n=t:e==="_value_"&&(n=t==="x"?"y":"x"),n}function PV(e,t){return e===t?"_index_":"_value_"}function EV(e){if(e==="top"||e==="bottom")return"x";if(e==="left"||e==="right")return"y"}function w_(e,t){return e==="x"||e==="y"?e:t.axis||EV(t.position)||e.charAt(0).toLowerCase()}function IV(e,t){let n=dl[e.type]||{scales:{}},r=t.scales||{},o=x_(e.type,t),i=Object.create(null),a=Object.create(null);return Object.keys(r).forEach(l=>{let c=r[l];if(!Lt(c))return console.error(`Invalid scale configuration for scale: ${l}`);if(c._proxy)return console.warn(`Ignoring resolver passed as options for scale: ${l}`);let d=w_(l,c),g=PV(d,o),v=n.scales||{};i[d]=i[d]||l,a[l]=Vf(Object.create(null),[{axis:d},c,v[d],v[g]])}),e.data.datasets.forEach(l=>{let c=l.type||e.type,d=l.indexAxis||x_(c,t),v=(dl[c]||{}).scales||{};Object.keys(v).forEach(w=>{let S=CV(w,d),C=l[S+"AxisID"]||i[S]||S;a[C]=a[C]||Object.create(null),Vf(a[C],[{axis:S},r[C],v[w]])})}),Object.keys(a).forEach(l=>{let c=a[l];Vf(c,[Ot.scales[c.type],Ot.scale])}),a}function ME(e){let t=e.options||(e.options={});t.plugins=Mt(t.plugins,{}),t.scales=IV(e,t)}function kE(e){return e=e||{},e.datasets=e.datasets||[],e.labels=e.labels||[],e}function DV(e){return e=e||{},e.data=kE(e.data),ME(e),e}var $P=new Map,CE=new Set;function Tg(e,t){let n=$P.get(e);return n||(n=t(),$P.set(e,n),CE.add(n)),n}var Gh=(e,t,n)=>{let r=ua(t,n);r!==void 0&&e.add(r)},b_=class{constructor(t){this._config=DV(t),this._scopeCache=new Map,this._resolverCache=new Map}get platform(){return this._config.platform}get type(){return this._config.type}set type(t){this._config.type=t}get data(){return this._config.data}set data(t){this._config.data=kE(t)}get options(){return this._config.options}set options(t){this._config.options=t}get plugins(){return this._config.plugins}update(){let t=this._config;this.clearCache(),ME(t)}clearCache(){this._scopeCache.clear(),this._resolverCache.clear()}datasetScopeKeys(t){return Tg(t,()=>[[`datasets.${t}`,""]])}datasetAnimationScopeKeys(t,n){return Tg(`${t}.transition.${n}`,()=>[[`datasets.${t}.transitions.${n}`,`transitions.${n}`],[`datasets.${t}`,""]])}datasetElementScopeKeys(t,n){return Tg(`${t}-${n}`,()=>[[`datasets.${t}.elements.${n}`,`datasets.${t}`,`elements.${n}`,""]])}pluginScopeKeys(t){let n=t.id,r=this.type;return Tg(`${r}-plugin-${n}`,()=>[[`plugins.${n}`,...t.additionalOptionScopes||[]]])}_cachedScopes(t,n){let r=this._scopeCache,o=r.get(t);return(!o||n)&&(o=new Map,r.set(t,o)),o}getOptionScopes(t,n,r){let{options:o,type:i}=this,a=this._cachedScopes(t,r),l=a.get(n);if(l)return l;let c=new Set;n.forEach(g=>{t&&(c.add(t),g.forEach(v=>Gh(c,t,v))),g.forEach(v=>Gh(c,o,v)),g.forEach(v=>Gh(c,dl[i]||{},v)),g.forEach(v=>Gh(c,Ot,v)),g.forEach(v=>Gh(c,Sg,v))});let d=Array.from(c);return d.length===0&&d.push(Object.create(null)),CE.has(n)&&a.set(n,d),d}chartOptionScopes(){let{options:t,type:n}=this;return[t,dl[n]||{},Ot.datasets[n]||{},{type:n},Ot,Sg]}resolveNamedOptions(t,n,r,o=[""]){let i={$shared:!0},{resolver:a,subPrefixes:l}=YP(this._resolverCache,t,o),c=a;if(LV(a,n)){i.$shared=!1,r=la(r)?r():r;let d=this.createResolver(t,r,l);c=Eu(a,r,d)}for(let d of n)i[d]=c[d];return i}createResolver(t,n,r=[""],o){let{resolver:i}=YP(this._resolverCache,t,r);return Lt(n)?Eu(i,n,void 0,o):i}};function YP(e,t,n){let r=e.get(t);r||(r=new Map,e.set(t,r));let o=n.join(),i=r.get(o);return i||(i={resolver:Cg(t,n),subPrefixes:n.filter(l=>!l.toLowerCase().includes("hover"))},r.set(o,i)),i}var TV=e=>Lt(e)&&Object.getOwnPropertyNames(e).reduce((t,n)=>t||la(e[n]),!1);function LV(e,t){let{isScriptable:n,isIndexable:r}=K6(e);for(let o of t){let i=n(o),a=r(o),l=(a||i)&&e[o];if(i&&(la(l)||TV(l))||a&&cn(l))return!0}return!1}var OV="3.9.1",NV=["top","bottom","left","right","chartArea"];function qP(e,t){return e==="top"||e==="bottom"||NV.indexOf(e)===-1&&t==="x"}function KP(e,t){return function(n,r){return n[e]===r[e]?n[t]-r[t]:n[e]-r[e]}}function GP(e){let t=e.chart,n=t.options.animation;t.notifyPlugins("afterRender"),fn(n&&n.onComplete,[e],t)}function AV(e){let t=e.chart,n=t.options.animation;fn(n&&n.onProgress,[e],t)}function PE(e){return J6()&&typeof e=="string"?e=document.getElementById(e):e&&e.length&&(e=e[0]),e&&e.canvas&&(e=e.canvas),e}var zg={},EE=e=>{let t=PE(e);return Object.values(zg).filter(n=>n.canvas===t).pop()};function FV(e,t,n){let r=Object.keys(e);for(let o of r){let i=+o;if(i>=t){let a=e[o];delete e[o],(n>0||i>t)&&(e[i+n]=a)}}}function RV(e,t,n,r){return!n||e.type==="mouseout"?null:r?t:e}var Ou=class{constructor(t,n){let r=this.config=new b_(n),o=PE(t),i=EE(o);if(i)throw new Error("Canvas is already in use. Chart with ID '"+i.id+"' must be destroyed before the canvas with ID '"+i.canvas.id+"' can be reused.");let a=r.createResolver(r.chartOptionScopes(),this.getContext());this.platform=new(r.platform||wV(o)),this.platform.updateConfig(r);let l=this.platform.acquireContext(o,a.aspectRatio),c=l&&l.canvas,d=c&&c.height,g=c&&c.width;if(this.id=qC(),this.ctx=l,this.canvas=c,this.width=g,this.height=d,this._options=a,this._aspectRatio=this.aspectRatio,this._layers=[],this._metasets=[],this._stacks=void 0,this.boxes=[],this.currentDevicePixelRatio=void 0,this.chartArea=void 0,this._active=[],this._lastEvent=void 0,this._listeners={},this._responsiveListeners=void 0,this._sortedMetasets=[],this.scales={},this._plugins=new __,this.$proxies={},this._hiddenIndices={},this.attached=!1,this._animationsDisabled=void 0,this.$context=void 0,this._doResize=oP(v=>this.update(v),a.resizeDelay||0),this._dataChanges=[],zg[this.id]=this,!l||!c){console.error("Failed to create chart: can't acquire context from the given item");return}fa.listen(this,"complete",GP),fa.listen(this,"progress",AV),this._initialize(),this.attached&&this.update()}get aspectRatio(){let{options:{aspectRatio:t,maintainAspectRatio:n},width:r,height:o,_aspectRatio:i}=this;return Ht(t)?n&&i?i:o?r/o:null:t}get data(){return this.config.data}set data(t){this.config.data=t}get options(){return this._options}set options(t){this.config.options=t}_initialize(){return this.notifyPlugins("beforeInit"),this.options.responsive?this.resize():Z6(this,this.options.devicePixelRatio),this.bindEvents(),this.notifyPlugins("afterInit"),this}clear(){return $6(this.canvas,this.ctx),this}stop(){return fa.stop(this),this}resize(t,n){fa.running(this)?this._resizeBeforeDraw={width:t,height:n}:this._resize(t,n)}_resize(t,n){let r=this.options,o=this.canvas,i=r.maintainAspectRatio&&this.aspectRatio,a=this.platform.getMaximumSize(o,t,n,i),l=r.devicePixelRatio||this.platform.getDevicePixelRatio(),c=this.width?"resize":"attach";this.width=a.width,this.height=a.height,this._aspectRatio=this.aspectRatio,Z6(this,l,!0)&&(this.notifyPlugins("resize",{size:a}),fn(r.onResize,[this,a],this),this.attached&&this._doResize(c)&&this.render())}ensureScalesHaveIDs(){let n=this.options.scales||{};Xt(n,(r,o)=>{r.id=o})}buildOrUpdateScales(){let t=this.options,n=t.scales,r=this.scales,o=Object.keys(r).reduce((a,l)=>(a[l]=!1,a),{}),i=[];n&&(i=i.concat(Object.keys(n).map(a=>{let l=n[a],c=w_(a,l),d=c==="r",g=c==="x";return{options:l,dposition:d?"chartArea":g?"bottom":"left",dtype:d?"radialLinear":g?"category":"linear"}}))),Xt(i,a=>{let l=a.options,c=l.id,d=w_(c,l),g=Mt(l.type,a.dtype);(l.position===void 0||qP(l.position,d)!==qP(a.dposition))&&(l.position=a.dposition),o[c]=!0;let v=null;if(c in r&&r[c].type===g)v=r[c];else{let w=Ms.getScale(g);v=new w({id:c,type:g,ctx:this.ctx,chart:this}),r[v.id]=v}v.init(l,t)}),Xt(o,(a,l)=>{a||delete r[l]}),Xt(r,a=>{jr.configure(this,a,a.options),jr.addBox(this,a)})}_updateMetasets(){let t=this._metasets,n=this.data.datasets.length,r=t.length;if(t.sort((o,i)=>o.index-i.index),r>n){for(let o=n;o<r;++o)this._destroyDatasetMeta(o);t.splice(n,r-n)}this._sortedMetasets=t.slice(0).sort(KP("order","index"))}_removeUnreferencedMetasets(){let{_metasets:t,data:{datasets:n}}=this;t.length>n.length&&delete this._stacks,t.forEach((r,o)=>{n.filter(i=>i===r._dataset).length===0&&this._destroyDatasetMeta(o)})}buildOrUpdateControllers(){let t=[],n=this.data.datasets,r,o;for(this._removeUnreferencedMetasets(),r=0,o=n.length;r<o;r++){let i=n[r],a=this.getDatasetMeta(r),l=i.type||this.config.type;if(a.type&&a.type!==l&&(this._destroyDatasetMeta(r),a=this.getDatasetMeta(r)),a.type=l,a.indexAxis=i.indexAxis||x_(l,this.options),a.order=i.order||0,a.index=r,a.label=""+i.label,a.visible=this.isDatasetVisible(r),a.controller)a.controller.updateIndex(r),a.controller.linkScales();else{let c=Ms.getController(l),{datasetElementType:d,dataElementType:g}=Ot.datasets[l];Object.assign(c.prototype,{dataElementType:Ms.getElement(g),datasetElementType:d&&Ms.getElement(d)}),a.controller=new c(this,r),t.push(a.controller)}}return this._updateMetasets(),t}_resetElements(){Xt(this.data.datasets,(t,n)=>{this.getDatasetMeta(n).controller.reset()},this)}reset(){this._resetElements(),this.notifyPlugins("reset")}update(t){let n=this.config;n.update();let r=this._options=n.createResolver(n.chartOptionScopes(),this.getContext()),o=this._animationsDisabled=!r.animation;if(this._updateScales(),this._checkEventBindings(),this._updateHiddenIndices(),this._plugins.invalidate(),this.notifyPlugins("beforeUpdate",{mode:t,cancelable:!0})===!1)return;let i=this.buildOrUpdateControllers();this.notifyPlugins("beforeElementsUpdate");let a=0;for(let d=0,g=this.data.datasets.length;d<g;d++){let{controller:v}=this.getDatasetMeta(d),w=!o&&i.indexOf(v)===-1;v.buildOrUpdateElements(w),a=Math.max(+v.getMaxOverflow(),a)}a=this._minPadding=r.layout.autoPadding?a:0,this._updateLayout(a),o||Xt(i,d=>{d.reset()}),this._updateDatasets(t),this.notifyPlugins("afterUpdate",{mode:t}),this._layers.sort(KP("z","_idx"));let{_active:l,_lastEvent:c}=this;c?this._eventHandler(c,!0):l.length&&this._updateHoverStyles(l,l,!0),this.render()}_updateScales(){Xt(this.scales,t=>{jr.removeBox(this,t)}),this.ensureScalesHaveIDs(),this.buildOrUpdateScales()}_checkEventBindings(){let t=this.options,n=new Set(Object.keys(this._listeners)),r=new Set(t.events);(!D6(n,r)||!!this._responsiveListeners!==t.responsive)&&(this.unbindEvents(),this.bindEvents())}_updateHiddenIndices(){let{_hiddenIndices:t}=this,n=this._getUniformDataChanges()||[];for(let{method:r,start:o,count:i}of n){let a=r==="_removeElements"?-i:i;FV(t,o,a)}}_getUniformDataChanges(){let t=this._dataChanges;if(!t||!t.length)return;this._dataChanges=[];let n=this.data.datasets.length,r=i=>new Set(t.filter(a=>a[0]===i).map((a,l)=>l+","+a.splice(1).join(","))),o=r(0);for(let i=1;i<n;i++)if(!D6(o,r(i)))return;return Array.from(o).map(i=>i.split(",")).map(i=>({method:i[1],start:+i[2],count:+i[3]}))}_updateLayout(t){if(this.notifyPlugins("beforeLayout",{cancelable:!0})===!1)return;jr.update(this,this.width,this.height,t);let n=this.chartArea,r=n.width<=0||n.height<=0;this._layers=[],Xt(this.boxes,o=>{r&&o.position==="chartArea"||(o.configure&&o.configure(),this._layers.push(...o._layers()))},this),this._layers.forEach((o,i)=>{o._idx=i}),this.notifyPlugins("afterLayout")}_updateDatasets(t){if(this.notifyPlugins("beforeDatasetsUpdate",{mode:t,cancelable:!0})!==!1){for(let n=0,r=this.data.datasets.length;n<r;++n)this.getDatasetMeta(n).controller.configure();for(let n=0,r=this.data.datasets.length;n<r;++n)this._updateDataset(n,la(t)?t({datasetIndex:n}):t);this.notifyPlugins("afterDatasetsUpdate",{mode:t})}}_updateDataset(t,n){let r=this.getDatasetMeta(t),o={meta:r,index:t,mode:n,cancelable:!0};this.notifyPlugins("beforeDatasetUpdate",o)!==!1&&(r.controller._update(n),o.cancelable=!1,this.notifyPlugins("afterDatasetUpdate",o))}render(){this.notifyPlugins("beforeRender",{cancelable:!0})!==!1&&(fa.has(this)?this.attached&&!fa.running(this)&&fa.start(this):(this.draw(),GP({chart:this})))}draw(){let t;if(this._resizeBeforeDraw){let{width:r,height:o}=this._resizeBeforeDraw;this._resize(r,o),this._resizeBeforeDraw=null}if(this.clear(),this.width<=0||this.height<=0||this.notifyPlugins("beforeDraw",{cancelable:!0})===!1)return;let n=this._layers;for(t=0;t<n.length&&n[t].z<=0;++t)n[t].draw(this.chartArea);for(this._drawDatasets();t<n.length;++t)n[t].draw(this.chartArea);this.notifyPlugins("afterDraw")}_getSortedDatasetMetas(t){let n=this._sortedMetasets,r=[],o,i;for(o=0,i=n.length;o<i;++o){let a=n[o];(!t||a.visible)&&r.push(a)}return r}getSortedVisibleDatasetMetas(){return this._getSortedDatasetMetas(!0)}_drawDatasets(){if(this.notifyPlugins("beforeDatasetsDraw",{cancelable:!0})===!1)return;let t=this.getSortedVisibleDatasetMetas();for(let n=t.length-1;n>=0;--n)this._drawDataset(t[n]);this.notifyPlugins("afterDatasetsDraw")}_drawDataset(t){let n=this.ctx,r=t._clip,o=!r.disabled,i=this.chartArea,a={meta:t,index:t.index,cancelable:!0};this.notifyPlugins("beforeDatasetDraw",a)!==!1&&(o&&Uh(n,{left:r.left===!1?0:i.left-r.left,right:r.right===!1?this.width:i.right+r.right,top:r.top===!1?0:i.top-r.top,bottom:r.bottom===!1?this.height:i.bottom+r.bottom}),t.controller.draw(),o&&Wh(n),a.cancelable=!1,this.notifyPlugins("afterDatasetDraw",a))}isPointInArea(t){return zf(t,this.chartArea,this._minPadding)}getElementsAtEventForMode(t,n,r,o){let i=nV.modes[n];return typeof i=="function"?i(this,t,r,o):[]}getDatasetMeta(t){let n=this.data.datasets[t],r=this._metasets,o=r.filter(i=>i&&i._dataset===n).pop();return o||(o={type:null,data:[],dataset:null,controller:null,hidden:null,xAxisID:null,yAxisID:null,order:n&&n.order||0,index:t,_dataset:n,_parsed:[],_sorted:!1},r.push(o)),o}getContext(){return this.$context||(this.$context=ca(null,{chart:this,type:"chart"}))}getVisibleDatasetCount(){return this.getSortedVisibleDatasetMetas().length}isDatasetVisible(t){let n=this.data.datasets[t];if(!n)return!1;let r=this.getDatasetMeta(t);return typeof r.hidden=="boolean"?!r.hidden:!n.hidden}setDatasetVisibility(t,n){let r=this.getDatasetMeta(t);r.hidden=!n}toggleDataVisibility(t){this._hiddenIndices[t]=!this._hiddenIndices[t]}getDataVisibility(t){return!this._hiddenIndices[t]}_updateVisibility(t,n,r){let o=r?"show":"hide",i=this.getDatasetMeta(t),a=i.controller._resolveAnimations(void 0,o);zo(n)?(i.data[n].hidden=!r,this.update()):(this.setDatasetVisibility(t,r),a.update(i,{visible:r}),this.update(l=>l.datasetIndex===t?o:void 0))}hide(t,n){this._updateVisibility(t,n,!1)}show(t,n){this._updateVisibility(t,n,!0)}_destroyDatasetMeta(t){let n=this._metasets[t];n&&n.controller&&n.controller._destroy(),delete this._metasets[t]}_stop(){let t,n;for(this.stop(),fa.remove(this),t=0,n=this.data.datasets.length;t<n;++t)this._destroyDatasetMeta(t)}destroy(){this.notifyPlugins("beforeDestroy");let{canvas:t,ctx:n}=this;this._stop(),this.config.clearCache(),t&&(this.unbindEvents(),$6(t,n),this.platform.releaseContext(n),this.canvas=null,this.ctx=null),this.notifyPlugins("destroy"),delete zg[this.id],this.notifyPlugins("afterDestroy")}toBase64Image(...t){return this.canvas.toDataURL(...t)}bindEvents(){this.bindUserEvents(),this.options.responsive?this.bindResponsiveEvents():this.attached=!0}bindUserEvents(){let t=this._listeners,n=this.platform,r=(i,a)=>{n.addEventListener(this,i,a),t[i]=a},o=(i,a,l)=>{i.offsetX=a,i.offsetY=l,this._eventHandler(i)};Xt(this.options.events,i=>r(i,o))}bindResponsiveEvents(){this._responsiveListeners||(this._responsiveListeners={});let t=this._responsiveListeners,n=this.platform,r=(c,d)=>{n.addEventListener(this,c,d),t[c]=d},o=(c,d)=>{t[c]&&(n.removeEventListener(this,c,d),delete t[c])},i=(c,d)=>{this.canvas&&this.resize(c,d)},a,l=()=>{o("attach",l),this.attached=!0,this.resize(),r("resize",i),r("detach",a)};a=()=>{this.attached=!1,o("resize",i),this._stop(),this._resize(0,0),r("attach",l)},n.isAttached(this.canvas)?l():a()}unbindEvents(){Xt(this._listeners,(t,n)=>{this.platform.removeEventListener(this,n,t)}),this._listeners={},Xt(this._responsiveListeners,(t,n)=>{this.platform.removeEventListener(this,n,t)}),this._responsiveListeners=void 0}updateHoverStyle(t,n,r){let o=r?"set":"remove",i,a,l,c;for(n==="dataset"&&(i=this.getDatasetMeta(t[0].datasetIndex),i.controller["_"+o+"DatasetHoverStyle"]()),l=0,c=t.length;l<c;++l){a=t[l];let d=a&&this.getDatasetMeta(a.datasetIndex).controller;d&&d[o+"HoverStyle"](a.element,a.datasetIndex,a.index)}}getActiveElements(){return this._active||[]}setActiveElements(t){let n=this._active||[],r=t.map(({datasetIndex:i,index:a})=>{let l=this.getDatasetMeta(i);if(!l)throw new Error("No dataset found at index "+i);return{datasetIndex:i,element:l.data[a],index:a}});!Vh(r,n)&&(this._active=r,this._lastEvent=null,this._updateHoverStyles(r,n))}notifyPlugins(t,n,r){return this._plugins.notify(this,t,n,r)}_updateHoverStyles(t,n,r){let o=this.options.hover,i=(c,d)=>c.filter(g=>!d.some(v=>g.datasetIndex===v.datasetIndex&&g.index===v.index)),a=i(n,t),l=r?t:i(t,n);a.length&&this.updateHoverStyle(a,o.mode,!1),l.length&&o.mode&&this.updateHoverStyle(l,o.mode,!0)}_eventHandler(t,n){let r={event:t,replay:n,cancelable:!0,inChartArea:this.isPointInArea(t)},o=a=>(a.options.events||this.options.events).includes(t.native.type);if(this.notifyPlugins("beforeEvent",r,o)===!1)return;let i=this._handleEvent(t,n,r.inChartArea);return r.cancelable=!1,this.notifyPlugins("afterEvent",r,o),(i||r.changed)&&this.render(),this}_handleEvent(t,n,r){let{_active:o=[],options:i}=this,a=n,l=this._getActiveElements(t,o,r,a),c=QC(t),d=RV(t,this._lastEvent,r,c);r&&(this._lastEvent=null,fn(i.onHover,[t,l,this],this),c&&fn(i.onClick,[t,l,this],this));let g=!Vh(l,o);return(g||n)&&(this._active=l,this._updateHoverStyles(l,o,n)),this._lastEvent=d,g}_getActiveElements(t,n,r,o){if(t.type==="mouseout")return[];if(!r)return n;let i=this.options.hover;return this.getElementsAtEventForMode(t,i.mode,i,o)}},QP=()=>Xt(Ou.instances,e=>e._plugins.invalidate()),yl=!0;Object.defineProperties(Ou,{defaults:{enumerable:yl,value:Ot},instances:{enumerable:yl,value:zg},overrides:{enumerable:yl,value:dl},registry:{enumerable:yl,value:Ms},version:{enumerable:yl,value:OV},getChart:{enumerable:yl,value:EE},register:{enumerable:yl,value:(...e)=>{Ms.add(...e),QP()}},unregister:{enumerable:yl,value:(...e)=>{Ms.remove(...e),QP()}}});function IE(e,t,n){let{startAngle:r,pixelMargin:o,x:i,y:a,outerRadius:l,innerRadius:c}=t,d=o/l;e.beginPath(),e.arc(i,a,l,r-d,n+d),c>o?(d=o/c,e.arc(i,a,c,n+d,r-d,!0)):e.arc(i,a,o,n+Dn,r-Dn),e.closePath(),e.clip()}function BV(e){return kg(e,["outerStart","outerEnd","innerStart","innerEnd"])}function zV(e,t,n,r){let o=BV(e.options.borderRadius),i=(n-t)/2,a=Math.min(i,r*t/2),l=c=>{let d=(n-Math.min(i,c))*r/2;return sr(c,0,Math.min(i,d))};return{outerStart:l(o.outerStart),outerEnd:l(o.outerEnd),innerStart:sr(o.innerStart,0,a),innerEnd:sr(o.innerEnd,0,a)}}function qf(e,t,n,r){return{x:n+e*Math.cos(t),y:r+e*Math.sin(t)}}function S_(e,t,n,r,o,i){let{x:a,y:l,startAngle:c,pixelMargin:d,innerRadius:g}=t,v=Math.max(t.outerRadius+r+n-d,0),w=g>0?g+r+n+d:0,S=0,C=o-c;if(r){let te=g>0?g-r:0,z=v>0?v-r:0,V=(te+z)/2,p=V!==0?C*V/(V+r):C;S=(C-p)/2}let L=Math.max(.001,C*v-n/_n)/v,k=(C-L)/2,M=c+k+S,P=o-k-S,{outerStart:D,outerEnd:T,innerStart:O,innerEnd:A}=zV(t,w,v,P-M),R=v-D,B=v-T,Y=M+D/R,K=P-T/B,Q=w+O,ne=w+A,oe=M+O/Q,ie=P-A/ne;if(e.beginPath(),i){if(e.arc(a,l,v,Y,K),T>0){let V=qf(B,K,a,l);e.arc(V.x,V.y,T,K,P+Dn)}let te=qf(ne,P,a,l);if(e.lineTo(te.x,te.y),A>0){let V=qf(ne,ie,a,l);e.arc(V.x,V.y,A,P+Dn,ie+Math.PI)}if(e.arc(a,l,w,P-A/w,M+O/w,!0),O>0){let V=qf(Q,oe,a,l);e.arc(V.x,V.y,O,oe+Math.PI,M-Dn)}let z=qf(R,M,a,l);if(e.lineTo(z.x,z.y),D>0){let V=qf(R,Y,a,l);e.arc(V.x,V.y,D,M-Dn,Y)}}else{e.moveTo(a,l);let te=Math.cos(Y)*v+a,z=Math.sin(Y)*v+l;e.lineTo(te,z);let V=Math.cos(K)*v+a,p=Math.sin(K)*v+l;e.lineTo(V,p)}e.closePath()}function jV(e,t,n,r,o){let{fullCircles:i,startAngle:a,circumference:l}=t,c=t.endAngle;if(i){S_(e,t,n,r,a+tn,o);for(let d=0;d<i;++d)e.fill();isNaN(l)||(c=a+l%tn,l%tn===0&&(c+=tn))}return S_(e,t,n,r,c,o),e.fill(),c}function VV(e,t,n){let{x:r,y:o,startAngle:i,pixelMargin:a,fullCircles:l}=t,c=Math.max(t.outerRadius-a,0),d=t.innerRadius+a,g;for(n&&IE(e,t,i+tn),e.beginPath(),e.arc(r,o,d,i+tn,i,!0),g=0;g<l;++g)e.stroke();for(e.beginPath(),e.arc(r,o,c,i,i+tn),g=0;g<l;++g)e.stroke()}function HV(e,t,n,r,o,i){let{options:a}=t,{borderWidth:l,borderJoinStyle:c}=a,d=a.borderAlign==="inner";l&&(d?(e.lineWidth=l*2,e.lineJoin=c||"round"):(e.lineWidth=l,e.lineJoin=c||"bevel"),t.fullCircles&&VV(e,t,d),d&&IE(e,t,o),S_(e,t,n,r,o,i),e.stroke())}var td=class extends Uo{constructor(t){super(),this.options=void 0,this.circumference=void 0,this.startAngle=void 0,this.endAngle=void 0,this.innerRadius=void 0,this.outerRadius=void 0,this.pixelMargin=0,this.fullCircles=0,t&&Object.assign(this,t)}inRange(t,n,r){let o=this.getProps(["x","y"],r),{angle:i,distance:a}=N6(o,{x:t,y:n}),{startAngle:l,endAngle:c,innerRadius:d,outerRadius:g,circumference:v}=this.getProps(["startAngle","endAngle","innerRadius","outerRadius","circumference"],r),w=this.options.spacing/2,C=Mt(v,c-l)>=tn||Uf(i,l,c),L=bs(a,d+w,g+w);return C&&L}getCenterPoint(t){let{x:n,y:r,startAngle:o,endAngle:i,innerRadius:a,outerRadius:l}=this.getProps(["x","y","startAngle","endAngle","innerRadius","outerRadius","circumference"],t),{offset:c,spacing:d}=this.options,g=(o+i)/2,v=(a+l+d+c)/2;return{x:n+Math.cos(g)*v,y:r+Math.sin(g)*v}}tooltipPosition(t){return this.getCenterPoint(t)}draw(t){let{options:n,circumference:r}=this,o=(n.offset||0)/2,i=(n.spacing||0)/2,a=n.circular;if(this.pixelMargin=n.borderAlign==="inner"?.33:0,this.fullCircles=r>tn?Math.floor(r/tn):0,r===0||this.innerRadius<0||this.outerRadius<0)return;t.save();let l=0;if(o){l=o/2;let d=(this.startAngle+this.endAngle)/2;t.translate(Math.cos(d)*l,Math.sin(d)*l),this.circumference>=_n&&(l=o)}t.fillStyle=n.backgroundColor,t.strokeStyle=n.borderColor;let c=jV(t,this,l,i,a);HV(t,this,l,i,c,a),t.restore()}};td.id="arc";td.defaults={borderAlign:"center",borderColor:"#fff",borderJoinStyle:void 0,borderRadius:0,borderWidth:2,offset:0,spacing:0,angle:void 0,circular:!0};td.defaultRoutes={backgroundColor:"backgroundColor"};function DE(e,t,n=t){e.lineCap=Mt(n.borderCapStyle,t.borderCapStyle),e.setLineDash(Mt(n.borderDash,t.borderDash)),e.lineDashOffset=Mt(n.borderDashOffset,t.borderDashOffset),e.lineJoin=Mt(n.borderJoinStyle,t.borderJoinStyle),e.lineWidth=Mt(n.borderWidth,t.borderWidth),e.strokeStyle=Mt(n.borderColor,t.borderColor)}function UV(e,t,n){e.lineTo(n.x,n.y)}function WV(e){return e.stepped?dP:e.tension||e.cubicInterpolationMode==="monotone"?pP:UV}function TE(e,t,n={}){let r=e.length,{start:o=0,end:i=r-1}=n,{start:a,end:l}=t,c=Math.max(o,a),d=Math.min(i,l),g=o<a&&i<a||o>l&&i>l;return{count:r,start:c,loop:t.loop,ilen:d<c&&!g?r+d-c:d-c}}function $V(e,t,n,r){let{points:o,options:i}=t,{count:a,start:l,loop:c,ilen:d}=TE(o,n,r),g=WV(i),{move:v=!0,reverse:w}=r||{},S,C,L;for(S=0;S<=d;++S)C=o[(l+(w?d-S:S))%a],!C.skip&&(v?(e.moveTo(C.x,C.y),v=!1):g(e,L,C,w,i.stepped),L=C);return c&&(C=o[(l+(w?d:0))%a],g(e,L,C,w,i.stepped)),!!c}function YV(e,t,n,r){let o=t.points,{count:i,start:a,ilen:l}=TE(o,n,r),{move:c=!0,reverse:d}=r||{},g=0,v=0,w,S,C,L,k,M,P=T=>(a+(d?l-T:T))%i,D=()=>{L!==k&&(e.lineTo(g,k),e.lineTo(g,L),e.lineTo(g,M))};for(c&&(S=o[P(0)],e.moveTo(S.x,S.y)),w=0;w<=l;++w){if(S=o[P(w)],S.skip)continue;let T=S.x,O=S.y,A=T|0;A===C?(O<L?L=O:O>k&&(k=O),g=(v*g+T)/++v):(D(),e.lineTo(T,O),C=A,v=0,L=k=O),M=O}D()}function M_(e){let t=e.options,n=t.borderDash&&t.borderDash.length;return!e._decimated&&!e._loop&&!t.tension&&t.cubicInterpolationMode!=="monotone"&&!t.stepped&&!n?YV:$V}function qV(e){return e.stepped?bP:e.tension||e.cubicInterpolationMode==="monotone"?SP:ul}function KV(e,t,n,r){let o=t._path;o||(o=t._path=new Path2D,t.path(o,n,r)&&o.closePath()),DE(e,t.options),e.stroke(o)}function GV(e,t,n,r){let{segments:o,options:i}=t,a=M_(t);for(let l of o)DE(e,i,l.style),e.beginPath(),a(e,t,l,{start:n,end:n+r-1})&&e.closePath(),e.stroke()}var QV=typeof Path2D=="function";function XV(e,t,n,r){QV&&!t.options.segment?KV(e,t,n,r):GV(e,t,n,r)}var ks=class extends Uo{constructor(t){super(),this.animated=!0,this.options=void 0,this._chart=void 0,this._loop=void 0,this._fullLoop=void 0,this._path=void 0,this._points=void 0,this._segments=void 0,this._decimated=!1,this._pointsUpdated=!1,this._datasetIndex=void 0,t&&Object.assign(this,t)}updateControlPoints(t,n){let r=this.options;if((r.tension||r.cubicInterpolationMode==="monotone")&&!r.stepped&&!this._pointsUpdated){let o=r.spanGaps?this._loop:this._fullLoop;_P(this._points,r,t,o,n),this._pointsUpdated=!0}}set points(t){this._points=t,delete this._segments,delete this._path,this._pointsUpdated=!1}get points(){return this._points}get segments(){return this._segments||(this._segments=kP(this,this.options.segment))}first(){let t=this.segments,n=this.points;return t.length&&n[t[0].start]}last(){let t=this.segments,n=this.points,r=t.length;return r&&n[t[r-1].end]}interpolate(t,n){let r=this.options,o=t[n],i=this.points,a=o_(this,{property:n,start:o,end:o});if(!a.length)return;let l=[],c=qV(r),d,g;for(d=0,g=a.length;d<g;++d){let{start:v,end:w}=a[d],S=i[v],C=i[w];if(S===C){l.push(S);continue}let L=Math.abs((o-S[n])/(C[n]-S[n])),k=c(S,C,L,r.stepped);k[n]=t[n],l.push(k)}return l.length===1?l[0]:l}pathSegment(t,n,r){return M_(this)(t,this,n,r)}path(t,n,r){let o=this.segments,i=M_(this),a=this._loop;n=n||0,r=r||this.points.length-n;for(let l of o)a&=i(t,this,l,{start:n,end:n+r-1});return!!a}draw(t,n,r,o){let i=this.options||{};(this.points||[]).length&&i.borderWidth&&(t.save(),XV(t,this,r,o),t.restore()),this.animated&&(this._pointsUpdated=!1,this._path=void 0)}};ks.id="line";ks.defaults={borderCapStyle:"butt",borderDash:[],borderDashOffset:0,borderJoinStyle:"miter",borderWidth:3,capBezierPoints:!0,cubicInterpolationMode:"default",fill:!1,spanGaps:!1,stepped:!1,tension:0};ks.defaultRoutes={backgroundColor:"backgroundColor",borderColor:"borderColor"};ks.descriptors={_scriptable:!0,_indexable:e=>e!=="borderDash"&&e!=="fill"};function XP(e,t,n,r){let o=e.options,{[n]:i}=e.getProps([n],r);return Math.abs(t-i)<o.radius+o.hitRadius}var nd=class extends Uo{constructor(t){super(),this.options=void 0,this.parsed=void 0,this.skip=void 0,this.stop=void 0,t&&Object.assign(this,t)}inRange(t,n,r){let o=this.options,{x:i,y:a}=this.getProps(["x","y"],r);return Math.pow(t-i,2)+Math.pow(n-a,2)<Math.pow(o.hitRadius+o.radius,2)}inXRange(t,n){return XP(this,t,"x",n)}inYRange(t,n){return XP(this,t,"y",n)}getCenterPoint(t){let{x:n,y:r}=this.getProps(["x","y"],t);return{x:n,y:r}}size(t){t=t||this.options||{};let n=t.radius||0;n=Math.max(n,n&&t.hoverRadius||0);let r=n&&t.borderWidth||0;return(n+r)*2}draw(t,n){let r=this.options;this.skip||r.radius<.1||!zf(this,n,this.size(r)/2)||(t.strokeStyle=r.borderColor,t.lineWidth=r.borderWidth,t.fillStyle=r.backgroundColor,Mg(t,r,this.x,this.y))}getRange(){let t=this.options||{};return t.radius+t.hitRadius}};nd.id="point";nd.defaults={borderWidth:1,hitRadius:1,hoverBorderWidth:1,hoverRadius:4,pointStyle:"circle",radius:3,rotation:0};nd.defaultRoutes={backgroundColor:"backgroundColor",borderColor:"borderColor"};function LE(e,t){let{x:n,y:r,base:o,width:i,height:a}=e.getProps(["x","y","base","width","height"],t),l,c,d,g,v;return e.horizontal?(v=a/2,l=Math.min(n,o),c=Math.max(n,o),d=r-v,g=r+v):(v=i/2,l=n-v,c=n+v,d=Math.min(r,o),g=Math.max(r,o)),{left:l,top:d,right:c,bottom:g}}function vl(e,t,n,r){return e?0:sr(t,n,r)}function JV(e,t,n){let r=e.options.borderWidth,o=e.borderSkipped,i=q6(r);return{t:vl(o.top,i.top,0,n),r:vl(o.right,i.right,0,t),b:vl(o.bottom,i.bottom,0,n),l:vl(o.left,i.left,0,t)}}function ZV(e,t,n){let{enableBorderRadius:r}=e.getProps(["enableBorderRadius"]),o=e.options.borderRadius,i=ml(o),a=Math.min(t,n),l=e.borderSkipped,c=r||Lt(o);return{topLeft:vl(!c||l.top||l.left,i.topLeft,0,a),topRight:vl(!c||l.top||l.right,i.topRight,0,a),bottomLeft:vl(!c||l.bottom||l.left,i.bottomLeft,0,a),bottomRight:vl(!c||l.bottom||l.right,i.bottomRight,0,a)}}function eH(e){let t=LE(e),n=t.right-t.left,r=t.bottom-t.top,o=JV(e,n/2,r/2),i=ZV(e,n/2,r/2);return{outer:{x:t.left,y:t.top,w:n,h:r,radius:i},inner:{x:t.left+o.l,y:t.top+o.t,w:n-o.l-o.r,h:r-o.t-o.b,radius:{topLeft:Math.max(0,i.topLeft-Math.max(o.t,o.l)),topRight:Math.max(0,i.topRight-Math.max(o.t,o.r)),bottomLeft:Math.max(0,i.bottomLeft-Math.max(o.b,o.l)),bottomRight:Math.max(0,i.bottomRight-Math.max(o.b,o.r))}}}}function f_(e,t,n,r){let o=t===null,i=n===null,l=e&&!(o&&i)&&LE(e,r);return l&&(o||bs(t,l.left,l.right))&&(i||bs(n,l.top,l.bottom))}function tH(e){return e.topLeft||e.topRight||e.bottomLeft||e.bottomRight}function nH(e,t){e.rect(t.x,t.y,t.w,t.h)}function d_(e,t,n={}){let r=e.x!==n.x?-t:0,o=e.y!==n.y?-t:0,i=(e.x+e.w!==n.x+n.w?t:0)-r,a=(e.y+e.h!==n.y+n.h?t:0)-o;return{x:e.x+r,y:e.y+o,w:e.w+i,h:e.h+a,radius:e.radius}}var rd=class extends Uo{constructor(t){super(),this.options=void 0,this.horizontal=void 0,this.base=void 0,this.width=void 0,this.height=void 0,this.inflateAmount=void 0,t&&Object.assign(this,t)}draw(t){let{inflateAmount:n,options:{borderColor:r,backgroundColor:o}}=this,{inner:i,outer:a}=eH(this),l=tH(a.radius)?Wf:nH;t.save(),(a.w!==i.w||a.h!==i.h)&&(t.beginPath(),l(t,d_(a,n,i)),t.clip(),l(t,d_(i,-n,a)),t.fillStyle=r,t.fill("evenodd")),t.beginPath(),l(t,d_(i,n)),t.fillStyle=o,t.fill(),t.restore()}inRange(t,n,r){return f_(this,t,n,r)}inXRange(t,n){return f_(this,t,null,n)}inYRange(t,n){return f_(this,null,t,n)}getCenterPoint(t){let{x:n,y:r,base:o,horizontal:i}=this.getProps(["x","y","base","horizontal"],t);return{x:i?(n+o)/2:n,y:i?r:(r+o)/2}}getRange(t){return t==="x"?this.width/2:this.height/2}};rd.id="bar";rd.defaults={borderSkipped:"start",borderWidth:0,borderRadius:0,inflateAmount:"auto",pointStyle:void 0};rd.defaultRoutes={backgroundColor:"backgroundColor",borderColor:"borderColor"};var rH=Object.freeze({__proto__:null,ArcElement:td,LineElement:ks,PointElement:nd,BarElement:rd});function oH(e,t,n,r,o){let i=o.samples||r;if(i>=n)return e.slice(t,t+n);let a=[],l=(n-2)/(i-2),c=0,d=t+n-1,g=t,v,w,S,C,L;for(a[c++]=e[g],v=0;v<i-2;v++){let k=0,M=0,P,D=Math.floor((v+1)*l)+1+t,T=Math.min(Math.floor((v+2)*l)+1,n)+t,O=T-D;for(P=D;P<T;P++)k+=e[P].x,M+=e[P].y;k/=O,M/=O;let A=Math.floor(v*l)+1+t,R=Math.min(Math.floor((v+1)*l)+1,n)+t,{x:B,y:Y}=e[g];for(S=C=-1,P=A;P<R;P++)C=.5*Math.abs((B-k)*(e[P].y-Y)-(B-e[P].x)*(M-Y)),C>S&&(S=C,w=e[P],L=P);a[c++]=w,g=L}return a[c++]=e[d],a}function iH(e,t,n,r){let o=0,i=0,a,l,c,d,g,v,w,S,C,L,k=[],M=t+n-1,P=e[t].x,T=e[M].x-P;for(a=t;a<t+n;++a){l=e[a],c=(l.x-P)/T*r,d=l.y;let O=c|0;if(O===g)d<C?(C=d,v=a):d>L&&(L=d,w=a),o=(i*o+l.x)/++i;else{let A=a-1;if(!Ht(v)&&!Ht(w)){let R=Math.min(v,w),B=Math.max(v,w);R!==S&&R!==A&&k.push(he(Z({},e[R]),{x:o})),B!==S&&B!==A&&k.push(he(Z({},e[B]),{x:o}))}a>0&&A!==S&&k.push(e[A]),k.push(l),g=O,i=0,C=L=d,v=w=S=a}}return k}function OE(e){if(e._decimated){let t=e._data;delete e._decimated,delete e._data,Object.defineProperty(e,"data",{value:t})}}function JP(e){e.data.datasets.forEach(t=>{OE(t)})}function sH(e,t){let n=t.length,r=0,o,{iScale:i}=e,{min:a,max:l,minDefined:c,maxDefined:d}=i.getUserBounds();return c&&(r=sr(xs(t,i.axis,a).lo,0,n-1)),d?o=sr(xs(t,i.axis,l).hi+1,r,n)-r:o=n-r,{start:r,count:o}}var aH={id:"decimation",defaults:{algorithm:"min-max",enabled:!1},beforeElementsUpdate:(e,t,n)=>{if(!n.enabled){JP(e);return}let r=e.width;e.data.datasets.forEach((o,i)=>{let{_data:a,indexAxis:l}=o,c=e.getDatasetMeta(i),d=a||o.data;if($f([l,e.options.indexAxis])==="y"||!c.controller.supportsDecimation)return;let g=e.scales[c.xAxisID];if(g.type!=="linear"&&g.type!=="time"||e.options.parsing)return;let{start:v,count:w}=sH(c,d),S=n.threshold||4*r;if(w<=S){OE(o);return}Ht(a)&&(o._data=d,delete o.data,Object.defineProperty(o,"data",{configurable:!0,enumerable:!0,get:function(){return this._decimated},set:function(L){this._data=L}}));let C;switch(n.algorithm){case"lttb":C=oH(d,v,w,r,n);break;case"min-max":C=iH(d,v,w,r);break;default:throw new Error(`Unsupported decimation algorithm '${n.algorithm}'`)}o._decimated=C})},destroy(e){JP(e)}};function lH(e,t,n){let r=e.segments,o=e.points,i=t.points,a=[];for(let l of r){let{start:c,end:d}=l;d=P_(c,d,o);let g=k_(n,o[c],o[d],l.loop);if(!t.segments){a.push({source:l,target:g,start:o[c],end:o[d]});continue}let v=o_(t,g);for(let w of v){let S=k_(n,i[w.start],i[w.end],w.loop),C=r_(l,o,S);for(let L of C)a.push({source:L,target:w,start:{[n]:ZP(g,S,"start",Math.max)},end:{[n]:ZP(g,S,"end",Math.min)}})}}return a}function k_(e,t,n,r){if(r)return;let o=t[e],i=n[e];return e==="angle"&&(o=yo(o),i=yo(i)),{property:e,start:o,end:i}}function uH(e,t){let{x:n=null,y:r=null}=e||{},o=t.points,i=[];return t.segments.forEach(({start:a,end:l})=>{l=P_(a,l,o);let c=o[a],d=o[l];r!==null?(i.push({x:c.x,y:r}),i.push({x:d.x,y:r})):n!==null&&(i.push({x:n,y:c.y}),i.push({x:n,y:d.y}))}),i}function P_(e,t,n){for(;t>e;t--){let r=n[t];if(!isNaN(r.x)&&!isNaN(r.y))break}return t}function ZP(e,t,n,r){return e&&t?r(e[n],t[n]):e?e[n]:t?t[n]:0}function NE(e,t){let n=[],r=!1;return cn(e)?(r=!0,n=e):n=uH(e,t),n.length?new ks({points:n,options:{tension:0},_loop:r,_fullLoop:r}):null}function eE(e){return e&&e.fill!==!1}function cH(e,t,n){let o=e[t].fill,i=[t],a;if(!n)return o;for(;o!==!1&&i.indexOf(o)===-1;){if(!Fn(o))return o;if(a=e[o],!a)return!1;if(a.visible)return o;i.push(o),o=a.fill}return!1}function fH(e,t,n){let r=mH(e);if(Lt(r))return isNaN(r.value)?!1:r;let o=parseFloat(r);return Fn(o)&&Math.floor(o)===o?dH(r[0],t,o,n):["origin","start","end","stack","shape"].indexOf(r)>=0&&r}function dH(e,t,n,r){return(e==="-"||e==="+")&&(n=t+n),n===t||n<0||n>=r?!1:n}function pH(e,t){let n=null;return e==="start"?n=t.bottom:e==="end"?n=t.top:Lt(e)?n=t.getPixelForValue(e.value):t.getBasePixel&&(n=t.getBasePixel()),n}function hH(e,t,n){let r;return e==="start"?r=n:e==="end"?r=t.options.reverse?t.min:t.max:Lt(e)?r=e.value:r=t.getBaseValue(),r}function mH(e){let t=e.options,n=t.fill,r=Mt(n&&n.target,n);return r===void 0&&(r=!!t.backgroundColor),r===!1||r===null?!1:r===!0?"origin":r}function gH(e){let{scale:t,index:n,line:r}=e,o=[],i=r.segments,a=r.points,l=yH(t,n);l.push(NE({x:null,y:t.bottom},r));for(let c=0;c<i.length;c++){let d=i[c];for(let g=d.start;g<=d.end;g++)vH(o,a[g],l)}return new ks({points:o,options:{}})}function yH(e,t){let n=[],r=e.getMatchingVisibleMetas("line");for(let o=0;o<r.length;o++){let i=r[o];if(i.index===t)break;i.hidden||n.unshift(i.dataset)}return n}function vH(e,t,n){let r=[];for(let o=0;o<n.length;o++){let i=n[o],{first:a,last:l,point:c}=_H(i,t,"x");if(!(!c||a&&l)){if(a)r.unshift(c);else if(e.push(c),!l)break}}e.push(...r)}function _H(e,t,n){let r=e.interpolate(t,n);if(!r)return{};let o=r[n],i=e.segments,a=e.points,l=!1,c=!1;for(let d=0;d<i.length;d++){let g=i[d],v=a[g.start][n],w=a[g.end][n];if(bs(o,v,w)){l=o===v,c=o===w;break}}return{first:l,last:c,point:r}}var jg=class{constructor(t){this.x=t.x,this.y=t.y,this.radius=t.radius}pathSegment(t,n,r){let{x:o,y:i,radius:a}=this;return n=n||{start:0,end:tn},t.arc(o,i,a,n.end,n.start,!0),!r.bounds}interpolate(t){let{x:n,y:r,radius:o}=this,i=t.angle;return{x:n+Math.cos(i)*o,y:r+Math.sin(i)*o,angle:i}}};function xH(e){let{chart:t,fill:n,line:r}=e;if(Fn(n))return wH(t,n);if(n==="stack")return gH(e);if(n==="shape")return!0;let o=bH(e);return o instanceof jg?o:NE(o,r)}function wH(e,t){let n=e.getDatasetMeta(t);return n&&e.isDatasetVisible(t)?n.dataset:null}function bH(e){return(e.scale||{}).getPointPositionForValue?MH(e):SH(e)}function SH(e){let{scale:t={},fill:n}=e,r=pH(n,t);if(Fn(r)){let o=t.isHorizontal();return{x:o?r:null,y:o?null:r}}return null}function MH(e){let{scale:t,fill:n}=e,r=t.options,o=t.getLabels().length,i=r.reverse?t.max:t.min,a=hH(n,t,i),l=[];if(r.grid.circular){let c=t.getPointPositionForValue(0,i);return new jg({x:c.x,y:c.y,radius:t.getDistanceFromCenterForValue(a)})}for(let c=0;c<o;++c)l.push(t.getPointPositionForValue(c,a));return l}function p_(e,t,n){let r=xH(t),{line:o,scale:i,axis:a}=t,l=o.options,c=l.fill,d=l.backgroundColor,{above:g=d,below:v=d}=c||{};r&&o.points.length&&(Uh(e,n),kH(e,{line:o,target:r,above:g,below:v,area:n,scale:i,axis:a}),Wh(e))}function kH(e,t){let{line:n,target:r,above:o,below:i,area:a,scale:l}=t,c=n._loop?"angle":t.axis;e.save(),c==="x"&&i!==o&&(tE(e,r,a.top),nE(e,{line:n,target:r,color:o,scale:l,property:c}),e.restore(),e.save(),tE(e,r,a.bottom)),nE(e,{line:n,target:r,color:i,scale:l,property:c}),e.restore()}function tE(e,t,n){let{segments:r,points:o}=t,i=!0,a=!1;e.beginPath();for(let l of r){let{start:c,end:d}=l,g=o[c],v=o[P_(c,d,o)];i?(e.moveTo(g.x,g.y),i=!1):(e.lineTo(g.x,n),e.lineTo(g.x,g.y)),a=!!t.pathSegment(e,l,{move:a}),a?e.closePath():e.lineTo(v.x,n)}e.lineTo(t.first().x,n),e.closePath(),e.clip()}function nE(e,t){let{line:n,target:r,property:o,color:i,scale:a}=t,l=lH(n,r,o);for(let{source:c,target:d,start:g,end:v}of l){let{style:{backgroundColor:w=i}={}}=c,S=r!==!0;e.save(),e.fillStyle=w,CH(e,a,S&&k_(o,g,v)),e.beginPath();let C=!!n.pathSegment(e,c),L;if(S){C?e.closePath():rE(e,r,v,o);let k=!!r.pathSegment(e,d,{move:C,reverse:!0});L=C&&k,L||rE(e,r,g,o)}e.closePath(),e.fill(L?"evenodd":"nonzero"),e.restore()}}function CH(e,t,n){let{top:r,bottom:o}=t.chart.chartArea,{property:i,start:a,end:l}=n||{};i==="x"&&(e.beginPath(),e.rect(a,r,l-a,o-r),e.clip())}function rE(e,t,n,r){let o=t.interpolate(n,r);o&&e.lineTo(o.x,o.y)}var PH={id:"filler",afterDatasetsUpdate(e,t,n){let r=(e.data.datasets||[]).length,o=[],i,a,l,c;for(a=0;a<r;++a)i=e.getDatasetMeta(a),l=i.dataset,c=null,l&&l.options&&l instanceof ks&&(c={visible:e.isDatasetVisible(a),index:a,fill:fH(l,a,r),chart:e,axis:i.controller.options.indexAxis,scale:i.vScale,line:l}),i.$filler=c,o.push(c);for(a=0;a<r;++a)c=o[a],!(!c||c.fill===!1)&&(c.fill=cH(o,a,n.propagate))},beforeDraw(e,t,n){let r=n.drawTime==="beforeDraw",o=e.getSortedVisibleDatasetMetas(),i=e.chartArea;for(let a=o.length-1;a>=0;--a){let l=o[a].$filler;l&&(l.line.updateControlPoints(i,l.axis),r&&l.fill&&p_(e.ctx,l,i))}},beforeDatasetsDraw(e,t,n){if(n.drawTime!=="beforeDatasetsDraw")return;let r=e.getSortedVisibleDatasetMetas();for(let o=r.length-1;o>=0;--o){let i=r[o].$filler;eE(i)&&p_(e.ctx,i,e.chartArea)}},beforeDatasetDraw(e,t,n){let r=t.meta.$filler;!eE(r)||n.drawTime!=="beforeDatasetDraw"||p_(e.ctx,r,e.chartArea)},defaults:{propagate:!0,drawTime:"beforeDatasetDraw"}},oE=(e,t)=>{let{boxHeight:n=t,boxWidth:r=t}=e;return e.usePointStyle&&(n=Math.min(n,t),r=e.pointStyleWidth||Math.min(r,t)),{boxWidth:r,boxHeight:n,itemHeight:Math.max(t,n)}},EH=(e,t)=>e!==null&&t!==null&&e.datasetIndex===t.datasetIndex&&e.index===t.index,Vg=class extends Uo{constructor(t){super(),this._added=!1,this.legendHitBoxes=[],this._hoveredItem=null,this.doughnutMode=!1,this.chart=t.chart,this.options=t.options,this.ctx=t.ctx,this.legendItems=void 0,this.columnSizes=void 0,this.lineWidths=void 0,this.maxHeight=void 0,this.maxWidth=void 0,this.top=void 0,this.bottom=void 0,this.left=void 0,this.right=void 0,this.height=void 0,this.width=void 0,this._margins=void 0,this.position=void 0,this.weight=void 0,this.fullSize=void 0}update(t,n,r){this.maxWidth=t,this.maxHeight=n,this._margins=r,this.setDimensions(),this.buildLabels(),this.fit()}setDimensions(){this.isHorizontal()?(this.width=this.maxWidth,this.left=this._margins.left,this.right=this.width):(this.height=this.maxHeight,this.top=this._margins.top,this.bottom=this.height)}buildLabels(){let t=this.options.labels||{},n=fn(t.generateLabels,[this.chart],this)||[];t.filter&&(n=n.filter(r=>t.filter(r,this.chart.data))),t.sort&&(n=n.sort((r,o)=>t.sort(r,o,this.chart.data))),this.options.reverse&&n.reverse(),this.legendItems=n}fit(){let{options:t,ctx:n}=this;if(!t.display){this.width=this.height=0;return}let r=t.labels,o=Qn(r.font),i=o.size,a=this._computeTitleHeight(),{boxWidth:l,itemHeight:c}=oE(r,i),d,g;n.font=o.string,this.isHorizontal()?(d=this.maxWidth,g=this._fitRows(a,i,l,c)+10):(g=this.maxHeight,d=this._fitCols(a,i,l,c)+10),this.width=Math.min(d,t.maxWidth||this.maxWidth),this.height=Math.min(g,t.maxHeight||this.maxHeight)}_fitRows(t,n,r,o){let{ctx:i,maxWidth:a,options:{labels:{padding:l}}}=this,c=this.legendHitBoxes=[],d=this.lineWidths=[0],g=o+l,v=t;i.textAlign="left",i.textBaseline="middle";let w=-1,S=-g;return this.legendItems.forEach((C,L)=>{let k=r+n/2+i.measureText(C.text).width;(L===0||d[d.length-1]+k+2*l>a)&&(v+=g,d[d.length-(L>0?0:1)]=0,S+=g,w++),c[L]={left:0,top:S,row:w,width:k,height:o},d[d.length-1]+=k+l}),v}_fitCols(t,n,r,o){let{ctx:i,maxHeight:a,options:{labels:{padding:l}}}=this,c=this.legendHitBoxes=[],d=this.columnSizes=[],g=a-t,v=l,w=0,S=0,C=0,L=0;return this.legendItems.forEach((k,M)=>{let P=r+n/2+i.measureText(k.text).width;M>0&&S+o+2*l>g&&(v+=w+l,d.push({width:w,height:S}),C+=w+l,L++,w=S=0),c[M]={left:C,top:S,col:L,width:P,height:o},w=Math.max(w,P),S+=o+l}),v+=w,d.push({width:w,height:S}),v}adjustHitBoxes(){if(!this.options.display)return;let t=this._computeTitleHeight(),{legendHitBoxes:n,options:{align:r,labels:{padding:o},rtl:i}}=this,a=Du(i,this.left,this.width);if(this.isHorizontal()){let l=0,c=Cr(r,this.left+o,this.right-this.lineWidths[l]);for(let d of n)l!==d.row&&(l=d.row,c=Cr(r,this.left+o,this.right-this.lineWidths[l])),d.top+=this.top+t+o,d.left=a.leftForLtr(a.x(c),d.width),c+=d.width+o}else{let l=0,c=Cr(r,this.top+t+o,this.bottom-this.columnSizes[l].height);for(let d of n)d.col!==l&&(l=d.col,c=Cr(r,this.top+t+o,this.bottom-this.columnSizes[l].height)),d.top=c,d.left+=this.left+o,d.left=a.leftForLtr(a.x(d.left),d.width),c+=d.height+o}}isHorizontal(){return this.options.position==="top"||this.options.position==="bottom"}draw(){if(this.options.display){let t=this.ctx;Uh(t,this),this._draw(),Wh(t)}}_draw(){let{options:t,columnSizes:n,lineWidths:r,ctx:o}=this,{align:i,labels:a}=t,l=Ot.color,c=Du(t.rtl,this.left,this.width),d=Qn(a.font),{color:g,padding:v}=a,w=d.size,S=w/2,C;this.drawTitle(),o.textAlign=c.textAlign("left"),o.textBaseline="middle",o.lineWidth=.5,o.font=d.string;let{boxWidth:L,boxHeight:k,itemHeight:M}=oE(a,w),P=function(R,B,Y){if(isNaN(L)||L<=0||isNaN(k)||k<0)return;o.save();let K=Mt(Y.lineWidth,1);if(o.fillStyle=Mt(Y.fillStyle,l),o.lineCap=Mt(Y.lineCap,"butt"),o.lineDashOffset=Mt(Y.lineDashOffset,0),o.lineJoin=Mt(Y.lineJoin,"miter"),o.lineWidth=K,o.strokeStyle=Mt(Y.strokeStyle,l),o.setLineDash(Mt(Y.lineDash,[])),a.usePointStyle){let Q={radius:k*Math.SQRT2/2,pointStyle:Y.pointStyle,rotation:Y.rotation,borderWidth:K},ne=c.xPlus(R,L/2),oe=B+S;Y6(o,Q,ne,oe,a.pointStyleWidth&&L)}else{let Q=B+Math.max((w-k)/2,0),ne=c.leftForLtr(R,L),oe=ml(Y.borderRadius);o.beginPath(),Object.values(oe).some(ie=>ie!==0)?Wf(o,{x:ne,y:Q,w:L,h:k,radius:oe}):o.rect(ne,Q,L,k),o.fill(),K!==0&&o.stroke()}o.restore()},D=function(R,B,Y){hl(o,Y.text,R,B+M/2,d,{strikethrough:Y.hidden,textAlign:c.textAlign(Y.textAlign)})},T=this.isHorizontal(),O=this._computeTitleHeight();T?C={x:Cr(i,this.left+v,this.right-r[0]),y:this.top+v+O,line:0}:C={x:this.left+v,y:Cr(i,this.top+O+v,this.bottom-n[0].height),line:0},t_(this.ctx,t.textDirection);let A=M+v;this.legendItems.forEach((R,B)=>{o.strokeStyle=R.fontColor||g,o.fillStyle=R.fontColor||g;let Y=o.measureText(R.text).width,K=c.textAlign(R.textAlign||(R.textAlign=a.textAlign)),Q=L+S+Y,ne=C.x,oe=C.y;c.setWidth(this.width),T?B>0&&ne+Q+v>this.right&&(oe=C.y+=A,C.line++,ne=C.x=Cr(i,this.left+v,this.right-r[C.line])):B>0&&oe+A>this.bottom&&(ne=C.x=ne+n[C.line].width+v,C.line++,oe=C.y=Cr(i,this.top+O+v,this.bottom-n[C.line].height));let ie=c.x(ne);P(ie,oe,R),ne=iP(K,ne+L+S,T?ne+Q:this.right,t.rtl),D(c.x(ne),oe,R),T?C.x+=Q+v:C.y+=A}),n_(this.ctx,t.textDirection)}drawTitle(){let t=this.options,n=t.title,r=Qn(n.font),o=Pr(n.padding);if(!n.display)return;let i=Du(t.rtl,this.left,this.width),a=this.ctx,l=n.position,c=r.size/2,d=o.top+c,g,v=this.left,w=this.width;if(this.isHorizontal())w=Math.max(...this.lineWidths),g=this.top+d,v=Cr(t.align,v,this.right-w);else{let C=this.columnSizes.reduce((L,k)=>Math.max(L,k.height),0);g=d+Cr(t.align,this.top,this.bottom-C-t.labels.padding-this._computeTitleHeight())}let S=Cr(l,v,v+w);a.textAlign=i.textAlign(bg(l)),a.textBaseline="middle",a.strokeStyle=n.color,a.fillStyle=n.color,a.font=r.string,hl(a,n.text,S,g,r)}_computeTitleHeight(){let t=this.options.title,n=Qn(t.font),r=Pr(t.padding);return t.display?n.lineHeight+r.height:0}_getLegendItemAt(t,n){let r,o,i;if(bs(t,this.left,this.right)&&bs(n,this.top,this.bottom)){for(i=this.legendHitBoxes,r=0;r<i.length;++r)if(o=i[r],bs(t,o.left,o.left+o.width)&&bs(n,o.top,o.top+o.height))return this.legendItems[r]}return null}handleEvent(t){let n=this.options;if(!IH(t.type,n))return;let r=this._getLegendItemAt(t.x,t.y);if(t.type==="mousemove"||t.type==="mouseout"){let o=this._hoveredItem,i=EH(o,r);o&&!i&&fn(n.onLeave,[t,o,this],this),this._hoveredItem=r,r&&!i&&fn(n.onHover,[t,r,this],this)}else r&&fn(n.onClick,[t,r,this],this)}};function IH(e,t){return!!((e==="mousemove"||e==="mouseout")&&(t.onHover||t.onLeave)||t.onClick&&(e==="click"||e==="mouseup"))}var DH={id:"legend",_element:Vg,start(e,t,n){let r=e.legend=new Vg({ctx:e.ctx,options:n,chart:e});jr.configure(e,r,n),jr.addBox(e,r)},stop(e){jr.removeBox(e,e.legend),delete e.legend},beforeUpdate(e,t,n){let r=e.legend;jr.configure(e,r,n),r.options=n},afterUpdate(e){let t=e.legend;t.buildLabels(),t.adjustHitBoxes()},afterEvent(e,t){t.replay||e.legend.handleEvent(t.event)},defaults:{display:!0,position:"top",align:"center",fullSize:!0,reverse:!1,weight:1e3,onClick(e,t,n){let r=t.datasetIndex,o=n.chart;o.isDatasetVisible(r)?(o.hide(r),t.hidden=!0):(o.show(r),t.hidden=!1)},onHover:null,onLeave:null,labels:{color:e=>e.chart.options.color,boxWidth:40,padding:10,generateLabels(e){let t=e.data.datasets,{labels:{usePointStyle:n,pointStyle:r,textAlign:o,color:i}}=e.legend.options;return e._getSortedDatasetMetas().map(a=>{let l=a.controller.getStyle(n?0:void 0),c=Pr(l.borderWidth);return{text:t[a.index].label,fillStyle:l.backgroundColor,fontColor:i,hidden:!a.visible,lineCap:l.borderCapStyle,lineDash:l.borderDash,lineDashOffset:l.borderDashOffset,lineJoin:l.borderJoinStyle,lineWidth:(c.width+c.height)/4,strokeStyle:l.borderColor,pointStyle:r||l.pointStyle,rotation:l.rotation,textAlign:o||l.textAlign,borderRadius:0,datasetIndex:a.index}},this)}},title:{color:e=>e.chart.options.color,display:!1,position:"center",text:""}},descriptors:{_scriptable:e=>!e.startsWith("on"),labels:{_scriptable:e=>!["generateLabels","filter","sort"].includes(e)}}},t1=class extends Uo{constructor(t){super(),this.chart=t.chart,this.options=t.options,this.ctx=t.ctx,this._padding=void 0,this.top=void 0,this.bottom=void 0,this.left=void 0,this.right=void 0,this.width=void 0,this.height=void 0,this.position=void 0,this.weight=void 0,this.fullSize=void 0}update(t,n){let r=this.options;if(this.left=0,this.top=0,!r.display){this.width=this.height=this.right=this.bottom=0;return}this.width=this.right=t,this.height=this.bottom=n;let o=cn(r.text)?r.text.length:1;this._padding=Pr(r.padding);let i=o*Qn(r.font).lineHeight+this._padding.height;this.isHorizontal()?this.height=i:this.width=i}isHorizontal(){let t=this.options.position;return t==="top"||t==="bottom"}_drawArgs(t){let{top:n,left:r,bottom:o,right:i,options:a}=this,l=a.align,c=0,d,g,v;return this.isHorizontal()?(g=Cr(l,r,i),v=n+t,d=i-r):(a.position==="left"?(g=r+t,v=Cr(l,o,n),c=_n*-.5):(g=i-t,v=Cr(l,n,o),c=_n*.5),d=o-n),{titleX:g,titleY:v,maxWidth:d,rotation:c}}draw(){let t=this.ctx,n=this.options;if(!n.display)return;let r=Qn(n.font),i=r.lineHeight/2+this._padding.top,{titleX:a,titleY:l,maxWidth:c,rotation:d}=this._drawArgs(i);hl(t,n.text,0,0,r,{color:n.color,maxWidth:c,rotation:d,textAlign:bg(n.align),textBaseline:"middle",translation:[a,l]})}};function TH(e,t){let n=new t1({ctx:e.ctx,options:t,chart:e});jr.configure(e,n,t),jr.addBox(e,n),e.titleBlock=n}var LH={id:"title",_element:t1,start(e,t,n){TH(e,n)},stop(e){let t=e.titleBlock;jr.removeBox(e,t),delete e.titleBlock},beforeUpdate(e,t,n){let r=e.titleBlock;jr.configure(e,r,n),r.options=n},defaults:{align:"center",display:!1,font:{weight:"bold"},fullSize:!0,padding:10,position:"top",text:"",weight:2e3},defaultRoutes:{color:"color"},descriptors:{_scriptable:!0,_indexable:!1}},Lg=new WeakMap,OH={id:"subtitle",start(e,t,n){let r=new t1({ctx:e.ctx,options:n,chart:e});jr.configure(e,r,n),jr.addBox(e,r),Lg.set(e,r)},stop(e){jr.removeBox(e,Lg.get(e)),Lg.delete(e)},beforeUpdate(e,t,n){let r=Lg.get(e);jr.configure(e,r,n),r.options=n},defaults:{align:"center",display:!1,font:{weight:"normal"},fullSize:!0,padding:0,position:"top",text:"",weight:1500},defaultRoutes:{color:"color"},descriptors:{_scriptable:!0,_indexable:!1}},Xh={average(e){if(!e.length)return!1;let t,n,r=0,o=0,i=0;for(t=0,n=e.length;t<n;++t){let a=e[t].element;if(a&&a.hasValue()){let l=a.tooltipPosition();r+=l.x,o+=l.y,++i}}return{x:r/i,y:o/i}},nearest(e,t){if(!e.length)return!1;let n=t.x,r=t.y,o=Number.POSITIVE_INFINITY,i,a,l;for(i=0,a=e.length;i<a;++i){let c=e[i].element;if(c&&c.hasValue()){let d=c.getCenterPoint(),g=yg(t,d);g<o&&(o=g,l=c)}}if(l){let c=l.tooltipPosition();n=c.x,r=c.y}return{x:n,y:r}}};function Ss(e,t){return t&&(cn(t)?Array.prototype.push.apply(e,t):e.push(t)),e}function da(e){return(typeof e=="string"||e instanceof String)&&e.indexOf(`
 `)>-1?e.split(`
 `):e}function NH(e,t){let{element:n,datasetIndex:r,index:o}=t,i=e.getDatasetMeta(r).controller,{label:a,value:l}=i.getLabelAndValue(o);return{chart:e,label:a,parsed:i.getParsed(o),raw:e.data.datasets[r].data[o],formattedValue:l,dataset:i.getDataset(),dataIndex:o,datasetIndex:r,element:n}}function iE(e,t){let n=e.chart.ctx,{body:r,footer:o,title:i}=e,{boxWidth:a,boxHeight:l}=t,c=Qn(t.bodyFont),d=Qn(t.titleFont),g=Qn(t.footerFont),v=i.length,w=o.length,S=r.length,C=Pr(t.padding),L=C.height,k=0,M=r.reduce((T,O)=>T+O.before.length+O.lines.length+O.after.length,0);if(M+=e.beforeBody.length+e.afterBody.length,v&&(L+=v*d.lineHeight+(v-1)*t.titleSpacing+t.titleMarginBottom),M){let T=t.displayColors?Math.max(l,c.lineHeight):c.lineHeight;L+=S*T+(M-S)*c.lineHeight+(M-1)*t.bodySpacing}w&&(L+=t.footerMarginTop+w*g.lineHeight+(w-1)*t.footerSpacing);let P=0,D=function(T){k=Math.max(k,n.measureText(T).width+P)};return n.save(),n.font=d.string,Xt(e.title,D),n.font=c.string,Xt(e.beforeBody.concat(e.afterBody),D),P=t.displayColors?a+2+t.boxPadding:0,Xt(r,T=>{Xt(T.before,D),Xt(T.lines,D),Xt(T.after,D)}),P=0,n.font=g.string,Xt(e.footer,D),n.restore(),k+=C.width,{width:k,height:L}}function AH(e,t){let{y:n,height:r}=t;return n<r/2?"top":n>e.height-r/2?"bottom":"center"}function FH(e,t,n,r){let{x:o,width:i}=r,a=n.caretSize+n.caretPadding;if(e==="left"&&o+i+a>t.width||e==="right"&&o-i-a<0)return!0}function RH(e,t,n,r){let{x:o,width:i}=n,{width:a,chartArea:{left:l,right:c}}=e,d="center";return r==="center"?d=o<=(l+c)/2?"left":"right":o<=i/2?d="left":o>=a-i/2&&(d="right"),FH(d,e,t,n)&&(d="center"),d}function sE(e,t,n){let r=n.yAlign||t.yAlign||AH(e,n);return{xAlign:n.xAlign||t.xAlign||RH(e,t,n,r),yAlign:r}}function BH(e,t){let{x:n,width:r}=e;return t==="right"?n-=r:t==="center"&&(n-=r/2),n}function zH(e,t,n){let{y:r,height:o}=e;return t==="top"?r+=n:t==="bottom"?r-=o+n:r-=o/2,r}function aE(e,t,n,r){let{caretSize:o,caretPadding:i,cornerRadius:a}=e,{xAlign:l,yAlign:c}=n,d=o+i,{topLeft:g,topRight:v,bottomLeft:w,bottomRight:S}=ml(a),C=BH(t,l),L=zH(t,c,d);return c==="center"?l==="left"?C+=d:l==="right"&&(C-=d):l==="left"?C-=Math.max(g,w)+o:l==="right"&&(C+=Math.max(v,S)+o),{x:sr(C,0,r.width-t.width),y:sr(L,0,r.height-t.height)}}function Og(e,t,n){let r=Pr(n.padding);return t==="center"?e.x+e.width/2:t==="right"?e.x+e.width-r.right:e.x+r.left}function lE(e){return Ss([],da(e))}function jH(e,t,n){return ca(e,{tooltip:t,tooltipItems:n,type:"tooltip"})}function uE(e,t){let n=t&&t.dataset&&t.dataset.tooltip&&t.dataset.tooltip.callbacks;return n?e.override(n):e}var n1=class extends Uo{constructor(t){super(),this.opacity=0,this._active=[],this._eventPosition=void 0,this._size=void 0,this._cachedAnimations=void 0,this._tooltipItems=[],this.$animations=void 0,this.$context=void 0,this.chart=t.chart||t._chart,this._chart=this.chart,this.options=t.options,this.dataPoints=void 0,this.title=void 0,this.beforeBody=void 0,this.body=void 0,this.afterBody=void 0,this.footer=void 0,this.xAlign=void 0,this.yAlign=void 0,this.x=void 0,this.y=void 0,this.height=void 0,this.width=void 0,this.caretX=void 0,this.caretY=void 0,this.labelColors=void 0,this.labelPointStyles=void 0,this.labelTextColors=void 0}initialize(t){this.options=t,this._cachedAnimations=void 0,this.$context=void 0}_resolveAnimations(){let t=this._cachedAnimations;if(t)return t;let n=this.chart,r=this.options.setContext(this.getContext()),o=r.enabled&&n.options.animation&&r.animations,i=new Fg(this.chart,o);return o._cacheable&&(this._cachedAnimations=Object.freeze(i)),i}getContext(){return this.$context||(this.$context=jH(this.chart.getContext(),this,this._tooltipItems))}getTitle(t,n){let{callbacks:r}=n,o=r.beforeTitle.apply(this,[t]),i=r.title.apply(this,[t]),a=r.afterTitle.apply(this,[t]),l=[];return l=Ss(l,da(o)),l=Ss(l,da(i)),l=Ss(l,da(a)),l}getBeforeBody(t,n){return lE(n.callbacks.beforeBody.apply(this,[t]))}getBody(t,n){let{callbacks:r}=n,o=[];return Xt(t,i=>{let a={before:[],lines:[],after:[]},l=uE(r,i);Ss(a.before,da(l.beforeLabel.call(this,i))),Ss(a.lines,l.label.call(this,i)),Ss(a.after,da(l.afterLabel.call(this,i))),o.push(a)}),o}getAfterBody(t,n){return lE(n.callbacks.afterBody.apply(this,[t]))}getFooter(t,n){let{callbacks:r}=n,o=r.beforeFooter.apply(this,[t]),i=r.footer.apply(this,[t]),a=r.afterFooter.apply(this,[t]),l=[];return l=Ss(l,da(o)),l=Ss(l,da(i)),l=Ss(l,da(a)),l}_createItems(t){let n=this._active,r=this.chart.data,o=[],i=[],a=[],l=[],c,d;for(c=0,d=n.length;c<d;++c)l.push(NH(this.chart,n[c]));return t.filter&&(l=l.filter((g,v,w)=>t.filter(g,v,w,r))),t.itemSort&&(l=l.sort((g,v)=>t.itemSort(g,v,r))),Xt(l,g=>{let v=uE(t.callbacks,g);o.push(v.labelColor.call(this,g)),i.push(v.labelPointStyle.call(this,g)),a.push(v.labelTextColor.call(this,g))}),this.labelColors=o,this.labelPointStyles=i,this.labelTextColors=a,this.dataPoints=l,l}update(t,n){let r=this.options.setContext(this.getContext()),o=this._active,i,a=[];if(!o.length)this.opacity!==0&&(i={opacity:0});else{let l=Xh[r.position].call(this,o,this._eventPosition);a=this._createItems(r),this.title=this.getTitle(a,r),this.beforeBody=this.getBeforeBody(a,r),this.body=this.getBody(a,r),this.afterBody=this.getAfterBody(a,r),this.footer=this.getFooter(a,r);let c=this._size=iE(this,r),d=Object.assign({},l,c),g=sE(this.chart,r,d),v=aE(r,d,g,this.chart);this.xAlign=g.xAlign,this.yAlign=g.yAlign,i={opacity:1,x:v.x,y:v.y,width:c.width,height:c.height,caretX:l.x,caretY:l.y}}this._tooltipItems=a,this.$context=void 0,i&&this._resolveAnimations().update(this,i),t&&r.external&&r.external.call(this,{chart:this.chart,tooltip:this,replay:n})}drawCaret(t,n,r,o){let i=this.getCaretPosition(t,r,o);n.lineTo(i.x1,i.y1),n.lineTo(i.x2,i.y2),n.lineTo(i.x3,i.y3)}getCaretPosition(t,n,r){let{xAlign:o,yAlign:i}=this,{caretSize:a,cornerRadius:l}=r,{topLeft:c,topRight:d,bottomLeft:g,bottomRight:v}=ml(l),{x:w,y:S}=t,{width:C,height:L}=n,k,M,P,D,T,O;return i==="center"?(T=S+L/2,o==="left"?(k=w,M=k-a,D=T+a,O=T-a):(k=w+C,M=k+a,D=T-a,O=T+a),P=k):(o==="left"?M=w+Math.max(c,g)+a:o==="right"?M=w+C-Math.max(d,v)-a:M=this.caretX,i==="top"?(D=S,T=D-a,k=M-a,P=M+a):(D=S+L,T=D+a,k=M+a,P=M-a),O=D),{x1:k,x2:M,x3:P,y1:D,y2:T,y3:O}}drawTitle(t,n,r){let o=this.title,i=o.length,a,l,c;if(i){let d=Du(r.rtl,this.x,this.width);for(t.x=Og(this,r.titleAlign,r),n.textAlign=d.textAlign(r.titleAlign),n.textBaseline="middle",a=Qn(r.titleFont),l=r.titleSpacing,n.fillStyle=r.titleColor,n.font=a.string,c=0;c<i;++c)n.fillText(o[c],d.x(t.x),t.y+a.lineHeight/2),t.y+=a.lineHeight+l,c+1===i&&(t.y+=r.titleMarginBottom-l)}}_drawColorBox(t,n,r,o,i){let a=this.labelColors[r],l=this.labelPointStyles[r],{boxHeight:c,boxWidth:d,boxPadding:g}=i,v=Qn(i.bodyFont),w=Og(this,"left",i),S=o.x(w),C=c<v.lineHeight?(v.lineHeight-c)/2:0,L=n.y+C;if(i.usePointStyle){let k={radius:Math.min(d,c)/2,pointStyle:l.pointStyle,rotation:l.rotation,borderWidth:1},M=o.leftForLtr(S,d)+d/2,P=L+c/2;t.strokeStyle=i.multiKeyBackground,t.fillStyle=i.multiKeyBackground,Mg(t,k,M,P),t.strokeStyle=a.borderColor,t.fillStyle=a.backgroundColor,Mg(t,k,M,P)}else{t.lineWidth=Lt(a.borderWidth)?Math.max(...Object.values(a.borderWidth)):a.borderWidth||1,t.strokeStyle=a.borderColor,t.setLineDash(a.borderDash||[]),t.lineDashOffset=a.borderDashOffset||0;let k=o.leftForLtr(S,d-g),M=o.leftForLtr(o.xPlus(S,1),d-g-2),P=ml(a.borderRadius);Object.values(P).some(D=>D!==0)?(t.beginPath(),t.fillStyle=i.multiKeyBackground,Wf(t,{x:k,y:L,w:d,h:c,radius:P}),t.fill(),t.stroke(),t.fillStyle=a.backgroundColor,t.beginPath(),Wf(t,{x:M,y:L+1,w:d-2,h:c-2,radius:P}),t.fill()):(t.fillStyle=i.multiKeyBackground,t.fillRect(k,L,d,c),t.strokeRect(k,L,d,c),t.fillStyle=a.backgroundColor,t.fillRect(M,L+1,d-2,c-2))}t.fillStyle=this.labelTextColors[r]}drawBody(t,n,r){let{body:o}=this,{bodySpacing:i,bodyAlign:a,displayColors:l,boxHeight:c,boxWidth:d,boxPadding:g}=r,v=Qn(r.bodyFont),w=v.lineHeight,S=0,C=Du(r.rtl,this.x,this.width),L=function(B){n.fillText(B,C.x(t.x+S),t.y+w/2),t.y+=w+i},k=C.textAlign(a),M,P,D,T,O,A,R;for(n.textAlign=a,n.textBaseline="middle",n.font=v.string,t.x=Og(this,k,r),n.fillStyle=r.bodyColor,Xt(this.beforeBody,L),S=l&&k!=="right"?a==="center"?d/2+g:d+2+g:0,T=0,A=o.length;T<A;++T){for(M=o[T],P=this.labelTextColors[T],n.fillStyle=P,Xt(M.before,L),D=M.lines,l&&D.length&&(this._drawColorBox(n,t,T,C,r),w=Math.max(v.lineHeight,c)),O=0,R=D.length;O<R;++O)L(D[O]),w=v.lineHeight;Xt(M.after,L)}S=0,w=v.lineHeight,Xt(this.afterBody,L),t.y-=i}drawFooter(t,n,r){let o=this.footer,i=o.length,a,l;if(i){let c=Du(r.rtl,this.x,this.width);for(t.x=Og(this,r.footerAlign,r),t.y+=r.footerMarginTop,n.textAlign=c.textAlign(r.footerAlign),n.textBaseline="middle",a=Qn(r.footerFont),n.fillStyle=r.footerColor,n.font=a.string,l=0;l<i;++l)n.fillText(o[l],c.x(t.x),t.y+a.lineHeight/2),t.y+=a.lineHeight+r.footerSpacing}}drawBackground(t,n,r,o){let{xAlign:i,yAlign:a}=this,{x:l,y:c}=t,{width:d,height:g}=r,{topLeft:v,topRight:w,bottomLeft:S,bottomRight:C}=ml(o.cornerRadius);n.fillStyle=o.backgroundColor,n.strokeStyle=o.borderColor,n.lineWidth=o.borderWidth,n.beginPath(),n.moveTo(l+v,c),a==="top"&&this.drawCaret(t,n,r,o),n.lineTo(l+d-w,c),n.quadraticCurveTo(l+d,c,l+d,c+w),a==="center"&&i==="right"&&this.drawCaret(t,n,r,o),n.lineTo(l+d,c+g-C),n.quadraticCurveTo(l+d,c+g,l+d-C,c+g),a==="bottom"&&this.drawCaret(t,n,r,o),n.lineTo(l+S,c+g),n.quadraticCurveTo(l,c+g,l,c+g-S),a==="center"&&i==="left"&&this.drawCaret(t,n,r,o),n.lineTo(l,c+v),n.quadraticCurveTo(l,c,l+v,c),n.closePath(),n.fill(),o.borderWidth>0&&n.stroke()}_updateAnimationTarget(t){let n=this.chart,r=this.$animations,o=r&&r.x,i=r&&r.y;if(o||i){let a=Xh[t.position].call(this,this._active,this._eventPosition);if(!a)return;let l=this._size=iE(this,t),c=Object.assign({},a,this._size),d=sE(n,t,c),g=aE(t,c,d,n);(o._to!==g.x||i._to!==g.y)&&(this.xAlign=d.xAlign,this.yAlign=d.yAlign,this.width=l.width,this.height=l.height,this.caretX=a.x,this.caretY=a.y,this._resolveAnimations().update(this,g))}}_willRender(){return!!this.opacity}draw(t){let n=this.options.setContext(this.getContext()),r=this.opacity;if(!r)return;this._updateAnimationTarget(n);let o={width:this.width,height:this.height},i={x:this.x,y:this.y};r=Math.abs(r)<.001?0:r;let a=Pr(n.padding),l=this.title.length||this.beforeBody.length||this.body.length||this.afterBody.length||this.footer.length;n.enabled&&l&&(t.save(),t.globalAlpha=r,this.drawBackground(i,t,o,n),t_(t,n.textDirection),i.y+=a.top,this.drawTitle(i,t,n),this.drawBody(i,t,n),this.drawFooter(i,t,n),n_(t,n.textDirection),t.restore())}getActiveElements(){return this._active||[]}setActiveElements(t,n){let r=this._active,o=t.map(({datasetIndex:l,index:c})=>{let d=this.chart.getDatasetMeta(l);if(!d)throw new Error("Cannot find a dataset at index "+l);return{datasetIndex:l,element:d.data[c],index:c}}),i=!Vh(r,o),a=this._positionChanged(o,n);(i||a)&&(this._active=o,this._eventPosition=n,this._ignoreReplayEvents=!0,this.update(!0))}handleEvent(t,n,r=!0){if(n&&this._ignoreReplayEvents)return!1;this._ignoreReplayEvents=!1;let o=this.options,i=this._active||[],a=this._getActiveElements(t,i,n,r),l=this._positionChanged(a,t),c=n||!Vh(a,i)||l;return c&&(this._active=a,(o.enabled||o.external)&&(this._eventPosition={x:t.x,y:t.y},this.update(!0,n))),c}_getActiveElements(t,n,r,o){let i=this.options;if(t.type==="mouseout")return[];if(!o)return n;let a=this.chart.getElementsAtEventForMode(t,i.mode,i,r);return i.reverse&&a.reverse(),a}_positionChanged(t,n){let{caretX:r,caretY:o,options:i}=this,a=Xh[i.position].call(this,t,n);return a!==!1&&(r!==a.x||o!==a.y)}};n1.positioners=Xh;var VH={id:"tooltip",_element:n1,positioners:Xh,afterInit(e,t,n){n&&(e.tooltip=new n1({chart:e,options:n}))},beforeUpdate(e,t,n){e.tooltip&&e.tooltip.initialize(n)},reset(e,t,n){e.tooltip&&e.tooltip.initialize(n)},afterDraw(e){let t=e.tooltip;if(t&&t._willRender()){let n={tooltip:t};if(e.notifyPlugins("beforeTooltipDraw",n)===!1)return;t.draw(e.ctx),e.notifyPlugins("afterTooltipDraw",n)}},afterEvent(e,t){if(e.tooltip){let n=t.replay;e.tooltip.handleEvent(t.event,n,t.inChartArea)&&(t.changed=!0)}},defaults:{enabled:!0,external:null,position:"average",backgroundColor:"rgba(0,0,0,0.8)",titleColor:"#fff",titleFont:{weight:"bold"},titleSpacing:2,titleMarginBottom:6,titleAlign:"left",bodyColor:"#fff",bodySpacing:2,bodyFont:{},bodyAlign:"left",footerColor:"#fff",footerSpacing:2,footerMarginTop:6,footerFont:{weight:"bold"},footerAlign:"left",padding:6,caretPadding:2,caretSize:5,cornerRadius:6,boxHeight:(e,t)=>t.bodyFont.size,boxWidth:(e,t)=>t.bodyFont.size,multiKeyBackground:"#fff",displayColors:!0,boxPadding:0,borderColor:"rgba(0,0,0,0)",borderWidth:0,animation:{duration:400,easing:"easeOutQuart"},animations:{numbers:{type:"number",properties:["x","y","width","height","caretX","caretY"]},opacity:{easing:"linear",duration:200}},callbacks:{beforeTitle:ws,title(e){if(e.length>0){let t=e[0],n=t.chart.data.labels,r=n?n.length:0;if(this&&this.options&&this.options.mode==="dataset")return t.dataset.label||"";if(t.label)return t.label;if(r>0&&t.dataIndex<r)return n[t.dataIndex]}return""},afterTitle:ws,beforeBody:ws,beforeLabel:ws,label(e){if(this&&this.options&&this.options.mode==="dataset")return e.label+": "+e.formattedValue||e.formattedValue;let t=e.dataset.label||"";t&&(t+=": ");let n=e.formattedValue;return Ht(n)||(t+=n),t},labelColor(e){let n=e.chart.getDatasetMeta(e.datasetIndex).controller.getStyle(e.dataIndex);return{borderColor:n.borderColor,backgroundColor:n.backgroundColor,borderWidth:n.borderWidth,borderDash:n.borderDash,borderDashOffset:n.borderDashOffset,borderRadius:0}},labelTextColor(){return this.options.bodyColor},labelPointStyle(e){let n=e.chart.getDatasetMeta(e.datasetIndex).controller.getStyle(e.dataIndex);return{pointStyle:n.pointStyle,rotation:n.rotation}},afterLabel:ws,afterBody:ws,beforeFooter:ws,footer:ws,afterFooter:ws}},defaultRoutes:{bodyFont:"font",footerFont:"font",titleFont:"font"},descriptors:{_scriptable:e=>e!=="filter"&&e!=="itemSort"&&e!=="external",_indexable:!1,callbacks:{_scriptable:!1,_indexable:!1},animation:{_fallback:!1},animations:{_fallback:"animation"}},additionalOptionScopes:["interaction"]},HH=Object.freeze({__proto__:null,Decimation:aH,Filler:PH,Legend:DH,SubTitle:OH,Title:LH,Tooltip:VH}),UH=(e,t,n,r)=>(typeof t=="string"?(n=e.push(t)-1,r.unshift({index:n,label:t})):isNaN(t)&&(n=null),n);function WH(e,t,n,r){let o=e.indexOf(t);if(o===-1)return UH(e,t,n,r);let i=e.lastIndexOf(t);return o!==i?n:o}var $H=(e,t)=>e===null?null:sr(Math.round(e),0,t),od=class extends Lu{constructor(t){super(t),this._startValue=void 0,this._valueRange=0,this._addedLabels=[]}init(t){let n=this._addedLabels;if(n.length){let r=this.getLabels();for(let{index:o,label:i}of n)r[o]===i&&r.splice(o,1);this._addedLabels=[]}super.init(t)}parse(t,n){if(Ht(t))return null;let r=this.getLabels();return n=isFinite(n)&&r[n]===t?n:WH(r,t,Mt(n,t),this._addedLabels),$H(n,r.length-1)}determineDataLimits(){let{minDefined:t,maxDefined:n}=this.getUserBounds(),{min:r,max:o}=this.getMinMax(!0);this.options.bounds==="ticks"&&(t||(r=0),n||(o=this.getLabels().length-1)),this.min=r,this.max=o}buildTicks(){let t=this.min,n=this.max,r=this.options.offset,o=[],i=this.getLabels();i=t===0&&n===i.length-1?i:i.slice(t,n+1),this._valueRange=Math.max(i.length-(r?0:1),1),this._startValue=this.min-(r?.5:0);for(let a=t;a<=n;a++)o.push({value:a});return o}getLabelForValue(t){let n=this.getLabels();return t>=0&&t<n.length?n[t]:t}configure(){super.configure(),this.isHorizontal()||(this._reversePixels=!this._reversePixels)}getPixelForValue(t){return typeof t!="number"&&(t=this.parse(t)),t===null?NaN:this.getPixelForDecimal((t-this._startValue)/this._valueRange)}getPixelForTick(t){let n=this.ticks;return t<0||t>n.length-1?null:this.getPixelForValue(n[t].value)}getValueForPixel(t){return Math.round(this._startValue+this.getDecimalForPixel(t)*this._valueRange)}getBasePixel(){return this.bottom}};od.id="category";od.defaults={ticks:{callback:od.prototype.getLabelForValue}};function YH(e,t){let n=[],{bounds:o,step:i,min:a,max:l,precision:c,count:d,maxTicks:g,maxDigits:v,includeBounds:w}=e,S=i||1,C=g-1,{min:L,max:k}=t,M=!Ht(a),P=!Ht(l),D=!Ht(d),T=(k-L)/(v+1),O=T6((k-L)/C/S)*S,A,R,B,Y;if(O<1e-14&&!M&&!P)return[{value:L},{value:k}];Y=Math.ceil(k/O)-Math.floor(L/O),Y>C&&(O=T6(Y*O/C/S)*S),Ht(c)||(A=Math.pow(10,c),O=Math.ceil(O*A)/A),o==="ticks"?(R=Math.floor(L/O)*O,B=Math.ceil(k/O)*O):(R=L,B=k),M&&P&&i&&JC((l-a)/i,O/1e3)?(Y=Math.round(Math.min((l-a)/O,g)),O=(l-a)/Y,R=a,B=l):D?(R=M?a:R,B=P?l:B,Y=d-1,O=(B-R)/Y):(Y=(B-R)/O,Hf(Y,Math.round(Y),O/1e3)?Y=Math.round(Y):Y=Math.ceil(Y));let K=Math.max(O6(O),O6(R));A=Math.pow(10,Ht(c)?K:c),R=Math.round(R*A)/A,B=Math.round(B*A)/A;let Q=0;for(M&&(w&&R!==a?(n.push({value:a}),R<a&&Q++,Hf(Math.round((R+Q*O)*A)/A,a,cE(a,T,e))&&Q++):R<a&&Q++);Q<Y;++Q)n.push({value:Math.round((R+Q*O)*A)/A});return P&&w&&B!==l?n.length&&Hf(n[n.length-1].value,l,cE(l,T,e))?n[n.length-1].value=l:n.push({value:l}):(!P||B===l)&&n.push({value:B}),n}function cE(e,t,{horizontal:n,minRotation:r}){let o=gi(r),i=(n?Math.sin(o):Math.cos(o))||.001,a=.75*t*(""+e).length;return Math.min(t/i,a)}var id=class extends Lu{constructor(t){super(t),this.start=void 0,this.end=void 0,this._startValue=void 0,this._endValue=void 0,this._valueRange=0}parse(t,n){return Ht(t)||(typeof t=="number"||t instanceof Number)&&!isFinite(+t)?null:+t}handleTickRangeOptions(){let{beginAtZero:t}=this.options,{minDefined:n,maxDefined:r}=this.getUserBounds(),{min:o,max:i}=this,a=c=>o=n?o:c,l=c=>i=r?i:c;if(t){let c=Qi(o),d=Qi(i);c<0&&d<0?l(0):c>0&&d>0&&a(0)}if(o===i){let c=1;(i>=Number.MAX_SAFE_INTEGER||o<=Number.MIN_SAFE_INTEGER)&&(c=Math.abs(i*.05)),l(i+c),t||a(o-c)}this.min=o,this.max=i}getTickLimit(){let t=this.options.ticks,{maxTicksLimit:n,stepSize:r}=t,o;return r?(o=Math.ceil(this.max/r)-Math.floor(this.min/r)+1,o>1e3&&(console.warn(`scales.${this.id}.ticks.stepSize: ${r} would result generating up to ${o} ticks. Limiting to 1000.`),o=1e3)):(o=this.computeTickLimit(),n=n||11),n&&(o=Math.min(n,o)),o}computeTickLimit(){return Number.POSITIVE_INFINITY}buildTicks(){let t=this.options,n=t.ticks,r=this.getTickLimit();r=Math.max(2,r);let o={maxTicks:r,bounds:t.bounds,min:t.min,max:t.max,precision:n.precision,step:n.stepSize,count:n.count,maxDigits:this._maxDigits(),horizontal:this.isHorizontal(),minRotation:n.minRotation||0,includeBounds:n.includeBounds!==!1},i=this._range||this,a=YH(o,i);return t.bounds==="ticks"&&L6(a,this,"value"),t.reverse?(a.reverse(),this.start=this.max,this.end=this.min):(this.start=this.min,this.end=this.max),a}configure(){let t=this.ticks,n=this.min,r=this.max;if(super.configure(),this.options.offset&&t.length){let o=(r-n)/Math.max(t.length-1,1)/2;n-=o,r+=o}this._startValue=n,this._endValue=r,this._valueRange=r-n}getLabelForValue(t){return Yf(t,this.chart.options.locale,this.options.ticks.format)}},r1=class extends id{determineDataLimits(){let{min:t,max:n}=this.getMinMax(!0);this.min=Fn(t)?t:0,this.max=Fn(n)?n:1,this.handleTickRangeOptions()}computeTickLimit(){let t=this.isHorizontal(),n=t?this.width:this.height,r=gi(this.options.ticks.minRotation),o=(t?Math.sin(r):Math.cos(r))||.001,i=this._resolveTickFontOptions(0);return Math.ceil(n/Math.min(40,i.lineHeight/o))}getPixelForValue(t){return t===null?NaN:this.getPixelForDecimal((t-this._startValue)/this._valueRange)}getValueForPixel(t){return this._startValue+this.getDecimalForPixel(t)*this._valueRange}};r1.id="linear";r1.defaults={ticks:{callback:Hg.formatters.numeric}};function fE(e){return e/Math.pow(10,Math.floor(Vo(e)))===1}function qH(e,t){let n=Math.floor(Vo(t.max)),r=Math.ceil(t.max/Math.pow(10,n)),o=[],i=jo(e.min,Math.pow(10,Math.floor(Vo(t.min)))),a=Math.floor(Vo(i)),l=Math.floor(i/Math.pow(10,a)),c=a<0?Math.pow(10,Math.abs(a)):1;do o.push({value:i,major:fE(i)}),++l,l===10&&(l=1,++a,c=a>=0?1:c),i=Math.round(l*Math.pow(10,a)*c)/c;while(a<n||a===n&&l<r);let d=jo(e.max,i);return o.push({value:d,major:fE(i)}),o}var o1=class extends Lu{constructor(t){super(t),this.start=void 0,this.end=void 0,this._startValue=void 0,this._valueRange=0}parse(t,n){let r=id.prototype.parse.apply(this,[t,n]);if(r===0){this._zero=!0;return}return Fn(r)&&r>0?r:null}determineDataLimits(){let{min:t,max:n}=this.getMinMax(!0);this.min=Fn(t)?Math.max(0,t):null,this.max=Fn(n)?Math.max(0,n):null,this.options.beginAtZero&&(this._zero=!0),this.handleTickRangeOptions()}handleTickRangeOptions(){let{minDefined:t,maxDefined:n}=this.getUserBounds(),r=this.min,o=this.max,i=c=>r=t?r:c,a=c=>o=n?o:c,l=(c,d)=>Math.pow(10,Math.floor(Vo(c))+d);r===o&&(r<=0?(i(1),a(10)):(i(l(r,-1)),a(l(o,1)))),r<=0&&i(l(o,-1)),o<=0&&a(l(r,1)),this._zero&&this.min!==this._suggestedMin&&r===l(this.min,0)&&i(l(r,-1)),this.min=r,this.max=o}buildTicks(){let t=this.options,n={min:this._userMin,max:this._userMax},r=qH(n,this);return t.bounds==="ticks"&&L6(r,this,"value"),t.reverse?(r.reverse(),this.start=this.max,this.end=this.min):(this.start=this.min,this.end=this.max),r}getLabelForValue(t){return t===void 0?"0":Yf(t,this.chart.options.locale,this.options.ticks.format)}configure(){let t=this.min;super.configure(),this._startValue=Vo(t),this._valueRange=Vo(this.max)-Vo(t)}getPixelForValue(t){return(t===void 0||t===0)&&(t=this.min),t===null||isNaN(t)?NaN:this.getPixelForDecimal(t===this.min?0:(Vo(t)-this._startValue)/this._valueRange)}getValueForPixel(t){let n=this.getDecimalForPixel(t);return Math.pow(10,this._startValue+n*this._valueRange)}};o1.id="logarithmic";o1.defaults={ticks:{callback:Hg.formatters.logarithmic,major:{enabled:!0}}};function C_(e){let t=e.ticks;if(t.display&&e.display){let n=Pr(t.backdropPadding);return Mt(t.font&&t.font.size,Ot.font.size)+n.height}return 0}function KH(e,t,n){return n=cn(n)?n:[n],{w:fP(e,t.string,n),h:n.length*t.lineHeight}}function dE(e,t,n,r,o){return e===r||e===o?{start:t-n/2,end:t+n/2}:e<r||e>o?{start:t-n,end:t}:{start:t,end:t+n}}function GH(e){let t={l:e.left+e._padding.left,r:e.right-e._padding.right,t:e.top+e._padding.top,b:e.bottom-e._padding.bottom},n=Object.assign({},t),r=[],o=[],i=e._pointLabels.length,a=e.options.pointLabels,l=a.centerPointLabels?_n/i:0;for(let c=0;c<i;c++){let d=a.setContext(e.getPointLabelContext(c));o[c]=d.padding;let g=e.getPointPosition(c,e.drawingArea+o[c],l),v=Qn(d.font),w=KH(e.ctx,v,e._pointLabels[c]);r[c]=w;let S=yo(e.getIndexAngle(c)+l),C=Math.round(xg(S)),L=dE(C,g.x,w.w,0,180),k=dE(C,g.y,w.h,90,270);QH(n,t,S,L,k)}e.setCenterPoint(t.l-n.l,n.r-t.r,t.t-n.t,n.b-t.b),e._pointLabelItems=XH(e,r,o)}function QH(e,t,n,r,o){let i=Math.abs(Math.sin(n)),a=Math.abs(Math.cos(n)),l=0,c=0;r.start<t.l?(l=(t.l-r.start)/i,e.l=Math.min(e.l,t.l-l)):r.end>t.r&&(l=(r.end-t.r)/i,e.r=Math.max(e.r,t.r+l)),o.start<t.t?(c=(t.t-o.start)/a,e.t=Math.min(e.t,t.t-c)):o.end>t.b&&(c=(o.end-t.b)/a,e.b=Math.max(e.b,t.b+c))}function XH(e,t,n){let r=[],o=e._pointLabels.length,i=e.options,a=C_(i)/2,l=e.drawingArea,c=i.pointLabels.centerPointLabels?_n/o:0;for(let d=0;d<o;d++){let g=e.getPointPosition(d,l+a+n[d],c),v=Math.round(xg(yo(g.angle+Dn))),w=t[d],S=eU(g.y,w.h,v),C=JH(v),L=ZH(g.x,w.w,C);r.push({x:g.x,y:S,textAlign:C,left:L,top:S,right:L+w.w,bottom:S+w.h})}return r}function JH(e){return e===0||e===180?"center":e<180?"left":"right"}function ZH(e,t,n){return n==="right"?e-=t:n==="center"&&(e-=t/2),e}function eU(e,t,n){return n===90||n===270?e-=t/2:(n>270||n<90)&&(e-=t),e}function tU(e,t){let{ctx:n,options:{pointLabels:r}}=e;for(let o=t-1;o>=0;o--){let i=r.setContext(e.getPointLabelContext(o)),a=Qn(i.font),{x:l,y:c,textAlign:d,left:g,top:v,right:w,bottom:S}=e._pointLabelItems[o],{backdropColor:C}=i;if(!Ht(C)){let L=ml(i.borderRadius),k=Pr(i.backdropPadding);n.fillStyle=C;let M=g-k.left,P=v-k.top,D=w-g+k.width,T=S-v+k.height;Object.values(L).some(O=>O!==0)?(n.beginPath(),Wf(n,{x:M,y:P,w:D,h:T,radius:L}),n.fill()):n.fillRect(M,P,D,T)}hl(n,e._pointLabels[o],l,c+a.lineHeight/2,a,{color:i.color,textAlign:d,textBaseline:"middle"})}}function AE(e,t,n,r){let{ctx:o}=e;if(n)o.arc(e.xCenter,e.yCenter,t,0,tn);else{let i=e.getPointPosition(0,t);o.moveTo(i.x,i.y);for(let a=1;a<r;a++)i=e.getPointPosition(a,t),o.lineTo(i.x,i.y)}}function nU(e,t,n,r){let o=e.ctx,i=t.circular,{color:a,lineWidth:l}=t;!i&&!r||!a||!l||n<0||(o.save(),o.strokeStyle=a,o.lineWidth=l,o.setLineDash(t.borderDash),o.lineDashOffset=t.borderDashOffset,o.beginPath(),AE(e,n,i,r),o.closePath(),o.stroke(),o.restore())}function rU(e,t,n){return ca(e,{label:n,index:t,type:"pointLabel"})}var Nu=class extends id{constructor(t){super(t),this.xCenter=void 0,this.yCenter=void 0,this.drawingArea=void 0,this._pointLabels=[],this._pointLabelItems=[]}setDimensions(){let t=this._padding=Pr(C_(this.options)/2),n=this.width=this.maxWidth-t.width,r=this.height=this.maxHeight-t.height;this.xCenter=Math.floor(this.left+n/2+t.left),this.yCenter=Math.floor(this.top+r/2+t.top),this.drawingArea=Math.floor(Math.min(n,r)/2)}determineDataLimits(){let{min:t,max:n}=this.getMinMax(!1);this.min=Fn(t)&&!isNaN(t)?t:0,this.max=Fn(n)&&!isNaN(n)?n:0,this.handleTickRangeOptions()}computeTickLimit(){return Math.ceil(this.drawingArea/C_(this.options))}generateTickLabels(t){id.prototype.generateTickLabels.call(this,t),this._pointLabels=this.getLabels().map((n,r)=>{let o=fn(this.options.pointLabels.callback,[n,r],this);return o||o===0?o:""}).filter((n,r)=>this.chart.getDataVisibility(r))}fit(){let t=this.options;t.display&&t.pointLabels.display?GH(this):this.setCenterPoint(0,0,0,0)}setCenterPoint(t,n,r,o){this.xCenter+=Math.floor((t-n)/2),this.yCenter+=Math.floor((r-o)/2),this.drawingArea-=Math.min(this.drawingArea/2,Math.max(t,n,r,o))}getIndexAngle(t){let n=tn/(this._pointLabels.length||1),r=this.options.startAngle||0;return yo(t*n+gi(r))}getDistanceFromCenterForValue(t){if(Ht(t))return NaN;let n=this.drawingArea/(this.max-this.min);return this.options.reverse?(this.max-t)*n:(t-this.min)*n}getValueForDistanceFromCenter(t){if(Ht(t))return NaN;let n=t/(this.drawingArea/(this.max-this.min));return this.options.reverse?this.max-n:this.min+n}getPointLabelContext(t){let n=this._pointLabels||[];if(t>=0&&t<n.length){let r=n[t];return rU(this.getContext(),t,r)}}getPointPosition(t,n,r=0){let o=this.getIndexAngle(t)-Dn+r;return{x:Math.cos(o)*n+this.xCenter,y:Math.sin(o)*n+this.yCenter,angle:o}}getPointPositionForValue(t,n){return this.getPointPosition(t,this.getDistanceFromCenterForValue(n))}getBasePosition(t){return this.getPointPositionForValue(t||0,this.getBaseValue())}getPointLabelPosition(t){let{left:n,top:r,right:o,bottom:i}=this._pointLabelItems[t];return{left:n,top:r,right:o,bottom:i}}drawBackground(){let{backgroundColor:t,grid:{circular:n}}=this.options;if(t){let r=this.ctx;r.save(),r.beginPath(),AE(this,this.getDistanceFromCenterForValue(this._endValue),n,this._pointLabels.length),r.closePath(),r.fillStyle=t,r.fill(),r.restore()}}drawGrid(){let t=this.ctx,n=this.options,{angleLines:r,grid:o}=n,i=this._pointLabels.length,a,l,c;if(n.pointLabels.display&&tU(this,i),o.display&&this.ticks.forEach((d,g)=>{if(g!==0){l=this.getDistanceFromCenterForValue(d.value);let v=o.setContext(this.getContext(g-1));nU(this,v,l,i)}}),r.display){for(t.save(),a=i-1;a>=0;a--){let d=r.setContext(this.getPointLabelContext(a)),{color:g,lineWidth:v}=d;!v||!g||(t.lineWidth=v,t.strokeStyle=g,t.setLineDash(d.borderDash),t.lineDashOffset=d.borderDashOffset,l=this.getDistanceFromCenterForValue(n.ticks.reverse?this.min:this.max),c=this.getPointPosition(a,l),t.beginPath(),t.moveTo(this.xCenter,this.yCenter),t.lineTo(c.x,c.y),t.stroke())}t.restore()}}drawBorder(){}drawLabels(){let t=this.ctx,n=this.options,r=n.ticks;if(!r.display)return;let o=this.getIndexAngle(0),i,a;t.save(),t.translate(this.xCenter,this.yCenter),t.rotate(o),t.textAlign="center",t.textBaseline="middle",this.ticks.forEach((l,c)=>{if(c===0&&!n.reverse)return;let d=r.setContext(this.getContext(c)),g=Qn(d.font);if(i=this.getDistanceFromCenterForValue(this.ticks[c].value),d.showLabelBackdrop){t.font=g.string,a=t.measureText(l.label).width,t.fillStyle=d.backdropColor;let v=Pr(d.backdropPadding);t.fillRect(-a/2-v.left,-i-g.size/2-v.top,a+v.width,g.size+v.height)}hl(t,l.label,0,-i,g,{color:d.color})}),t.restore()}drawTitle(){}};Nu.id="radialLinear";Nu.defaults={display:!0,animate:!0,position:"chartArea",angleLines:{display:!0,lineWidth:1,borderDash:[],borderDashOffset:0},grid:{circular:!1},startAngle:0,ticks:{showLabelBackdrop:!0,callback:Hg.formatters.numeric},pointLabels:{backdropColor:void 0,backdropPadding:2,display:!0,font:{size:10},callback(e){return e},padding:5,centerPointLabels:!1}};Nu.defaultRoutes={"angleLines.color":"borderColor","pointLabels.color":"color","ticks.color":"color"};Nu.descriptors={angleLines:{_fallback:"grid"}};var Ug={millisecond:{common:!0,size:1,steps:1e3},second:{common:!0,size:1e3,steps:60},minute:{common:!0,size:6e4,steps:60},hour:{common:!0,size:36e5,steps:24},day:{common:!0,size:864e5,steps:30},week:{common:!1,size:6048e5,steps:4},month:{common:!0,size:2628e6,steps:12},quarter:{common:!1,size:7884e6,steps:4},year:{common:!0,size:3154e7}},vo=Object.keys(Ug);function oU(e,t){return e-t}function pE(e,t){if(Ht(t))return null;let n=e._adapter,{parser:r,round:o,isoWeekday:i}=e._parseOpts,a=t;return typeof r=="function"&&(a=r(a)),Fn(a)||(a=typeof r=="string"?n.parse(a,r):n.parse(a)),a===null?null:(o&&(a=o==="week"&&(Iu(i)||i===!0)?n.startOf(a,"isoWeek",i):n.startOf(a,o)),+a)}function hE(e,t,n,r){let o=vo.length;for(let i=vo.indexOf(e);i<o-1;++i){let a=Ug[vo[i]],l=a.steps?a.steps:Number.MAX_SAFE_INTEGER;if(a.common&&Math.ceil((n-t)/(l*a.size))<=r)return vo[i]}return vo[o-1]}function iU(e,t,n,r,o){for(let i=vo.length-1;i>=vo.indexOf(n);i--){let a=vo[i];if(Ug[a].common&&e._adapter.diff(o,r,a)>=t-1)return a}return vo[n?vo.indexOf(n):0]}function sU(e){for(let t=vo.indexOf(e)+1,n=vo.length;t<n;++t)if(Ug[vo[t]].common)return vo[t]}function mE(e,t,n){if(!n)e[t]=!0;else if(n.length){let{lo:r,hi:o}=wg(n,t),i=n[r]>=t?n[r]:n[o];e[i]=!0}}function aU(e,t,n,r){let o=e._adapter,i=+o.startOf(t[0].value,r),a=t[t.length-1].value,l,c;for(l=i;l<=a;l=+o.add(l,1,r))c=n[l],c>=0&&(t[c].major=!0);return t}function gE(e,t,n){let r=[],o={},i=t.length,a,l;for(a=0;a<i;++a)l=t[a],o[l]=a,r.push({value:l,major:!1});return i===0||!n?r:aU(e,r,o,n)}var Au=class extends Lu{constructor(t){super(t),this._cache={data:[],labels:[],all:[]},this._unit="day",this._majorUnit=void 0,this._offsets={},this._normalized=!1,this._parseOpts=void 0}init(t,n){let r=t.time||(t.time={}),o=this._adapter=new Xj._date(t.adapters.date);o.init(n),Vf(r.displayFormats,o.formats()),this._parseOpts={parser:r.parser,round:r.round,isoWeekday:r.isoWeekday},super.init(t),this._normalized=n.normalized}parse(t,n){return t===void 0?null:pE(this,t)}beforeLayout(){super.beforeLayout(),this._cache={data:[],labels:[],all:[]}}determineDataLimits(){let t=this.options,n=this._adapter,r=t.time.unit||"day",{min:o,max:i,minDefined:a,maxDefined:l}=this.getUserBounds();function c(d){!a&&!isNaN(d.min)&&(o=Math.min(o,d.min)),!l&&!isNaN(d.max)&&(i=Math.max(i,d.max))}(!a||!l)&&(c(this._getLabelBounds()),(t.bounds!=="ticks"||t.ticks.source!=="labels")&&c(this.getMinMax(!1))),o=Fn(o)&&!isNaN(o)?o:+n.startOf(Date.now(),r),i=Fn(i)&&!isNaN(i)?i:+n.endOf(Date.now(),r)+1,this.min=Math.min(o,i-1),this.max=Math.max(o+1,i)}_getLabelBounds(){let t=this.getLabelTimestamps(),n=Number.POSITIVE_INFINITY,r=Number.NEGATIVE_INFINITY;return t.length&&(n=t[0],r=t[t.length-1]),{min:n,max:r}}buildTicks(){let t=this.options,n=t.time,r=t.ticks,o=r.source==="labels"?this.getLabelTimestamps():this._generate();t.bounds==="ticks"&&o.length&&(this.min=this._userMin||o[0],this.max=this._userMax||o[o.length-1]);let i=this.min,a=this.max,l=tP(o,i,a);return this._unit=n.unit||(r.autoSkip?hE(n.minUnit,this.min,this.max,this._getLabelCapacity(i)):iU(this,l.length,n.minUnit,this.min,this.max)),this._majorUnit=!r.major.enabled||this._unit==="year"?void 0:sU(this._unit),this.initOffsets(o),t.reverse&&l.reverse(),gE(this,l,this._majorUnit)}afterAutoSkip(){this.options.offsetAfterAutoskip&&this.initOffsets(this.ticks.map(t=>+t.value))}initOffsets(t){let n=0,r=0,o,i;this.options.offset&&t.length&&(o=this.getDecimalForValue(t[0]),t.length===1?n=1-o:n=(this.getDecimalForValue(t[1])-o)/2,i=this.getDecimalForValue(t[t.length-1]),t.length===1?r=i:r=(i-this.getDecimalForValue(t[t.length-2]))/2);let a=t.length<3?.5:.25;n=sr(n,0,a),r=sr(r,0,a),this._offsets={start:n,end:r,factor:1/(n+1+r)}}_generate(){let t=this._adapter,n=this.min,r=this.max,o=this.options,i=o.time,a=i.unit||hE(i.minUnit,n,r,this._getLabelCapacity(n)),l=Mt(i.stepSize,1),c=a==="week"?i.isoWeekday:!1,d=Iu(c)||c===!0,g={},v=n,w,S;if(d&&(v=+t.startOf(v,"isoWeek",c)),v=+t.startOf(v,d?"day":a),t.diff(r,n,a)>1e5*l)throw new Error(n+" and "+r+" are too far apart with stepSize of "+l+" "+a);let C=o.ticks.source==="data"&&this.getDataTimestamps();for(w=v,S=0;w<r;w=+t.add(w,l,a),S++)mE(g,w,C);return(w===r||o.bounds==="ticks"||S===1)&&mE(g,w,C),Object.keys(g).sort((L,k)=>L-k).map(L=>+L)}getLabelForValue(t){let n=this._adapter,r=this.options.time;return r.tooltipFormat?n.format(t,r.tooltipFormat):n.format(t,r.displayFormats.datetime)}_tickFormatFunction(t,n,r,o){let i=this.options,a=i.time.displayFormats,l=this._unit,c=this._majorUnit,d=l&&a[l],g=c&&a[c],v=r[n],w=c&&g&&v&&v.major,S=this._adapter.format(t,o||(w?g:d)),C=i.ticks.callback;return C?fn(C,[S,n,r],this):S}generateTickLabels(t){let n,r,o;for(n=0,r=t.length;n<r;++n)o=t[n],o.label=this._tickFormatFunction(o.value,n,t)}getDecimalForValue(t){return t===null?NaN:(t-this.min)/(this.max-this.min)}getPixelForValue(t){let n=this._offsets,r=this.getDecimalForValue(t);return this.getPixelForDecimal((n.start+r)*n.factor)}getValueForPixel(t){let n=this._offsets,r=this.getDecimalForPixel(t)/n.factor-n.end;return this.min+r*(this.max-this.min)}_getLabelSize(t){let n=this.options.ticks,r=this.ctx.measureText(t).width,o=gi(this.isHorizontal()?n.maxRotation:n.minRotation),i=Math.cos(o),a=Math.sin(o),l=this._resolveTickFontOptions(0).size;return{w:r*i+l*a,h:r*a+l*i}}_getLabelCapacity(t){let n=this.options.time,r=n.displayFormats,o=r[n.unit]||r.millisecond,i=this._tickFormatFunction(t,0,gE(this,[t],this._majorUnit),o),a=this._getLabelSize(i),l=Math.floor(this.isHorizontal()?this.width/a.w:this.height/a.h)-1;return l>0?l:1}getDataTimestamps(){let t=this._cache.data||[],n,r;if(t.length)return t;let o=this.getMatchingVisibleMetas();if(this._normalized&&o.length)return this._cache.data=o[0].controller.getAllParsedValues(this);for(n=0,r=o.length;n<r;++n)t=t.concat(o[n].controller.getAllParsedValues(this));return this._cache.data=this.normalize(t)}getLabelTimestamps(){let t=this._cache.labels||[],n,r;if(t.length)return t;let o=this.getLabels();for(n=0,r=o.length;n<r;++n)t.push(pE(this,o[n]));return this._cache.labels=this._normalized?t:this.normalize(t)}normalize(t){return F6(t.sort(oU))}};Au.id="time";Au.defaults={bounds:"data",adapters:{},time:{parser:!1,unit:!1,round:!1,isoWeekday:!1,minUnit:"millisecond",displayFormats:{}},ticks:{source:"auto",major:{enabled:!1}}};function Ng(e,t,n){let r=0,o=e.length-1,i,a,l,c;n?(t>=e[r].pos&&t<=e[o].pos&&({lo:r,hi:o}=xs(e,"pos",t)),{pos:i,time:l}=e[r],{pos:a,time:c}=e[o]):(t>=e[r].time&&t<=e[o].time&&({lo:r,hi:o}=xs(e,"time",t)),{time:i,pos:l}=e[r],{time:a,pos:c}=e[o]);let d=a-i;return d?l+(c-l)*(t-i)/d:l}var i1=class extends Au{constructor(t){super(t),this._table=[],this._minPos=void 0,this._tableRange=void 0}initOffsets(){let t=this._getTimestampsForTable(),n=this._table=this.buildLookupTable(t);this._minPos=Ng(n,this.min),this._tableRange=Ng(n,this.max)-this._minPos,super.initOffsets(t)}buildLookupTable(t){let{min:n,max:r}=this,o=[],i=[],a,l,c,d,g;for(a=0,l=t.length;a<l;++a)d=t[a],d>=n&&d<=r&&o.push(d);if(o.length<2)return[{time:n,pos:0},{time:r,pos:1}];for(a=0,l=o.length;a<l;++a)g=o[a+1],c=o[a-1],d=o[a],Math.round((g+c)/2)!==d&&i.push({time:d,pos:a/(l-1)});return i}_getTimestampsForTable(){let t=this._cache.all||[];if(t.length)return t;let n=this.getDataTimestamps(),r=this.getLabelTimestamps();return n.length&&r.length?t=this.normalize(n.concat(r)):t=n.length?n:r,t=this._cache.all=t,t}getDecimalForValue(t){return(Ng(this._table,t)-this._minPos)/this._tableRange}getValueForPixel(t){let n=this._offsets,r=this.getDecimalForPixel(t)/n.factor-n.end;return Ng(this._table,r*this._tableRange+this._minPos,!0)}};i1.id="timeseries";i1.defaults=Au.defaults;var lU=Object.freeze({__proto__:null,CategoryScale:od,LinearScale:r1,LogarithmicScale:o1,RadialLinearScale:Nu,TimeScale:Au,TimeSeriesScale:i1}),FE=[Qj,rH,HH,lU];Ou.register(...FE);var RE=Ou;var uU=Intl.DateTimeFormat(navigator.language,{hour:"numeric"}),BE=function(){return uU.resolvedOptions().hour12},cU={long(e,t){let n=this.short(e,t);return t.isBucketPartial?`Partial of ${n}`:n},short(e,t){return nl(On(e))}},fU={long(e,t){let n=this.short(e,t);return t.isBucketPartial?`Partial week of ${n}`:`Week of ${n}`},short(e,t){return hu(On(e),t.shouldShowYear)}},dU={long(e,t){return On(e).format("ddd, D MMM")},short(e,t){return hu(On(e),t.shouldShowYear)}},pU={long(e,t){return this.short(e,t)},short(e,t){return BE()?On(e).format("ha"):On(e).format("HH:mm")}},hU={long(e,t){if(t.period=="realtime"){let n=Math.abs(e);return n===1?"1 minute ago":n+" minutes ago"}else return this.short(e,t)},short(e,t){return t.period==="realtime"?e+"m":BE()?On(e).format("h:mma"):On(e).format("HH:mm")}},mU={month:cU,week:fU,date:dU,hour:pU,minute:hU};function Cs({interval:e,longForm:t,period:n,isPeriodFull:r,shouldShowYear:o=!1}){let i=t?"long":"short",a={period:n,interval:e,isBucketPartial:!r,shouldShowYear:o};return function(l,c,d){return mU[e][i](l,a)}}var zE=function(e,t,n,r=!1){var a;let o=(a=t.full_intervals)==null?void 0:a[n];r&&(o=!0);let i=Cs({interval:t.interval,longForm:!0,period:e.period,isPeriodFull:o})(n);return e.period==="realtime"?Cs({interval:t.interval,longForm:!0,period:e.period})(n):t.interval==="hour"||t.interval=="minute"?`${Cs({interval:"date",longForm:!0,period:e.period})(n)}, ${i}`:i},gU=function(e,t){return e==0&&t>0?100:e==0&&t==0?0:Math.round((t-e)/e*100)},yU=function(e,t,n,r){let o=r.dataPoints.find(C=>C.dataset.yAxisID=="y"),i=r.dataPoints.find(C=>C.dataset.yAxisID=="yComparison"),a=o&&zE(e,t,t.labels[o.dataIndex]),l=i&&zE(e,t,t.comparison_labels[i.dataIndex],!0),c=(o==null?void 0:o.raw)||0,d=(i==null?void 0:i.raw)||0,g=a&&l&&gU(d,c),v=ag[n],w=v(c),S=i&&v(d);return{label:a,formattedValue:w,comparisonLabel:l,formattedComparisonValue:S,comparisonDifference:g}};function E_(e,t,n){return r=>{let o=r.tooltip,i=document.getElementById("main-graph-canvas").getBoundingClientRect(),a=document.getElementById("chartjs-tooltip");if(a||(a=document.createElement("div"),a.id="chartjs-tooltip",a.style.display="none",a.style.opacity=0,document.body.appendChild(a)),a&&i&&window.innerWidth<768&&(a.style.top=i.y+i.height+window.scrollY+15+"px",a.style.left=i.x+"px",a.style.right=null,a.style.opacity=1),o.opacity===0){a.style.display="none";return}if(o.body){let l=yU(n,e,t,o);a.innerHTML=`
         <aside class="text-gray-100 flex flex-col">
@@ -35,7 +35,7 @@ export default function StatsExport() {
   function renderExportLink() {
     const interval = getCurrentInterval(site, query)
     const queryParams = api.serializeQuery(query, [{ interval }])
-    const endpoint = `/${encodeURIComponent(site.domain)}/export${queryParams}`
+    const endpoint = `/api/stats/${encodeURIComponent(site.domain)}/export${queryParams}`
 
     return (
       <a href={endpoint} download onClick={startExport}>
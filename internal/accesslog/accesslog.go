@@ -0,0 +1,177 @@
+// Package accesslog parses web server access logs.
+package accesslog
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"path"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+)
+
+type Format uint8
+
+const (
+	// Combined is the NCSA combined log format used by default by nginx and
+	// apache. Common format lines are also accepted.
+	Combined Format = iota
+	Common
+	JSON
+)
+
+func ParseFormat(s string) (Format, error) {
+	switch strings.ToLower(s) {
+	case "", "combined":
+		return Combined, nil
+	case "common":
+		return Common, nil
+	case "json":
+		return JSON, nil
+	default:
+		return 0, fmt.Errorf("unknown log format %q", s)
+	}
+}
+
+// Entry is a single request from an access log.
+type Entry struct {
+	Time      time.Time
+	IP        string
+	Method    string
+	Path      string
+	Host      string
+	Referrer  string
+	UserAgent string
+	Status    int
+}
+
+const timeLayout = "02/Jan/2006:15:04:05 -0700"
+
+var (
+	ErrInvalidLine = errors.New("invalid log line")
+
+	// %h %l %u [%t] "%r" %>s %b with optional "%{Referer}i" "%{User-agent}i"
+	lineRe = regexp.MustCompile(`^(\S+) \S+ \S+ \[([^\]]+)\] "((?:[^"\\]|\\.)*)" (\d{3}) \S+(?: "((?:[^"\\]|\\.)*)" "((?:[^"\\]|\\.)*)")?`)
+)
+
+func Parse(f Format, line []byte) (*Entry, error) {
+	if f == JSON {
+		return parseJSON(line)
+	}
+	return parseText(line)
+}
+
+func parseText(line []byte) (*Entry, error) {
+	m := lineRe.FindSubmatch(line)
+	if m == nil {
+		return nil, ErrInvalidLine
+	}
+	ts, err := time.Parse(timeLayout, string(m[2]))
+	if err != nil {
+		return nil, fmt.Errorf("invalid time %w", err)
+	}
+	e := &Entry{
+		Time:      ts,
+		IP:        string(m[1]),
+		Referrer:  value(string(m[5])),
+		UserAgent: value(string(m[6])),
+	}
+	e.Status, _ = strconv.Atoi(string(m[4]))
+	e.request(string(m[3]))
+	return e, nil
+}
+
+func parseJSON(line []byte) (*Entry, error) {
+	o := map[string]any{}
+	err := json.Unmarshal(line, &o)
+	if err != nil {
+		return nil, err
+	}
+	e := &Entry{
+		IP:        str(o, "remote_addr", "ip", "client_ip"),
+		Host:      str(o, "host", "server_name"),
+		Referrer:  value(str(o, "http_referer", "referer", "referrer")),
+		UserAgent: value(str(o, "http_user_agent", "user_agent")),
+	}
+	if r := str(o, "request"); r != "" {
+		e.request(r)
+	} else {
+		e.Method = str(o, "method", "request_method")
+		e.Path = str(o, "request_uri", "uri", "path")
+	}
+	switch s := first(o, "status").(type) {
+	case float64:
+		e.Status = int(s)
+	case string:
+		e.Status, _ = strconv.Atoi(s)
+	}
+	switch t := first(o, "time", "time_iso8601", "time_local", "timestamp", "@timestamp").(type) {
+	case float64:
+		sec, frac := int64(t), t-float64(int64(t))
+		e.Time = time.Unix(sec, int64(frac*1e9))
+	case string:
+		e.Time, err = time.Parse(time.RFC3339, t)
+		if err != nil {
+			e.Time, err = time.Parse(timeLayout, t)
+			if err != nil {
+				return nil, fmt.Errorf("invalid time %w", err)
+			}
+		}
+	default:
+		return nil, errors.New("missing time")
+	}
+	return e, nil
+}
+
+// request parses request line like GET /index.html HTTP/1.1
+func (e *Entry) request(r string) {
+	method, rest, _ := strings.Cut(r, " ")
+	uri, _, _ := strings.Cut(rest, " ")
+	e.Method = method
+	e.Path = uri
+}
+
+var pageExtensions = map[string]struct{}{
+	"": {}, ".html": {}, ".htm": {}, ".php": {}, ".asp": {}, ".aspx": {}, ".jsp": {},
+}
+
+// Pageview returns true if e is a successful GET request for a page. Assets,
+// errors and redirects are not page views.
+func (e *Entry) Pageview() bool {
+	if e.Method != "GET" {
+		return false
+	}
+	if (e.Status < 200 || e.Status >= 300) && e.Status != 304 {
+		return false
+	}
+	p, _, _ := strings.Cut(e.Path, "?")
+	if !strings.HasPrefix(p, "/") {
+		return false
+	}
+	_, ok := pageExtensions[strings.ToLower(path.Ext(p))]
+	return ok
+}
+
+func first(o map[string]any, keys ...string) any {
+	for _, k := range keys {
+		if v, ok := o[k]; ok {
+			return v
+		}
+	}
+	return nil
+}
+
+func str(o map[string]any, keys ...string) string {
+	s, _ := first(o, keys...).(string)
+	return s
+}
+
+// value normalizes empty log values which are written as -
+func value(s string) string {
+	if s == "-" {
+		return ""
+	}
+	return s
+}
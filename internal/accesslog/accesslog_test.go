@@ -0,0 +1,59 @@
+package accesslog
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestParse(t *testing.T) {
+	t.Run("combined", func(t *testing.T) {
+		e, err := Parse(Combined, []byte(`203.0.113.9 - - [10/Oct/2023:13:55:36 -0700] "GET /blog/?page=2 HTTP/1.1" 200 2326 "https://www.google.com/" "Mozilla/5.0 (X11; Linux x86_64)"`))
+		require.NoError(t, err)
+		require.True(t, time.Date(2023, 10, 10, 20, 55, 36, 0, time.UTC).Equal(e.Time))
+		require.Equal(t, "203.0.113.9", e.IP)
+		require.Equal(t, "GET", e.Method)
+		require.Equal(t, "/blog/?page=2", e.Path)
+		require.Equal(t, 200, e.Status)
+		require.Equal(t, "https://www.google.com/", e.Referrer)
+		require.Equal(t, "Mozilla/5.0 (X11; Linux x86_64)", e.UserAgent)
+		require.True(t, e.Pageview())
+	})
+	t.Run("common", func(t *testing.T) {
+		e, err := Parse(Common, []byte(`203.0.113.9 - frank [10/Oct/2023:13:55:36 +0000] "GET /app.js HTTP/1.1" 200 512`))
+		require.NoError(t, err)
+		require.Equal(t, "/app.js", e.Path)
+		require.Empty(t, e.UserAgent)
+		require.False(t, e.Pageview())
+	})
+	t.Run("json", func(t *testing.T) {
+		e, err := Parse(JSON, []byte(`{"time_iso8601":"2023-10-10T20:55:36+00:00","remote_addr":"203.0.113.9","request":"GET /about HTTP/2.0","status":"200","http_referer":"-","http_user_agent":"curl/8.0","host":"vinceanalytics.com"}`))
+		require.NoError(t, err)
+		require.Equal(t, "/about", e.Path)
+		require.Equal(t, "vinceanalytics.com", e.Host)
+		require.Empty(t, e.Referrer)
+		require.Equal(t, 200, e.Status)
+		require.True(t, e.Pageview())
+	})
+	t.Run("invalid", func(t *testing.T) {
+		_, err := Parse(Combined, []byte(`not a log line`))
+		require.ErrorIs(t, err, ErrInvalidLine)
+	})
+}
+
+func TestPageview(t *testing.T) {
+	for _, c := range []struct {
+		e    Entry
+		want bool
+	}{
+		{Entry{Method: "GET", Path: "/", Status: 200}, true},
+		{Entry{Method: "GET", Path: "/index.html", Status: 304}, true},
+		{Entry{Method: "POST", Path: "/", Status: 200}, false},
+		{Entry{Method: "GET", Path: "/", Status: 404}, false},
+		{Entry{Method: "GET", Path: "/", Status: 301}, false},
+		{Entry{Method: "GET", Path: "/logo.png", Status: 200}, false},
+	} {
+		require.Equal(t, c.want, c.e.Pageview(), "%+v", c.e)
+	}
+}
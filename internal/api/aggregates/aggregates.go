@@ -21,6 +21,10 @@ type Stats struct {
 	BounceRate     float64
 	VisitDuration  float64
 	Events         float64
+	TotalRevenue   float64
+	AverageRevenue float64
+
+	revenue, revenueCount float64
 }
 
 func Reduce(metrics []string) func(*Stats, map[string]any) {
@@ -51,6 +55,10 @@ func reduce(metric string) func(s *Stats, o map[string]any) {
 		return func(s *Stats, o map[string]any) { o[metric] = s.VisitDuration }
 	case "events":
 		return func(s *Stats, o map[string]any) { o[metric] = s.Events }
+	case "total_revenue":
+		return func(s *Stats, o map[string]any) { o[metric] = s.TotalRevenue }
+	case "average_revenue":
+		return func(s *Stats, o map[string]any) { o[metric] = s.AverageRevenue }
 	default:
 		return func(s *Stats, o map[string]any) {}
 	}
@@ -72,6 +80,10 @@ func StatToValue(metric string) func(s *Stats) float64 {
 		return func(s *Stats) float64 { return s.VisitDuration }
 	case "events":
 		return func(s *Stats) float64 { return s.Events }
+	case "total_revenue":
+		return func(s *Stats) float64 { return s.TotalRevenue }
+	case "average_revenue":
+		return func(s *Stats) float64 { return s.AverageRevenue }
 	default:
 		return func(s *Stats) float64 { return 0 }
 	}
@@ -96,6 +108,11 @@ func (s *Stats) Compute() {
 
 	//avoid negative bounce rates
 	s.BounceRate = max(s.BounceRate, 0)
+
+	s.TotalRevenue = s.revenue / models.RevenueScale
+	if s.revenueCount != 0 {
+		s.AverageRevenue = math.Round(s.TotalRevenue/s.revenueCount*100) / 100
+	}
 }
 
 func (d *Stats) Read(cu *cursor.Cursor, f models.Field, view, shard uint64, match *ro2.Bitmap) error {
@@ -123,6 +140,10 @@ func (d *Stats) Read(cu *cursor.Cursor, f models.Field, view, shard uint64, matc
 
 	case models.Field_event:
 		d.Events += float64(match.Count())
+	case models.Field_revenue:
+		count, sum := ro2.ReadSum(cu, match)
+		d.revenue += float64(sum)
+		d.revenueCount += float64(count)
 	}
 	return nil
 }
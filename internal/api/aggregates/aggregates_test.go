@@ -0,0 +1,35 @@
+package aggregates
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"github.com/vinceanalytics/vince/internal/models"
+	"github.com/vinceanalytics/vince/internal/timeseries/timeseriestest"
+	"github.com/vinceanalytics/vince/internal/web/query"
+)
+
+func TestRevenue(t *testing.T) {
+	now := time.Now().UTC()
+	var events []*models.Model
+	for i, amount := range []int64{1050, 2000} {
+		events = append(events, &models.Model{
+			Timestamp: now.UnixMilli(),
+			Id:        uint64(i + 1),
+			Domain:    []byte("vinceanalytics.com"),
+			Event:     []byte("Purchase"),
+			Revenue:   amount,
+		})
+	}
+	_, ts := timeseriestest.New(t, events...)
+
+	s := Aggregates(context.Background(), ts, "vinceanalytics.com",
+		now.Add(-time.Hour), now.Add(time.Hour), query.Hour, nil,
+		[]string{"events", "total_revenue", "average_revenue"})
+	s.Compute()
+	require.Equal(t, float64(2), s.Events)
+	require.Equal(t, 30.5, s.TotalRevenue)
+	require.Equal(t, 15.25, s.AverageRevenue)
+}
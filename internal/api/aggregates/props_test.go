@@ -0,0 +1,62 @@
+package aggregates
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"github.com/vinceanalytics/vince/internal/models"
+	"github.com/vinceanalytics/vince/internal/timeseries/timeseriestest"
+	"github.com/vinceanalytics/vince/internal/web/query"
+)
+
+func TestPropsFilter(t *testing.T) {
+	// Events of different domains in one batch must be written under their own
+	// domain and odd durations exercise the lowest bit of BSI values.
+	now := time.Now().UTC()
+	var events []*models.Model
+	for i, e := range []struct {
+		domain   string
+		author   string
+		plan     string
+		duration int64
+	}{
+		{"a.com", "john", "pro", 1051},
+		{"b.com", "john", "", 2001},
+		{"a.com", "jane", "pro", 7},
+		{"a.com", "john", "", 3},
+	} {
+		m := &models.Model{
+			Timestamp: now.UnixMilli(),
+			Id:        uint64(i + 1),
+			Domain:    []byte(e.domain),
+			Duration:  e.duration,
+			Props:     [][]byte{models.Prop([]byte("author"), []byte(e.author))},
+		}
+		if e.plan != "" {
+			// an event can have many properties
+			m.Props = append(m.Props, models.Prop([]byte("plan"), []byte(e.plan)))
+		}
+		events = append(events, m)
+	}
+	_, ts := timeseriestest.New(t, events...)
+
+	filters := query.Filters{
+		{Op: "is", Key: query.PropsPrefix + "author", Value: []string{"john"}},
+	}
+	for domain, total := range map[string]float64{"a.com": 1054, "b.com": 2001} {
+		s := Aggregates(context.Background(), ts, domain,
+			now.Add(-time.Hour), now.Add(time.Hour), query.Hour, filters,
+			[]string{"visit_duration"})
+		require.Equal(t, total, s.VisitDuration, domain)
+	}
+
+	s := Aggregates(context.Background(), ts, "a.com",
+		now.Add(-time.Hour), now.Add(time.Hour), query.Hour, query.Filters{
+			{Op: "is", Key: query.PropsPrefix + "author", Value: []string{"john"}},
+			{Op: "is", Key: query.PropsPrefix + "plan", Value: []string{"pro"}},
+		},
+		[]string{"visit_duration"})
+	require.Equal(t, float64(1051), s.VisitDuration)
+}
@@ -0,0 +1,35 @@
+package api
+
+import (
+	"fmt"
+	"net/http"
+
+	v1 "github.com/vinceanalytics/vince/gen/go/vince/v1"
+	"github.com/vinceanalytics/vince/internal/ops"
+	"github.com/vinceanalytics/vince/internal/web/db"
+)
+
+// Backup streams an archive of all data. Archives contain every site so keys
+// restricted to some sites are rejected.
+func Backup(db *db.Config, w http.ResponseWriter, r *http.Request) {
+	key := Key(r.Context())
+	if !ops.KeyAllSites(key) {
+		http.Error(w,
+			"The API key must have access to all sites to create backups.",
+			http.StatusForbidden,
+		)
+		return
+	}
+	m := db.Backup(w, r)
+	if m == nil {
+		return
+	}
+	err := db.Ops().Audit(&v1.AuditEntry{
+		Actor:   "api_key:" + key.Name,
+		Action:  ops.AuditBackup,
+		Details: fmt.Sprintf("shards=%d files=%d", len(m.Shards), len(m.Files)),
+	})
+	if err != nil {
+		db.Logger().Error("recording audit entry", "action", ops.AuditBackup, "err", err)
+	}
+}
@@ -52,6 +52,11 @@ func BreakdownGoals(ctx context.Context, ts *timeseries.Timeseries, site *v1.Sit
 		if err != nil {
 			return nil, err
 		}
+		for i := range events.Results {
+			m := events.Results[i]
+			m["name"] = m[models.Field_event.String()]
+			delete(m, models.Field_event.String())
+		}
 	}
 	pages := new(Result)
 	if len(pageGoals) > 0 {
@@ -105,6 +110,32 @@ func Breakdown(ctx context.Context, ts *timeseries.Timeseries, domain string, pa
 		})
 }
 
+// BreakdownProps breaks down by values of custom property key. Results use key
+// as the property name.
+func BreakdownProps(ctx context.Context, ts *timeseries.Timeseries, domain string, params *query.Query, metrics []string, key string) (*Result, error) {
+	ctx, task := trace.NewTask(ctx, "store.BreakdownProps")
+	defer task.End()
+	return breakdown(
+		ctx,
+		ts,
+		findProp(ts, key),
+		domain, params, metrics, models.Field_props, func(_ string, values map[string]*Stats) *Result {
+			a := &Result{
+				Results: make([]map[string]any, 0, len(values)),
+			}
+			reduce := aggregates.Reduce(metrics)
+			for k, v := range values {
+				v.Compute()
+				x := map[string]any{
+					key: k,
+				}
+				reduce(v, x)
+				a.Results = append(a.Results, x)
+			}
+			return a
+		})
+}
+
 func BreakdownExitPages(ctx context.Context, ts *timeseries.Timeseries, domain string, params *query.Query) (*Result, error) {
 	ctx, task := trace.NewTask(ctx, "store.BreakdownExitPages")
 	defer task.End()
@@ -199,17 +230,31 @@ func BreakdownVisitorsWithPercentage(ctx context.Context, ts *timeseries.Timeser
 
 }
 
-func findString(field models.Field) func(context.Context, *timeseries.Timeseries, uint64) string {
-	return func(ctx context.Context, tx *timeseries.Timeseries, u uint64) string {
-		return tx.Find(ctx, field, u)
+func findString(field models.Field) func(context.Context, *timeseries.Timeseries, uint64) (string, bool) {
+	return func(ctx context.Context, tx *timeseries.Timeseries, u uint64) (string, bool) {
+		return tx.Find(ctx, field, u), true
+	}
+}
+
+// findProp only accepts rows belonging to custom property key. All props are
+// stored under the same field, rows for other keys are skipped.
+func findProp(ts *timeseries.Timeseries, key string) func(context.Context, *timeseries.Timeseries, uint64) (string, bool) {
+	values := make(map[uint64]string)
+	prefix := models.PropKey([]byte(key))
+	ts.Search(models.Field_props, prefix, func(b []byte, id uint64) {
+		values[id] = string(b[len(prefix):])
+	})
+	return func(_ context.Context, _ *timeseries.Timeseries, id uint64) (string, bool) {
+		value, ok := values[id]
+		return value, ok
 	}
 }
 
-func findCity(_ context.Context, _ *timeseries.Timeseries, id uint64) uint32 {
-	return uint32(id)
+func findCity(_ context.Context, _ *timeseries.Timeseries, id uint64) (uint32, bool) {
+	return uint32(id), true
 }
 
-func breakdown[T cmp.Ordered](ctx context.Context, ts *timeseries.Timeseries, tr func(ctx context.Context, tx *timeseries.Timeseries, id uint64) T, domain string, params *query.Query, metrics []string, field models.Field,
+func breakdown[T cmp.Ordered](ctx context.Context, ts *timeseries.Timeseries, tr func(ctx context.Context, tx *timeseries.Timeseries, id uint64) (T, bool), domain string, params *query.Query, metrics []string, field models.Field,
 	fn func(property string, values map[T]*Stats) *Result) (*Result, error) {
 	fields := models.DataForMetrics(metrics...)
 	valuesToScan := fields
@@ -223,7 +268,10 @@ func breakdown[T cmp.Ordered](ctx context.Context, ts *timeseries.Timeseries, tr
 		if dataField == field {
 			f := breadMatch.Add(view, shard)
 			ro2.ReadMutex(ra, shard, columns, func(row uint64, rm *ro2.Bitmap) {
-				value := tr(ctx, ts, row)
+				value, ok := tr(ctx, ts, row)
+				if !ok {
+					return
+				}
 				f(value, rm)
 			})
 			return nil
@@ -0,0 +1,121 @@
+package cohort
+
+import (
+	"context"
+	"math"
+	"runtime/trace"
+	"slices"
+	"time"
+
+	"github.com/vinceanalytics/vince/internal/compute"
+	"github.com/vinceanalytics/vince/internal/encoding"
+	"github.com/vinceanalytics/vince/internal/models"
+	"github.com/vinceanalytics/vince/internal/ro2"
+	"github.com/vinceanalytics/vince/internal/timeseries"
+	"github.com/vinceanalytics/vince/internal/timeseries/cursor"
+	"github.com/vinceanalytics/vince/internal/util/xtime"
+	"github.com/vinceanalytics/vince/internal/web/query"
+)
+
+type Result struct {
+	Interval string   `json:"interval"`
+	Cohorts  []Cohort `json:"cohorts"`
+}
+
+// Cohort groups visitors whose first visit in the queried period happened on
+// Date.
+type Cohort struct {
+	Date     string `json:"date"`
+	Visitors uint64 `json:"visitors"`
+	// Retention has an entry for Date and every following period. The first
+	// entry is always the whole cohort.
+	Retention []Period `json:"retention"`
+}
+
+type Period struct {
+	Visitors   uint64  `json:"visitors"`
+	Percentage float64 `json:"percentage"`
+}
+
+// Cohorts computes visitor retention grouped by week or month of first visit.
+// Other intervals are reported by week. Only whole periods within the query
+// range are included.
+func Cohorts(ctx context.Context, ts *timeseries.Timeseries, domain string, params *query.Query) (*Result, error) {
+	ctx, task := trace.NewTask(ctx, "store.Cohorts")
+	defer task.End()
+
+	interval := params.Interval()
+	if interval != query.Month {
+		interval = query.Week
+	}
+	res := encoding.Resolution(interval)
+	// views are yielded for (start, end], shift start so that a period starting
+	// exactly at start is included.
+	start := params.Start().Add(-time.Millisecond)
+	end := params.End()
+
+	var views []uint64
+	for v := range compute.Range(res, start, end) {
+		if int64(v) <= end.UnixMilli() {
+			views = append(views, v)
+		}
+	}
+	slices.Sort(views)
+
+	values := models.BitSet(0)
+	values.Set(models.Field_id)
+	visitors := make(map[uint64]*ro2.Bitmap, len(views))
+	err := ts.Select(ctx, values, domain, start, end, interval, params.Filter(),
+		func(cu *cursor.Cursor, field models.Field, view, shard uint64, columns *ro2.Bitmap) error {
+			b, ok := visitors[view]
+			if !ok {
+				b = ro2.NewBitmap()
+				visitors[view] = b
+			}
+			b.UnionInPlace(ro2.ReadDistinctBSI(cu, shard, columns))
+			return nil
+		})
+	if err != nil {
+		return nil, err
+	}
+
+	r := &Result{
+		Interval: interval.String(),
+		Cohorts:  make([]Cohort, 0, len(views)),
+	}
+	loc := params.Location()
+	seen := ro2.NewBitmap()
+	for i, view := range views {
+		period := visitors[view]
+		if period == nil {
+			period = ro2.NewBitmap()
+		}
+		first := period.Difference(seen)
+		seen.UnionInPlace(period)
+
+		c := Cohort{
+			Date:      xtime.UnixMilli(int64(view)).In(loc).Format(time.DateOnly),
+			Visitors:  first.Count(),
+			Retention: make([]Period, 0, len(views)-i),
+		}
+		for _, next := range views[i:] {
+			n := uint64(0)
+			if b := visitors[next]; b != nil {
+				n = first.IntersectionCount(b)
+			}
+			c.Retention = append(c.Retention, Period{
+				Visitors:   n,
+				Percentage: percent(n, c.Visitors),
+			})
+		}
+		r.Cohorts = append(r.Cohorts, c)
+	}
+	return r, nil
+}
+
+func percent(a, b uint64) float64 {
+	if b == 0 {
+		return 0
+	}
+	return math.Round(float64(a)/float64(b)*10000) / 100
+}
@@ -0,0 +1,59 @@
+package cohort
+
+import (
+	"context"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"github.com/vinceanalytics/vince/internal/models"
+	"github.com/vinceanalytics/vince/internal/timeseries/timeseriestest"
+	"github.com/vinceanalytics/vince/internal/web/query"
+)
+
+func TestCohorts(t *testing.T) {
+	// Sundays, weeks start on sunday.
+	first := time.Date(2024, time.January, 7, 12, 0, 0, 0, time.UTC)
+	second := first.AddDate(0, 0, 7)
+	events := []struct {
+		ts time.Time
+		id uint64
+	}{
+		{first, 1},
+		{first, 2},
+		{second, 1},
+		{second, 3},
+	}
+	var ms []*models.Model
+	for _, e := range events {
+		ms = append(ms, &models.Model{
+			Timestamp: e.ts.UnixMilli(),
+			Id:        e.id,
+			Domain:    []byte("vinceanalytics.com"),
+			View:      true,
+		})
+	}
+	_, ts := timeseriestest.New(t, ms...)
+
+	params := query.New(url.Values{
+		"period":   {"custom"},
+		"from":     {"2024-01-07"},
+		"to":       {"2024-01-20"},
+		"interval": {"week"},
+	}, nil)
+	r, err := Cohorts(context.Background(), ts, "vinceanalytics.com", params)
+	require.NoError(t, err)
+	require.Equal(t, "week", r.Interval)
+	require.Len(t, r.Cohorts, 2)
+
+	c := r.Cohorts[0]
+	require.Equal(t, "2024-01-07", c.Date)
+	require.Equal(t, uint64(2), c.Visitors)
+	require.Equal(t, []Period{{2, 100}, {1, 50}}, c.Retention)
+
+	c = r.Cohorts[1]
+	require.Equal(t, "2024-01-14", c.Date)
+	require.Equal(t, uint64(1), c.Visitors)
+	require.Equal(t, []Period{{1, 100}}, c.Retention)
+}
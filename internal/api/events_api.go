@@ -0,0 +1,90 @@
+package api
+
+import (
+	"bufio"
+	"bytes"
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/vinceanalytics/vince/internal/ops"
+	"github.com/vinceanalytics/vince/internal/web/db"
+)
+
+const (
+	maxBatchSize  = 32 << 20
+	maxLineSize   = 1 << 20
+	maxBatchLines = 10_000
+)
+
+// Line status values reported by Events.
+const (
+	LineAccepted = "accepted"
+	LineDropped  = "dropped"
+	LineInvalid  = "invalid"
+)
+
+type LineStatus struct {
+	Line   int    `json:"line"`
+	Status string `json:"status"`
+	Error  string `json:"error,omitempty"`
+}
+
+type EventsResult struct {
+	Accepted int          `json:"accepted"`
+	Dropped  int          `json:"dropped"`
+	Invalid  int          `json:"invalid"`
+	Lines    []LineStatus `json:"lines"`
+	Error    string       `json:"error,omitempty"`
+}
+
+// Events ingests a batch of newline delimited JSON events. Each line is
+// validated independently, a bad line does not affect the rest of the batch.
+// Batches are limited to maxBatchLines lines.
+func Events(dba *db.Config, w http.ResponseWriter, r *http.Request) {
+	r.Body = http.MaxBytesReader(w, r.Body, maxBatchSize)
+	sc := bufio.NewScanner(r.Body)
+	sc.Buffer(make([]byte, 0, 64<<10), maxLineSize)
+
+	key := Key(r.Context())
+	allow := func(domain string) bool {
+		return ops.KeyHasSite(key, domain)
+	}
+	result := EventsResult{Lines: []LineStatus{}}
+	var n int
+	for sc.Scan() {
+		n++
+		if n > maxBatchLines {
+			// Lines before the limit were already accepted, we still report them.
+			result.Error = fmt.Sprintf("batch has more than %d lines", maxBatchLines)
+			dba.JSONCode(http.StatusRequestEntityTooLarge, w, result)
+			return
+		}
+		line := bytes.TrimSpace(sc.Bytes())
+		if len(line) == 0 {
+			continue
+		}
+		status := LineStatus{Line: n, Status: LineAccepted}
+		err := dba.ProcessLine(r.Context(), line, allow)
+		switch {
+		case err == nil:
+			result.Accepted++
+		case errors.Is(err, db.ErrDrop):
+			status.Status = LineDropped
+			result.Dropped++
+		default:
+			status.Status = LineInvalid
+			status.Error = err.Error()
+			result.Invalid++
+		}
+		result.Lines = append(result.Lines, status)
+	}
+	if err := sc.Err(); err != nil {
+		// Lines before the failure were already accepted, we still report them.
+		dba.Logger().Error("reading events batch", "line", n+1, "err", err)
+		result.Error = err.Error()
+		dba.JSONCode(http.StatusBadRequest, w, result)
+		return
+	}
+	dba.JSONCode(http.StatusAccepted, w, result)
+}
@@ -0,0 +1,32 @@
+package api
+
+import (
+	"net/http"
+
+	"github.com/vinceanalytics/vince/internal/compute"
+	"github.com/vinceanalytics/vince/internal/export"
+	"github.com/vinceanalytics/vince/internal/web/db"
+	"github.com/vinceanalytics/vince/internal/web/query"
+)
+
+// ExportEvents streams raw events of site_id as csv or parquet. The period is
+// selected like other stats endpoints and always covers whole days.
+func ExportEvents(db *db.Config, w http.ResponseWriter, r *http.Request) {
+	domain := r.URL.Query().Get("site_id")
+	format, err := export.ParseFormat(r.URL.Query().Get("format"))
+	if err != nil {
+		http.Error(w, "Invalid format. Please use csv or parquet.", http.StatusBadRequest)
+		return
+	}
+	params := query.New(r.URL.Query(), db.Timezone(domain))
+	start := compute.Date(params.Start())
+	end := compute.Date(params.End()).AddDate(0, 0, 1)
+
+	w.Header().Set("content-type", format.ContentType())
+	w.Header().Set("content-disposition", `attachment; filename="`+format.Filename(domain, start, end.AddDate(0, 0, -1))+`"`)
+	n, err := export.Events(r.Context(), db.TimeSeries(), domain, start, end, format, w)
+	if err != nil {
+		// Headers are already sent, the client sees a truncated export.
+		db.Logger().Error("exporting events", "domain", domain, "events", n, "err", err)
+	}
+}
@@ -0,0 +1,164 @@
+package funnel
+
+import (
+	"context"
+	"errors"
+	"math"
+	"runtime/trace"
+
+	v1 "github.com/vinceanalytics/vince/gen/go/vince/v1"
+	"github.com/vinceanalytics/vince/internal/models"
+	"github.com/vinceanalytics/vince/internal/ro2"
+	"github.com/vinceanalytics/vince/internal/timeseries"
+	"github.com/vinceanalytics/vince/internal/timeseries/cursor"
+	"github.com/vinceanalytics/vince/internal/web/query"
+)
+
+const (
+	MinSteps = 2
+	MaxSteps = 8
+)
+
+var ErrSteps = errors.New("funnels must have between 2 and 8 steps")
+
+type Result struct {
+	Name                            string  `json:"name"`
+	Steps                           []Step  `json:"steps"`
+	AllVisitors                     uint64  `json:"all_visitors"`
+	EnteringVisitors                uint64  `json:"entering_visitors"`
+	EnteringVisitorsPercentage      float64 `json:"entering_visitors_percentage"`
+	NeverEnteringVisitors           uint64  `json:"never_entering_visitors"`
+	NeverEnteringVisitorsPercentage float64 `json:"never_entering_visitors_percentage"`
+}
+
+type Step struct {
+	Label              string  `json:"label"`
+	Visitors           uint64  `json:"visitors"`
+	ConversionRate     float64 `json:"conversion_rate"`
+	ConversionRateStep float64 `json:"conversion_rate_step"`
+	Dropoff            uint64  `json:"dropoff"`
+	DropoffPercentage  float64 `json:"dropoff_percentage"`
+}
+
+// Validate checks that f can be computed.
+func Validate(f *v1.Funnel) error {
+	if len(f.Steps) < MinSteps || len(f.Steps) > MaxSteps {
+		return ErrSteps
+	}
+	for _, s := range f.Steps {
+		if s.Name == "" && s.Path == "" {
+			return errors.New("funnel step must have event name or page path")
+		}
+	}
+	return nil
+}
+
+// Label returns display name of a funnel step.
+func Label(g *v1.Goal) string {
+	if g.Path != "" {
+		return "Visit " + g.Path
+	}
+	return g.Name
+}
+
+// Funnel computes how many visitors completed each step of f in order. A
+// visitor reaches a step when they trigger it after reaching the previous one.
+//
+// Event timestamps are not stored, steps of the same visitor are ordered by the
+// view they fall in at params interval. Within a view we fall back to columns
+// which are assigned in ingestion order.
+func Funnel(ctx context.Context, ts *timeseries.Timeseries, domain string, params *query.Query, f *v1.Funnel) (*Result, error) {
+	ctx, task := trace.NewTask(ctx, "store.Funnel")
+	defer task.End()
+
+	values := models.BitSet(0)
+	values.Set(models.Field_id)
+
+	all := ro2.NewBitmap()
+	err := ts.Select(ctx, values, domain, params.Start(), params.End(), params.Interval(), params.Filter(),
+		func(cu *cursor.Cursor, field models.Field, view, shard uint64, columns *ro2.Bitmap) error {
+			all.UnionInPlace(ro2.ReadDistinctBSI(cu, shard, columns))
+			return nil
+		})
+	if err != nil {
+		return nil, err
+	}
+
+	counts := make([]uint64, len(f.Steps))
+	var prev map[int64]position
+	for i, step := range f.Steps {
+		reached := make(map[int64]position)
+		err := ts.Select(ctx, values, domain, params.Start(), params.End(), params.Interval(), params.With(filter(step)).Filter(),
+			func(cu *cursor.Cursor, field models.Field, view, shard uint64, columns *ro2.Bitmap) error {
+				ro2.ReadBSI(cu, shard, columns, func(column uint64, visitor int64) {
+					pos := position{view: view, column: column}
+					if prev != nil {
+						from, ok := prev[visitor]
+						if !ok || !from.before(pos) {
+							return
+						}
+					}
+					if at, ok := reached[visitor]; !ok || pos.before(at) {
+						reached[visitor] = pos
+					}
+				})
+				return nil
+			})
+		if err != nil {
+			return nil, err
+		}
+		counts[i] = uint64(len(reached))
+		if len(reached) == 0 {
+			break
+		}
+		prev = reached
+	}
+
+	r := &Result{
+		Name:             f.Name,
+		Steps:            make([]Step, len(f.Steps)),
+		AllVisitors:      all.Count(),
+		EnteringVisitors: counts[0],
+	}
+	r.NeverEnteringVisitors = r.AllVisitors - min(r.AllVisitors, r.EnteringVisitors)
+	r.EnteringVisitorsPercentage = percent(r.EnteringVisitors, r.AllVisitors)
+	r.NeverEnteringVisitorsPercentage = percent(r.NeverEnteringVisitors, r.AllVisitors)
+	for i, step := range f.Steps {
+		s := Step{
+			Label:              Label(step),
+			Visitors:           counts[i],
+			ConversionRate:     percent(counts[i], counts[0]),
+			ConversionRateStep: 100,
+		}
+		if i > 0 {
+			s.Dropoff = counts[i-1] - counts[i]
+			s.ConversionRateStep = percent(counts[i], counts[i-1])
+			s.DropoffPercentage = percent(s.Dropoff, counts[i-1])
+		}
+		r.Steps[i] = s
+	}
+	return r, nil
+}
+
+// position orders events of a visitor.
+type position struct {
+	view, column uint64
+}
+
+func (p position) before(o position) bool {
+	return p.view < o.view || (p.view == o.view && p.column < o.column)
+}
+
+func filter(g *v1.Goal) *query.Filter {
+	if g.Path != "" {
+		return &query.Filter{Op: "is", Key: models.Field_page.String(), Value: []string{g.Path}}
+	}
+	return &query.Filter{Op: "is", Key: models.Field_event.String(), Value: []string{g.Name}}
+}
+
+func percent(a, b uint64) float64 {
+	if b == 0 {
+		return 0
+	}
+	return math.Round(float64(a)/float64(b)*10000) / 100
+}
@@ -0,0 +1,107 @@
+package funnel
+
+import (
+	"context"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	v1 "github.com/vinceanalytics/vince/gen/go/vince/v1"
+	"github.com/vinceanalytics/vince/internal/models"
+	"github.com/vinceanalytics/vince/internal/timeseries/timeseriestest"
+	"github.com/vinceanalytics/vince/internal/web/query"
+)
+
+func TestFunnel(t *testing.T) {
+	now := time.Now().UTC()
+	events := []struct {
+		id    uint64
+		page  string
+		event string
+	}{
+		{1, "/", ""},
+		{2, "/", ""},
+		{3, "/pricing", ""},
+		{1, "/pricing", ""},
+		{3, "/", ""},
+		{2, "/", "Signup"},
+		{1, "/pricing", "Signup"},
+	}
+	var ms []*models.Model
+	for _, e := range events {
+		m := &models.Model{
+			Timestamp: now.UnixMilli(),
+			Id:        e.id,
+			Domain:    []byte("vinceanalytics.com"),
+			Page:      []byte(e.page),
+		}
+		if e.event != "" {
+			m.Event = []byte(e.event)
+		} else {
+			m.View = true
+		}
+		ms = append(ms, m)
+	}
+	_, ts := timeseriestest.New(t, ms...)
+
+	f := &v1.Funnel{
+		Name: "Signup",
+		Steps: []*v1.Goal{
+			{Path: "/"},
+			{Path: "/pricing"},
+			{Name: "Signup"},
+		},
+	}
+	require.NoError(t, Validate(f))
+	r, err := Funnel(context.Background(), ts, "vinceanalytics.com",
+		query.New(url.Values{"period": {"day"}}, nil), f)
+	require.NoError(t, err)
+	require.Equal(t, uint64(3), r.AllVisitors)
+	require.Equal(t, uint64(3), r.EnteringVisitors)
+
+	visitors := make([]uint64, len(r.Steps))
+	for i := range r.Steps {
+		visitors[i] = r.Steps[i].Visitors
+	}
+	require.Equal(t, []uint64{3, 1, 1}, visitors)
+	require.Equal(t, uint64(2), r.Steps[1].Dropoff)
+	require.Equal(t, 33.33, r.Steps[2].ConversionRate)
+}
+
+func TestFunnelOrder(t *testing.T) {
+	// Events are not always ingested in the order they happened, for example
+	// when importing. The visitor reached the steps in order but the second
+	// step was ingested first.
+	now := time.Now().UTC()
+	yesterday := now.Add(-24 * time.Hour)
+	events := []struct {
+		id   uint64
+		page string
+		at   time.Time
+	}{
+		{1, "/pricing", now},
+		{1, "/", yesterday},
+	}
+	var ms []*models.Model
+	for _, e := range events {
+		ms = append(ms, &models.Model{
+			Timestamp: e.at.UnixMilli(),
+			Id:        e.id,
+			Domain:    []byte("vinceanalytics.com"),
+			Page:      []byte(e.page),
+			View:      true,
+		})
+	}
+	_, ts := timeseriestest.New(t, ms...)
+
+	f := &v1.Funnel{
+		Name:  "Pricing",
+		Steps: []*v1.Goal{{Path: "/"}, {Path: "/pricing"}},
+	}
+	r, err := Funnel(context.Background(), ts, "vinceanalytics.com",
+		query.New(url.Values{"period": {"7d"}, "interval": {"date"}}, nil), f)
+	require.NoError(t, err)
+	require.Equal(t, uint64(1), r.Steps[0].Visitors)
+	require.Equal(t, uint64(1), r.Steps[1].Visitors)
+}
@@ -0,0 +1,247 @@
+package paths
+
+import (
+	"cmp"
+	"context"
+	"math"
+	"net/url"
+	"runtime/trace"
+	"slices"
+	"strconv"
+
+	"github.com/vinceanalytics/vince/internal/models"
+	"github.com/vinceanalytics/vince/internal/ro2"
+	"github.com/vinceanalytics/vince/internal/timeseries"
+	"github.com/vinceanalytics/vince/internal/timeseries/cursor"
+	"github.com/vinceanalytics/vince/internal/web/query"
+)
+
+const (
+	DefaultSteps = 3
+	MaxSteps     = 5
+	// number of entries kept for each step and for top paths.
+	limit = 10
+)
+
+// Target selects sessions to explore. When Entry is true only sessions that
+// landed on Page are considered.
+type Target struct {
+	Page  string
+	Entry bool
+}
+
+// Parse reads target page and number of steps from page or entry_page and
+// steps query parameters.
+func Parse(u url.Values) (t Target, steps int, ok bool) {
+	if p := u.Get("entry_page"); p != "" {
+		t = Target{Page: p, Entry: true}
+	} else {
+		t = Target{Page: u.Get("page")}
+	}
+	steps, err := strconv.Atoi(u.Get("steps"))
+	if err != nil {
+		steps = DefaultSteps
+	}
+	return t, steps, t.Page != ""
+}
+
+type Result struct {
+	Page     string `json:"page"`
+	Sessions uint64 `json:"sessions"`
+	// Next and Previous have one entry per step away from Page.
+	Next     [][]Entry `json:"next"`
+	Previous [][]Entry `json:"previous"`
+	Paths    []Path    `json:"paths"`
+	Links    []Link    `json:"links"`
+}
+
+type Entry struct {
+	Name       string  `json:"name"`
+	Sessions   uint64  `json:"sessions"`
+	Percentage float64 `json:"percentage"`
+}
+
+// Path is a sequence of pages starting at the target page.
+type Path struct {
+	Pages    []string `json:"pages"`
+	Sessions uint64   `json:"sessions"`
+}
+
+// Link is a transition between pages at consecutive steps after the target
+// page, suitable for rendering a Sankey diagram. Step 1 links the target page
+// to the first next page.
+type Link struct {
+	Step     int    `json:"step"`
+	Source   string `json:"source"`
+	Target   string `json:"target"`
+	Sessions uint64 `json:"sessions"`
+}
+
+type pageview struct {
+	sequence int64
+	page     uint64
+}
+
+type link struct {
+	step           int
+	source, target uint64
+}
+
+// path holds page ids starting at target page, unused steps are 0.
+type path [MaxSteps + 1]uint64
+
+// Explore returns pages visited before and after target within the same
+// session. Sessions are matched on their first visit of target page.
+func Explore(ctx context.Context, ts *timeseries.Timeseries, domain string, params *query.Query, target Target, steps int) (*Result, error) {
+	ctx, task := trace.NewTask(ctx, "store.Paths")
+	defer task.End()
+
+	steps = min(max(steps, 1), MaxSteps)
+	r := &Result{
+		Page:     target.Page,
+		Next:     make([][]Entry, 0, steps),
+		Previous: make([][]Entry, 0, steps),
+		Paths:    []Path{},
+		Links:    []Link{},
+	}
+	pageID := ts.Translate(models.Field_page, []byte(target.Page))
+	if pageID == 0 {
+		return r, nil
+	}
+
+	values := models.BitSet(0)
+	values.Set(models.Field_page)
+	values.Set(models.Field_session_id)
+	values.Set(models.Field_sequence)
+
+	// only pageviews have a sequence, it is the last field we read for each
+	// view and shard.
+	var (
+		pages    = map[uint64]uint64{}
+		session  = map[uint64]uint64{}
+		sessions = map[uint64][]pageview{}
+	)
+	err := ts.Select(ctx, values, domain, params.Start(), params.End(), params.Interval(), params.Filter(),
+		func(cu *cursor.Cursor, field models.Field, view, shard uint64, columns *ro2.Bitmap) error {
+			switch field {
+			case models.Field_page:
+				clear(pages)
+				clear(session)
+				ro2.ReadMutexColumns(cu, shard, columns, func(column, row uint64) {
+					pages[column] = row
+				})
+			case models.Field_session_id:
+				ro2.ReadBSI(cu, shard, columns, func(column uint64, value int64) {
+					session[column] = uint64(value)
+				})
+			case models.Field_sequence:
+				ro2.ReadBSI(cu, shard, columns, func(column uint64, value int64) {
+					sid, ok := session[column]
+					if !ok {
+						return
+					}
+					sessions[sid] = append(sessions[sid], pageview{sequence: value, page: pages[column]})
+				})
+			}
+			return nil
+		})
+	if err != nil {
+		return nil, err
+	}
+
+	next := make([]map[uint64]uint64, steps)
+	prev := make([]map[uint64]uint64, steps)
+	for i := range steps {
+		next[i] = map[uint64]uint64{}
+		prev[i] = map[uint64]uint64{}
+	}
+	links := map[link]uint64{}
+	paths := map[path]uint64{}
+	for _, views := range sessions {
+		slices.SortFunc(views, func(a, b pageview) int {
+			return cmp.Compare(a.sequence, b.sequence)
+		})
+		at := slices.IndexFunc(views, func(v pageview) bool { return v.page == pageID })
+		if at == -1 || (target.Entry && views[at].sequence != 1) {
+			continue
+		}
+		r.Sessions++
+		p := path{pageID}
+		for i := range steps {
+			if j := at + i + 1; j < len(views) {
+				next[i][views[j].page]++
+				p[i+1] = views[j].page
+				links[link{step: i + 1, source: views[j-1].page, target: views[j].page}]++
+			}
+			if j := at - i - 1; j >= 0 && !target.Entry {
+				prev[i][views[j].page]++
+			}
+		}
+		paths[p]++
+	}
+	if r.Sessions == 0 {
+		return r, nil
+	}
+
+	names := map[uint64]string{}
+	name := func(id uint64) string {
+		n, ok := names[id]
+		if !ok {
+			n = ts.Find(ctx, models.Field_page, id)
+			names[id] = n
+		}
+		return n
+	}
+	entries := func(m map[uint64]uint64) []Entry {
+		o := make([]Entry, 0, len(m))
+		for id, n := range m {
+			o = append(o, Entry{Name: name(id), Sessions: n, Percentage: percent(n, r.Sessions)})
+		}
+		slices.SortFunc(o, func(a, b Entry) int {
+			return cmp.Or(cmp.Compare(b.Sessions, a.Sessions), cmp.Compare(a.Name, b.Name))
+		})
+		return o[:min(len(o), limit)]
+	}
+	for i := range steps {
+		if len(next[i]) > 0 {
+			r.Next = append(r.Next, entries(next[i]))
+		}
+		if len(prev[i]) > 0 {
+			r.Previous = append(r.Previous, entries(prev[i]))
+		}
+	}
+	for k, n := range paths {
+		p := Path{Sessions: n}
+		for _, id := range k {
+			if id == 0 {
+				break
+			}
+			p.Pages = append(p.Pages, name(id))
+		}
+		r.Paths = append(r.Paths, p)
+	}
+	slices.SortFunc(r.Paths, func(a, b Path) int {
+		return cmp.Or(cmp.Compare(b.Sessions, a.Sessions), slices.Compare(a.Pages, b.Pages))
+	})
+	r.Paths = r.Paths[:min(len(r.Paths), limit)]
+	for k, n := range links {
+		r.Links = append(r.Links, Link{
+			Step:     k.step,
+			Source:   name(k.source),
+			Target:   name(k.target),
+			Sessions: n,
+		})
+	}
+	slices.SortFunc(r.Links, func(a, b Link) int {
+		return cmp.Or(cmp.Compare(a.Step, b.Step), cmp.Compare(b.Sessions, a.Sessions),
+			cmp.Compare(a.Source, b.Source), cmp.Compare(a.Target, b.Target))
+	})
+	return r, nil
+}
+
+func percent(a, b uint64) float64 {
+	if b == 0 {
+		return 0
+	}
+	return math.Round(float64(a)/float64(b)*10000) / 100
+}
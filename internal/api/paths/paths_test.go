@@ -0,0 +1,62 @@
+package paths
+
+import (
+	"context"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"github.com/vinceanalytics/vince/internal/models"
+	"github.com/vinceanalytics/vince/internal/timeseries/timeseriestest"
+	"github.com/vinceanalytics/vince/internal/web/query"
+)
+
+func TestExplore(t *testing.T) {
+	now := time.Now().UTC()
+	var events []*models.Model
+	for id, pages := range [][]string{
+		{"/", "/pricing", "/signup", "/done"},
+		{"/pricing", "/docs"},
+		{"/blog", "/pricing", "/signup"},
+	} {
+		var session *models.Cached
+		for i, page := range pages {
+			m := &models.Model{
+				Timestamp: now.Add(time.Duration(i) * time.Second).UnixMilli(),
+				Id:        uint64(id + 1),
+				Domain:    []byte("vinceanalytics.com"),
+				Page:      []byte(page),
+				View:      true,
+			}
+			if session == nil {
+				m.StartSession()
+				session = m.Cached()
+			} else {
+				m.Update(session)
+			}
+			events = append(events, m)
+		}
+	}
+	_, ts := timeseriestest.New(t, events...)
+
+	params := query.New(url.Values{"period": {"day"}}, nil)
+	r, err := Explore(context.Background(), ts, "vinceanalytics.com", params, Target{Page: "/pricing"}, 2)
+	require.NoError(t, err)
+	require.Equal(t, uint64(3), r.Sessions)
+	require.Equal(t, [][]Entry{
+		{{Name: "/signup", Sessions: 2, Percentage: 66.67}, {Name: "/docs", Sessions: 1, Percentage: 33.33}},
+		{{Name: "/done", Sessions: 1, Percentage: 33.33}},
+	}, r.Next)
+	require.Equal(t, [][]Entry{
+		{{Name: "/", Sessions: 1, Percentage: 33.33}, {Name: "/blog", Sessions: 1, Percentage: 33.33}},
+	}, r.Previous)
+	require.Len(t, r.Paths, 3)
+	require.Equal(t, Link{Step: 1, Source: "/pricing", Target: "/signup", Sessions: 2}, r.Links[0])
+
+	r, err = Explore(context.Background(), ts, "vinceanalytics.com", params, Target{Page: "/", Entry: true}, 1)
+	require.NoError(t, err)
+	require.Equal(t, uint64(1), r.Sessions)
+	require.Equal(t, [][]Entry{{{Name: "/pricing", Sessions: 1, Percentage: 100}}}, r.Next)
+	require.Empty(t, r.Previous)
+}
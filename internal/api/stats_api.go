@@ -1,15 +1,23 @@
 package api
 
 import (
+	"context"
+	"errors"
 	"net/http"
 	"slices"
 	"strings"
 
+	v1 "github.com/vinceanalytics/vince/gen/go/vince/v1"
 	"github.com/vinceanalytics/vince/internal/api/aggregates"
 	"github.com/vinceanalytics/vince/internal/api/breakdown"
+	"github.com/vinceanalytics/vince/internal/api/cohort"
+	"github.com/vinceanalytics/vince/internal/api/funnel"
+	"github.com/vinceanalytics/vince/internal/api/paths"
 	"github.com/vinceanalytics/vince/internal/api/timeseries"
 	"github.com/vinceanalytics/vince/internal/api/visitors"
 	"github.com/vinceanalytics/vince/internal/models"
+	"github.com/vinceanalytics/vince/internal/ops"
+	"github.com/vinceanalytics/vince/internal/ratelimit"
 	"github.com/vinceanalytics/vince/internal/web/db"
 	"github.com/vinceanalytics/vince/internal/web/db/plug"
 	"github.com/vinceanalytics/vince/internal/web/query"
@@ -26,7 +34,7 @@ func CurrentVisitors(db *db.Config, w http.ResponseWriter, r *http.Request) {
 
 func Agggregates(db *db.Config, w http.ResponseWriter, r *http.Request) {
 	domain := r.URL.Query().Get("site_id")
-	params := query.New(r.URL.Query())
+	params := query.New(r.URL.Query(), db.Timezone(domain))
 
 	stats := aggregates.Aggregates(
 		r.Context(), db.TimeSeries(),
@@ -40,7 +48,7 @@ func Agggregates(db *db.Config, w http.ResponseWriter, r *http.Request) {
 
 func Timeseries(db *db.Config, w http.ResponseWriter, r *http.Request) {
 	domain := r.URL.Query().Get("site_id")
-	params := query.New(r.URL.Query())
+	params := query.New(r.URL.Query(), db.Timezone(domain))
 
 	result, err := timeseries.Timeseries(
 		r.Context(), db.TimeSeries(),
@@ -73,7 +81,7 @@ func Timeseries(db *db.Config, w http.ResponseWriter, r *http.Request) {
 func Breakdown(db *db.Config, w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 	domain := r.URL.Query().Get("site_id")
-	params := query.New(r.URL.Query())
+	params := query.New(r.URL.Query(), db.Timezone(domain))
 	if params.Property() == models.Field_domain {
 		http.Error(w, http.StatusText(http.StatusBadRequest), http.StatusBadRequest)
 		return
@@ -82,9 +90,12 @@ func Breakdown(db *db.Config, w http.ResponseWriter, r *http.Request) {
 		rs  *breakdown.Result
 		err error
 	)
-	if params.Property() == models.Field_city {
+	switch params.Property() {
+	case models.Field_city:
 		rs, err = breakdown.BreakdownCity(ctx, db.TimeSeries(), domain, params, params.Metrics())
-	} else {
+	case models.Field_props:
+		rs, err = breakdown.BreakdownProps(ctx, db.TimeSeries(), domain, params, params.Metrics(), params.Prop())
+	default:
 		rs, err = breakdown.Breakdown(ctx, db.TimeSeries(), domain, params, params.Metrics(), params.Property())
 		if err == nil && params.Property() == models.Field_subdivision1_code {
 			for i := range rs.Results {
@@ -101,33 +112,135 @@ func Breakdown(db *db.Config, w http.ResponseWriter, r *http.Request) {
 	db.JSON(w, rs)
 }
 
-func Authorize(h plug.Handler) plug.Handler {
-	return func(db *db.Config, w http.ResponseWriter, r *http.Request) {
-		auth := r.Header.Get("authorization")
-		token := strings.TrimPrefix(auth, "Bearer ")
-		token = strings.TrimSpace(token)
-		if token == "" {
-			http.Error(w,
-				"Missing site ID. Please provide the required site_id parameter with your request.",
-				http.StatusUnauthorized,
-			)
-			return
+func Funnel(db *db.Config, w http.ResponseWriter, r *http.Request) {
+	domain := r.URL.Query().Get("site_id")
+	name := r.URL.Query().Get("funnel")
+	var f *v1.Funnel
+	if site := db.Ops().Site(domain); site != nil {
+		for _, x := range site.Funnels {
+			if x.Name == name {
+				f = x
+				break
+			}
 		}
+	}
+	if f == nil {
+		http.Error(w, "Funnel not found. Please provide a valid funnel parameter with your request.", http.StatusBadRequest)
+		return
+	}
+	params := query.New(r.URL.Query(), db.Timezone(domain))
+	rs, err := funnel.Funnel(r.Context(), db.TimeSeries(), domain, params, f)
+	if err != nil {
+		db.Logger().Error("computing funnel", "err", err)
+		http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+		return
+	}
+	db.JSON(w, rs)
+}
 
-		if !db.Ops().ValidAPIKkey(token) {
-			http.Error(w,
-				"Invalid API key or site ID. Please make sure you're using a valid API key with access to the site you've requested.",
-				http.StatusUnauthorized,
-			)
-			return
-		}
-		if r.URL.Query().Get("site_id") == "" {
-			http.Error(w,
-				"Missing site ID. Please provide the required site_id parameter with your request.",
-				http.StatusUnauthorized,
-			)
-			return
+func Retention(db *db.Config, w http.ResponseWriter, r *http.Request) {
+	domain := r.URL.Query().Get("site_id")
+	params := query.New(r.URL.Query(), db.Timezone(domain))
+	rs, err := cohort.Cohorts(r.Context(), db.TimeSeries(), domain, params)
+	if err != nil {
+		db.Logger().Error("computing cohorts", "err", err)
+		http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+		return
+	}
+	db.JSON(w, rs)
+}
+
+func Paths(db *db.Config, w http.ResponseWriter, r *http.Request) {
+	domain := r.URL.Query().Get("site_id")
+	target, steps, ok := paths.Parse(r.URL.Query())
+	if !ok {
+		http.Error(w, "Missing page. Please provide page or entry_page parameter with your request.", http.StatusBadRequest)
+		return
+	}
+	params := query.New(r.URL.Query(), db.Timezone(domain))
+	rs, err := paths.Explore(r.Context(), db.TimeSeries(), domain, params, target, steps)
+	if err != nil {
+		db.Logger().Error("exploring paths", "err", err)
+		http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+		return
+	}
+	db.JSON(w, rs)
+}
+
+// Authorize checks for a valid API key granted scope on the requested site_id.
+func Authorize(scope string) plug.Middleware {
+	return func(h plug.Handler) plug.Handler {
+		return AuthorizeKey(scope)(func(db *db.Config, w http.ResponseWriter, r *http.Request) {
+			domain := r.URL.Query().Get("site_id")
+			if domain == "" {
+				http.Error(w,
+					"Missing site ID. Please provide the required site_id parameter with your request.",
+					http.StatusUnauthorized,
+				)
+				return
+			}
+			if !ops.KeyHasSite(Key(r.Context()), domain) {
+				http.Error(w,
+					"The API key does not have access to the site you've requested.",
+					http.StatusForbidden,
+				)
+				return
+			}
+			h(db, w, r)
+		})
+	}
+}
+
+// AuthorizeKey only checks for a valid API key granted scope. Use it for
+// endpoints that are not scoped to a single site. The key is available to
+// handlers with Key.
+func AuthorizeKey(scope string) plug.Middleware {
+	return func(h plug.Handler) plug.Handler {
+		return func(db *db.Config, w http.ResponseWriter, r *http.Request) {
+			auth := r.Header.Get("authorization")
+			token := strings.TrimPrefix(auth, "Bearer ")
+			token = strings.TrimSpace(token)
+			if token == "" {
+				http.Error(w,
+					"Missing API key. Please use a valid Vince API key as a Bearer Token.",
+					http.StatusUnauthorized,
+				)
+				return
+			}
+			key, err := db.Ops().UseAPIKey(token)
+			if err != nil {
+				if !errors.Is(err, ops.ErrAPIKeyInvalid) && !errors.Is(err, ops.ErrAPIKeyExpired) {
+					db.Logger().Error("checking api key", "err", err)
+				}
+				http.Error(w,
+					"Invalid API key or site ID. Please make sure you're using a valid API key with access to the site you've requested.",
+					http.StatusUnauthorized,
+				)
+				return
+			}
+			if !ops.KeyAllows(key, scope) {
+				http.Error(w,
+					"The API key is not allowed to perform this action.",
+					http.StatusForbidden,
+				)
+				return
+			}
+			if ok, wait := db.AllowAPI(key.Name); !ok {
+				w.Header().Set("Retry-After", ratelimit.RetryAfter(wait))
+				http.Error(w,
+					"Too many requests. Please slow down and retry later.",
+					http.StatusTooManyRequests,
+				)
+				return
+			}
+			h(db, w, r.WithContext(context.WithValue(r.Context(), keyContext{}, key)))
 		}
-		h(db, w, r)
 	}
 }
+
+type keyContext struct{}
+
+// Key returns the API key that authorized the request.
+func Key(ctx context.Context) *v1.APIKey {
+	return ctx.Value(keyContext{}).(*v1.APIKey)
+}
@@ -17,8 +17,9 @@ func Timeseries(ctx context.Context, ts *timeseries.Timeseries, domain string, p
 	fields := models.DataForMetrics(metrics...)
 
 	format := params.Interval().Format()
+	loc := params.Location()
 	err := ts.Select(ctx, fields, domain, params.Start(), params.End(), params.Interval(), params.Filter(), func(ra *cursor.Cursor, dataField models.Field, view, shard uint64, columns *ro2.Bitmap) error {
-		timestamp := xtime.UnixMilli(int64(view)).Format(format)
+		timestamp := xtime.UnixMilli(int64(view)).In(loc).Format(format)
 		m, ok := values[timestamp]
 		if !ok {
 			m = new(aggregates.Stats)
@@ -0,0 +1,51 @@
+package timeseries
+
+import (
+	"context"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"github.com/vinceanalytics/vince/internal/models"
+	"github.com/vinceanalytics/vince/internal/timeseries/timeseriestest"
+	"github.com/vinceanalytics/vince/internal/web/query"
+)
+
+func TestTimeseriesLocation(t *testing.T) {
+	loc, err := time.LoadLocation("America/New_York")
+	if err != nil {
+		t.Skip("missing timezone data")
+	}
+	_, ts := timeseriestest.New(t)
+	ts.SetZone(func([]byte) *time.Location { return loc })
+
+	// 02:30 UTC is still the previous day in New York.
+	var events []*models.Model
+	for i, at := range []time.Time{
+		time.Date(2024, time.August, 15, 2, 30, 0, 0, time.UTC),
+		time.Date(2024, time.August, 15, 5, 0, 0, 0, time.UTC),
+		time.Date(2024, time.August, 15, 14, 0, 0, 0, time.UTC),
+	} {
+		events = append(events, &models.Model{
+			Timestamp: at.UnixMilli(),
+			Id:        uint64(i + 1),
+			Domain:    []byte("vinceanalytics.com"),
+			View:      true,
+		})
+	}
+	timeseriestest.Add(t, ts, events...)
+
+	params := query.New(url.Values{
+		"period":   {"month"},
+		"date":     {"2024-08-15"},
+		"interval": {"date"},
+	}, loc)
+	values, err := Timeseries(context.Background(), ts, "vinceanalytics.com", params, []string{"pageviews"})
+	require.NoError(t, err)
+	views := map[string]float64{}
+	for k, v := range values {
+		views[k] = v.PageViews
+	}
+	require.Equal(t, map[string]float64{"2024-08-14 00:00:00": 1, "2024-08-15 00:00:00": 2}, views)
+}
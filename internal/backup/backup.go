@@ -0,0 +1,194 @@
+// Package backup archives and restores vince data directories.
+//
+// An archive is a zstd compressed tar of a checkpoint of the data directory.
+// The first entry is a JSON manifest listing every file that follows, restore
+// uses it to verify the archive is complete.
+package backup
+
+import (
+	"archive/tar"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/klauspost/compress/zstd"
+	"github.com/vinceanalytics/vince/internal/version"
+)
+
+// Format is the version of the archive layout.
+const Format = 1
+
+const manifestName = "manifest.json"
+
+var (
+	ErrManifest = errors.New("backup: missing or invalid manifest")
+	ErrNotEmpty = errors.New("backup: restore directory is not empty")
+)
+
+type Manifest struct {
+	Format  int       `json:"format"`
+	Version string    `json:"version"`
+	Created time.Time `json:"created"`
+	Shards  []uint64  `json:"shards"`
+	Files   []File    `json:"files"`
+}
+
+type File struct {
+	Path string `json:"path"`
+	Size int64  `json:"size"`
+}
+
+// Filename returns the default name of an archive created at ts.
+func Filename(ts time.Time) string {
+	return "vince-backup-" + ts.UTC().Format("20060102T150405Z") + ".tar.zst"
+}
+
+// Write archives checkpoint directory dir into w.
+func Write(w io.Writer, dir string, shards []uint64) (*Manifest, error) {
+	m := &Manifest{
+		Format:  Format,
+		Version: strings.TrimSpace(version.VERSION),
+		Created: time.Now().UTC(),
+		Shards:  shards,
+	}
+	err := filepath.WalkDir(dir, func(path string, d fs.DirEntry, err error) error {
+		if err != nil || d.IsDir() {
+			return err
+		}
+		info, err := d.Info()
+		if err != nil {
+			return err
+		}
+		rel, err := filepath.Rel(dir, path)
+		if err != nil {
+			return err
+		}
+		m.Files = append(m.Files, File{Path: filepath.ToSlash(rel), Size: info.Size()})
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	zw, err := zstd.NewWriter(w)
+	if err != nil {
+		return nil, err
+	}
+	tw := tar.NewWriter(zw)
+	data, _ := json.MarshalIndent(m, "", "  ")
+	err = tw.WriteHeader(&tar.Header{
+		Name:    manifestName,
+		Mode:    0644,
+		Size:    int64(len(data)),
+		ModTime: m.Created,
+	})
+	if err != nil {
+		return nil, err
+	}
+	_, err = tw.Write(data)
+	if err != nil {
+		return nil, err
+	}
+	for _, f := range m.Files {
+		err = writeFile(tw, dir, f, m.Created)
+		if err != nil {
+			return nil, err
+		}
+	}
+	err = tw.Close()
+	if err != nil {
+		return nil, err
+	}
+	return m, zw.Close()
+}
+
+func writeFile(tw *tar.Writer, dir string, f File, mod time.Time) error {
+	r, err := os.Open(filepath.Join(dir, filepath.FromSlash(f.Path)))
+	if err != nil {
+		return err
+	}
+	defer r.Close()
+	err = tw.WriteHeader(&tar.Header{
+		Name:    f.Path,
+		Mode:    0644,
+		Size:    f.Size,
+		ModTime: mod,
+	})
+	if err != nil {
+		return err
+	}
+	// Checkpoint files are immutable, copying exactly Size bytes is safe.
+	_, err = io.CopyN(tw, r, f.Size)
+	return err
+}
+
+// Restore extracts archive r into dir. dir must not exist or be empty.
+func Restore(r io.Reader, dir string) (*Manifest, error) {
+	entries, err := os.ReadDir(dir)
+	if err != nil && !errors.Is(err, fs.ErrNotExist) {
+		return nil, err
+	}
+	if len(entries) > 0 {
+		return nil, ErrNotEmpty
+	}
+	zr, err := zstd.NewReader(r)
+	if err != nil {
+		return nil, err
+	}
+	defer zr.Close()
+	tr := tar.NewReader(zr)
+
+	h, err := tr.Next()
+	if err != nil || h.Name != manifestName {
+		return nil, ErrManifest
+	}
+	var m Manifest
+	err = json.NewDecoder(tr).Decode(&m)
+	if err != nil || m.Format != Format {
+		return nil, ErrManifest
+	}
+	expect := make(map[string]int64, len(m.Files))
+	for _, f := range m.Files {
+		expect[f.Path] = f.Size
+	}
+	for {
+		h, err := tr.Next()
+		if err != nil {
+			if errors.Is(err, io.EOF) {
+				break
+			}
+			return nil, err
+		}
+		size, ok := expect[h.Name]
+		if !ok || !filepath.IsLocal(h.Name) || h.Typeflag != tar.TypeReg || h.Size != size {
+			return nil, fmt.Errorf("backup: unexpected archive entry %q", h.Name)
+		}
+		err = extract(tr, filepath.Join(dir, filepath.FromSlash(h.Name)), h.Size)
+		if err != nil {
+			return nil, err
+		}
+		delete(expect, h.Name)
+	}
+	for path := range expect {
+		return nil, fmt.Errorf("backup: archive is missing %q", path)
+	}
+	return &m, nil
+}
+
+func extract(r io.Reader, path string, size int64) error {
+	err := os.MkdirAll(filepath.Dir(path), 0755)
+	if err != nil {
+		return err
+	}
+	f, err := os.OpenFile(path, os.O_CREATE|os.O_EXCL|os.O_WRONLY, 0644)
+	if err != nil {
+		return err
+	}
+	_, err = io.CopyN(f, r, size)
+	return errors.Join(err, f.Sync(), f.Close())
+}
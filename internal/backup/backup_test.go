@@ -0,0 +1,54 @@
+package backup
+
+import (
+	"bytes"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"github.com/vinceanalytics/vince/internal/encoding"
+	"github.com/vinceanalytics/vince/internal/location"
+	"github.com/vinceanalytics/vince/internal/models"
+	"github.com/vinceanalytics/vince/internal/shards"
+	"github.com/vinceanalytics/vince/internal/timeseries"
+	"github.com/vinceanalytics/vince/internal/timeseries/timeseriestest"
+)
+
+func TestBackup(t *testing.T) {
+	now := time.Date(2024, 3, 1, 10, 0, 0, 0, time.UTC)
+	db, _ := timeseriestest.New(t, &models.Model{
+		Timestamp: now.UnixMilli(),
+		Id:        1,
+		Domain:    []byte("a.com"),
+		View:      true,
+	})
+
+	dir, ls, err := db.Checkpoint()
+	require.NoError(t, err)
+	var b bytes.Buffer
+	m, err := Write(&b, dir, ls)
+	require.NoError(t, err)
+	require.Equal(t, ls, m.Shards)
+	require.NotEmpty(t, m.Files)
+
+	t.Run("restore", func(t *testing.T) {
+		path := filepath.Join(t.TempDir(), "data")
+		got, err := Restore(bytes.NewReader(b.Bytes()), path)
+		require.NoError(t, err)
+		require.Equal(t, m.Files, got.Files)
+
+		rdb, err := shards.New(path)
+		require.NoError(t, err)
+		defer rdb.Close()
+		rts := timeseries.New(rdb, location.New())
+		require.Equal(t, uint64(1), rts.Visitors(now.Add(-time.Second), now, encoding.Hour, "a.com"))
+
+		_, err = Restore(bytes.NewReader(b.Bytes()), path)
+		require.ErrorIs(t, err, ErrNotEmpty)
+	})
+	t.Run("truncated", func(t *testing.T) {
+		_, err := Restore(bytes.NewReader(b.Bytes()[:b.Len()/2]), t.TempDir())
+		require.Error(t, err)
+	})
+}
@@ -0,0 +1,153 @@
+// Package clientip resolves the address of the client that sent a request
+// through trusted reverse proxies.
+package clientip
+
+import (
+	"fmt"
+	"net"
+	"net/http"
+	"net/netip"
+	"strings"
+)
+
+// Headers that can carry the client address.
+const (
+	XForwardedFor  = "X-Forwarded-For"
+	XRealIP        = "X-Real-IP"
+	CFConnectingIP = "CF-Connecting-IP"
+	Forwarded      = "Forwarded"
+)
+
+// DefaultTrusted only trusts proxies on the same host. Private networks are
+// not trusted by default because any host on them could spoof client
+// addresses.
+var DefaultTrusted = []string{"127.0.0.0/8", "::1/128"}
+
+// DefaultHeaders are used when no headers are configured.
+var DefaultHeaders = []string{XForwardedFor, XRealIP}
+
+// Resolver finds client addresses. Headers are only read from requests sent by
+// trusted proxies.
+type Resolver struct {
+	trusted []netip.Prefix
+	headers []string
+}
+
+// New returns a resolver trusting proxies in CIDR ranges trusted and reading
+// headers in order, the first header present wins.
+func New(trusted, headers []string) (*Resolver, error) {
+	r := &Resolver{}
+	for _, s := range trusted {
+		p, err := netip.ParsePrefix(s)
+		if err != nil {
+			a, aerr := netip.ParseAddr(s)
+			if aerr != nil {
+				return nil, fmt.Errorf("clientip: invalid trusted proxy %q", s)
+			}
+			p = netip.PrefixFrom(a.Unmap(), a.Unmap().BitLen())
+		}
+		r.trusted = append(r.trusted, p.Masked())
+	}
+	for _, h := range headers {
+		switch name := http.CanonicalHeaderKey(strings.TrimSpace(h)); name {
+		case http.CanonicalHeaderKey(XForwardedFor), http.CanonicalHeaderKey(XRealIP),
+			http.CanonicalHeaderKey(CFConnectingIP), http.CanonicalHeaderKey(Forwarded):
+			r.headers = append(r.headers, name)
+		default:
+			return nil, fmt.Errorf("clientip: unsupported header %q, use one of %s, %s, %s or %s",
+				h, XForwardedFor, XRealIP, CFConnectingIP, Forwarded)
+		}
+	}
+	return r, nil
+}
+
+// IP returns the client address of r or - when it is unknown.
+func (c *Resolver) IP(r *http.Request) string {
+	peer, ok := parse(r.RemoteAddr)
+	if !ok {
+		return "-"
+	}
+	if !c.trust(peer) {
+		return peer.String()
+	}
+	for _, h := range c.headers {
+		values := r.Header.Values(h)
+		if len(values) == 0 {
+			continue
+		}
+		var ip netip.Addr
+		switch h {
+		case XForwardedFor:
+			ip, ok = c.hops(values, func(s string) string { return s })
+		case Forwarded:
+			ip, ok = c.hops(values, forwardedFor)
+		default:
+			ip, ok = parse(values[0])
+		}
+		if ok {
+			return ip.String()
+		}
+	}
+	return peer.String()
+}
+
+func (c *Resolver) trust(ip netip.Addr) bool {
+	for _, p := range c.trusted {
+		if p.Contains(ip) {
+			return true
+		}
+	}
+	return false
+}
+
+// hops walks a comma separated list of proxy hops from right to left and
+// returns the first address that is not a trusted proxy. When all hops are
+// trusted the leftmost one is returned.
+func (c *Resolver) hops(values []string, addr func(string) string) (ip netip.Addr, ok bool) {
+	var ls []string
+	for _, v := range values {
+		ls = append(ls, strings.Split(v, ",")...)
+	}
+	for i := len(ls) - 1; i >= 0; i-- {
+		hop, valid := parse(addr(ls[i]))
+		if !valid {
+			// We can't tell who added hops past a malformed one.
+			return
+		}
+		ip, ok = hop, true
+		if !c.trust(hop) {
+			return
+		}
+	}
+	return
+}
+
+// forwardedFor returns the for parameter of a Forwarded header element.
+func forwardedFor(element string) string {
+	for _, pair := range strings.Split(element, ";") {
+		k, v, _ := strings.Cut(strings.TrimSpace(pair), "=")
+		if strings.EqualFold(k, "for") {
+			v = strings.Trim(v, `"`)
+			if strings.HasPrefix(v, "[") {
+				// [ipv6] or [ipv6]:port
+				v = strings.TrimPrefix(v, "[")
+				v, _, _ = strings.Cut(v, "]")
+			}
+			return v
+		}
+	}
+	return ""
+}
+
+// parse parses an address with an optional port.
+func parse(s string) (netip.Addr, bool) {
+	s = strings.TrimSpace(s)
+	if host, _, err := net.SplitHostPort(s); err == nil {
+		s = host
+	}
+	a, err := netip.ParseAddr(s)
+	if err != nil {
+		return netip.Addr{}, false
+	}
+	return a.Unmap(), true
+}
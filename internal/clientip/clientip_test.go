@@ -0,0 +1,49 @@
+package clientip
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestIP(t *testing.T) {
+	r, err := New([]string{"10.0.0.0/8", "2001:db8::1"}, []string{"forwarded", "x-forwarded-for", "cf-connecting-ip"})
+	require.NoError(t, err)
+
+	cases := []struct {
+		name   string
+		remote string
+		header http.Header
+		want   string
+	}{
+		{"untrusted peer", "203.0.113.9:1234", http.Header{"X-Forwarded-For": {"198.51.100.1"}}, "203.0.113.9"},
+		{"no header", "10.0.0.1:1234", nil, "10.0.0.1"},
+		{"single hop", "10.0.0.1:1234", http.Header{"X-Forwarded-For": {"198.51.100.1"}}, "198.51.100.1"},
+		{"spoofed hop", "10.0.0.1:1234", http.Header{"X-Forwarded-For": {"1.1.1.1, 198.51.100.1, 10.0.0.2"}}, "198.51.100.1"},
+		{"multiple lines", "10.0.0.1:1234", http.Header{"X-Forwarded-For": {"1.1.1.1", "198.51.100.1"}}, "198.51.100.1"},
+		{"all trusted", "10.0.0.1:1234", http.Header{"X-Forwarded-For": {"10.0.0.3, 10.0.0.2"}}, "10.0.0.3"},
+		{"malformed hop", "10.0.0.1:1234", http.Header{"X-Forwarded-For": {"198.51.100.1, garbage"}}, "10.0.0.1"},
+		{"forwarded", "[2001:db8::1]:443", http.Header{"Forwarded": {`for=192.0.2.60;proto=http, for="[2001:db8:cafe::17]:4711"`}}, "2001:db8:cafe::17"},
+		{"forwarded wins", "10.0.0.1:1234", http.Header{
+			"Forwarded":       {"for=192.0.2.60"},
+			"X-Forwarded-For": {"198.51.100.1"},
+		}, "192.0.2.60"},
+		{"single value", "10.0.0.1:1234", http.Header{"Cf-Connecting-Ip": {"198.51.100.7"}}, "198.51.100.7"},
+		{"ignored header", "10.0.0.1:1234", http.Header{"X-Real-Ip": {"198.51.100.7"}}, "10.0.0.1"},
+		{"bad remote", "", nil, "-"},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			req := &http.Request{RemoteAddr: c.remote, Header: c.header}
+			require.Equal(t, c.want, r.IP(req))
+		})
+	}
+}
+
+func TestNew(t *testing.T) {
+	_, err := New([]string{"10.0.0.0/33"}, nil)
+	require.Error(t, err)
+	_, err = New(nil, []string{"X-Client-IP"})
+	require.Error(t, err)
+}
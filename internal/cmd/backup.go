@@ -0,0 +1,160 @@
+package cmd
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+	"net/http"
+	"os"
+	"strings"
+
+	"github.com/urfave/cli/v3"
+	"github.com/vinceanalytics/vince/internal/backup"
+	"github.com/vinceanalytics/vince/internal/shards"
+	"github.com/vinceanalytics/vince/internal/util/xtime"
+)
+
+var backupData = &cli.Command{
+	Name:  "backup",
+	Usage: "Creates an archive of all data",
+	Description: `Without --url the data directory is opened directly, which only works
+when the server is not running. With --url the backup is downloaded from a
+running server using an API key granted the backup:read permission.`,
+	Flags: []cli.Flag{
+		&cli.StringFlag{
+			Name:    "data",
+			Usage:   "directory to store data",
+			Sources: cli.EnvVars("VINCE_DATA"),
+			Value:   "vince-data",
+		},
+		&cli.StringFlag{
+			Name:    "url",
+			Usage:   "url of a running vince server to back up",
+			Sources: cli.EnvVars("VINCE_URL"),
+		},
+		&cli.StringFlag{
+			Name:    "key",
+			Usage:   "API key used with --url",
+			Sources: cli.EnvVars("VINCE_API_KEY"),
+		},
+		&cli.StringFlag{
+			Name:  "out",
+			Usage: "archive path, - writes to stdout",
+		},
+	},
+	Action: func(ctx context.Context, c *cli.Command) error {
+		out := c.String("out")
+		if out == "" {
+			out = backup.Filename(xtime.Now())
+		}
+		write := func(w io.Writer) error {
+			if c.String("url") != "" {
+				return download(ctx, w, c.String("url"), c.String("key"), "/api/v1/backup")
+			}
+			return local(w, c.String("data"))
+		}
+		err := output(out, write)
+		if err != nil {
+			return err
+		}
+		slog.Info("backup created", "path", out)
+		return nil
+	},
+}
+
+// output calls write with path opened for writing, - is stdout. Files are
+// only created once write succeeds.
+func output(path string, write func(w io.Writer) error) error {
+	if path == "-" {
+		return write(os.Stdout)
+	}
+	tmp := path + ".tmp"
+	f, err := os.Create(tmp)
+	if err != nil {
+		return err
+	}
+	err = write(f)
+	err = errors.Join(err, f.Sync(), f.Close())
+	if err != nil {
+		os.Remove(tmp)
+		return err
+	}
+	return os.Rename(tmp, path)
+}
+
+func local(w io.Writer, data string) error {
+	db, err := shards.New(data)
+	if err != nil {
+		return fmt.Errorf("opening data directory, use --url to back up a running server: %w", err)
+	}
+	defer db.Close()
+	dir, ls, err := db.Checkpoint()
+	if err != nil {
+		return err
+	}
+	defer os.RemoveAll(dir)
+	_, err = backup.Write(w, dir, ls)
+	return err
+}
+
+// download copies the response of an authenticated GET request to path of a
+// running server into w.
+func download(ctx context.Context, w io.Writer, url, key, path string) error {
+	if key == "" {
+		return errors.New("missing --key")
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, strings.TrimSuffix(url, "/")+path, nil)
+	if err != nil {
+		return err
+	}
+	req.Header.Set("authorization", "Bearer "+key)
+	res, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer res.Body.Close()
+	if res.StatusCode != http.StatusOK {
+		msg, _ := io.ReadAll(io.LimitReader(res.Body, 4<<10))
+		return fmt.Errorf("request failed: %s: %s", res.Status, strings.TrimSpace(string(msg)))
+	}
+	_, err = io.Copy(w, res.Body)
+	return err
+}
+
+var restoreData = &cli.Command{
+	Name:      "restore",
+	Usage:     "Restores an archive created with vince backup",
+	ArgsUsage: "FILE",
+	Flags: []cli.Flag{
+		&cli.StringFlag{
+			Name:    "data",
+			Usage:   "directory to restore into, it must be empty",
+			Sources: cli.EnvVars("VINCE_DATA"),
+			Value:   "vince-data",
+		},
+	},
+	Action: func(ctx context.Context, c *cli.Command) error {
+		path := c.Args().First()
+		if path == "" {
+			return errors.New("missing backup file")
+		}
+		f, err := os.Open(path)
+		if err != nil {
+			return err
+		}
+		defer f.Close()
+		m, err := backup.Restore(f, c.String("data"))
+		if err != nil {
+			return err
+		}
+		slog.Info("backup restored",
+			"data", c.String("data"),
+			"created", m.Created,
+			"version", m.Version,
+			"shards", len(m.Shards),
+		)
+		return nil
+	},
+}
@@ -11,6 +11,6 @@ func Cli() *cli.Command {
 		Usage:       "The cloud native web analytics server",
 		Description: `Self hosted web analytics server that respects user privacy`,
 		Version:     version.VERSION,
-		Commands:    []*cli.Command{serve, admin},
+		Commands:    []*cli.Command{serve, admin, resetTwoFactor, importData, exportData, backupData, restoreData},
 	}
 }
@@ -0,0 +1,158 @@
+package cmd
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+	"net/url"
+	"time"
+
+	"github.com/cockroachdb/pebble"
+	"github.com/urfave/cli/v3"
+	v1 "github.com/vinceanalytics/vince/gen/go/vince/v1"
+	"github.com/vinceanalytics/vince/internal/encoding"
+	"github.com/vinceanalytics/vince/internal/export"
+	"github.com/vinceanalytics/vince/internal/location"
+	"github.com/vinceanalytics/vince/internal/ops"
+	"github.com/vinceanalytics/vince/internal/shards"
+	"github.com/vinceanalytics/vince/internal/timeseries"
+	"github.com/vinceanalytics/vince/internal/util/data"
+	"github.com/vinceanalytics/vince/internal/util/xtime"
+	"google.golang.org/protobuf/proto"
+)
+
+var exportData = &cli.Command{
+	Name:  "export",
+	Usage: "Exports stored data",
+	Commands: []*cli.Command{
+		{
+			Name:  "events",
+			Usage: "Exports raw events of a site as csv or parquet",
+			Description: `Events are reconstructed from minute views, timestamps are truncated to
+the minute. Without --url the data directory is opened directly, which only
+works when the server is not running. With --url events are downloaded from a
+running server using an API key granted the stats:read permission.`,
+			Flags: []cli.Flag{
+				&cli.StringFlag{
+					Name:    "data",
+					Usage:   "directory to store data",
+					Sources: cli.EnvVars("VINCE_DATA"),
+					Value:   "vince-data",
+				},
+				&cli.StringFlag{
+					Name:    "url",
+					Usage:   "url of a running vince server to export from",
+					Sources: cli.EnvVars("VINCE_URL"),
+				},
+				&cli.StringFlag{
+					Name:    "key",
+					Usage:   "API key used with --url",
+					Sources: cli.EnvVars("VINCE_API_KEY"),
+				},
+				&cli.StringFlag{
+					Name:     "domain",
+					Usage:    "site to export",
+					Required: true,
+				},
+				&cli.StringFlag{
+					Name:     "from",
+					Usage:    "first day to export, YYYY-MM-DD in the site timezone",
+					Required: true,
+				},
+				&cli.StringFlag{
+					Name:  "to",
+					Usage: "last day to export, defaults to today",
+				},
+				&cli.StringFlag{
+					Name:  "format",
+					Usage: "csv or parquet",
+					Value: string(export.CSV),
+				},
+				&cli.StringFlag{
+					Name:  "out",
+					Usage: "output path, - writes to stdout",
+				},
+			},
+			Action: exportEvents,
+		},
+	},
+}
+
+func exportEvents(ctx context.Context, c *cli.Command) error {
+	format, err := export.ParseFormat(c.String("format"))
+	if err != nil {
+		return err
+	}
+	domain := c.String("domain")
+	from := c.String("from")
+	to := c.String("to")
+	if to == "" {
+		to = xtime.Now().Format(time.DateOnly)
+	}
+	for _, d := range []string{from, to} {
+		if _, err := time.Parse(time.DateOnly, d); err != nil {
+			return fmt.Errorf("invalid date %q, use YYYY-MM-DD", d)
+		}
+	}
+	out := c.String("out")
+	if out == "" {
+		day, _ := time.Parse(time.DateOnly, from)
+		last, _ := time.Parse(time.DateOnly, to)
+		out = format.Filename(domain, day, last)
+	}
+	var n int
+	err = output(out, func(w io.Writer) error {
+		if c.String("url") != "" {
+			q := url.Values{
+				"site_id": {domain},
+				"period":  {"custom"},
+				"from":    {from},
+				"to":      {to},
+				"format":  {string(format)},
+			}
+			return download(ctx, w, c.String("url"), c.String("key"), "/api/v1/export/events?"+q.Encode())
+		}
+		var err error
+		n, err = exportLocal(ctx, w, c.String("data"), domain, from, to, format)
+		return err
+	})
+	if err != nil {
+		return err
+	}
+	args := []any{"domain", domain, "path", out}
+	if c.String("url") == "" {
+		args = append(args, "events", n)
+	}
+	slog.Info("events exported", args...)
+	return nil
+}
+
+func exportLocal(ctx context.Context, w io.Writer, path, domain, from, to string, format export.Format) (int, error) {
+	db, err := shards.New(path)
+	if err != nil {
+		return 0, fmt.Errorf("opening data directory, use --url to export from a running server: %w", err)
+	}
+	defer db.Close()
+
+	var site v1.Site
+	err = data.Get(db.Get(), encoding.Site([]byte(domain)), func(val []byte) error {
+		return proto.Unmarshal(val, &site)
+	})
+	if err != nil {
+		if errors.Is(err, pebble.ErrNotFound) {
+			return 0, fmt.Errorf("site %q does not exist", domain)
+		}
+		return 0, err
+	}
+	tz := ops.Location(site.Timezone)
+	start, _ := time.ParseInLocation(time.DateOnly, from, tz)
+	last, _ := time.ParseInLocation(time.DateOnly, to, tz)
+
+	lo := location.New()
+	defer lo.Close()
+	ts := timeseries.New(db, lo)
+	defer ts.Close()
+	return export.Events(ctx, ts, domain, start, last.AddDate(0, 0, 1), format, w)
+}
@@ -0,0 +1,217 @@
+package cmd
+
+import (
+	"bufio"
+	"compress/gzip"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/cockroachdb/pebble"
+	"github.com/urfave/cli/v3"
+	v1 "github.com/vinceanalytics/vince/gen/go/vince/v1"
+	"github.com/vinceanalytics/vince/internal/accesslog"
+	"github.com/vinceanalytics/vince/internal/encoding"
+	"github.com/vinceanalytics/vince/internal/location"
+	"github.com/vinceanalytics/vince/internal/ops"
+	"github.com/vinceanalytics/vince/internal/plausible"
+	"github.com/vinceanalytics/vince/internal/shards"
+	"github.com/vinceanalytics/vince/internal/timeseries"
+	"github.com/vinceanalytics/vince/internal/util/data"
+	"github.com/vinceanalytics/vince/internal/util/oracle"
+	"github.com/vinceanalytics/vince/internal/web/db"
+	"google.golang.org/protobuf/proto"
+)
+
+var importData = &cli.Command{
+	Name:  "import",
+	Usage: "Imports historical data",
+	Commands: []*cli.Command{
+		{
+			Name:      "plausible",
+			Usage:     "Imports a Plausible CSV export zip file",
+			ArgsUsage: "FILE",
+			Flags: []cli.Flag{
+				&cli.StringFlag{
+					Name:    "data",
+					Usage:   "directory to store data",
+					Sources: cli.EnvVars("VINCE_DATA"),
+					Value:   "vince-data",
+				},
+				&cli.StringFlag{
+					Name:     "domain",
+					Usage:    "site to import data into",
+					Required: true,
+				},
+			},
+			Action: func(ctx context.Context, c *cli.Command) error {
+				path := c.Args().First()
+				if path == "" {
+					return errors.New("missing export file")
+				}
+				export, err := plausible.Open(path)
+				if err != nil {
+					return err
+				}
+				db, err := shards.New(c.String("data"))
+				if err != nil {
+					return err
+				}
+				defer db.Close()
+
+				domain := c.String("domain")
+				var site v1.Site
+				err = data.Get(db.Get(), encoding.Site([]byte(domain)), func(val []byte) error {
+					return proto.Unmarshal(val, &site)
+				})
+				if err != nil {
+					if errors.Is(err, pebble.ErrNotFound) {
+						return fmt.Errorf("site %q does not exist", domain)
+					}
+					return err
+				}
+				tz := ops.Location(site.Timezone)
+				export.In(tz)
+				lo := location.New()
+				defer lo.Close()
+				ts := timeseries.New(db, lo)
+				ts.SetZone(func([]byte) *time.Location { return tz })
+				defer ts.Close()
+
+				err = plausible.Import(ts, domain, export)
+				if err != nil {
+					return err
+				}
+				start, end := export.Range()
+				slog.Info("successfully imported plausible export", "domain", domain,
+					"start", start.Format("2006-01-02"), "end", end.Format("2006-01-02"))
+				return nil
+			},
+		},
+		{
+			Name:      "logs",
+			Usage:     "Imports web server access logs. Files must be given from oldest to newest",
+			ArgsUsage: "FILE...",
+			Flags: []cli.Flag{
+				&cli.StringFlag{
+					Name:        "data",
+					Usage:       "directory to store data",
+					Sources:     cli.EnvVars("VINCE_DATA"),
+					Value:       "vince-data",
+					Destination: &oracle.DataPath,
+				},
+				&cli.StringFlag{
+					Name:     "domain",
+					Usage:    "site to import data into",
+					Required: true,
+				},
+				&cli.StringFlag{
+					Name:  "format",
+					Usage: "log format, one of combined, common or json",
+					Value: "combined",
+				},
+			},
+			Action: importLogs,
+		},
+	},
+}
+
+func importLogs(ctx context.Context, c *cli.Command) error {
+	format, err := accesslog.ParseFormat(c.String("format"))
+	if err != nil {
+		return err
+	}
+	files := c.Args().Slice()
+	if len(files) == 0 {
+		return errors.New("missing log files")
+	}
+	bdb, err := shards.New(oracle.DataPath)
+	if err != nil {
+		return err
+	}
+	defer bdb.Close()
+
+	_, err = ops.LoadAdmin(bdb.Get())
+	if err != nil {
+		return err
+	}
+	db, err := db.Open(bdb, nil)
+	if err != nil {
+		return err
+	}
+	defer db.Close()
+
+	domain := c.String("domain")
+	if !db.Ops().HasSite(domain) {
+		return fmt.Errorf("site %q does not exist", domain)
+	}
+	var stats logStats
+	for _, file := range files {
+		err := importLogFile(db, domain, format, file, &stats)
+		if err != nil {
+			return fmt.Errorf("importing %s %w", file, err)
+		}
+	}
+	err = db.TimeSeries().Save()
+	if err != nil {
+		return err
+	}
+	slog.Info("successfully imported access logs", "domain", domain,
+		"pageviews", stats.pageviews, "skipped", stats.skipped,
+		"dropped", stats.dropped, "invalid", stats.invalid)
+	return nil
+}
+
+type logStats struct {
+	pageviews, skipped, dropped, invalid int
+}
+
+func importLogFile(dba *db.Config, domain string, format accesslog.Format, file string, stats *logStats) error {
+	f, err := os.Open(file)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+	var r io.Reader = f
+	if strings.HasSuffix(file, ".gz") {
+		gz, err := gzip.NewReader(f)
+		if err != nil {
+			return err
+		}
+		defer gz.Close()
+		r = gz
+	}
+	sc := bufio.NewScanner(r)
+	sc.Buffer(make([]byte, 0, 64<<10), 1<<20)
+	for sc.Scan() {
+		line := sc.Bytes()
+		if len(line) == 0 {
+			continue
+		}
+		e, err := accesslog.Parse(format, line)
+		if err != nil {
+			stats.invalid++
+			continue
+		}
+		if !e.Pageview() {
+			stats.skipped++
+			continue
+		}
+		err = dba.ProcessLog(domain, e)
+		if err != nil {
+			if errors.Is(err, db.ErrDrop) {
+				stats.dropped++
+				continue
+			}
+			stats.invalid++
+			continue
+		}
+		stats.pageviews++
+	}
+	return sc.Err()
+}
@@ -11,6 +11,7 @@ import (
 
 	"github.com/urfave/cli/v3"
 	"github.com/vinceanalytics/vince/internal/api"
+	"github.com/vinceanalytics/vince/internal/clientip"
 	"github.com/vinceanalytics/vince/internal/ops"
 	"github.com/vinceanalytics/vince/internal/shards"
 	"github.com/vinceanalytics/vince/internal/util/acme"
@@ -84,6 +85,112 @@ var serve = &cli.Command{
 			Sources:     cli.EnvVars("VINCE_PROFILE"),
 			Destination: &oracle.Profile,
 		},
+		&cli.StringFlag{
+			Name:        "currencyRates",
+			Usage:       "path to JSON file with currency exchange rates for revenue conversion",
+			Sources:     cli.EnvVars("VINCE_CURRENCY_RATES"),
+			Destination: &oracle.CurrencyRates,
+		},
+		&cli.StringFlag{
+			Name:        "oidcIssuer",
+			Usage:       "OpenID Connect issuer url, enables single sign-on",
+			Sources:     cli.EnvVars("VINCE_OIDC_ISSUER"),
+			Destination: &oracle.OIDC.Issuer,
+		},
+		&cli.StringFlag{
+			Name:        "oidcClientID",
+			Usage:       "OpenID Connect client id",
+			Sources:     cli.EnvVars("VINCE_OIDC_CLIENT_ID"),
+			Destination: &oracle.OIDC.ClientID,
+		},
+		&cli.StringFlag{
+			Name:        "oidcClientSecret",
+			Usage:       "OpenID Connect client secret",
+			Sources:     cli.EnvVars("VINCE_OIDC_CLIENT_SECRET"),
+			Destination: &oracle.OIDC.ClientSecret,
+		},
+		&cli.StringSliceFlag{
+			Name:        "oidcEmailDomains",
+			Usage:       "only allow single sign-on for emails in these domains",
+			Sources:     cli.EnvVars("VINCE_OIDC_EMAIL_DOMAINS"),
+			Destination: &oracle.OIDC.EmailDomains,
+		},
+		&cli.StringFlag{
+			Name:        "oidcGroupsClaim",
+			Usage:       "ID token claim listing user groups",
+			Value:       "groups",
+			Sources:     cli.EnvVars("VINCE_OIDC_GROUPS_CLAIM"),
+			Destination: &oracle.OIDC.GroupsClaim,
+		},
+		&cli.StringSliceFlag{
+			Name:        "oidcRoles",
+			Usage:       "map groups to site roles as group:role or group:role:domain",
+			Sources:     cli.EnvVars("VINCE_OIDC_ROLES"),
+			Destination: &oracle.OIDC.Roles,
+		},
+		&cli.StringSliceFlag{
+			Name:        "trusted-proxies",
+			Usage:       "CIDR ranges of reverse proxies trusted to set the client ip, only loopback by default",
+			Value:       clientip.DefaultTrusted,
+			Sources:     cli.EnvVars("VINCE_TRUSTED_PROXIES"),
+			Destination: &oracle.TrustedProxies,
+		},
+		&cli.StringSliceFlag{
+			Name:        "clientIPHeaders",
+			Usage:       "headers read from trusted proxies in order, X-Forwarded-For, X-Real-IP, CF-Connecting-IP or Forwarded",
+			Value:       clientip.DefaultHeaders,
+			Sources:     cli.EnvVars("VINCE_CLIENT_IP_HEADERS"),
+			Destination: &oracle.ClientIPHeaders,
+		},
+		&cli.FloatFlag{
+			Name:        "apiRateLimit",
+			Usage:       "maximum requests per second for each API key, 0 disables the limit",
+			Value:       10,
+			Sources:     cli.EnvVars("VINCE_API_RATE_LIMIT"),
+			Destination: &oracle.RateLimit.API,
+		},
+		&cli.IntFlag{
+			Name:        "apiRateBurst",
+			Usage:       "maximum burst of requests for each API key",
+			Value:       20,
+			Sources:     cli.EnvVars("VINCE_API_RATE_BURST"),
+			Destination: &oracle.RateLimit.APIBurst,
+		},
+		&cli.FloatFlag{
+			Name:        "eventsRateLimit",
+			Usage:       "maximum events per second for each client of a site, 0 disables the limit",
+			Value:       10,
+			Sources:     cli.EnvVars("VINCE_EVENTS_RATE_LIMIT"),
+			Destination: &oracle.RateLimit.Events,
+		},
+		&cli.IntFlag{
+			Name:        "eventsRateBurst",
+			Usage:       "maximum burst of events for each client of a site",
+			Value:       50,
+			Sources:     cli.EnvVars("VINCE_EVENTS_RATE_BURST"),
+			Destination: &oracle.RateLimit.EventsBurst,
+		},
+		&cli.IntFlag{
+			Name:        "ingestQueue",
+			Usage:       "maximum events waiting to be processed, queued events are kept in the events log and new events are dropped when it is full",
+			Value:       db.DefaultIngestQueue,
+			Sources:     cli.EnvVars("VINCE_INGEST_QUEUE"),
+			Destination: &oracle.Ingest.Queue,
+		},
+		&cli.IntFlag{
+			Name:        "ingestBatch",
+			Usage:       "number of events committed together",
+			Value:       db.DefaultIngestBatch,
+			Sources:     cli.EnvVars("VINCE_INGEST_BATCH"),
+			Destination: &oracle.Ingest.Batch,
+		},
+		&cli.DurationFlag{
+			Name:        "ingestInterval",
+			Usage:       "maximum time events wait before they are committed",
+			Value:       db.DefaultIngestInterval,
+			Sources:     cli.EnvVars("VINCE_INGEST_INTERVAL"),
+			Destination: &oracle.Ingest.Interval,
+		},
 		&cli.StringFlag{
 			Name:    "adminName",
 			Usage:   "administrator name",
@@ -152,6 +259,12 @@ func run(ctx context.Context, c *cli.Command) error {
 			Then(web.Stats),
 	))
 
+	mux.Handle("GET /{domain}/retention", db.Wrap("query.Retention")(
+		plug.Browser().
+			With(web.RequireSiteAccess).
+			Then(web.RetentionReport),
+	))
+
 	mux.HandleFunc("GET /login", db.Wrap("admin.LoginForm")(
 		plug.Browser().
 			With(plug.CSRF).
@@ -165,6 +278,30 @@ func run(ctx context.Context, c *cli.Command) error {
 			Then(web.Login),
 	))
 
+	mux.HandleFunc("GET /login/2fa", db.Wrap("admin.TwoFactorForm")(
+		plug.Browser().
+			With(plug.CSRF).
+			Then(web.TwoFactorForm),
+	))
+
+	mux.HandleFunc("POST /login/2fa", db.Wrap("admin.TwoFactorLogin")(
+		plug.Browser().
+			With(plug.VerifyCSRF).
+			Then(web.TwoFactorLogin),
+	))
+
+	mux.HandleFunc("GET /login/oidc", db.Wrap("admin.SSOLogin")(
+		plug.Browser().
+			With(plug.RequireLogout).
+			Then(web.SSOLogin),
+	))
+
+	mux.HandleFunc("GET /login/oidc/callback", db.Wrap("admin.SSOCallback")(
+		plug.Browser().
+			With(plug.RequireLogout).
+			Then(web.SSOCallback),
+	))
+
 	mux.HandleFunc("GET /settings", db.Wrap("admin.Settings")(
 		plug.Browser().
 			With(plug.CSRF).
@@ -172,10 +309,91 @@ func run(ctx context.Context, c *cli.Command) error {
 			Then(web.UserSetting),
 	))
 
+	mux.HandleFunc("POST /settings/password", db.Wrap("admin.ChangePassword")(
+		plug.Browser().
+			With(plug.VerifyCSRF).
+			With(plug.RequireAccount).
+			Then(web.ChangePassword),
+	))
+
+	mux.HandleFunc("GET /settings/2fa", db.Wrap("admin.TwoFactorSetup")(
+		plug.Browser().
+			With(plug.CSRF).
+			With(plug.RequireAccount).
+			Then(web.TwoFactorSetup),
+	))
+
+	mux.HandleFunc("POST /settings/2fa", db.Wrap("admin.EnableTwoFactor")(
+		plug.Browser().
+			With(plug.VerifyCSRF).
+			With(plug.RequireAccount).
+			Then(web.EnableTwoFactor),
+	))
+
+	mux.HandleFunc("POST /settings/2fa/disable", db.Wrap("admin.DisableTwoFactor")(
+		plug.Browser().
+			With(plug.VerifyCSRF).
+			With(plug.RequireAccount).
+			Then(web.DisableTwoFactor),
+	))
+
+	mux.HandleFunc("GET /settings/users/new", db.Wrap("admin.NewUserForm")(
+		plug.Browser().
+			With(plug.CSRF).
+			With(plug.RequireAccount).
+			With(plug.RequireAdmin).
+			Then(web.NewUserForm),
+	))
+
+	mux.HandleFunc("POST /settings/users", db.Wrap("admin.CreateUser")(
+		plug.Browser().
+			With(plug.VerifyCSRF).
+			With(plug.RequireAccount).
+			With(plug.RequireAdmin).
+			Then(web.CreateUser),
+	))
+
+	mux.HandleFunc("POST /settings/users/{name}/delete", db.Wrap("admin.DeleteUser")(
+		plug.Browser().
+			With(plug.VerifyCSRF).
+			With(plug.RequireAccount).
+			With(plug.RequireAdmin).
+			Then(web.DeleteUser),
+	))
+
+	mux.HandleFunc("GET /settings/audit", db.Wrap("admin.AuditLog")(
+		plug.Browser().
+			With(plug.RequireAccount).
+			With(plug.RequireAdmin).
+			Then(web.AuditLog),
+	))
+
+	mux.HandleFunc("GET /settings/audit/export", db.Wrap("admin.ExportAuditLog")(
+		plug.Browser().
+			With(plug.RequireAccount).
+			With(plug.RequireAdmin).
+			Then(web.ExportAuditLog),
+	))
+
+	mux.HandleFunc("GET /settings/backup", db.Wrap("admin.Backup")(
+		plug.Browser().
+			With(plug.RequireAccount).
+			With(plug.RequireAdmin).
+			Then(web.Backup),
+	))
+
+	mux.HandleFunc("GET /settings/ingest", db.Wrap("admin.Ingest")(
+		plug.Browser().
+			With(plug.RequireAccount).
+			With(plug.RequireAdmin).
+			Then(web.Ingest),
+	))
+
 	mux.HandleFunc("GET /settings/api-keys/new", db.Wrap("admin.NewAPIKeyForm")(
 		plug.Browser().
 			With(plug.CSRF).
 			With(plug.RequireAccount).
+			With(plug.RequireAdmin).
 			Then(web.NewApiKey),
 	))
 
@@ -183,15 +401,29 @@ func run(ctx context.Context, c *cli.Command) error {
 		plug.Browser().
 			With(plug.VerifyCSRF).
 			With(plug.RequireAccount).
+			With(plug.RequireAdmin).
 			Then(web.CreateAPiKey),
 	))
 
 	mux.HandleFunc("GET /settings/api-keys/{name}", db.Wrap("admin.DeleteAPIKey")(
 		plug.Browser().
 			With(plug.RequireAccount).
+			With(plug.RequireAdmin).
 			Then(web.DeleteAPiKey),
 	))
 
+	mux.HandleFunc("GET /invitations/{id}/accept", db.Wrap("admin.InvitationForm")(
+		plug.Browser().
+			With(plug.CSRF).
+			Then(web.InvitationForm),
+	))
+
+	mux.HandleFunc("POST /invitations/{id}/accept", db.Wrap("admin.AcceptInvitation")(
+		plug.Browser().
+			With(plug.VerifyCSRF).
+			Then(web.AcceptInvitation),
+	))
+
 	mux.HandleFunc("GET /logout", db.Wrap("admin.Logout")(
 		plug.Browser().
 			Then(web.Logout),
@@ -225,7 +457,8 @@ func run(ctx context.Context, c *cli.Command) error {
 
 	sites := plug.Browser().
 		With(plug.RequireAccount).
-		With(web.RequireSiteAccess)
+		With(web.RequireSiteAccess).
+		With(web.RequireSiteRole(ops.RoleAdmin))
 
 	mux.HandleFunc("GET /v1/share/{domain}", db.Wrap("admin.Share")(
 		plug.Browser().
@@ -285,6 +518,24 @@ func run(ctx context.Context, c *cli.Command) error {
 			Then(web.CreateGoal),
 	))
 
+	mux.HandleFunc("POST /{domain}/funnels/delete", db.Wrap("admin.DeleteFunnel")(
+		sites.
+			With(plug.VerifyCSRF).
+			Then(web.DeleteFunnel),
+	))
+
+	mux.HandleFunc("POST /{domain}/funnels", db.Wrap("admin.CreateFunnel")(
+		sites.
+			With(plug.VerifyCSRF).
+			Then(web.CreateFunnel),
+	))
+
+	mux.HandleFunc("GET /{domain}/funnels/new", db.Wrap("admin.NewFunnelForm")(
+		sites.
+			With(plug.CSRF).
+			Then(web.NewFunnelForm),
+	))
+
 	mux.HandleFunc("GET /{domain}/goals/new", db.Wrap("admin.NewGoalForm")(
 		sites.
 			With(plug.CSRF).
@@ -317,13 +568,79 @@ func run(ctx context.Context, c *cli.Command) error {
 			Then(web.Settings),
 	))
 
+	mux.HandleFunc("POST /{domain}/settings/currency", db.Wrap("site.UpdateCurrency")(
+		sites.
+			With(plug.VerifyCSRF).
+			Then(web.UpdateCurrency),
+	))
+
+	mux.HandleFunc("POST /{domain}/settings/timezone", db.Wrap("site.UpdateTimezone")(
+		sites.
+			With(plug.VerifyCSRF).
+			Then(web.UpdateTimezone),
+	))
+
+	mux.HandleFunc("POST /{domain}/settings/rate-limit", db.Wrap("site.UpdateRateLimit")(
+		sites.
+			With(plug.VerifyCSRF).
+			Then(web.UpdateRateLimit),
+	))
+
+	mux.HandleFunc("POST /{domain}/settings/exclusions", db.Wrap("site.UpdateExclusions")(
+		sites.
+			With(plug.VerifyCSRF).
+			Then(web.UpdateExclusions),
+	))
+
+	mux.HandleFunc("POST /{domain}/settings/retention", db.Wrap("site.UpdateRetention")(
+		sites.
+			With(plug.VerifyCSRF).
+			Then(web.UpdateRetention),
+	))
+
+	mux.HandleFunc("POST /{domain}/settings/import/plausible", db.Wrap("site.ImportPlausible")(
+		sites.
+			With(plug.VerifyCSRF).
+			Then(web.ImportPlausible),
+	))
+
+	mux.HandleFunc("GET /{domain}/settings/funnels", db.Wrap("site.FunnelSettings")(
+		sites.
+			Then(web.FunnelSettings),
+	))
+
 	mux.HandleFunc("GET /{domain}/settings/goals", db.Wrap("site.GoalSettings")(
 		sites.
 			Then(web.GoalSettings),
 	))
 
+	mux.HandleFunc("POST /{domain}/memberships/invite", db.Wrap("site.Invite")(
+		sites.
+			With(plug.VerifyCSRF).
+			Then(web.Invite),
+	))
+
+	mux.HandleFunc("POST /{domain}/memberships/role", db.Wrap("site.UpdateMemberRole")(
+		sites.
+			With(plug.VerifyCSRF).
+			Then(web.UpdateMemberRole),
+	))
+
+	mux.HandleFunc("POST /{domain}/memberships/remove", db.Wrap("site.RemoveMember")(
+		sites.
+			With(plug.VerifyCSRF).
+			Then(web.RemoveMember),
+	))
+
+	mux.HandleFunc("POST /{domain}/invitations/delete", db.Wrap("site.DeleteInvitation")(
+		sites.
+			With(plug.VerifyCSRF).
+			Then(web.DeleteInvitation),
+	))
+
 	mux.HandleFunc("/{domain}/delete", db.Wrap("site.Delete")(
 		sites.
+			With(web.RequireSiteRole(ops.RoleOwner)).
 			Then(web.Delete),
 	))
 
@@ -440,9 +757,24 @@ func run(ctx context.Context, c *cli.Command) error {
 			Then(conversions.Conversion),
 	))
 
+	mux.HandleFunc("GET /api/stats/{domain}/funnels/{id}", db.Wrap("api.Funnel")(
+		stats.
+			Then(web.Funnel),
+	))
+
+	mux.HandleFunc("GET /api/stats/{domain}/retention", db.Wrap("api.Retention")(
+		stats.
+			Then(web.Retention),
+	))
+
+	mux.HandleFunc("GET /api/stats/{domain}/paths/", db.Wrap("api.Paths")(
+		stats.
+			Then(web.Paths),
+	))
+
 	mux.HandleFunc("GET /api/stats/{domain}/custom-prop-values/{prop_key}/", db.Wrap("api.Props")(
 		stats.
-			Then(web.UnimplementedStat),
+			Then(web.CustomPropValues),
 	))
 
 	mux.HandleFunc("GET /api/stats/{domain}/suggestions/{filter_name}/", db.Wrap("api.Suggestions")(
@@ -450,7 +782,12 @@ func run(ctx context.Context, c *cli.Command) error {
 			Then(suggestions.Suggest),
 	))
 
-	statsAPI := plug.API().With(api.Authorize)
+	mux.HandleFunc("GET /api/stats/{domain}/export", db.Wrap("api.Export")(
+		stats.
+			Then(web.Export),
+	))
+
+	statsAPI := plug.API().With(api.Authorize(ops.ScopeStatsRead))
 
 	mux.HandleFunc("GET /api/v1/stats/realtime/visitors", db.Wrap("api.v1.CurrentVisitors")(
 		statsAPI.
@@ -472,6 +809,36 @@ func run(ctx context.Context, c *cli.Command) error {
 			Then(api.Timeseries),
 	))
 
+	mux.HandleFunc("GET /api/v1/stats/funnel", db.Wrap("api.v1.Funnel")(
+		statsAPI.
+			Then(api.Funnel),
+	))
+
+	mux.HandleFunc("GET /api/v1/stats/retention", db.Wrap("api.v1.Retention")(
+		statsAPI.
+			Then(api.Retention),
+	))
+
+	mux.HandleFunc("GET /api/v1/stats/paths", db.Wrap("api.v1.Paths")(
+		statsAPI.
+			Then(api.Paths),
+	))
+
+	mux.HandleFunc("GET /api/v1/export/events", db.Wrap("api.v1.ExportEvents")(
+		statsAPI.
+			Then(api.ExportEvents),
+	))
+
+	mux.HandleFunc("POST /api/v1/events", db.Wrap("api.v1.Events")(
+		plug.API().With(api.AuthorizeKey(ops.ScopeEventsWrite)).
+			Then(api.Events),
+	))
+
+	mux.HandleFunc("GET /api/v1/backup", db.Wrap("api.v1.Backup")(
+		plug.API().With(api.AuthorizeKey(ops.ScopeBackup)).
+			Then(api.Backup),
+	))
+
 	mux.HandleFunc("/api/event", db.Wrap("api.Event")(web.Event))
 
 	svr := &http.Server{
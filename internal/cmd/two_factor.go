@@ -0,0 +1,51 @@
+package cmd
+
+import (
+	"context"
+	"log/slog"
+
+	"github.com/urfave/cli/v3"
+	v1 "github.com/vinceanalytics/vince/gen/go/vince/v1"
+	"github.com/vinceanalytics/vince/internal/ops"
+	"github.com/vinceanalytics/vince/internal/shards"
+)
+
+var resetTwoFactor = &cli.Command{
+	Name:  "reset-2fa",
+	Usage: "Removes two-factor authentication from a locked out account",
+	Flags: []cli.Flag{
+		&cli.StringFlag{
+			Name:    "data",
+			Usage:   "directory to store data",
+			Sources: cli.EnvVars("VINCE_DATA"),
+			Value:   "vince-data",
+		},
+		&cli.StringFlag{
+			Name:     "name",
+			Usage:    "account name",
+			Required: true,
+		},
+	},
+	Action: func(ctx context.Context, c *cli.Command) error {
+		db, err := shards.New(c.String("data"))
+		if err != nil {
+			return err
+		}
+		defer db.Close()
+
+		err = ops.ResetTwoFactor(db.Get(), c.String("name"))
+		if err != nil {
+			return err
+		}
+		err = ops.Audit(db.Get(), &v1.AuditEntry{
+			Actor:  "cli",
+			Action: ops.AuditTwoFactorReset,
+			Target: c.String("name"),
+		})
+		if err != nil {
+			return err
+		}
+		slog.Info("successfully reset two-factor authentication", "name", c.String("name"))
+		return nil
+	},
+}
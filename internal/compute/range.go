@@ -52,7 +52,7 @@ func ByMinute(start, end time.Time) iter.Seq[time.Time] {
 
 func ByHour(start, end time.Time) iter.Seq[time.Time] {
 	return func(yield func(time.Time) bool) {
-		t := end.Truncate(time.Hour).Add(time.Hour)
+		t := Hour(end).Add(time.Hour)
 		for t.After(start) {
 			if !yield(t) {
 				return
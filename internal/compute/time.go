@@ -2,27 +2,32 @@ package compute
 
 import "time"
 
+// Time buckets are computed in the location of ts. Callers convert timestamps
+// to the site timezone first so that day, week and month boundaries align
+// with the site's local calendar.
+
 func Minute(ts time.Time) time.Time {
 	return ts.Truncate(time.Minute)
 }
 
 func Hour(ts time.Time) time.Time {
-	return ts.Truncate(time.Hour)
+	yy, mm, dd := ts.Date()
+	return time.Date(yy, mm, dd, ts.Hour(), 0, 0, 0, ts.Location())
 }
 
 func Date(ts time.Time) time.Time {
 	yy, mm, dd := ts.Date()
-	return time.Date(yy, mm, dd, 0, 0, 0, 0, time.UTC)
+	return time.Date(yy, mm, dd, 0, 0, 0, 0, ts.Location())
 }
 
 func Week(ts time.Time) time.Time {
 	yy, mm, dd := ts.Date()
-	t := time.Date(yy, mm, dd, 0, 0, 0, 0, time.UTC)
+	t := time.Date(yy, mm, dd, 0, 0, 0, 0, ts.Location())
 	t = t.AddDate(0, 0, -int(t.Weekday()))
 	return t
 }
 
 func Month(ts time.Time) time.Time {
 	yy, mm, _ := ts.Date()
-	return time.Date(yy, mm, 1, 0, 0, 0, 0, time.UTC)
+	return time.Date(yy, mm, 1, 0, 0, 0, 0, ts.Location())
 }
@@ -0,0 +1,58 @@
+// Package currency converts revenue amounts between currencies using a locally
+// configured rate table.
+package currency
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"strings"
+)
+
+// Rates maps ISO 4217 currency codes to the amount of that currency equivalent
+// to one unit of a common base currency. The base currency itself is not
+// important as long as all rates are relative to it.
+type Rates map[string]float64
+
+// Load reads rates from a JSON object of currency code to rate.
+func Load(path string) (Rates, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	var r Rates
+	err = json.Unmarshal(data, &r)
+	if err != nil {
+		return nil, fmt.Errorf("decoding currency rates %w", err)
+	}
+	o := make(Rates, len(r))
+	for k, v := range r {
+		if !Valid(k) || v <= 0 {
+			return nil, fmt.Errorf("invalid currency rate %q=%v", k, v)
+		}
+		o[k] = v
+	}
+	return o, nil
+}
+
+// Convert converts amount in from currency to to currency. Returns false when
+// either rate is missing.
+func (r Rates) Convert(amount float64, from, to string) (float64, bool) {
+	if from == to {
+		return amount, true
+	}
+	a, ok := r[from]
+	if !ok {
+		return 0, false
+	}
+	b, ok := r[to]
+	if !ok {
+		return 0, false
+	}
+	return amount / a * b, true
+}
+
+// Valid returns true if code looks like ISO 4217 currency code.
+func Valid(code string) bool {
+	return len(code) == 3 && strings.Trim(code, "ABCDEFGHIJKLMNOPQRSTUVWXYZ") == ""
+}
@@ -0,0 +1,31 @@
+package currency
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestConvert(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "rates.json")
+	require.NoError(t, os.WriteFile(path, []byte(`{"USD":1,"EUR":0.5}`), 0600))
+	r, err := Load(path)
+	require.NoError(t, err)
+
+	v, ok := r.Convert(10, "EUR", "USD")
+	require.True(t, ok)
+	require.Equal(t, 20.0, v)
+
+	v, ok = r.Convert(10, "GBP", "GBP")
+	require.True(t, ok)
+	require.Equal(t, 10.0, v)
+
+	_, ok = r.Convert(10, "GBP", "USD")
+	require.False(t, ok)
+
+	require.True(t, Valid("USD"))
+	require.False(t, Valid("usd"))
+	require.False(t, Valid("US"))
+}
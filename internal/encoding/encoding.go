@@ -62,6 +62,10 @@ func (k *Key) WriteExistence(res Resolution, field models.Field, view, domainId,
 	binary.BigEndian.PutUint64(k[bmContainer:], co)
 }
 
+func (k *Key) View() uint64 {
+	return binary.BigEndian.Uint64(k[bmView:])
+}
+
 func (k *Key) Component() (field models.Field, co uint64) {
 	field = models.Field(k[bmField])
 	co = binary.BigEndian.Uint64(k[bmContainer:])
@@ -97,6 +101,39 @@ func APIKeyHash(hash []byte) []byte {
 	return o
 }
 
+func User(name []byte) []byte {
+	o := make([]byte, 2+len(name))
+	copy(o, keys.UserPrefix)
+	copy(o[2:], name)
+	return o
+}
+
+func Invitation(id []byte) []byte {
+	o := make([]byte, 2+len(id))
+	copy(o, keys.InvitationPrefix)
+	copy(o[2:], id)
+	return o
+}
+
+func TwoFactor(name []byte) []byte {
+	o := make([]byte, 2+len(name))
+	copy(o, keys.TwoFactorPrefix)
+	copy(o[2:], name)
+	return o
+}
+
+func Audit(ts uint64) []byte {
+	o := make([]byte, 2, 10)
+	copy(o, keys.AuditPrefix)
+	return num(o, ts)
+}
+
+func Purge(domainId uint64) []byte {
+	o := make([]byte, 2, 10)
+	copy(o, keys.PurgePrefix)
+	return num(o, domainId)
+}
+
 func ACME(key []byte) []byte {
 	o := make([]byte, 2+len(key))
 	copy(o, keys.AcmePrefix)
@@ -56,6 +56,20 @@ func TestPrefix(t *testing.T) {
 				return APIKeyHash([]byte("test"))
 			},
 		},
+		{
+			"audit",
+			keys.AuditPrefix,
+			func() []byte {
+				return Audit(1)
+			},
+		},
+		{
+			"purge",
+			keys.PurgePrefix,
+			func() []byte {
+				return Purge(1)
+			},
+		},
 		{
 			"acme",
 			keys.AcmePrefix,
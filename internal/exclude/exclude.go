@@ -0,0 +1,139 @@
+// Package exclude decides which events of a site are dropped before they are
+// recorded.
+package exclude
+
+import (
+	"fmt"
+	"net/netip"
+	"regexp"
+	"strings"
+
+	v1 "github.com/vinceanalytics/vince/gen/go/vince/v1"
+)
+
+// Reason is why an event was excluded.
+type Reason int
+
+const (
+	None Reason = iota
+	IP
+	Path
+	Host
+	Cookie
+)
+
+// Reasons is the number of reasons events are excluded for, excluding None.
+const Reasons = int(Cookie)
+
+var names = [...]string{"", "ip", "path", "host", "cookie"}
+
+func (r Reason) String() string {
+	return names[r]
+}
+
+// Rules are compiled site exclusions.
+type Rules struct {
+	ips    []netip.Prefix
+	paths  []*regexp.Regexp
+	hosts  []string
+	cookie string
+}
+
+// Compile validates and compiles e. A nil e compiles to rules matching
+// nothing.
+func Compile(e *v1.Exclusions) (*Rules, error) {
+	r := &Rules{cookie: e.GetCookie()}
+	for _, s := range e.GetIps() {
+		p, err := netip.ParsePrefix(s)
+		if err != nil {
+			a, aerr := netip.ParseAddr(s)
+			if aerr != nil {
+				return nil, fmt.Errorf("invalid ip range %q", s)
+			}
+			p = netip.PrefixFrom(a.Unmap(), a.Unmap().BitLen())
+		}
+		r.ips = append(r.ips, p.Masked())
+	}
+	for _, s := range e.GetPaths() {
+		if !strings.HasPrefix(s, "/") {
+			return nil, fmt.Errorf("invalid path %q, paths start with /", s)
+		}
+		re, err := glob(s)
+		if err != nil {
+			return nil, fmt.Errorf("invalid path %q", s)
+		}
+		r.paths = append(r.paths, re)
+	}
+	for _, s := range e.GetHosts() {
+		if s == "" || strings.ContainsAny(s, "/:") || strings.Contains(s[1:], "*") ||
+			(s[0] == '*' && !strings.HasPrefix(s, "*.")) {
+			return nil, fmt.Errorf("invalid hostname %q", s)
+		}
+		r.hosts = append(r.hosts, strings.ToLower(s))
+	}
+	return r, nil
+}
+
+// glob compiles a path pattern where * matches within a path segment and **
+// matches across segments.
+func glob(pattern string) (*regexp.Regexp, error) {
+	var b strings.Builder
+	b.WriteByte('^')
+	for i := 0; i < len(pattern); i++ {
+		if pattern[i] != '*' {
+			b.WriteString(regexp.QuoteMeta(pattern[i : i+1]))
+			continue
+		}
+		if i+1 < len(pattern) && pattern[i+1] == '*' {
+			b.WriteString(".*")
+			i++
+			continue
+		}
+		b.WriteString("[^/]*")
+	}
+	b.WriteByte('$')
+	return regexp.Compile(b.String())
+}
+
+// Match returns why an event from ip to host and path is excluded. cookie
+// reports whether the event request carried a cookie, it is nil for events
+// that were not sent by a browser.
+func (r *Rules) Match(ip, host, path string, cookie func(name string) bool) Reason {
+	if len(r.ips) > 0 {
+		if a, err := netip.ParseAddr(ip); err == nil {
+			a = a.Unmap()
+			for _, p := range r.ips {
+				if p.Contains(a) {
+					return IP
+				}
+			}
+		}
+	}
+	for _, re := range r.paths {
+		if re.MatchString(path) {
+			return Path
+		}
+	}
+	if len(r.hosts) > 0 && !r.allowHost(strings.ToLower(host)) {
+		return Host
+	}
+	if r.cookie != "" && cookie != nil && cookie(r.cookie) {
+		return Cookie
+	}
+	return None
+}
+
+func (r *Rules) allowHost(host string) bool {
+	for _, h := range r.hosts {
+		if suffix, ok := strings.CutPrefix(h, "*"); ok {
+			if strings.HasSuffix(host, suffix) {
+				return true
+			}
+			continue
+		}
+		if host == h {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,57 @@
+package exclude
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	v1 "github.com/vinceanalytics/vince/gen/go/vince/v1"
+)
+
+func TestMatch(t *testing.T) {
+	r, err := Compile(&v1.Exclusions{
+		Ips:    []string{"10.0.0.0/8", "2001:db8::1"},
+		Paths:  []string{"/admin/**", "/preview/*/draft"},
+		Hosts:  []string{"example.com", "*.example.com"},
+		Cookie: "vince_ignore",
+	})
+	require.NoError(t, err)
+	has := func(name string) bool { return name == "vince_ignore" }
+
+	cases := []struct {
+		ip, host, path string
+		cookie         func(string) bool
+		want           Reason
+	}{
+		{"1.2.3.4", "example.com", "/", nil, None},
+		{"10.1.2.3", "example.com", "/", nil, IP},
+		{"2001:db8::1", "example.com", "/", nil, IP},
+		{"-", "example.com", "/", nil, None},
+		{"1.2.3.4", "example.com", "/admin/users/1", nil, Path},
+		{"1.2.3.4", "example.com", "/admin", nil, None},
+		{"1.2.3.4", "example.com", "/preview/1/draft", nil, Path},
+		{"1.2.3.4", "example.com", "/preview/1/2/draft", nil, None},
+		{"1.2.3.4", "blog.Example.com", "/", nil, None},
+		{"1.2.3.4", "staging-example.com", "/", nil, Host},
+		{"1.2.3.4", "example.com", "/", has, Cookie},
+	}
+	for _, c := range cases {
+		require.Equal(t, c.want, r.Match(c.ip, c.host, c.path, c.cookie), "%s %s %s", c.ip, c.host, c.path)
+	}
+
+	none, err := Compile(nil)
+	require.NoError(t, err)
+	require.Equal(t, None, none.Match("10.0.0.1", "example.com", "/admin/x", has))
+}
+
+func TestCompile(t *testing.T) {
+	for _, e := range []*v1.Exclusions{
+		{Ips: []string{"10.0.0.0/33"}},
+		{Ips: []string{"office"}},
+		{Paths: []string{"admin/**"}},
+		{Hosts: []string{"example.com/path"}},
+		{Hosts: []string{"staging*.example.com"}},
+	} {
+		_, err := Compile(e)
+		require.Error(t, err, "%v", e)
+	}
+}
@@ -0,0 +1,232 @@
+// Package export streams raw events reconstructed from the timeseries.
+package export
+
+import (
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"io"
+	"strconv"
+	"time"
+
+	"github.com/parquet-go/parquet-go"
+	"github.com/vinceanalytics/vince/internal/location"
+	"github.com/vinceanalytics/vince/internal/models"
+	"github.com/vinceanalytics/vince/internal/timeseries"
+)
+
+type Format string
+
+const (
+	CSV     Format = "csv"
+	Parquet Format = "parquet"
+)
+
+var ErrFormat = errors.New("export: unknown format, use csv or parquet")
+
+func ParseFormat(s string) (Format, error) {
+	switch f := Format(s); f {
+	case CSV, Parquet:
+		return f, nil
+	case "":
+		return CSV, nil
+	default:
+		return "", ErrFormat
+	}
+}
+
+func (f Format) ContentType() string {
+	if f == Parquet {
+		return "application/vnd.apache.parquet"
+	}
+	return "text/csv"
+}
+
+// Filename returns the default name of an export of domain.
+func (f Format) Filename(domain string, start, end time.Time) string {
+	return domain + "-" + start.Format(time.DateOnly) + "-" + end.Format(time.DateOnly) + "." + string(f)
+}
+
+// Row is an exported event. Timestamps are truncated to the minute.
+type Row struct {
+	Timestamp        time.Time         `parquet:"timestamp,timestamp(millisecond)"`
+	Domain           string            `parquet:"domain,dict"`
+	VisitorID        uint64            `parquet:"visitor_id"`
+	SessionID        uint64            `parquet:"session_id"`
+	Sequence         int64             `parquet:"sequence"`
+	Event            string            `parquet:"event,dict"`
+	Page             string            `parquet:"page,dict"`
+	Host             string            `parquet:"host,dict"`
+	EntryPage        string            `parquet:"entry_page,dict"`
+	ExitPage         string            `parquet:"exit_page,dict"`
+	Referrer         string            `parquet:"referrer,dict"`
+	Source           string            `parquet:"source,dict"`
+	UtmSource        string            `parquet:"utm_source,dict"`
+	UtmMedium        string            `parquet:"utm_medium,dict"`
+	UtmCampaign      string            `parquet:"utm_campaign,dict"`
+	UtmContent       string            `parquet:"utm_content,dict"`
+	UtmTerm          string            `parquet:"utm_term,dict"`
+	Browser          string            `parquet:"browser,dict"`
+	BrowserVersion   string            `parquet:"browser_version,dict"`
+	Os               string            `parquet:"os,dict"`
+	OsVersion        string            `parquet:"os_version,dict"`
+	Device           string            `parquet:"device,dict"`
+	Country          string            `parquet:"country,dict"`
+	Subdivision1Code string            `parquet:"subdivision1_code,dict"`
+	Subdivision2Code string            `parquet:"subdivision2_code,dict"`
+	City             string            `parquet:"city,dict"`
+	View             bool              `parquet:"view"`
+	Session          bool              `parquet:"session"`
+	Bounce           *bool             `parquet:"bounce,optional"`
+	DurationMs       int64             `parquet:"duration_ms"`
+	Revenue          float64           `parquet:"revenue"`
+	Props            map[string]string `parquet:"props"`
+}
+
+// header lists csv columns in the same order as Row fields.
+var header = []string{
+	"timestamp", "domain", "visitor_id", "session_id", "sequence", "event",
+	"page", "host", "entry_page", "exit_page", "referrer", "source",
+	"utm_source", "utm_medium", "utm_campaign", "utm_content", "utm_term",
+	"browser", "browser_version", "os", "os_version", "device", "country",
+	"subdivision1_code", "subdivision2_code", "city", "view", "session",
+	"bounce", "duration_ms", "revenue", "props",
+}
+
+func newRow(lo *location.Location, m *models.Model) *Row {
+	r := &Row{
+		Timestamp:        time.UnixMilli(m.Timestamp).UTC(),
+		Domain:           string(m.Domain),
+		VisitorID:        m.Id,
+		SessionID:        m.SessionId,
+		Sequence:         m.Sequence,
+		Event:            string(m.Event),
+		Page:             string(m.Page),
+		Host:             string(m.Host),
+		EntryPage:        string(m.EntryPage),
+		ExitPage:         string(m.ExitPage),
+		Referrer:         string(m.Referrer),
+		Source:           string(m.Source),
+		UtmSource:        string(m.UtmSource),
+		UtmMedium:        string(m.UtmMedium),
+		UtmCampaign:      string(m.UtmCampaign),
+		UtmContent:       string(m.UtmContent),
+		UtmTerm:          string(m.UtmTerm),
+		Browser:          string(m.Browser),
+		BrowserVersion:   string(m.BrowserVersion),
+		Os:               string(m.Os),
+		OsVersion:        string(m.OsVersion),
+		Device:           string(m.Device),
+		Country:          string(m.Country),
+		Subdivision1Code: string(m.Subdivision1Code),
+		Subdivision2Code: string(m.Subdivision2Code),
+		View:             m.View,
+		Session:          m.Session,
+		DurationMs:       time.Duration(m.Duration).Milliseconds(),
+		Revenue:          float64(m.Revenue) / models.RevenueScale,
+	}
+	if m.City != 0 {
+		r.City = lo.GetCity(m.City).Name
+	}
+	if m.Bounce != 0 {
+		bounce := m.Bounce == 1
+		r.Bounce = &bounce
+	}
+	if len(m.Props) > 0 {
+		r.Props = make(map[string]string, len(m.Props))
+		for _, p := range m.Props {
+			k, v := models.SplitProp(p)
+			r.Props[string(k)] = string(v)
+		}
+	}
+	return r
+}
+
+func (r *Row) record(o []string) []string {
+	o = append(o[:0],
+		r.Timestamp.Format(time.RFC3339), r.Domain,
+		strconv.FormatUint(r.VisitorID, 10), strconv.FormatUint(r.SessionID, 10),
+		strconv.FormatInt(r.Sequence, 10), r.Event, r.Page, r.Host,
+		r.EntryPage, r.ExitPage, r.Referrer, r.Source,
+		r.UtmSource, r.UtmMedium, r.UtmCampaign, r.UtmContent, r.UtmTerm,
+		r.Browser, r.BrowserVersion, r.Os, r.OsVersion, r.Device, r.Country,
+		r.Subdivision1Code, r.Subdivision2Code, r.City,
+		strconv.FormatBool(r.View), strconv.FormatBool(r.Session),
+	)
+	if r.Bounce != nil {
+		o = append(o, strconv.FormatBool(*r.Bounce))
+	} else {
+		o = append(o, "")
+	}
+	o = append(o,
+		strconv.FormatInt(r.DurationMs, 10),
+		strconv.FormatFloat(r.Revenue, 'f', -1, 64),
+	)
+	if len(r.Props) > 0 {
+		props, _ := json.Marshal(r.Props)
+		o = append(o, string(props))
+	} else {
+		o = append(o, "")
+	}
+	return o
+}
+
+type writer interface {
+	Write(r *Row) error
+	Close() error
+}
+
+type csvWriter struct {
+	w      *csv.Writer
+	record []string
+}
+
+func (c *csvWriter) Write(r *Row) error {
+	c.record = r.record(c.record)
+	return c.w.Write(c.record)
+}
+
+func (c *csvWriter) Close() error {
+	c.w.Flush()
+	return c.w.Error()
+}
+
+type parquetWriter struct {
+	w *parquet.GenericWriter[Row]
+}
+
+func (p *parquetWriter) Write(r *Row) error {
+	_, err := p.w.Write([]Row{*r})
+	return err
+}
+
+func (p *parquetWriter) Close() error {
+	return p.w.Close()
+}
+
+func newWriter(w io.Writer, f Format) (writer, error) {
+	if f == Parquet {
+		return &parquetWriter{w: parquet.NewGenericWriter[Row](w, parquet.Compression(&parquet.Zstd))}, nil
+	}
+	c := csv.NewWriter(w)
+	err := c.Write(header)
+	if err != nil {
+		return nil, err
+	}
+	return &csvWriter{w: c}, nil
+}
+
+// Events writes events of domain recorded in [start, end) to w and returns
+// how many were written.
+func Events(ctx context.Context, ts *timeseries.Timeseries, domain string, start, end time.Time, f Format, w io.Writer) (n int, err error) {
+	o, err := newWriter(w, f)
+	if err != nil {
+		return 0, err
+	}
+	err = ts.Export(ctx, domain, start, end, func(m *models.Model) error {
+		n++
+		return o.Write(newRow(ts.Location(), m))
+	})
+	return n, errors.Join(err, o.Close())
+}
@@ -0,0 +1,79 @@
+package export
+
+import (
+	"bytes"
+	"context"
+	"encoding/csv"
+	"testing"
+	"time"
+
+	"github.com/parquet-go/parquet-go"
+	"github.com/stretchr/testify/require"
+	"github.com/vinceanalytics/vince/internal/models"
+	"github.com/vinceanalytics/vince/internal/timeseries/timeseriestest"
+)
+
+func TestEvents(t *testing.T) {
+	day := time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC)
+	at := day.Add(10*time.Hour + 30*time.Minute + 15*time.Second)
+	events := []*models.Model{
+		{
+			Timestamp: at.UnixMilli(), Id: 7, SessionId: 70, Sequence: 1,
+			Domain: []byte("a.com"), Page: []byte("/"), Host: []byte("a.com"),
+			Browser: []byte("Firefox"), Country: []byte("DE"), View: true, Session: true, Bounce: 1,
+		},
+		{
+			Timestamp: at.Add(time.Minute).UnixMilli(), Id: 7, SessionId: 70,
+			Domain: []byte("a.com"), Event: []byte("Signup"), Revenue: 1250,
+			Props: [][]byte{models.Prop([]byte("plan"), []byte("pro"))},
+		},
+		{
+			Timestamp: at.UnixMilli(), Id: 8, Domain: []byte("b.com"), Page: []byte("/"), View: true,
+		},
+		{
+			Timestamp: day.AddDate(0, 0, 1).UnixMilli(), Id: 9, Domain: []byte("a.com"), View: true,
+		},
+	}
+	_, ts := timeseriestest.New(t, events...)
+
+	t.Run("csv", func(t *testing.T) {
+		var b bytes.Buffer
+		n, err := Events(context.Background(), ts, "a.com", day, day.AddDate(0, 0, 1), CSV, &b)
+		require.NoError(t, err)
+		require.Equal(t, 2, n)
+		records, err := csv.NewReader(&b).ReadAll()
+		require.NoError(t, err)
+		require.Len(t, records, 3)
+		require.Equal(t, header, records[0])
+		row := map[string]string{}
+		for i, h := range header {
+			row[h] = records[1][i]
+		}
+		require.Equal(t, "2024-03-01T10:30:00Z", row["timestamp"])
+		require.Equal(t, "7", row["visitor_id"])
+		require.Equal(t, "/", row["page"])
+		require.Equal(t, "Firefox", row["browser"])
+		require.Equal(t, "true", row["view"])
+		require.Equal(t, "true", row["bounce"])
+		for i, h := range header {
+			row[h] = records[2][i]
+		}
+		require.Equal(t, "Signup", row["event"])
+		require.Equal(t, "12.5", row["revenue"])
+		require.Equal(t, `{"plan":"pro"}`, row["props"])
+		require.Equal(t, "", row["bounce"])
+	})
+	t.Run("parquet", func(t *testing.T) {
+		var b bytes.Buffer
+		n, err := Events(context.Background(), ts, "a.com", day, day.AddDate(0, 0, 2), Parquet, &b)
+		require.NoError(t, err)
+		require.Equal(t, 3, n)
+		rows, err := parquet.Read[Row](bytes.NewReader(b.Bytes()), int64(b.Len()))
+		require.NoError(t, err)
+		require.Len(t, rows, 3)
+		require.Equal(t, uint64(70), rows[0].SessionID)
+		require.Equal(t, "Signup", rows[1].Event)
+		require.Equal(t, map[string]string{"plan": "pro"}, rows[1].Props)
+		require.Equal(t, uint64(9), rows[2].VisitorID)
+	})
+}
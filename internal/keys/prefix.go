@@ -24,6 +24,12 @@ var (
 	APIKeyNamePrefix   = []byte{ops, 0x03}
 	APIKeyHashPrefix   = []byte{ops, 0x04}
 	AdminPrefix        = []byte{ops, 0x05}
+	UserPrefix         = []byte{ops, 0x06}
+	InvitationPrefix   = []byte{ops, 0x07}
+	TwoFactorPrefix    = []byte{ops, 0x08}
+	AuditPrefix        = []byte{ops, 0x09}
+	PurgePrefix        = []byte{ops, 0x0a}
+	Salts              = []byte{ops, 0x0b}
 	TranslateKeyPrefix = []byte{tr, 0x00}
 	TranslateIDPrefix  = []byte{tr, 0x01}
 	TranslateSeqPrefix = []byte{tr, 0x02}
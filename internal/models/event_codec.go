@@ -0,0 +1,160 @@
+package models
+
+import (
+	"encoding/binary"
+	"errors"
+)
+
+var errCorrupt = errors.New("models: corrupt encoding")
+
+func (m *Model) bytesFields() [21]*[]byte {
+	return [...]*[]byte{
+		&m.ExitPage, &m.UtmTerm, &m.EntryPage, &m.Subdivision1Code, &m.Event,
+		&m.UtmSource, &m.UtmMedium, &m.Browser, &m.BrowserVersion, &m.Country,
+		&m.Device, &m.Domain, &m.Subdivision2Code, &m.UtmContent, &m.Page,
+		&m.Host, &m.Os, &m.OsVersion, &m.UtmCampaign, &m.Referrer, &m.Source,
+	}
+}
+
+// Marshal appends binary encoding of m to b.
+func (m *Model) Marshal(b []byte) []byte {
+	for _, f := range m.bytesFields() {
+		b = appendBytes(b, *f)
+	}
+	b = binary.AppendUvarint(b, uint64(len(m.Props)))
+	for _, p := range m.Props {
+		b = appendBytes(b, p)
+	}
+	b = binary.AppendVarint(b, m.Timestamp)
+	b = binary.AppendVarint(b, m.Duration)
+	b = binary.AppendVarint(b, m.Revenue)
+	b = binary.AppendVarint(b, m.Sequence)
+	b = binary.AppendUvarint(b, m.Id)
+	b = binary.AppendUvarint(b, m.SessionId)
+	b = binary.AppendUvarint(b, uint64(m.City))
+	b = binary.AppendUvarint(b, m.PreviousId)
+	return append(b, byte(m.Bounce), flag(m.View), flag(m.Session))
+}
+
+// Unmarshal decodes m from data encoded with Marshal. Byte slices of m
+// reference data.
+func (m *Model) Unmarshal(data []byte) error {
+	d := decoder{data: data}
+	for _, f := range m.bytesFields() {
+		*f = d.bytes()
+	}
+	switch n := d.uvarint(); {
+	case n > uint64(len(d.data)):
+		d.err = errCorrupt
+	case n > 0:
+		m.Props = make([][]byte, n)
+		for i := range m.Props {
+			m.Props[i] = d.bytes()
+		}
+	}
+	m.Timestamp = d.varint()
+	m.Duration = d.varint()
+	m.Revenue = d.varint()
+	m.Sequence = d.varint()
+	m.Id = d.uvarint()
+	m.SessionId = d.uvarint()
+	m.City = uint32(d.uvarint())
+	m.PreviousId = d.uvarint()
+	if d.err != nil || len(d.data) != 3 {
+		return errCorrupt
+	}
+	m.Bounce = int8(d.data[0])
+	m.View = d.data[1] == 1
+	m.Session = d.data[2] == 1
+	return nil
+}
+
+// Marshal appends binary encoding of c to b.
+func (c *Cached) Marshal(b []byte) []byte {
+	b = binary.AppendVarint(b, c.Start)
+	b = binary.AppendUvarint(b, c.Session)
+	b = binary.AppendVarint(b, c.Sequence)
+	b = appendBytes(b, c.EntryPage)
+	b = appendBytes(b, c.Host)
+	b = appendBytes(b, c.ExitPage)
+	b = binary.AppendVarint(b, c.Timestamp)
+	return append(b, byte(c.Bounce))
+}
+
+// Unmarshal decodes c from data encoded with Marshal. Byte slices of c
+// reference data.
+func (c *Cached) Unmarshal(data []byte) error {
+	d := decoder{data: data}
+	c.Start = d.varint()
+	c.Session = d.uvarint()
+	c.Sequence = d.varint()
+	c.EntryPage = d.bytes()
+	c.Host = d.bytes()
+	c.ExitPage = d.bytes()
+	c.Timestamp = d.varint()
+	if d.err != nil || len(d.data) != 1 {
+		return errCorrupt
+	}
+	c.Bounce = int8(d.data[0])
+	return nil
+}
+
+func flag(v bool) byte {
+	if v {
+		return 1
+	}
+	return 0
+}
+
+func appendBytes(b, v []byte) []byte {
+	b = binary.AppendUvarint(b, uint64(len(v)))
+	return append(b, v...)
+}
+
+type decoder struct {
+	data []byte
+	err  error
+}
+
+func (d *decoder) uvarint() uint64 {
+	if d.err != nil {
+		return 0
+	}
+	v, n := binary.Uvarint(d.data)
+	if n <= 0 {
+		d.err = errCorrupt
+		return 0
+	}
+	d.data = d.data[n:]
+	return v
+}
+
+func (d *decoder) varint() int64 {
+	if d.err != nil {
+		return 0
+	}
+	v, n := binary.Varint(d.data)
+	if n <= 0 {
+		d.err = errCorrupt
+		return 0
+	}
+	d.data = d.data[n:]
+	return v
+}
+
+func (d *decoder) bytes() []byte {
+	n := d.uvarint()
+	if d.err != nil {
+		return nil
+	}
+	if n > uint64(len(d.data)) {
+		d.err = errCorrupt
+		return nil
+	}
+	if n == 0 {
+		return nil
+	}
+	v := d.data[:n:n]
+	d.data = d.data[n:]
+	return v
+}
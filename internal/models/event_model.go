@@ -1,6 +1,9 @@
 package models
 
 import (
+	"encoding/binary"
+	"hash/fnv"
+	"math"
 	"time"
 
 	"github.com/vinceanalytics/vince/internal/util/xtime"
@@ -29,17 +32,27 @@ type Model struct {
 	UtmCampaign      []byte
 	Referrer         []byte
 	Source           []byte
+	Props            [][]byte
 	Timestamp        int64
 	Duration         int64
+	Revenue          int64
+	Sequence         int64
 	Id               uint64
+	SessionId        uint64
 	City             uint32
 	Bounce           int8
 	View             bool
 	Session          bool
+
+	// PreviousId is the visitor id hashed with the previous salt. It is never
+	// stored, sessions started before a salt rotation are looked up with it.
+	PreviousId uint64
 }
 
 type Cached struct {
 	Start     int64
+	Session   uint64
+	Sequence  int64
 	EntryPage []byte
 	Host      []byte
 	ExitPage  []byte
@@ -54,17 +67,62 @@ func (m *Model) Cached() *Cached {
 		Timestamp: m.Timestamp,
 		Start:     m.Timestamp,
 		Bounce:    m.Bounce,
+		Session:   m.SessionId,
+		Sequence:  m.Sequence,
 	}
 }
 
+// StartSession marks m as the first event of a new session. Pageviews are
+// numbered from 1 within a session, other events keep sequence 0.
+func (m *Model) StartSession() {
+	m.SessionId = SessionID(m.Id, m.Timestamp)
+	m.Sequence = 0
+	if m.View {
+		m.Sequence = 1
+	}
+}
+
+// ImportedVisitor is set on ids of visitors created when importing aggregated
+// stats. Hashed visitor ids never set it so the two can not collide, visitor
+// ids are stored as bsi values and must stay positive.
+const ImportedVisitor = 1 << 62
+
+// VisitorID returns a positive visitor id from hash h that never has
+// ImportedVisitor set.
+func VisitorID(h uint64) uint64 {
+	return h & (ImportedVisitor - 1)
+}
+
+// SessionID derives a session id from visitor id and session start timestamp.
+// It is always positive so that it can be stored as a bsi value.
+func SessionID(id uint64, start int64) uint64 {
+	h := fnv.New64a()
+	var b [16]byte
+	binary.BigEndian.PutUint64(b[:], id)
+	binary.BigEndian.PutUint64(b[8:], uint64(start))
+	h.Write(b[:])
+	return h.Sum64() & math.MaxInt64
+}
+
 var maxSession = (15 * time.Minute).Milliseconds()
 
+// Expired returns true if an event at timestamp ts starts a new session.
+func (c *Cached) Expired(ts int64) bool {
+	return ts-c.Start >= maxSession
+}
+
 func (m *Model) Update(session *Cached) *Cached {
 	// check if the session has already expied
-	if m.Timestamp-session.Start >= maxSession {
+	if session.Expired(m.Timestamp) {
 		//drop existing session and create a new on
+		m.StartSession()
 		return m.Cached()
 	}
+	m.SessionId = session.Session
+	if m.View {
+		session.Sequence++
+		m.Sequence = session.Sequence
+	}
 	if session.Bounce == 1 {
 		session.Bounce, m.Bounce = -1, -1
 	} else {
@@ -33,6 +33,10 @@ const (
 	Field_id                Field = 26
 	Field_bounce            Field = 27
 	Field_duration          Field = 28
+	Field_props             Field = 29
+	Field_revenue           Field = 30
+	Field_session_id        Field = 31
+	Field_sequence          Field = 32
 )
 
 var (
@@ -66,6 +70,10 @@ var (
 		Field_id:                "id",
 		Field_bounce:            "bounce",
 		Field_duration:          "duration",
+		Field_props:             "props",
+		Field_revenue:           "revenue",
+		Field_session_id:        "session_id",
+		Field_sequence:          "sequence",
 	}
 	Field_value = map[string]Field{
 		"unknown":           Field_unknown,
@@ -97,6 +105,10 @@ var (
 		"id":                Field_id,
 		"bounce":            Field_bounce,
 		"duration":          Field_duration,
+		"props":             Field_props,
+		"revenue":           Field_revenue,
+		"session_id":        Field_session_id,
+		"sequence":          Field_sequence,
 	}
 )
 
@@ -1,11 +1,15 @@
 package models
 
+// Field_props, Field_revenue, Field_session_id and Field_sequence are appended
+// after bsi fields to keep existing field ids stable. Translated fields are the
+// string fields up to Field_subdivision2_code followed by Field_props, use
+// Translated to index them.
 const (
 	MutexFieldSize       = Field_session + 1
-	TranslatedFieldsSize = Field_subdivision2_code + 1
+	TranslatedFieldsSize = Field_subdivision2_code + 2
 	SearchFieldSize      = Field_city + 1
 	BSIFieldsSize        = Field_duration - Field_timestamp + 1
-	AllFields            = Field_duration + 1
+	AllFields            = Field_sequence + 1
 )
 
 func (f Field) Mutex() byte {
@@ -16,6 +20,13 @@ func (f Field) BSI() byte {
 	return byte(f - Field_timestamp)
 }
 
+func (f Field) Translated() byte {
+	if f == Field_props {
+		return byte(Field_subdivision2_code + 1)
+	}
+	return byte(f)
+}
+
 func BSI(i int) Field {
 	return Field(i) + Field_timestamp
 }
@@ -24,6 +35,13 @@ func Mutex(i int) Field {
 	return Field(i)
 }
 
+func Translated(i int) Field {
+	if i == int(Field_subdivision2_code+1) {
+		return Field_props
+	}
+	return Field(i)
+}
+
 func DataForMetrics(m ...string) BitSet {
 	var s BitSet
 	for _, v := range m {
@@ -45,6 +63,8 @@ func DataForMetrics(m ...string) BitSet {
 			s.Set(Field_session)
 		case "events":
 			s.Set(Field_event)
+		case "total_revenue", "average_revenue":
+			s.Set(Field_revenue)
 		}
 	}
 	return s
@@ -26,12 +26,23 @@ func (v BitSet) Len() int {
 	return bits.OnesCount64(uint64(v))
 }
 
+// All returns fields set in v. Field_props is only scanned when breaking down
+// by custom properties, it is returned first so breakdowns see it before any
+// data field.
 func (v BitSet) All() (o []Field) {
 	o = make([]Field, 0, v.Len())
+	if v.Test(Field_props) {
+		o = append(o, Field_props)
+	}
 	for i := Field_domain; i <= Field_duration; i++ {
 		if v.Test(i) {
 			o = append(o, i)
 		}
 	}
+	for i := Field_revenue; i <= Field_sequence; i++ {
+		if v.Test(i) {
+			o = append(o, i)
+		}
+	}
 	return
 }
@@ -10,6 +10,10 @@ func TestTranslate(t *testing.T) {
 	for n := range int(MutexFieldSize) {
 		require.Equal(t, n, int(Mutex(n).Mutex()))
 	}
+	for n := range int(TranslatedFieldsSize) {
+		require.Equal(t, n, int(Translated(n).Translated()))
+	}
+	require.Equal(t, Field_props, Translated(int(TranslatedFieldsSize-1)))
 	for n := range int(BSIFieldsSize) {
 		require.Equal(t, n, int(BSI(n).BSI()))
 	}
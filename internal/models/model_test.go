@@ -2,11 +2,63 @@ package models
 
 import (
 	"testing"
+	"time"
 	"unsafe"
 
 	"github.com/stretchr/testify/require"
 )
 
 func TestSize(t *testing.T) {
-	require.Equal(t, 536, int(unsafe.Sizeof(Model{})))
+	require.Equal(t, 592, int(unsafe.Sizeof(Model{})))
+}
+
+func TestSessionSequence(t *testing.T) {
+	minute := time.Minute.Milliseconds()
+	first := &Model{Id: 1, Timestamp: minute, View: true}
+	first.StartSession()
+	require.NotZero(t, first.SessionId)
+	require.Equal(t, int64(1), first.Sequence)
+	session := first.Cached()
+
+	event := &Model{Id: 1, Timestamp: 2 * minute}
+	require.Nil(t, event.Update(session))
+	require.Equal(t, first.SessionId, event.SessionId)
+	require.Equal(t, int64(0), event.Sequence)
+
+	view := &Model{Id: 1, Timestamp: 3 * minute, View: true}
+	require.Nil(t, view.Update(session))
+	require.Equal(t, first.SessionId, view.SessionId)
+	require.Equal(t, int64(2), view.Sequence)
+
+	expired := &Model{Id: 1, Timestamp: 30 * minute, View: true}
+	next := expired.Update(session)
+	require.NotNil(t, next)
+	require.NotEqual(t, first.SessionId, expired.SessionId)
+	require.Equal(t, int64(1), expired.Sequence)
+	require.Equal(t, expired.SessionId, next.Session)
+}
+
+func TestModelMarshal(t *testing.T) {
+	m := &Model{
+		Domain:     []byte("vinceanalytics.com"),
+		Page:       []byte("/"),
+		Props:      [][]byte{Prop([]byte("plan"), []byte("pro"))},
+		Timestamp:  time.Minute.Milliseconds(),
+		Duration:   -1,
+		Id:         7,
+		SessionId:  9,
+		City:       10,
+		Bounce:     -1,
+		View:       true,
+		PreviousId: 11,
+	}
+	var o Model
+	require.NoError(t, o.Unmarshal(m.Marshal(nil)))
+	require.Equal(t, m, &o)
+	require.Error(t, o.Unmarshal(m.Marshal(nil)[:10]))
+
+	c := &Cached{Start: 1, Session: 2, Sequence: 3, EntryPage: []byte("/"), Timestamp: 4, Bounce: 1}
+	var oc Cached
+	require.NoError(t, oc.Unmarshal(c.Marshal(nil)))
+	require.Equal(t, c, &oc)
 }
@@ -0,0 +1,27 @@
+package models
+
+import "bytes"
+
+const propSep = 0x00
+
+// Prop encodes custom event property key and value. Encoded properties are
+// translated and stored under Field_props.
+func Prop(key, value []byte) []byte {
+	o := make([]byte, 0, len(key)+1+len(value))
+	o = append(o, key...)
+	o = append(o, propSep)
+	return append(o, value...)
+}
+
+// PropKey returns prefix shared by all encoded values of property key.
+func PropKey(key []byte) []byte {
+	o := make([]byte, 0, len(key)+1)
+	o = append(o, key...)
+	return append(o, propSep)
+}
+
+// SplitProp decodes property encoded with Prop.
+func SplitProp(prop []byte) (key, value []byte) {
+	key, value, _ = bytes.Cut(prop, []byte{propSep})
+	return
+}
@@ -0,0 +1,16 @@
+package models
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestProp(t *testing.T) {
+	prop := Prop([]byte("author"), []byte("john"))
+	require.True(t, bytes.HasPrefix(prop, PropKey([]byte("author"))))
+	key, value := SplitProp(prop)
+	require.Equal(t, "author", string(key))
+	require.Equal(t, "john", string(value))
+}
@@ -0,0 +1,5 @@
+package models
+
+// RevenueScale is the number of stored units per one unit of currency. Revenue
+// is stored as an integer amount in the site reporting currency.
+const RevenueScale = 100
@@ -0,0 +1,318 @@
+// Package oidc implements OpenID Connect authorization code flow with PKCE for
+// dashboard single sign-on.
+package oidc
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+)
+
+var (
+	ErrState  = errors.New("oidc: state mismatch")
+	ErrDomain = errors.New("oidc: email domain is not allowed")
+	ErrEmail  = errors.New("oidc: verified email claim is required")
+)
+
+// Config configures a Provider.
+type Config struct {
+	Issuer       string
+	ClientID     string
+	ClientSecret string
+	RedirectURL  string
+	// Scopes requested in addition to openid. Defaults to email, profile and groups.
+	Scopes []string
+	// EmailDomains restricts login to accounts with email in these domains. Empty
+	// allows any domain.
+	EmailDomains []string
+	// GroupsClaim is the ID token claim listing groups. Defaults to groups.
+	GroupsClaim string
+	Roles       []Mapping
+	Client      *http.Client
+}
+
+// Mapping grants Role on Domain to members of Group. Empty Domain applies to
+// all sites.
+type Mapping struct {
+	Group  string
+	Role   string
+	Domain string
+}
+
+// ParseMapping parses group:role or group:role:domain.
+func ParseMapping(s string) (m Mapping, err error) {
+	parts := strings.Split(s, ":")
+	if len(parts) < 2 || len(parts) > 3 || parts[0] == "" || parts[1] == "" {
+		err = fmt.Errorf("oidc: invalid role mapping %q expected group:role[:domain]", s)
+		return
+	}
+	m.Group, m.Role = parts[0], parts[1]
+	if len(parts) == 3 {
+		m.Domain = parts[2]
+	}
+	return
+}
+
+// Claims are ID token claims used by vince.
+type Claims struct {
+	Issuer            string   `json:"iss"`
+	Subject           string   `json:"sub"`
+	Audience          audience `json:"aud"`
+	Expiry            int64    `json:"exp"`
+	IssuedAt          int64    `json:"iat"`
+	Nonce             string   `json:"nonce"`
+	Email             string   `json:"email"`
+	EmailVerified     *bool    `json:"email_verified"`
+	PreferredUsername string   `json:"preferred_username"`
+	Groups            []string `json:"-"`
+}
+
+type audience []string
+
+func (a *audience) UnmarshalJSON(b []byte) error {
+	var s string
+	if json.Unmarshal(b, &s) == nil {
+		*a = audience{s}
+		return nil
+	}
+	var ls []string
+	err := json.Unmarshal(b, &ls)
+	*a = ls
+	return err
+}
+
+type metadata struct {
+	Issuer                string `json:"issuer"`
+	AuthorizationEndpoint string `json:"authorization_endpoint"`
+	TokenEndpoint         string `json:"token_endpoint"`
+	JWKSURI               string `json:"jwks_uri"`
+}
+
+// Provider talks to a single OpenID Connect identity provider. Discovery
+// and keys are fetched lazily and cached, so the provider can be created
+// before the identity provider is reachable.
+type Provider struct {
+	cfg Config
+	now func() time.Time
+	mu  sync.Mutex
+	md  *metadata
+	ks  keySet
+}
+
+func New(cfg Config) *Provider {
+	cfg.Issuer = strings.TrimSuffix(cfg.Issuer, "/")
+	if len(cfg.Scopes) == 0 {
+		cfg.Scopes = []string{"email", "profile", "groups"}
+	}
+	if cfg.GroupsClaim == "" {
+		cfg.GroupsClaim = "groups"
+	}
+	if cfg.Client == nil {
+		cfg.Client = &http.Client{Timeout: 10 * time.Second}
+	}
+	return &Provider{cfg: cfg, now: time.Now}
+}
+
+func (p *Provider) metadata(ctx context.Context) (*metadata, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if p.md != nil {
+		return p.md, nil
+	}
+	var md metadata
+	err := p.get(ctx, p.cfg.Issuer+"/.well-known/openid-configuration", &md)
+	if err != nil {
+		return nil, fmt.Errorf("oidc: discovery %w", err)
+	}
+	if strings.TrimSuffix(md.Issuer, "/") != p.cfg.Issuer {
+		return nil, fmt.Errorf("oidc: discovery issuer %q does not match %q", md.Issuer, p.cfg.Issuer)
+	}
+	p.md = &md
+	return p.md, nil
+}
+
+func (p *Provider) get(ctx context.Context, u string, o any) error {
+	r, err := http.NewRequestWithContext(ctx, http.MethodGet, u, nil)
+	if err != nil {
+		return err
+	}
+	res, err := p.cfg.Client.Do(r)
+	if err != nil {
+		return err
+	}
+	defer res.Body.Close()
+	if res.StatusCode != http.StatusOK {
+		return fmt.Errorf("unexpected status %s from %s", res.Status, u)
+	}
+	return json.NewDecoder(res.Body).Decode(o)
+}
+
+// AuthURL returns the identity provider URL to redirect the browser to.
+func (p *Provider) AuthURL(ctx context.Context, state, nonce, verifier string) (string, error) {
+	md, err := p.metadata(ctx)
+	if err != nil {
+		return "", err
+	}
+	q := url.Values{
+		"response_type":         {"code"},
+		"client_id":             {p.cfg.ClientID},
+		"redirect_uri":          {p.cfg.RedirectURL},
+		"scope":                 {strings.Join(append([]string{"openid"}, p.cfg.Scopes...), " ")},
+		"state":                 {state},
+		"nonce":                 {nonce},
+		"code_challenge":        {Challenge(verifier)},
+		"code_challenge_method": {"S256"},
+	}
+	sep := "?"
+	if strings.Contains(md.AuthorizationEndpoint, "?") {
+		sep = "&"
+	}
+	return md.AuthorizationEndpoint + sep + q.Encode(), nil
+}
+
+// Exchange trades authorization code for tokens and returns verified ID token
+// claims.
+func (p *Provider) Exchange(ctx context.Context, code, verifier, nonce string) (*Claims, error) {
+	md, err := p.metadata(ctx)
+	if err != nil {
+		return nil, err
+	}
+	form := url.Values{
+		"grant_type":    {"authorization_code"},
+		"code":          {code},
+		"redirect_uri":  {p.cfg.RedirectURL},
+		"code_verifier": {verifier},
+	}
+	r, err := http.NewRequestWithContext(ctx, http.MethodPost, md.TokenEndpoint, strings.NewReader(form.Encode()))
+	if err != nil {
+		return nil, err
+	}
+	r.Header.Set("content-type", "application/x-www-form-urlencoded")
+	r.Header.Set("accept", "application/json")
+	r.SetBasicAuth(url.QueryEscape(p.cfg.ClientID), url.QueryEscape(p.cfg.ClientSecret))
+	res, err := p.cfg.Client.Do(r)
+	if err != nil {
+		return nil, fmt.Errorf("oidc: token exchange %w", err)
+	}
+	defer res.Body.Close()
+	body, err := io.ReadAll(io.LimitReader(res.Body, 1<<20))
+	if err != nil {
+		return nil, fmt.Errorf("oidc: token exchange %w", err)
+	}
+	if res.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("oidc: token exchange failed with %s %s", res.Status, body)
+	}
+	var tok struct {
+		IDToken string `json:"id_token"`
+	}
+	err = json.Unmarshal(body, &tok)
+	if err != nil {
+		return nil, fmt.Errorf("oidc: decoding token response %w", err)
+	}
+	if tok.IDToken == "" {
+		return nil, errors.New("oidc: token response is missing id_token")
+	}
+	return p.Verify(ctx, tok.IDToken, nonce)
+}
+
+// Verify checks signature and standard claims of raw ID token.
+func (p *Provider) Verify(ctx context.Context, raw, nonce string) (*Claims, error) {
+	md, err := p.metadata(ctx)
+	if err != nil {
+		return nil, err
+	}
+	payload, err := p.verifySignature(ctx, md, raw)
+	if err != nil {
+		return nil, err
+	}
+	var c Claims
+	err = json.Unmarshal(payload, &c)
+	if err != nil {
+		return nil, fmt.Errorf("oidc: decoding claims %w", err)
+	}
+	var extra map[string]json.RawMessage
+	json.Unmarshal(payload, &extra)
+	if g, ok := extra[p.cfg.GroupsClaim]; ok {
+		c.Groups = groups(g)
+	}
+	now := p.now()
+	switch {
+	case strings.TrimSuffix(c.Issuer, "/") != p.cfg.Issuer:
+		return nil, fmt.Errorf("oidc: unexpected issuer %q", c.Issuer)
+	case !slices.Contains(c.Audience, p.cfg.ClientID):
+		return nil, errors.New("oidc: token was not issued for this client")
+	case c.Expiry == 0 || now.After(time.Unix(c.Expiry, 0).Add(time.Minute)):
+		return nil, errors.New("oidc: token expired")
+	case c.IssuedAt != 0 && time.Unix(c.IssuedAt, 0).After(now.Add(time.Minute)):
+		return nil, errors.New("oidc: token issued in the future")
+	case c.Nonce != nonce:
+		return nil, errors.New("oidc: nonce mismatch")
+	}
+	return &c, nil
+}
+
+// groups accepts a list of strings or a single string claim.
+func groups(raw json.RawMessage) []string {
+	var ls []string
+	if json.Unmarshal(raw, &ls) == nil {
+		return ls
+	}
+	var s string
+	if json.Unmarshal(raw, &s) == nil && s != "" {
+		return []string{s}
+	}
+	return nil
+}
+
+// User returns the account name for verified claims. Accounts are identified
+// by their email address.
+func (p *Provider) User(c *Claims) (string, error) {
+	if c.Email == "" || (c.EmailVerified != nil && !*c.EmailVerified) {
+		return "", ErrEmail
+	}
+	email := strings.ToLower(c.Email)
+	if len(p.cfg.EmailDomains) > 0 {
+		_, domain, _ := strings.Cut(email, "@")
+		if !slices.ContainsFunc(p.cfg.EmailDomains, func(d string) bool {
+			return strings.EqualFold(strings.TrimPrefix(d, "@"), domain)
+		}) {
+			return "", ErrDomain
+		}
+	}
+	return email, nil
+}
+
+// Roles returns role mappings matching groups in c.
+func (p *Provider) Roles(c *Claims) (ls []Mapping) {
+	for _, m := range p.cfg.Roles {
+		if slices.Contains(c.Groups, m.Group) {
+			ls = append(ls, m)
+		}
+	}
+	return
+}
+
+// Random returns a url safe random string suitable for state, nonce and PKCE
+// verifier.
+func Random() string {
+	b := make([]byte, 32)
+	rand.Read(b)
+	return base64.RawURLEncoding.EncodeToString(b)
+}
+
+// Challenge derives S256 PKCE code challenge from verifier.
+func Challenge(verifier string) string {
+	h := sha256.Sum256([]byte(verifier))
+	return base64.RawURLEncoding.EncodeToString(h[:])
+}
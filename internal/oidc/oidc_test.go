@@ -0,0 +1,83 @@
+package oidc_test
+
+import (
+	"context"
+	"net/url"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/vinceanalytics/vince/internal/oidc"
+	"github.com/vinceanalytics/vince/internal/oidc/oidctest"
+)
+
+func TestFlow(t *testing.T) {
+	idp := oidctest.New("vince", "secret")
+	defer idp.Close()
+
+	admins, err := oidc.ParseMapping("analytics:admin:vinceanalytics.com")
+	require.NoError(t, err)
+	p := oidc.New(oidc.Config{
+		Issuer:       idp.URL,
+		ClientID:     "vince",
+		ClientSecret: "secret",
+		RedirectURL:  "http://localhost:8080/login/oidc/callback",
+		EmailDomains: []string{"example.com"},
+		Roles:        []oidc.Mapping{admins},
+	})
+	ctx := context.Background()
+
+	login := func(t *testing.T, claims map[string]any) (*oidc.Claims, error) {
+		t.Helper()
+		state, nonce, verifier := oidc.Random(), oidc.Random(), oidc.Random()
+		auth, err := p.AuthURL(ctx, state, nonce, verifier)
+		require.NoError(t, err)
+		cb, err := idp.Authorize(auth, claims)
+		require.NoError(t, err)
+		u, err := url.Parse(cb)
+		require.NoError(t, err)
+		require.Equal(t, state, u.Query().Get("state"))
+		return p.Exchange(ctx, u.Query().Get("code"), verifier, nonce)
+	}
+
+	t.Run("allowed", func(t *testing.T) {
+		c, err := login(t, map[string]any{
+			"sub":            "1",
+			"email":          "Jane@example.com",
+			"email_verified": true,
+			"groups":         []string{"analytics"},
+		})
+		require.NoError(t, err)
+		user, err := p.User(c)
+		require.NoError(t, err)
+		require.Equal(t, "jane@example.com", user)
+		require.Equal(t, []oidc.Mapping{admins}, p.Roles(c))
+	})
+	t.Run("domain", func(t *testing.T) {
+		c, err := login(t, map[string]any{"sub": "2", "email": "joe@other.com"})
+		require.NoError(t, err)
+		_, err = p.User(c)
+		require.ErrorIs(t, err, oidc.ErrDomain)
+	})
+	t.Run("unverified", func(t *testing.T) {
+		c, err := login(t, map[string]any{"sub": "3", "email": "joe@example.com", "email_verified": false})
+		require.NoError(t, err)
+		_, err = p.User(c)
+		require.ErrorIs(t, err, oidc.ErrEmail)
+	})
+	t.Run("pkce", func(t *testing.T) {
+		auth, err := p.AuthURL(ctx, "state", "nonce", oidc.Random())
+		require.NoError(t, err)
+		cb, err := idp.Authorize(auth, map[string]any{"sub": "4"})
+		require.NoError(t, err)
+		u, _ := url.Parse(cb)
+		_, err = p.Exchange(ctx, u.Query().Get("code"), oidc.Random(), "nonce")
+		require.Error(t, err)
+	})
+	t.Run("nonce", func(t *testing.T) {
+		token := idp.Sign(map[string]any{
+			"iss": idp.URL, "aud": "vince", "sub": "5", "exp": 1 << 40, "nonce": "a",
+		})
+		_, err := p.Verify(ctx, token, "b")
+		require.Error(t, err)
+	})
+}
@@ -0,0 +1,165 @@
+// Package oidctest provides an in-process OpenID Connect identity provider for
+// tests.
+package oidctest
+
+import (
+	"crypto"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"sync"
+	"time"
+)
+
+// Server is a mock identity provider. It supports discovery, JWKS and the
+// authorization code grant with PKCE.
+type Server struct {
+	*httptest.Server
+	ClientID     string
+	ClientSecret string
+
+	key   *rsa.PrivateKey
+	mu    sync.Mutex
+	codes map[string]grant
+}
+
+type grant struct {
+	challenge string
+	redirect  string
+	claims    map[string]any
+}
+
+func New(clientID, clientSecret string) *Server {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		panic(err)
+	}
+	s := &Server{
+		ClientID:     clientID,
+		ClientSecret: clientSecret,
+		key:          key,
+		codes:        make(map[string]grant),
+	}
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /.well-known/openid-configuration", s.discovery)
+	mux.HandleFunc("GET /jwks", s.jwks)
+	mux.HandleFunc("POST /token", s.token)
+	s.Server = httptest.NewServer(mux)
+	return s
+}
+
+func (s *Server) discovery(w http.ResponseWriter, r *http.Request) {
+	json.NewEncoder(w).Encode(map[string]any{
+		"issuer":                 s.URL,
+		"authorization_endpoint": s.URL + "/authorize",
+		"token_endpoint":         s.URL + "/token",
+		"jwks_uri":               s.URL + "/jwks",
+	})
+}
+
+func (s *Server) jwks(w http.ResponseWriter, r *http.Request) {
+	pub := s.key.PublicKey
+	json.NewEncoder(w).Encode(map[string]any{
+		"keys": []map[string]any{{
+			"kid": "test",
+			"kty": "RSA",
+			"use": "sig",
+			"alg": "RS256",
+			"n":   base64.RawURLEncoding.EncodeToString(pub.N.Bytes()),
+			"e":   base64.RawURLEncoding.EncodeToString(big.NewInt(int64(pub.E)).Bytes()),
+		}},
+	})
+}
+
+// Authorize simulates a user signing in at authURL with claims. It returns the
+// callback URL the browser would be redirected to.
+func (s *Server) Authorize(authURL string, claims map[string]any) (string, error) {
+	u, err := url.Parse(authURL)
+	if err != nil {
+		return "", err
+	}
+	q := u.Query()
+	if q.Get("client_id") != s.ClientID {
+		return "", errors.New("oidctest: unknown client")
+	}
+	if q.Get("code_challenge_method") != "S256" || q.Get("code_challenge") == "" {
+		return "", errors.New("oidctest: missing pkce challenge")
+	}
+	all := map[string]any{
+		"iss":   s.URL,
+		"aud":   s.ClientID,
+		"nonce": q.Get("nonce"),
+		"iat":   time.Now().Unix(),
+		"exp":   time.Now().Add(time.Hour).Unix(),
+	}
+	for k, v := range claims {
+		all[k] = v
+	}
+	code := random()
+	s.mu.Lock()
+	s.codes[code] = grant{
+		challenge: q.Get("code_challenge"),
+		redirect:  q.Get("redirect_uri"),
+		claims:    all,
+	}
+	s.mu.Unlock()
+	cb, err := url.Parse(q.Get("redirect_uri"))
+	if err != nil {
+		return "", err
+	}
+	cq := cb.Query()
+	cq.Set("code", code)
+	cq.Set("state", q.Get("state"))
+	cb.RawQuery = cq.Encode()
+	return cb.String(), nil
+}
+
+func (s *Server) token(w http.ResponseWriter, r *http.Request) {
+	id, secret, _ := r.BasicAuth()
+	if id != s.ClientID || secret != s.ClientSecret {
+		http.Error(w, `{"error":"invalid_client"}`, http.StatusUnauthorized)
+		return
+	}
+	r.ParseForm()
+	s.mu.Lock()
+	g, ok := s.codes[r.Form.Get("code")]
+	delete(s.codes, r.Form.Get("code"))
+	s.mu.Unlock()
+	h := sha256.Sum256([]byte(r.Form.Get("code_verifier")))
+	if !ok || g.redirect != r.Form.Get("redirect_uri") ||
+		base64.RawURLEncoding.EncodeToString(h[:]) != g.challenge {
+		http.Error(w, `{"error":"invalid_grant"}`, http.StatusBadRequest)
+		return
+	}
+	json.NewEncoder(w).Encode(map[string]any{
+		"access_token": random(),
+		"token_type":   "Bearer",
+		"id_token":     s.Sign(g.claims),
+	})
+}
+
+// Sign returns RS256 signed token with claims.
+func (s *Server) Sign(claims map[string]any) string {
+	header, _ := json.Marshal(map[string]any{"alg": "RS256", "kid": "test", "typ": "JWT"})
+	payload, _ := json.Marshal(claims)
+	data := base64.RawURLEncoding.EncodeToString(header) + "." + base64.RawURLEncoding.EncodeToString(payload)
+	h := sha256.Sum256([]byte(data))
+	sig, err := rsa.SignPKCS1v15(rand.Reader, s.key, crypto.SHA256, h[:])
+	if err != nil {
+		panic(err)
+	}
+	return data + "." + base64.RawURLEncoding.EncodeToString(sig)
+}
+
+func random() string {
+	b := make([]byte, 16)
+	rand.Read(b)
+	return base64.RawURLEncoding.EncodeToString(b)
+}
@@ -0,0 +1,300 @@
+package ops
+
+import (
+	"bytes"
+	"crypto/sha512"
+	"errors"
+	"slices"
+	"sync/atomic"
+	"time"
+
+	"github.com/cockroachdb/pebble"
+	v1 "github.com/vinceanalytics/vince/gen/go/vince/v1"
+	"github.com/vinceanalytics/vince/internal/encoding"
+	"github.com/vinceanalytics/vince/internal/keys"
+	"github.com/vinceanalytics/vince/internal/util/data"
+	"github.com/vinceanalytics/vince/internal/util/xtime"
+	"google.golang.org/protobuf/proto"
+)
+
+// Capabilities that can be granted to an API key.
+const (
+	ScopeStatsRead   = "stats:read"
+	ScopeEventsWrite = "events:write"
+	ScopeBackup      = "backup:read"
+)
+
+// MinAPIKeyLen is the minimum length of a generated API key.
+const MinAPIKeyLen = 64
+
+var (
+	ErrAPIKeyName    = errors.New("name is required")
+	ErrAPIKeyExists  = errors.New("api key already exists")
+	ErrAPIKeyInvalid = errors.New("invalid api key")
+	ErrAPIKeyExpired = errors.New("api key expired")
+	ErrAPIKeySites   = errors.New("select at least one site")
+	ErrScope         = errors.New("unknown scope")
+)
+
+// Scopes returns all capabilities that can be granted to API keys.
+func Scopes() []string {
+	return []string{ScopeStatsRead, ScopeEventsWrite, ScopeBackup}
+}
+
+// KeyScopes returns capabilities granted to key. Keys without scopes predate
+// scoping and keep the access they had.
+func KeyScopes(key *v1.APIKey) []string {
+	if len(key.Scopes) == 0 {
+		return []string{ScopeStatsRead, ScopeEventsWrite}
+	}
+	return key.Scopes
+}
+
+// KeyAllows reports whether key grants scope.
+func KeyAllows(key *v1.APIKey, scope string) bool {
+	return slices.Contains(KeyScopes(key), scope)
+}
+
+// KeyAllSites reports whether key can access every site. Keys without scopes
+// and sites predate scoping and keep the access they had.
+func KeyAllSites(key *v1.APIKey) bool {
+	return key.AllSites || (len(key.Scopes) == 0 && len(key.Sites) == 0)
+}
+
+// KeyHasSite reports whether key can access domain.
+func KeyHasSite(key *v1.APIKey, domain string) bool {
+	return KeyAllSites(key) || slices.Contains(key.Sites, domain)
+}
+
+// KeyExpired reports whether key can no longer be used.
+func KeyExpired(key *v1.APIKey) bool {
+	return key.Expires != 0 && xtime.Now().UnixMilli() >= int64(key.Expires)
+}
+
+// CreateAPIKey stores a new API key described by a. Only the hash of key is
+// stored.
+func (db *Ops) CreateAPIKey(key string, a *v1.APIKey) error {
+	if a.Name == "" {
+		return ErrAPIKeyName
+	}
+	if len(key) < MinAPIKeyLen {
+		return ErrAPIKeyInvalid
+	}
+	for _, s := range a.Scopes {
+		if !slices.Contains(Scopes(), s) {
+			return ErrScope
+		}
+	}
+	if !a.AllSites && len(a.Sites) == 0 {
+		return ErrAPIKeySites
+	}
+	for _, s := range a.Sites {
+		if !db.HasSite(s) {
+			return ErrNoSite
+		}
+	}
+	db.keys.Lock()
+	defer db.keys.Unlock()
+
+	nameKey := encoding.APIKeyName([]byte(a.Name))
+	err := data.Get(db.db, nameKey, func(val []byte) error { return nil })
+	if err == nil {
+		return ErrAPIKeyExists
+	}
+	if !errors.Is(err, pebble.ErrNotFound) {
+		return err
+	}
+	hash := sha512.Sum512([]byte(key))
+	a = cloneProto(a)
+	a.Prefix = key[:6]
+	a.Hash = hash[:]
+	a.Created = uint64(xtime.Now().UnixMilli())
+	if a.AllSites {
+		a.Sites = nil
+	}
+	slices.Sort(a.Sites)
+	slices.Sort(a.Scopes)
+	a.Sites = slices.Compact(a.Sites)
+	a.Scopes = slices.Compact(a.Scopes)
+	value, _ := proto.Marshal(a)
+	return errors.Join(
+		db.db.Set(nameKey, value, nil),
+		db.db.Set(encoding.APIKeyHash(hash[:]), []byte(a.Name), nil),
+	)
+}
+
+// keyUsage counts requests of an API key that are not saved yet.
+type keyUsage struct {
+	last     atomic.Uint64
+	requests atomic.Uint64
+}
+
+// UseAPIKey returns the API key matching token and records the request in its
+// usage counters. Counters are kept in memory until SaveAPIKeyUsage is called.
+func (db *Ops) UseAPIKey(token string) (*v1.APIKey, error) {
+	hash := sha512.Sum512([]byte(token))
+	var name []byte
+	err := data.Get(db.db, encoding.APIKeyHash(hash[:]), func(val []byte) error {
+		name = bytes.Clone(val)
+		return nil
+	})
+	if err != nil {
+		if errors.Is(err, pebble.ErrNotFound) {
+			return nil, ErrAPIKeyInvalid
+		}
+		return nil, err
+	}
+	var a *v1.APIKey
+	if len(name) == 0 {
+		// Keys created before usage tracking did not link the hash to the name.
+		a, err = db.findAPIKey(hash[:])
+		if err == nil {
+			db.keys.Lock()
+			err = db.db.Set(encoding.APIKeyHash(hash[:]), []byte(a.Name), nil)
+			db.keys.Unlock()
+		}
+	} else {
+		a, err = db.apiKey(string(name))
+	}
+	if err != nil {
+		return nil, err
+	}
+	if KeyExpired(a) {
+		return nil, ErrAPIKeyExpired
+	}
+	v, _ := db.usage.LoadOrStore(a.Name, new(keyUsage))
+	u := v.(*keyUsage)
+	a.LastUsed = uint64(xtime.Now().UnixMilli())
+	u.last.Store(a.LastUsed)
+	a.Requests += u.requests.Add(1)
+	return a, nil
+}
+
+// SaveAPIKeyUsage adds usage recorded by UseAPIKey since the last call to the
+// stored keys.
+func (db *Ops) SaveAPIKeyUsage() error {
+	db.keys.Lock()
+	defer db.keys.Unlock()
+
+	type pending struct {
+		u *keyUsage
+		n uint64
+	}
+	var saved []pending
+	ba := db.db.NewBatch()
+	defer ba.Close()
+	var err error
+	db.usage.Range(func(name, v any) bool {
+		u := v.(*keyUsage)
+		n := u.requests.Swap(0)
+		if n == 0 {
+			return true
+		}
+		saved = append(saved, pending{u: u, n: n})
+		var a *v1.APIKey
+		a, err = db.apiKey(name.(string))
+		if err != nil {
+			if errors.Is(err, ErrAPIKeyInvalid) {
+				// the key was deleted
+				db.usage.Delete(name)
+				err = nil
+				return true
+			}
+			return false
+		}
+		a.Requests += n
+		a.LastUsed = max(a.LastUsed, u.last.Load())
+		value, _ := proto.Marshal(a)
+		err = ba.Set(encoding.APIKeyName([]byte(a.Name)), value, nil)
+		return err == nil
+	})
+	if err == nil {
+		err = ba.Commit(pebble.Sync)
+	}
+	if err != nil {
+		// try again next time
+		for _, p := range saved {
+			p.u.requests.Add(p.n)
+		}
+	}
+	return err
+}
+
+func (db *Ops) apiKey(name string) (*v1.APIKey, error) {
+	var a v1.APIKey
+	err := data.Get(db.db, encoding.APIKeyName([]byte(name)), func(val []byte) error {
+		return proto.Unmarshal(val, &a)
+	})
+	if err != nil {
+		if errors.Is(err, pebble.ErrNotFound) {
+			return nil, ErrAPIKeyInvalid
+		}
+		return nil, err
+	}
+	return &a, nil
+}
+
+func (db *Ops) findAPIKey(hash []byte) (*v1.APIKey, error) {
+	ls, err := db.apiKeys()
+	if err != nil {
+		return nil, err
+	}
+	for _, a := range ls {
+		if bytes.Equal(a.Hash, hash) {
+			return a, nil
+		}
+	}
+	return nil, ErrAPIKeyInvalid
+}
+
+func (db *Ops) DeleteAPIKey(name string) error {
+	db.keys.Lock()
+	defer db.keys.Unlock()
+	a, err := db.apiKey(name)
+	if err != nil {
+		return err
+	}
+	db.usage.Delete(name)
+	return errors.Join(
+		db.db.Delete(encoding.APIKeyName([]byte(name)), nil),
+		db.db.Delete(encoding.APIKeyHash(a.Hash), nil),
+	)
+}
+
+// APIKeys returns all API keys including usage that is not saved yet.
+func (db *Ops) APIKeys() ([]*v1.APIKey, error) {
+	ls, err := db.apiKeys()
+	if err != nil {
+		return nil, err
+	}
+	for _, a := range ls {
+		if v, ok := db.usage.Load(a.Name); ok {
+			u := v.(*keyUsage)
+			a.Requests += u.requests.Load()
+			a.LastUsed = max(a.LastUsed, u.last.Load())
+		}
+	}
+	return ls, nil
+}
+
+func (db *Ops) apiKeys() (ls []*v1.APIKey, err error) {
+	err = data.Prefix(db.db, keys.APIKeyNamePrefix, func(key, value []byte) error {
+		var a v1.APIKey
+		err := proto.Unmarshal(value, &a)
+		if err != nil {
+			return err
+		}
+		ls = append(ls, &a)
+		return nil
+	})
+	return
+}
+
+// APIKeyExpiry converts days to an expiry timestamp. Zero days means the key
+// never expires.
+func APIKeyExpiry(days int) uint64 {
+	if days <= 0 {
+		return 0
+	}
+	return uint64(xtime.Now().Add(time.Duration(days) * 24 * time.Hour).UnixMilli())
+}
@@ -0,0 +1,79 @@
+package ops
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/cockroachdb/pebble"
+	"github.com/stretchr/testify/require"
+	v1 "github.com/vinceanalytics/vince/gen/go/vince/v1"
+	"github.com/vinceanalytics/vince/internal/util/xtime"
+)
+
+func TestAPIKeys(t *testing.T) {
+	db, err := pebble.Open(t.TempDir(), nil)
+	require.NoError(t, err)
+	defer db.Close()
+
+	require.NoError(t, CreateAdmin(db, "root", "password"))
+	o := New(db, nil, "vinceanalytics.com")
+
+	token := strings.Repeat("a", MinAPIKeyLen)
+	require.ErrorIs(t, o.CreateAPIKey(token, &v1.APIKey{Name: "ci", Sites: []string{"example.com"}}), ErrNoSite)
+	require.ErrorIs(t, o.CreateAPIKey(token, &v1.APIKey{Name: "ci", Scopes: []string{"all"}}), ErrScope)
+	require.ErrorIs(t, o.CreateAPIKey(token, &v1.APIKey{Name: "ci", Scopes: []string{ScopeStatsRead}}), ErrAPIKeySites)
+	require.NoError(t, o.CreateAPIKey(token, &v1.APIKey{
+		Name:   "ci",
+		Sites:  []string{"vinceanalytics.com"},
+		Scopes: []string{ScopeStatsRead},
+	}))
+	require.ErrorIs(t, o.CreateAPIKey(token, &v1.APIKey{Name: "ci", AllSites: true}), ErrAPIKeyExists)
+
+	_, err = o.UseAPIKey(strings.Repeat("b", MinAPIKeyLen))
+	require.ErrorIs(t, err, ErrAPIKeyInvalid)
+
+	for range 2 {
+		_, err = o.UseAPIKey(token)
+		require.NoError(t, err)
+	}
+	ls, err := o.APIKeys()
+	require.NoError(t, err)
+	require.Len(t, ls, 1)
+	k := ls[0]
+	require.Equal(t, uint64(2), k.Requests)
+	require.NotZero(t, k.LastUsed)
+	require.True(t, KeyAllows(k, ScopeStatsRead))
+	require.False(t, KeyAllows(k, ScopeEventsWrite))
+	require.True(t, KeyHasSite(k, "vinceanalytics.com"))
+	require.False(t, KeyHasSite(k, "example.com"))
+	require.False(t, KeyHasSite(&v1.APIKey{Scopes: []string{ScopeStatsRead}}, "vinceanalytics.com"))
+	require.True(t, KeyHasSite(&v1.APIKey{Scopes: []string{ScopeStatsRead}, AllSites: true}, "example.com"))
+	require.True(t, KeyHasSite(&v1.APIKey{}, "example.com"))
+
+	// usage is only stored when saved
+	stored, err := o.apiKey("ci")
+	require.NoError(t, err)
+	require.Zero(t, stored.Requests)
+	require.NoError(t, o.SaveAPIKeyUsage())
+	stored, err = o.apiKey("ci")
+	require.NoError(t, err)
+	require.Equal(t, uint64(2), stored.Requests)
+	require.Equal(t, k.LastUsed, stored.LastUsed)
+	ls, err = o.APIKeys()
+	require.NoError(t, err)
+	require.Equal(t, uint64(2), ls[0].Requests)
+
+	expired := strings.Repeat("c", MinAPIKeyLen)
+	require.NoError(t, o.CreateAPIKey(expired, &v1.APIKey{
+		Name:     "old",
+		Scopes:   []string{ScopeStatsRead},
+		AllSites: true,
+		Expires:  uint64(xtime.Now().UnixMilli() - 1),
+	}))
+	_, err = o.UseAPIKey(expired)
+	require.ErrorIs(t, err, ErrAPIKeyExpired)
+
+	require.NoError(t, o.DeleteAPIKey("ci"))
+	_, err = o.UseAPIKey(token)
+	require.ErrorIs(t, err, ErrAPIKeyInvalid)
+}
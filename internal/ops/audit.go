@@ -0,0 +1,152 @@
+package ops
+
+import (
+	"encoding/binary"
+	"math"
+	"sync"
+
+	"github.com/cockroachdb/pebble"
+	v1 "github.com/vinceanalytics/vince/gen/go/vince/v1"
+	"github.com/vinceanalytics/vince/internal/encoding"
+	"github.com/vinceanalytics/vince/internal/keys"
+	"github.com/vinceanalytics/vince/internal/util/xtime"
+	"google.golang.org/protobuf/proto"
+)
+
+// Audited actions.
+const (
+	AuditSiteCreate       = "site.create"
+	AuditSiteDelete       = "site.delete"
+	AuditSitePublic       = "site.make_public"
+	AuditSitePrivate      = "site.make_private"
+	AuditSiteCurrency     = "site.currency"
+	AuditSiteTimezone     = "site.timezone"
+	AuditSiteRateLimit    = "site.rate_limit"
+	AuditSiteExclusions   = "site.exclusions"
+	AuditSiteRetention    = "site.retention"
+	AuditSiteImport       = "site.import"
+	AuditGoalCreate       = "goal.create"
+	AuditGoalDelete       = "goal.delete"
+	AuditFunnelCreate     = "funnel.create"
+	AuditFunnelDelete     = "funnel.delete"
+	AuditSharedLinkCreate = "shared_link.create"
+	AuditSharedLinkUpdate = "shared_link.update"
+	AuditSharedLinkDelete = "shared_link.delete"
+	AuditMemberInvite     = "member.invite"
+	AuditMemberRole       = "member.role"
+	AuditMemberRemove     = "member.remove"
+	AuditInvitationDelete = "invitation.delete"
+	AuditInvitationAccept = "invitation.accept"
+	AuditAPIKeyCreate     = "api_key.create"
+	AuditAPIKeyRevoke     = "api_key.revoke"
+	AuditUserCreate       = "user.create"
+	AuditUserDelete       = "user.delete"
+	AuditUserPassword     = "user.password"
+	AuditTwoFactorEnable  = "two_factor.enable"
+	AuditTwoFactorDisable = "two_factor.disable"
+	AuditTwoFactorReset   = "two_factor.reset"
+	AuditBackup           = "backup.create"
+)
+
+// AuditActions returns all audited actions.
+func AuditActions() []string {
+	return []string{
+		AuditSiteCreate, AuditSiteDelete, AuditSitePublic, AuditSitePrivate,
+		AuditSiteCurrency, AuditSiteTimezone, AuditSiteRateLimit, AuditSiteExclusions,
+		AuditSiteRetention, AuditSiteImport, AuditGoalCreate, AuditGoalDelete,
+		AuditFunnelCreate, AuditFunnelDelete, AuditSharedLinkCreate, AuditSharedLinkUpdate,
+		AuditSharedLinkDelete, AuditMemberInvite, AuditMemberRole, AuditMemberRemove,
+		AuditInvitationDelete, AuditInvitationAccept, AuditAPIKeyCreate, AuditAPIKeyRevoke,
+		AuditUserCreate, AuditUserDelete, AuditUserPassword, AuditTwoFactorEnable,
+		AuditTwoFactorDisable, AuditTwoFactorReset, AuditBackup,
+	}
+}
+
+// AuditFilter selects audit log entries. Empty fields match all entries.
+type AuditFilter struct {
+	Actor  string
+	Action string
+	Site   string
+	// Before only matches entries recorded before this timestamp, it is used
+	// to page through the log.
+	Before uint64
+}
+
+func (f *AuditFilter) match(e *v1.AuditEntry) bool {
+	return (f.Actor == "" || f.Actor == e.Actor) &&
+		(f.Action == "" || f.Action == e.Action) &&
+		(f.Site == "" || f.Site == e.Site)
+}
+
+// auditMu serializes audit writes. Entries are keyed by timestamp so we make
+// sure they are unique and increasing.
+var auditMu sync.Mutex
+
+// Audit appends e to the audit log. Entries are never modified or removed.
+func Audit(db *pebble.DB, e *v1.AuditEntry) error {
+	auditMu.Lock()
+	defer auditMu.Unlock()
+	// The log may have been written by another process or before the clock
+	// moved back, always start after the newest entry.
+	newest, err := newestAudit(db)
+	if err != nil {
+		return err
+	}
+	ts := uint64(xtime.Now().UnixNano())
+	if ts <= newest {
+		ts = newest + 1
+	}
+	e = cloneProto(e)
+	e.Timestamp = ts
+	data, _ := proto.Marshal(e)
+	return db.Set(encoding.Audit(ts), data, nil)
+}
+
+// newestAudit returns timestamp of the most recent audit entry in db.
+func newestAudit(db *pebble.DB) (uint64, error) {
+	iter, err := db.NewIter(&pebble.IterOptions{
+		LowerBound: keys.AuditPrefix,
+		UpperBound: encoding.Audit(math.MaxUint64),
+	})
+	if err != nil {
+		return 0, err
+	}
+	defer iter.Close()
+	if !iter.Last() {
+		return 0, nil
+	}
+	key := iter.Key()
+	return binary.BigEndian.Uint64(key[len(key)-8:]), nil
+}
+
+func (db *Ops) Audit(e *v1.AuditEntry) error {
+	return Audit(db.db, e)
+}
+
+// AuditLog calls f with entries matching filter, newest first, until f returns
+// false.
+func (db *Ops) AuditLog(filter AuditFilter, f func(e *v1.AuditEntry) bool) error {
+	before := filter.Before
+	if before == 0 {
+		before = math.MaxUint64
+	}
+	iter, err := db.db.NewIter(&pebble.IterOptions{
+		LowerBound: keys.AuditPrefix,
+		UpperBound: encoding.Audit(before),
+	})
+	if err != nil {
+		return err
+	}
+	defer iter.Close()
+	for iter.Last(); iter.Valid(); iter.Prev() {
+		var e v1.AuditEntry
+		err := proto.Unmarshal(iter.Value(), &e)
+		if err != nil {
+			return err
+		}
+		if filter.match(&e) && !f(&e) {
+			break
+		}
+	}
+	return nil
+}
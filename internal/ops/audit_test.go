@@ -0,0 +1,61 @@
+package ops
+
+import (
+	"testing"
+	"time"
+
+	"github.com/cockroachdb/pebble"
+	"github.com/stretchr/testify/require"
+	v1 "github.com/vinceanalytics/vince/gen/go/vince/v1"
+	"github.com/vinceanalytics/vince/internal/encoding"
+	"google.golang.org/protobuf/proto"
+)
+
+func TestAudit(t *testing.T) {
+	db, err := pebble.Open(t.TempDir(), nil)
+	require.NoError(t, err)
+	defer db.Close()
+
+	require.NoError(t, CreateAdmin(db, "root", "password"))
+	o := New(db, nil)
+
+	for _, e := range []*v1.AuditEntry{
+		{Actor: "root", Action: "site.create", Site: "a.com"},
+		{Actor: "root", Action: "goal.create", Site: "a.com", Target: "Signup"},
+		{Actor: "jane", Action: "site.create", Site: "b.com"},
+	} {
+		require.NoError(t, o.Audit(e))
+	}
+	read := func(f AuditFilter) (ls []*v1.AuditEntry) {
+		require.NoError(t, o.AuditLog(f, func(e *v1.AuditEntry) bool {
+			ls = append(ls, e)
+			return true
+		}))
+		return
+	}
+	all := read(AuditFilter{})
+	require.Len(t, all, 3)
+	require.Equal(t, "jane", all[0].Actor)
+	require.Greater(t, all[0].Timestamp, all[1].Timestamp)
+
+	require.Len(t, read(AuditFilter{Site: "a.com"}), 2)
+	require.Len(t, read(AuditFilter{Actor: "root", Action: "site.create"}), 1)
+
+	older := read(AuditFilter{Before: all[0].Timestamp})
+	require.Len(t, older, 2)
+	require.Equal(t, "goal.create", older[0].Action)
+
+	t.Run("after newest entry", func(t *testing.T) {
+		// entries written by another process or before the clock moved back
+		// must not be overwritten.
+		ahead := uint64(time.Now().Add(time.Hour).UnixNano())
+		data, _ := proto.Marshal(&v1.AuditEntry{Actor: "cli", Action: "two_factor.reset", Timestamp: ahead})
+		require.NoError(t, db.Set(encoding.Audit(ahead), data, nil))
+
+		require.NoError(t, o.Audit(&v1.AuditEntry{Actor: "root", Action: "site.delete"}))
+		all := read(AuditFilter{})
+		require.Len(t, all, 5)
+		require.Equal(t, ahead+1, all[0].Timestamp)
+		require.Equal(t, "cli", all[1].Actor)
+	})
+}
@@ -0,0 +1,36 @@
+package ops
+
+import (
+	v1 "github.com/vinceanalytics/vince/gen/go/vince/v1"
+	"github.com/vinceanalytics/vince/internal/encoding"
+	"github.com/vinceanalytics/vince/internal/keys"
+	"github.com/vinceanalytics/vince/internal/util/data"
+	"google.golang.org/protobuf/proto"
+)
+
+// SavePurge records progress of the job removing timeseries data of a deleted
+// domain. Jobs are keyed by domain id so a site deleted twice while its first
+// purge is pending has two jobs.
+func (db *Ops) SavePurge(p *v1.Purge) error {
+	data, _ := proto.Marshal(p)
+	return db.db.Set(encoding.Purge(p.DomainId), data, nil)
+}
+
+// Purges returns all unfinished purge jobs.
+func (db *Ops) Purges() (ls []*v1.Purge, err error) {
+	err = data.Prefix(db.db, keys.PurgePrefix, func(key, value []byte) error {
+		var p v1.Purge
+		err := proto.Unmarshal(value, &p)
+		if err != nil {
+			return err
+		}
+		ls = append(ls, &p)
+		return nil
+	})
+	return
+}
+
+// DeletePurge removes a completed purge job.
+func (db *Ops) DeletePurge(domainId uint64) error {
+	return db.db.Delete(encoding.Purge(domainId), nil)
+}
@@ -0,0 +1,32 @@
+package ops
+
+import (
+	"testing"
+
+	"github.com/cockroachdb/pebble"
+	"github.com/stretchr/testify/require"
+	v1 "github.com/vinceanalytics/vince/gen/go/vince/v1"
+)
+
+func TestPurges(t *testing.T) {
+	pb, err := pebble.Open(t.TempDir(), nil)
+	require.NoError(t, err)
+	defer pb.Close()
+	require.NoError(t, CreateAdmin(pb, "root", "password"))
+	db := New(pb, nil)
+
+	require.NoError(t, db.SavePurge(&v1.Purge{Domain: "a.com", DomainId: 1, Shards: 3}))
+	require.NoError(t, db.SavePurge(&v1.Purge{Domain: "b.com", DomainId: 2, Shards: 3}))
+	require.NoError(t, db.SavePurge(&v1.Purge{Domain: "a.com", DomainId: 1, Shard: 2, Shards: 3}))
+
+	ls, err := db.Purges()
+	require.NoError(t, err)
+	require.Len(t, ls, 2)
+	require.Equal(t, uint64(2), ls[0].Shard)
+
+	require.NoError(t, db.DeletePurge(1))
+	ls, err = db.Purges()
+	require.NoError(t, err)
+	require.Len(t, ls, 1)
+	require.Equal(t, "b.com", ls[0].Domain)
+}
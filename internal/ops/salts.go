@@ -0,0 +1,51 @@
+package ops
+
+import (
+	"crypto/rand"
+	"errors"
+	"time"
+
+	"github.com/cockroachdb/pebble"
+	v1 "github.com/vinceanalytics/vince/gen/go/vince/v1"
+	"github.com/vinceanalytics/vince/internal/keys"
+	"github.com/vinceanalytics/vince/internal/util/data"
+	"google.golang.org/protobuf/proto"
+)
+
+const saltSize = 32
+
+// SaltRotation returns when a salt created at created is rotated. Salts are
+// rotated at midnight UTC so visitors keep the same id for the whole day.
+func SaltRotation(created time.Time) time.Time {
+	return created.UTC().Truncate(24 * time.Hour).Add(24 * time.Hour)
+}
+
+// Salts returns salts used to hash visitor ids. When the current salt is due
+// for rotation at now it becomes the previous salt and a new one is generated.
+// Only the current and previous salts are kept, older ids can not be
+// recomputed.
+func (db *Ops) Salts(now time.Time) (*v1.Salts, error) {
+	var s v1.Salts
+	err := data.Get(db.db, keys.Salts, func(val []byte) error {
+		return proto.Unmarshal(val, &s)
+	})
+	if err != nil && !errors.Is(err, pebble.ErrNotFound) {
+		return nil, err
+	}
+	if len(s.Current) != 0 && now.Before(SaltRotation(time.UnixMilli(int64(s.Created)))) {
+		return &s, nil
+	}
+	salt := make([]byte, saltSize)
+	rand.Read(salt)
+	next := &v1.Salts{
+		Current:  salt,
+		Previous: s.Current,
+		Created:  uint64(now.UnixMilli()),
+	}
+	val, _ := proto.Marshal(next)
+	err = db.db.Set(keys.Salts, val, nil)
+	if err != nil {
+		return nil, err
+	}
+	return next, nil
+}
@@ -0,0 +1,35 @@
+package ops
+
+import (
+	"testing"
+	"time"
+
+	"github.com/cockroachdb/pebble"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSalts(t *testing.T) {
+	pb, err := pebble.Open(t.TempDir(), nil)
+	require.NoError(t, err)
+	defer pb.Close()
+	require.NoError(t, CreateAdmin(pb, "root", "password"))
+	db := New(pb, nil)
+
+	now := time.Date(2024, 1, 1, 18, 0, 0, 0, time.UTC)
+	first, err := db.Salts(now)
+	require.NoError(t, err)
+	require.Len(t, first.Current, saltSize)
+	require.Empty(t, first.Previous)
+
+	// salts are rotated at midnight, not a day after they were created
+	midnight := time.Date(2024, 1, 2, 0, 0, 0, 0, time.UTC)
+	require.Equal(t, midnight, SaltRotation(now))
+	same, err := db.Salts(midnight.Add(-time.Minute))
+	require.NoError(t, err)
+	require.Equal(t, first.Current, same.Current)
+
+	next, err := db.Salts(midnight)
+	require.NoError(t, err)
+	require.Equal(t, first.Current, next.Previous)
+	require.NotEqual(t, first.Current, next.Current)
+}
@@ -2,13 +2,13 @@ package ops
 
 import (
 	"cmp"
-	"crypto/sha512"
 	"errors"
 	"fmt"
 	"regexp"
 	"slices"
 	"strings"
 	"sync"
+	"time"
 
 	"filippo.io/age"
 	"github.com/cockroachdb/pebble"
@@ -28,9 +28,14 @@ type Ops struct {
 	db    *pebble.DB
 	tr    translation.Translator
 	admin struct {
+		sync.RWMutex
 		name     string
 		password []byte
 	}
+	// keys serializes api key updates.
+	keys sync.Mutex
+	// usage maps api key names to *keyUsage.
+	usage sync.Map
 	sites struct {
 		sync.RWMutex
 		// We expect a reasonable number of sites to be managed by a single instance
@@ -41,6 +46,8 @@ type Ops struct {
 		// Values must never be modified. Use cloneProto for safe copies.
 		domains map[string]*v1.Site
 	}
+	// twoFactorMu serializes counting failed two-factor attempts.
+	twoFactorMu sync.Mutex
 }
 
 func New(db *pebble.DB, tr translation.Translator, sites ...string) *Ops {
@@ -66,62 +73,78 @@ func New(db *pebble.DB, tr translation.Translator, sites ...string) *Ops {
 	return o
 }
 
-func (db *Ops) CreateAPIKey(name string, key string) error {
-	prefix := key[:6]
-	hash := sha512.Sum512([]byte(key))
-	data, _ := proto.Marshal(&v1.APIKey{
-		Name:   name,
-		Prefix: prefix,
-		Hash:   hash[:],
-	})
-	return errors.Join(
-		db.db.Set(encoding.APIKeyName([]byte(name)), data, nil),
-		db.db.Set(encoding.APIKeyHash(hash[:]), []byte{}, nil),
-	)
+func (db *Ops) HasSite(domain string) (ok bool) {
+	db.sites.RLock()
+	_, ok = db.sites.domains[domain]
+	db.sites.RUnlock()
+	return
 }
 
-func (db *Ops) ValidAPIKkey(key string) bool {
-	hash := sha512.Sum512([]byte(key))
-	hk := encoding.APIKeyHash(hash[:])
-	err := data.Get(db.db, hk, func(val []byte) error { return nil })
-	return err == nil
+// Currency returns reporting currency of domain.
+func (db *Ops) Currency(domain string) (code string) {
+	db.sites.RLock()
+	if sx := db.sites.domains[domain]; sx != nil {
+		code = sx.Currency
+	}
+	db.sites.RUnlock()
+	return
 }
 
-func (db *Ops) DeleteAPIKey(name string) error {
-	nameKey := encoding.APIKeyName([]byte(name))
-	var a v1.APIKey
-	err := data.Get(db.db, nameKey, func(val []byte) error {
-		return proto.Unmarshal(val, &a)
-	})
-	if err != nil {
-		return err
+// Timezone returns the location used to bucket and report stats for domain.
+// Sites without a configured timezone use UTC.
+func (db *Ops) Timezone(domain string) *time.Location {
+	db.sites.RLock()
+	var name string
+	if sx := db.sites.domains[domain]; sx != nil {
+		name = sx.Timezone
 	}
-	return errors.Join(
-		db.db.Delete(nameKey, nil),
-		db.db.Delete(encoding.APIKeyHash(a.Hash), nil),
-	)
+	db.sites.RUnlock()
+	return Location(name)
 }
 
-func (db *Ops) APIKeys() (ls []*v1.APIKey, err error) {
-	err = data.Prefix(db.db, keys.APIKeyNamePrefix, func(key, value []byte) error {
-		var a v1.APIKey
-		err := proto.Unmarshal(value, &a)
-		if err != nil {
-			return err
-		}
-		ls = append(ls, &a)
-		return nil
-	})
+// RateLimit returns the events rate limit configured for domain. A zero rate
+// means the site uses the global limit.
+func (db *Ops) RateLimit(domain string) (rate float64, burst uint32) {
+	db.sites.RLock()
+	if sx := db.sites.domains[domain]; sx != nil {
+		rate, burst = sx.RateLimit.GetRate(), sx.RateLimit.GetBurst()
+	}
+	db.sites.RUnlock()
 	return
 }
 
-func (db *Ops) HasSite(domain string) (ok bool) {
+// Exclusions returns rules for events of domain that must not be recorded.
+// The returned value is shared and must not be modified, it is replaced
+// whenever the site is saved.
+func (db *Ops) Exclusions(domain string) (e *v1.Exclusions) {
 	db.sites.RLock()
-	_, ok = db.sites.domains[domain]
+	if sx := db.sites.domains[domain]; sx != nil {
+		e = sx.Exclusions
+	}
 	db.sites.RUnlock()
 	return
 }
 
+var locations sync.Map
+
+// Location returns the *time.Location for IANA zone name. Empty and unknown
+// names resolve to UTC. Loaded locations are cached because this is called
+// for every ingested event.
+func Location(name string) *time.Location {
+	if name == "" {
+		return time.UTC
+	}
+	if lo, ok := locations.Load(name); ok {
+		return lo.(*time.Location)
+	}
+	lo, err := time.LoadLocation(name)
+	if err != nil {
+		return time.UTC
+	}
+	locations.Store(name, lo)
+	return lo
+}
+
 func (db *Ops) Site(domain string) (u *v1.Site) {
 	db.sites.RLock()
 	sx := db.sites.domains[domain]
@@ -230,7 +253,10 @@ func CreateAdmin(db *pebble.DB, name string, password string) error {
 }
 
 func (db *Ops) VerifyPassword(password string) (match bool) {
-	err := bcrypt.CompareHashAndPassword(db.admin.password, []byte(password))
+	db.admin.RLock()
+	hashed := db.admin.password
+	db.admin.RUnlock()
+	err := bcrypt.CompareHashAndPassword(hashed, []byte(password))
 	return err == nil
 }
 
@@ -254,6 +280,7 @@ func LoadAdmin(db *pebble.DB) (a *v1.Admin, err error) {
 
 func (db *Ops) Save(u *v1.Site) {
 	slices.SortFunc(u.Shares, compareShare)
+	slices.SortFunc(u.Members, compareMember)
 	sd, err := proto.Marshal(u)
 	assert.Nil(err, "marshal site")
 	err = db.db.Set(encoding.Site([]byte(u.Domain)), sd, nil)
@@ -0,0 +1,240 @@
+package ops
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/base32"
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/cockroachdb/pebble"
+	"github.com/pquerna/otp"
+	"github.com/pquerna/otp/totp"
+	v1 "github.com/vinceanalytics/vince/gen/go/vince/v1"
+	"github.com/vinceanalytics/vince/internal/encoding"
+	"github.com/vinceanalytics/vince/internal/util/data"
+	"github.com/vinceanalytics/vince/internal/util/xtime"
+	"google.golang.org/protobuf/proto"
+)
+
+const (
+	twoFactorIssuer = "Vince"
+	twoFactorPeriod = 30
+
+	// RecoveryCodes is the number of one time codes issued when enabling two
+	// factor authentication.
+	RecoveryCodes = 10
+
+	// TwoFactorAttempts is the number of failed codes accepted before
+	// verification is locked. Each following lockout doubles in length up to
+	// maxTwoFactorLockout.
+	TwoFactorAttempts   = 5
+	twoFactorLockout    = 5 * time.Minute
+	maxTwoFactorLockout = 24 * time.Hour
+)
+
+var (
+	ErrTwoFactorCode    = errors.New("invalid verification code")
+	ErrTwoFactorEnabled = errors.New("two-factor authentication is already enabled")
+	ErrTwoFactorLocked  = errors.New("too many failed verification attempts")
+)
+
+var b32 = base32.StdEncoding.WithPadding(base32.NoPadding)
+
+func (db *Ops) twoFactor(name string) (*v1.TwoFactor, error) {
+	var tf v1.TwoFactor
+	err := data.Get(db.db, encoding.TwoFactor([]byte(name)), func(val []byte) error {
+		return proto.Unmarshal(val, &tf)
+	})
+	if err != nil {
+		if errors.Is(err, pebble.ErrNotFound) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return &tf, nil
+}
+
+func (db *Ops) saveTwoFactor(name string, tf *v1.TwoFactor) error {
+	data, _ := proto.Marshal(tf)
+	return db.db.Set(encoding.TwoFactor([]byte(name)), data, nil)
+}
+
+// HasTwoFactor returns true if name must provide a second factor on login.
+func (db *Ops) HasTwoFactor(name string) bool {
+	tf, _ := db.twoFactor(name)
+	return tf != nil && tf.Enabled
+}
+
+// SetupTwoFactor starts enrollment for name and returns the key to provision
+// authenticator apps with. Calling it again before enrollment completes returns
+// the same key.
+func (db *Ops) SetupTwoFactor(name string) (*otp.Key, error) {
+	tf, err := db.twoFactor(name)
+	if err != nil {
+		return nil, err
+	}
+	if tf != nil && tf.Enabled {
+		return nil, ErrTwoFactorEnabled
+	}
+	opts := totp.GenerateOpts{
+		Issuer:      twoFactorIssuer,
+		AccountName: name,
+		Period:      twoFactorPeriod,
+	}
+	if tf != nil {
+		opts.Secret, err = b32.DecodeString(tf.Secret)
+		if err != nil {
+			return nil, err
+		}
+	}
+	key, err := totp.Generate(opts)
+	if err != nil {
+		return nil, err
+	}
+	if tf == nil {
+		err = db.saveTwoFactor(name, &v1.TwoFactor{Secret: key.Secret()})
+		if err != nil {
+			return nil, err
+		}
+	}
+	return key, nil
+}
+
+// EnableTwoFactor completes enrollment started with SetupTwoFactor. code must
+// be generated by the provisioned authenticator. Returns recovery codes that
+// are shown to the user once, only their hashes are stored.
+func (db *Ops) EnableTwoFactor(name, code string) ([]string, error) {
+	tf, err := db.twoFactor(name)
+	if err != nil {
+		return nil, err
+	}
+	if tf == nil {
+		return nil, ErrTwoFactorCode
+	}
+	if tf.Enabled {
+		return nil, ErrTwoFactorEnabled
+	}
+	step, ok := validTOTP(tf.Secret, code, tf.LastStep)
+	if !ok {
+		return nil, ErrTwoFactorCode
+	}
+	codes := make([]string, RecoveryCodes)
+	tf.RecoveryCodes = make([][]byte, RecoveryCodes)
+	for i := range codes {
+		codes[i] = recoveryCode()
+		tf.RecoveryCodes[i] = hashRecovery(codes[i])
+	}
+	tf.Enabled = true
+	tf.LastStep = step
+	return codes, db.saveTwoFactor(name, tf)
+}
+
+// VerifyTwoFactor checks code as either a TOTP code or an unused recovery code.
+// Codes can only be used once.
+//
+// Failed attempts are counted, after TwoFactorAttempts failures verification
+// fails with ErrTwoFactorLocked until the lockout expires, even for valid
+// codes.
+func (db *Ops) VerifyTwoFactor(name, code string) error {
+	db.twoFactorMu.Lock()
+	defer db.twoFactorMu.Unlock()
+
+	tf, err := db.twoFactor(name)
+	if err != nil {
+		return err
+	}
+	if tf == nil || !tf.Enabled {
+		return ErrTwoFactorCode
+	}
+	now := xtime.Now()
+	if locked(tf, now) {
+		return ErrTwoFactorLocked
+	}
+	if step, ok := validTOTP(tf.Secret, code, tf.LastStep); ok {
+		tf.LastStep = step
+		tf.Failures = 0
+		return db.saveTwoFactor(name, tf)
+	}
+	h := hashRecovery(code)
+	for i := range tf.RecoveryCodes {
+		if subtle.ConstantTimeCompare(tf.RecoveryCodes[i], h) == 1 {
+			tf.RecoveryCodes = append(tf.RecoveryCodes[:i], tf.RecoveryCodes[i+1:]...)
+			tf.Failures = 0
+			return db.saveTwoFactor(name, tf)
+		}
+	}
+	tf.Failures++
+	if tf.Failures%TwoFactorAttempts != 0 {
+		return errors.Join(ErrTwoFactorCode, db.saveTwoFactor(name, tf))
+	}
+	lockout := twoFactorLockout << min(tf.Failures/TwoFactorAttempts-1, 10)
+	tf.LockedUntil = uint64(now.Add(min(lockout, maxTwoFactorLockout)).UnixMilli())
+	return errors.Join(ErrTwoFactorLocked, db.saveTwoFactor(name, tf))
+}
+
+// TwoFactorLocked returns true if name can not verify codes because of too many
+// failed attempts.
+func (db *Ops) TwoFactorLocked(name string) bool {
+	tf, _ := db.twoFactor(name)
+	return tf != nil && locked(tf, xtime.Now())
+}
+
+func locked(tf *v1.TwoFactor, now time.Time) bool {
+	return uint64(now.UnixMilli()) < tf.LockedUntil
+}
+
+// RemainingRecoveryCodes returns number of unused recovery codes for name.
+func (db *Ops) RemainingRecoveryCodes(name string) int {
+	tf, _ := db.twoFactor(name)
+	if tf == nil {
+		return 0
+	}
+	return len(tf.RecoveryCodes)
+}
+
+func (db *Ops) DisableTwoFactor(name string) error {
+	return ResetTwoFactor(db.db, name)
+}
+
+// ResetTwoFactor removes two-factor authentication for name. It is used to
+// recover locked out accounts.
+func ResetTwoFactor(db *pebble.DB, name string) error {
+	return db.Delete(encoding.TwoFactor([]byte(name)), nil)
+}
+
+// validTOTP accepts codes for the current period and one period of clock skew
+// in either direction. Periods at or before last were already used and are
+// rejected to prevent replay.
+func validTOTP(secret, code string, last uint64) (uint64, bool) {
+	code = strings.TrimSpace(code)
+	now := uint64(xtime.Now().Unix()) / twoFactorPeriod
+	for _, step := range []uint64{now, now - 1, now + 1} {
+		if step <= last {
+			continue
+		}
+		want, err := totp.GenerateCode(secret, time.Unix(int64(step*twoFactorPeriod), 0))
+		if err != nil {
+			return 0, false
+		}
+		if subtle.ConstantTimeCompare([]byte(want), []byte(code)) == 1 {
+			return step, true
+		}
+	}
+	return 0, false
+}
+
+func recoveryCode() string {
+	b := make([]byte, 5)
+	rand.Read(b)
+	s := strings.ToLower(b32.EncodeToString(b))
+	return s[:4] + "-" + s[4:]
+}
+
+func hashRecovery(code string) []byte {
+	code = strings.ToLower(strings.NewReplacer("-", "", " ", "").Replace(code))
+	h := sha256.Sum256([]byte(code))
+	return h[:]
+}
@@ -0,0 +1,57 @@
+package ops
+
+import (
+	"testing"
+	"time"
+
+	"github.com/cockroachdb/pebble"
+	"github.com/pquerna/otp/totp"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTwoFactor(t *testing.T) {
+	db, err := pebble.Open(t.TempDir(), nil)
+	require.NoError(t, err)
+	defer db.Close()
+
+	require.NoError(t, CreateAdmin(db, "root", "password"))
+	o := New(db, nil)
+
+	key, err := o.SetupTwoFactor("root")
+	require.NoError(t, err)
+	again, err := o.SetupTwoFactor("root")
+	require.NoError(t, err)
+	require.Equal(t, key.Secret(), again.Secret())
+	require.False(t, o.HasTwoFactor("root"))
+
+	_, err = o.EnableTwoFactor("root", "000000")
+	require.ErrorIs(t, err, ErrTwoFactorCode)
+
+	code, err := totp.GenerateCode(key.Secret(), time.Now())
+	require.NoError(t, err)
+	recovery, err := o.EnableTwoFactor("root", code)
+	require.NoError(t, err)
+	require.Len(t, recovery, RecoveryCodes)
+	require.True(t, o.HasTwoFactor("root"))
+
+	// codes can not be replayed
+	require.ErrorIs(t, o.VerifyTwoFactor("root", code), ErrTwoFactorCode)
+
+	require.NoError(t, o.VerifyTwoFactor("root", recovery[0]))
+	require.ErrorIs(t, o.VerifyTwoFactor("root", recovery[0]), ErrTwoFactorCode)
+	require.Equal(t, RecoveryCodes-1, o.RemainingRecoveryCodes("root"))
+
+	// failed attempts lock verification, even for valid codes
+	require.NoError(t, o.VerifyTwoFactor("root", recovery[1]))
+	for range TwoFactorAttempts - 1 {
+		require.ErrorIs(t, o.VerifyTwoFactor("root", "000000"), ErrTwoFactorCode)
+	}
+	require.False(t, o.TwoFactorLocked("root"))
+	require.ErrorIs(t, o.VerifyTwoFactor("root", "000000"), ErrTwoFactorLocked)
+	require.True(t, o.TwoFactorLocked("root"))
+	require.ErrorIs(t, o.VerifyTwoFactor("root", recovery[2]), ErrTwoFactorLocked)
+	require.Equal(t, RecoveryCodes-2, o.RemainingRecoveryCodes("root"))
+
+	require.NoError(t, ResetTwoFactor(db, "root"))
+	require.False(t, o.HasTwoFactor("root"))
+}
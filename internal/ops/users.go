@@ -0,0 +1,415 @@
+package ops
+
+import (
+	"cmp"
+	"errors"
+	"fmt"
+	"maps"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/cockroachdb/pebble"
+	gonanoid "github.com/matoous/go-nanoid/v2"
+	v1 "github.com/vinceanalytics/vince/gen/go/vince/v1"
+	"github.com/vinceanalytics/vince/internal/encoding"
+	"github.com/vinceanalytics/vince/internal/keys"
+	"github.com/vinceanalytics/vince/internal/util/data"
+	"github.com/vinceanalytics/vince/internal/util/xtime"
+	"golang.org/x/crypto/bcrypt"
+	"google.golang.org/protobuf/proto"
+)
+
+// Site roles ordered by increasing permissions. Viewers can only see stats,
+// admins can also change site settings and invite people and owners can
+// additionally delete the site.
+const (
+	RoleViewer = "viewer"
+	RoleAdmin  = "admin"
+	RoleOwner  = "owner"
+)
+
+// InvitationTTL is how long an invitation link can be used.
+const InvitationTTL = 7 * 24 * time.Hour
+
+const MinPasswordLen = 6
+
+var (
+	ErrUserName   = errors.New("name is required")
+	ErrUserExists = errors.New("user already exists")
+	ErrUserAdmin  = errors.New("administrator account can not be deleted")
+	ErrRole       = errors.New("unknown role")
+	ErrNoSite     = errors.New("site not found")
+	ErrInvitation = errors.New("invitation not found or expired")
+	ErrPassword   = fmt.Errorf("password must be at least %d characters", MinPasswordLen)
+)
+
+// Roles returns all site roles in increasing order of permissions.
+func Roles() []string {
+	return []string{RoleViewer, RoleAdmin, RoleOwner}
+}
+
+// ValidRole reports whether role is a known site role.
+func ValidRole(role string) bool {
+	return rank(role) > 0
+}
+
+// Allows reports whether role grants at least the permissions of required.
+func Allows(role, required string) bool {
+	return rank(role) > 0 && rank(role) >= rank(required)
+}
+
+func rank(role string) int {
+	switch role {
+	case RoleViewer:
+		return 1
+	case RoleAdmin:
+		return 2
+	case RoleOwner:
+		return 3
+	default:
+		return 0
+	}
+}
+
+// IsAdmin returns true if name is the instance administrator. The administrator
+// owns all sites and manages user accounts.
+func (db *Ops) IsAdmin(name string) bool {
+	return name != "" && name == db.admin.name
+}
+
+// HasUser returns true if name is a known account.
+func (db *Ops) HasUser(name string) bool {
+	if name == "" {
+		return false
+	}
+	return db.IsAdmin(name) || data.Has(db.db, encoding.User([]byte(name)))
+}
+
+func (db *Ops) CreateUser(name, password string) error {
+	name = strings.TrimSpace(name)
+	if name == "" {
+		return ErrUserName
+	}
+	if len(password) < MinPasswordLen {
+		return ErrPassword
+	}
+	if db.HasUser(name) {
+		return ErrUserExists
+	}
+	hashed, err := bcrypt.GenerateFromPassword([]byte(password), bcrypt.DefaultCost)
+	if err != nil {
+		return fmt.Errorf("hashing user password %w", err)
+	}
+	data, _ := proto.Marshal(&v1.User{Name: name, HashedPassword: hashed})
+	return db.db.Set(encoding.User([]byte(name)), data, nil)
+}
+
+// EnsureUser creates account name without password if it does not exist. It is
+// used for accounts managed by single sign-on.
+func (db *Ops) EnsureUser(name string) error {
+	if name == "" {
+		return ErrUserName
+	}
+	if db.HasUser(name) {
+		return nil
+	}
+	data, _ := proto.Marshal(&v1.User{Name: name})
+	return db.db.Set(encoding.User([]byte(name)), data, nil)
+}
+
+func (db *Ops) User(name string) (u *v1.User) {
+	if name == "" {
+		return
+	}
+	var o v1.User
+	err := data.Get(db.db, encoding.User([]byte(name)), func(val []byte) error {
+		return proto.Unmarshal(val, &o)
+	})
+	if err != nil {
+		return
+	}
+	return &o
+}
+
+func (db *Ops) Users() (ls []*v1.User, err error) {
+	err = data.Prefix(db.db, keys.UserPrefix, func(key, value []byte) error {
+		var u v1.User
+		err := proto.Unmarshal(value, &u)
+		if err != nil {
+			return err
+		}
+		ls = append(ls, &u)
+		return nil
+	})
+	return
+}
+
+// DeleteUser removes the account and all its site memberships.
+func (db *Ops) DeleteUser(name string) error {
+	if db.IsAdmin(name) {
+		return ErrUserAdmin
+	}
+	err := errors.Join(
+		db.db.Delete(encoding.User([]byte(name)), nil),
+		ResetTwoFactor(db.db, name),
+	)
+	if err != nil {
+		return err
+	}
+	var sites []*v1.Site
+	db.Domains(func(s *v1.Site) {
+		if memberIndex(s, name) != -1 {
+			sites = append(sites, s)
+		}
+	})
+	for _, s := range sites {
+		s.Members = slices.DeleteFunc(s.Members, func(m *v1.Member) bool {
+			return m.User == name
+		})
+		db.Save(s)
+	}
+	return nil
+}
+
+// ChangePassword replaces the password of the account name.
+func (db *Ops) ChangePassword(name, password string) error {
+	if len(password) < MinPasswordLen {
+		return ErrPassword
+	}
+	if db.IsAdmin(name) {
+		err := CreateAdmin(db.db, name, password)
+		if err != nil {
+			return err
+		}
+		admin, err := LoadAdmin(db.db)
+		if err != nil {
+			return err
+		}
+		db.admin.Lock()
+		db.admin.password = admin.HashedPassword
+		db.admin.Unlock()
+		return nil
+	}
+	u := db.User(name)
+	if u == nil {
+		return pebble.ErrNotFound
+	}
+	hashed, err := bcrypt.GenerateFromPassword([]byte(password), bcrypt.DefaultCost)
+	if err != nil {
+		return fmt.Errorf("hashing user password %w", err)
+	}
+	u.HashedPassword = hashed
+	return db.saveUser(u)
+}
+
+func (db *Ops) saveUser(u *v1.User) error {
+	data, _ := proto.Marshal(u)
+	return db.db.Set(encoding.User([]byte(u.Name)), data, nil)
+}
+
+// Authenticate verifies password for the account name.
+func (db *Ops) Authenticate(name, password string) bool {
+	if db.IsAdmin(name) {
+		return db.VerifyPassword(password)
+	}
+	u := db.User(name)
+	if u == nil {
+		return false
+	}
+	return bcrypt.CompareHashAndPassword(u.HashedPassword, []byte(password)) == nil
+}
+
+// Role returns the role of user on domain. Empty string means user has no
+// access to the site.
+func (db *Ops) Role(domain, user string) string {
+	site := db.Site(domain)
+	if site == nil {
+		return ""
+	}
+	return db.RoleOf(site, user)
+}
+
+// RoleOf is like Role but works with an already loaded site.
+func (db *Ops) RoleOf(site *v1.Site, user string) string {
+	if db.IsAdmin(user) {
+		return RoleOwner
+	}
+	i := memberIndex(site, user)
+	if i == -1 {
+		return ""
+	}
+	return site.Members[i].Role
+}
+
+func (db *Ops) SetRole(domain, user, role string) error {
+	if !ValidRole(role) {
+		return ErrRole
+	}
+	site := db.Site(domain)
+	if site == nil {
+		return ErrNoSite
+	}
+	if i := memberIndex(site, user); i != -1 {
+		site.Members[i].Role = role
+	} else {
+		site.Members = append(site.Members, &v1.Member{User: user, Role: role})
+	}
+	db.Save(site)
+	return nil
+}
+
+func (db *Ops) RemoveMember(domain, user string) {
+	site := db.Site(domain)
+	if site == nil {
+		return
+	}
+	site.Members = slices.DeleteFunc(site.Members, func(m *v1.Member) bool {
+		return m.User == user
+	})
+	db.Save(site)
+}
+
+// SyncRoles makes roles granted to user by single sign-on match want, a map of
+// domain to role. Roles granted by an earlier sync are changed or revoked when
+// they are no longer wanted, unless someone changed them since. Roles given by
+// other means are never downgraded.
+//
+// changed is called for every updated membership, role is empty when the
+// membership was removed.
+func (db *Ops) SyncRoles(user string, want map[string]string, changed func(domain, role string)) error {
+	if db.IsAdmin(user) {
+		return nil
+	}
+	u := db.User(user)
+	if u == nil {
+		return pebble.ErrNotFound
+	}
+	granted := make(map[string]string, len(u.SsoGrants))
+	for _, g := range u.SsoGrants {
+		granted[g.Domain] = g.Role
+	}
+	var errs []error
+	grants := make([]*v1.Grant, 0, len(want))
+	for _, domain := range slices.Sorted(maps.Keys(want)) {
+		role := want[domain]
+		current := db.Role(domain, user)
+		managed := current != "" && current == granted[domain]
+		if !managed && Allows(current, role) {
+			continue
+		}
+		if current != role {
+			err := db.SetRole(domain, user, role)
+			if err != nil {
+				errs = append(errs, fmt.Errorf("%s: %w", domain, err))
+				continue
+			}
+			changed(domain, role)
+		}
+		grants = append(grants, &v1.Grant{Domain: domain, Role: role})
+	}
+	for _, g := range u.SsoGrants {
+		if _, ok := want[g.Domain]; ok || db.Role(g.Domain, user) != g.Role {
+			continue
+		}
+		db.RemoveMember(g.Domain, user)
+		changed(g.Domain, "")
+	}
+	u.SsoGrants = grants
+	return errors.Join(append(errs, db.saveUser(u))...)
+}
+
+func (db *Ops) CreateInvitation(domain, role, by string) (*v1.Invitation, error) {
+	if !ValidRole(role) {
+		return nil, ErrRole
+	}
+	if !db.HasSite(domain) {
+		return nil, ErrNoSite
+	}
+	inv := &v1.Invitation{
+		Id:        gonanoid.Must(24),
+		Domain:    domain,
+		Role:      role,
+		InvitedBy: by,
+		Expires:   uint64(xtime.Now().Add(InvitationTTL).UnixMilli()),
+	}
+	data, _ := proto.Marshal(inv)
+	err := db.db.Set(encoding.Invitation([]byte(inv.Id)), data, nil)
+	if err != nil {
+		return nil, err
+	}
+	return inv, nil
+}
+
+// Invitation returns a pending invitation. Expired invitations and invitations
+// to deleted sites result in ErrInvitation.
+func (db *Ops) Invitation(id string) (*v1.Invitation, error) {
+	var inv v1.Invitation
+	err := data.Get(db.db, encoding.Invitation([]byte(id)), func(val []byte) error {
+		return proto.Unmarshal(val, &inv)
+	})
+	if err != nil {
+		if errors.Is(err, pebble.ErrNotFound) {
+			err = ErrInvitation
+		}
+		return nil, err
+	}
+	if !valid(&inv) || !db.HasSite(inv.Domain) {
+		return nil, ErrInvitation
+	}
+	return &inv, nil
+}
+
+// Invitations returns pending invitations for domain.
+func (db *Ops) Invitations(domain string) (ls []*v1.Invitation, err error) {
+	err = data.Prefix(db.db, keys.InvitationPrefix, func(key, value []byte) error {
+		var inv v1.Invitation
+		err := proto.Unmarshal(value, &inv)
+		if err != nil {
+			return err
+		}
+		if inv.Domain == domain && valid(&inv) {
+			ls = append(ls, &inv)
+		}
+		return nil
+	})
+	return
+}
+
+func (db *Ops) DeleteInvitation(id string) error {
+	return db.db.Delete(encoding.Invitation([]byte(id)), nil)
+}
+
+// AcceptInvitation grants user the invited role and consumes the invitation.
+func (db *Ops) AcceptInvitation(id, user string) (*v1.Invitation, error) {
+	inv, err := db.Invitation(id)
+	if err != nil {
+		return nil, err
+	}
+	// never downgrade existing members by accepting an invitation.
+	if !Allows(db.Role(inv.Domain, user), inv.Role) {
+		err = db.SetRole(inv.Domain, user, inv.Role)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return inv, db.DeleteInvitation(id)
+}
+
+func valid(inv *v1.Invitation) bool {
+	return xtime.Now().UnixMilli() < int64(inv.Expires)
+}
+
+func memberIndex(site *v1.Site, user string) int {
+	i, ok := slices.BinarySearchFunc(site.Members, user, func(m *v1.Member, u string) int {
+		return cmp.Compare(m.User, u)
+	})
+	if !ok {
+		return -1
+	}
+	return i
+}
+
+func compareMember(a, b *v1.Member) int {
+	return cmp.Compare(a.User, b.User)
+}
@@ -0,0 +1,87 @@
+package ops
+
+import (
+	"testing"
+
+	"github.com/cockroachdb/pebble"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMembership(t *testing.T) {
+	db, err := pebble.Open(t.TempDir(), nil)
+	require.NoError(t, err)
+	defer db.Close()
+
+	require.NoError(t, CreateAdmin(db, "root", "password"))
+	o := New(db, nil, "vinceanalytics.com")
+
+	require.Equal(t, ErrUserExists, o.CreateUser("root", "password"))
+	require.NoError(t, o.CreateUser("jane", "password"))
+	require.True(t, o.Authenticate("jane", "password"))
+	require.False(t, o.Authenticate("jane", "wrong"))
+
+	require.Equal(t, RoleOwner, o.Role("vinceanalytics.com", "root"))
+	require.Equal(t, "", o.Role("vinceanalytics.com", "jane"))
+
+	inv, err := o.CreateInvitation("vinceanalytics.com", RoleViewer, "root")
+	require.NoError(t, err)
+	_, err = o.AcceptInvitation(inv.Id, "jane")
+	require.NoError(t, err)
+	require.Equal(t, RoleViewer, o.Role("vinceanalytics.com", "jane"))
+
+	_, err = o.AcceptInvitation(inv.Id, "jane")
+	require.Equal(t, ErrInvitation, err)
+
+	require.False(t, Allows(RoleViewer, RoleAdmin))
+	require.True(t, Allows(RoleOwner, RoleAdmin))
+
+	require.NoError(t, o.DeleteUser("jane"))
+	require.Equal(t, "", o.Role("vinceanalytics.com", "jane"))
+	require.Equal(t, ErrUserAdmin, o.DeleteUser("root"))
+}
+
+func TestSyncRoles(t *testing.T) {
+	db, err := pebble.Open(t.TempDir(), nil)
+	require.NoError(t, err)
+	defer db.Close()
+
+	require.NoError(t, CreateAdmin(db, "root", "password"))
+	o := New(db, nil, "a.com", "b.com", "c.com")
+	require.NoError(t, o.EnsureUser("jane"))
+	require.NoError(t, o.SetRole("c.com", "jane", RoleOwner))
+
+	var changes []string
+	sync := func(want map[string]string) {
+		changes = changes[:0]
+		require.NoError(t, o.SyncRoles("jane", want, func(domain, role string) {
+			changes = append(changes, domain+":"+role)
+		}))
+	}
+
+	sync(map[string]string{"a.com": RoleAdmin, "b.com": RoleViewer, "c.com": RoleViewer})
+	require.Equal(t, []string{"a.com:admin", "b.com:viewer"}, changes)
+	require.Equal(t, RoleOwner, o.Role("c.com", "jane"), "roles given by other means are kept")
+
+	t.Run("downgrades and revokes", func(t *testing.T) {
+		sync(map[string]string{"a.com": RoleViewer})
+		require.Equal(t, []string{"a.com:viewer", "b.com:"}, changes)
+		require.Equal(t, RoleViewer, o.Role("a.com", "jane"))
+		require.Equal(t, "", o.Role("b.com", "jane"))
+		require.Equal(t, RoleOwner, o.Role("c.com", "jane"))
+	})
+
+	t.Run("keeps roles changed since", func(t *testing.T) {
+		require.NoError(t, o.SetRole("a.com", "jane", RoleOwner))
+		sync(nil)
+		require.Empty(t, changes)
+		require.Equal(t, RoleOwner, o.Role("a.com", "jane"))
+	})
+
+	t.Run("keeps password", func(t *testing.T) {
+		sync(map[string]string{"b.com": RoleAdmin})
+		require.NoError(t, o.ChangePassword("jane", "password"))
+		sync(nil)
+		require.Equal(t, []string{"b.com:"}, changes)
+		require.True(t, o.Authenticate("jane", "password"))
+	})
+}
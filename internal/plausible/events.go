@@ -0,0 +1,140 @@
+package plausible
+
+import (
+	"errors"
+	"time"
+
+	"github.com/vinceanalytics/vince/internal/models"
+	"github.com/vinceanalytics/vince/internal/timeseries"
+)
+
+// ErrOverlap is returned when the import range contains events for the domain.
+var ErrOverlap = errors.New("domain has events in the import date range")
+
+const dayMillis = int64(24 * time.Hour / time.Millisecond)
+
+// Import writes synthesized events of e for domain into ts. Dates that already
+// have events for domain are never touched, the whole import is refused
+// instead.
+//
+// Like (*timeseries.Timeseries)Add, this must be called in the same goroutine
+// that adds events.
+func Import(ts *timeseries.Timeseries, domain string, e *Export) error {
+	start, end := e.Range()
+	if ts.Exists(domain, start, end) {
+		return ErrOverlap
+	}
+	name := []byte(domain)
+	for _, d := range e.Days() {
+		err := d.Events(name, ts.Add)
+		if err != nil {
+			return err
+		}
+	}
+	return ts.Save()
+}
+
+// Events calls fn for every event synthesized from d. The same model is reused
+// between calls.
+func (d *Day) Events(domain []byte, fn func(m *models.Model) error) error {
+	n := max(d.Pageviews, d.Visits)
+	total := n
+	for _, e := range d.events {
+		total += e.count
+	}
+	if total == 0 {
+		return nil
+	}
+	step := max(dayMillis/total, 1)
+	visitors := max(d.Visitors, 1)
+	var duration int64
+	if d.Visits > 0 {
+		duration = d.Duration * int64(time.Second) / d.Visits
+	}
+	sessions := newCursors(d.sessions)
+	views := newCursors(d.views)
+
+	var m models.Model
+	var i int64
+	next := func() {
+		m = models.Model{}
+		m.Id = d.visitor(i % visitors)
+		m.Domain = domain
+		m.Timestamp = d.Date.UnixMilli() + min(i*step, dayMillis-1)
+	}
+	for ; i < n; i++ {
+		next()
+		if i < d.Visits {
+			m.Session = true
+			if i < d.Bounces {
+				m.Bounce = 1
+			}
+			m.Duration = duration
+			sessions.apply(&m)
+		}
+		if i < d.Pageviews {
+			m.View = true
+			views.apply(&m)
+		}
+		err := fn(&m)
+		if err != nil {
+			return err
+		}
+	}
+	for _, e := range d.events {
+		for range e.count {
+			next()
+			m.Event = e.name
+			m.Page = e.page
+			m.Props = e.props
+			err := fn(&m)
+			if err != nil {
+				return err
+			}
+			i++
+		}
+	}
+	return nil
+}
+
+// visitor returns a stable visitor id for the i'th visitor of the day. Ids have
+// models.ImportedVisitor set so they never collide with hashed visitor ids.
+func (d *Day) visitor(i int64) uint64 {
+	day := uint64(d.Date.Unix() / int64(24*time.Hour/time.Second))
+	return models.ImportedVisitor | day<<32 | uint64(i)
+}
+
+type cursors []cursor
+
+func newCursors(runs [][]run) cursors {
+	o := make(cursors, 0, len(runs))
+	for _, r := range runs {
+		if len(r) > 0 {
+			o = append(o, cursor{runs: r})
+		}
+	}
+	return o
+}
+
+func (c cursors) apply(m *models.Model) {
+	for i := range c {
+		c[i].apply(m)
+	}
+}
+
+type cursor struct {
+	runs []run
+	used int64
+}
+
+func (c *cursor) apply(m *models.Model) {
+	if len(c.runs) == 0 {
+		return
+	}
+	c.runs[0].apply(m)
+	c.used++
+	if c.used == c.runs[0].count {
+		c.runs = c.runs[1:]
+		c.used = 0
+	}
+}
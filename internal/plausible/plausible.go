@@ -0,0 +1,334 @@
+// Package plausible imports Plausible Analytics CSV exports.
+//
+// Exports only contain daily aggregates, each file breaking down the same
+// visits by a different dimension. We synthesize events for every day such
+// that aggregating them yields the exported totals: visits become session
+// events carrying bounces and visit duration, pageviews become view events and
+// each dimension file is spread across those events in order.
+package plausible
+
+import (
+	"archive/zip"
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"path"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/vinceanalytics/vince/internal/models"
+)
+
+const dateLayout = "2006-01-02"
+
+// Export is a parsed Plausible CSV export.
+type Export struct {
+	days map[int64]*Day
+}
+
+// Day holds aggregates for a single date of the export.
+type Day struct {
+	Date      time.Time
+	Visitors  int64
+	Pageviews int64
+	Bounces   int64
+	Visits    int64
+	// Duration is the total visit duration in seconds.
+	Duration int64
+
+	sessions [][]run
+	views    [][]run
+	events   []event
+}
+
+type run struct {
+	count int64
+	apply func(m *models.Model)
+}
+
+type event struct {
+	count int64
+	name  []byte
+	page  []byte
+	props [][]byte
+}
+
+// Open reads the export zip file at path.
+func Open(path string) (*Export, error) {
+	r, err := zip.OpenReader(path)
+	if err != nil {
+		return nil, err
+	}
+	defer r.Close()
+	return Read(&r.Reader)
+}
+
+// Read parses all known files in the export zip. Unknown files are ignored.
+func Read(r *zip.Reader) (*Export, error) {
+	e := &Export{days: make(map[int64]*Day)}
+	var found bool
+	for _, f := range r.File {
+		kind, ok := fileKind(f.Name)
+		if !ok {
+			continue
+		}
+		found = true
+		err := e.readFile(f, kind)
+		if err != nil {
+			return nil, fmt.Errorf("reading %s %w", f.Name, err)
+		}
+	}
+	if !found {
+		return nil, errors.New("no plausible export files found")
+	}
+	return e, nil
+}
+
+// Range returns the first and last dates in the export.
+func (e *Export) Range() (start, end time.Time) {
+	for _, d := range e.days {
+		if start.IsZero() || d.Date.Before(start) {
+			start = d.Date
+		}
+		if d.Date.After(end) {
+			end = d.Date
+		}
+	}
+	return
+}
+
+// In anchors all days of the export to midnight in loc. Plausible exports dates
+// in the site timezone, this must be called before Import for sites that are
+// not in UTC.
+func (e *Export) In(loc *time.Location) {
+	for _, d := range e.days {
+		yy, mm, dd := d.Date.Date()
+		d.Date = time.Date(yy, mm, dd, 0, 0, 0, 0, loc)
+	}
+}
+
+// Days returns all days of the export sorted by date.
+func (e *Export) Days() []*Day {
+	o := make([]*Day, 0, len(e.days))
+	for _, d := range e.days {
+		o = append(o, d)
+	}
+	slices.SortFunc(o, func(a, b *Day) int {
+		return a.Date.Compare(b.Date)
+	})
+	return o
+}
+
+var kinds = []string{
+	"visitors", "sources", "pages", "entry_pages", "exit_pages",
+	"locations", "devices", "browsers", "operating_systems", "custom_events",
+}
+
+func fileKind(name string) (string, bool) {
+	base := path.Base(name)
+	if !strings.HasPrefix(base, "imported_") || !strings.HasSuffix(base, ".csv") {
+		return "", false
+	}
+	base = strings.TrimPrefix(base, "imported_")
+	// longest match first, entry_pages and exit_pages share a suffix with pages.
+	var kind string
+	for _, k := range kinds {
+		if strings.HasPrefix(base, k+"_") || base == k+".csv" {
+			if len(k) > len(kind) {
+				kind = k
+			}
+		}
+	}
+	return kind, kind != ""
+}
+
+func (e *Export) readFile(f *zip.File, kind string) error {
+	rc, err := f.Open()
+	if err != nil {
+		return err
+	}
+	defer rc.Close()
+	r := csv.NewReader(rc)
+	header, err := r.Read()
+	if err != nil {
+		return err
+	}
+	cols := make(map[string]int, len(header))
+	for i, h := range header {
+		cols[strings.TrimSpace(h)] = i
+	}
+	if _, ok := cols["date"]; !ok {
+		return errors.New("missing date column")
+	}
+	line := 1
+	for {
+		rec, err := r.Read()
+		if err != nil {
+			if errors.Is(err, io.EOF) {
+				return nil
+			}
+			return err
+		}
+		line++
+		row := record{cols: cols, values: rec}
+		err = e.add(kind, row)
+		if err != nil {
+			return fmt.Errorf("line %d %w", line, err)
+		}
+	}
+}
+
+func (e *Export) day(r record) (*Day, error) {
+	date, err := time.Parse(dateLayout, r.get("date"))
+	if err != nil {
+		return nil, err
+	}
+	key := date.Unix()
+	d, ok := e.days[key]
+	if !ok {
+		d = &Day{Date: date}
+		e.days[key] = d
+	}
+	return d, nil
+}
+
+func (e *Export) add(kind string, r record) error {
+	d, err := e.day(r)
+	if err != nil {
+		return err
+	}
+	switch kind {
+	case "visitors":
+		d.Visitors += r.int("visitors")
+		d.Pageviews += r.int("pageviews")
+		d.Bounces += r.int("bounces")
+		d.Visits += r.int("visits")
+		d.Duration += r.int("visit_duration")
+	case "sources":
+		source := r.bytes("source")
+		referrer := r.bytes("referrer")
+		medium := r.bytes("utm_medium")
+		usource := r.bytes("utm_source")
+		campaign := r.bytes("utm_campaign")
+		content := r.bytes("utm_content")
+		term := r.bytes("utm_term")
+		d.session(kind, r.int("visits"), func(m *models.Model) {
+			m.Source = source
+			m.Referrer = referrer
+			m.UtmMedium = medium
+			m.UtmSource = usource
+			m.UtmCampaign = campaign
+			m.UtmContent = content
+			m.UtmTerm = term
+		})
+	case "entry_pages":
+		page := r.bytes("entry_page")
+		d.session(kind, r.int("entrances"), func(m *models.Model) {
+			m.EntryPage = page
+		})
+	case "exit_pages":
+		page := r.bytes("exit_page")
+		d.session(kind, r.int("exits"), func(m *models.Model) {
+			m.ExitPage = page
+		})
+	case "locations":
+		country := r.bytes("country")
+		region := r.bytes("region")
+		city := r.int("city")
+		d.session(kind, r.int("visits"), func(m *models.Model) {
+			m.Country = country
+			m.Subdivision1Code = region
+			m.City = uint32(city)
+		})
+	case "devices":
+		device := r.bytes("device")
+		d.session(kind, r.int("visits"), func(m *models.Model) {
+			m.Device = device
+		})
+	case "browsers":
+		browser := r.bytes("browser")
+		version := r.bytes("browser_version")
+		d.session(kind, r.int("visits"), func(m *models.Model) {
+			m.Browser = browser
+			m.BrowserVersion = version
+		})
+	case "operating_systems":
+		os := r.bytes("operating_system")
+		version := r.bytes("operating_system_version")
+		d.session(kind, r.int("visits"), func(m *models.Model) {
+			m.Os = os
+			m.OsVersion = version
+		})
+	case "pages":
+		host := r.bytes("hostname")
+		page := r.bytes("page")
+		d.view(kind, r.int("pageviews"), func(m *models.Model) {
+			m.Host = host
+			m.Page = page
+		})
+	case "custom_events":
+		ev := event{
+			count: r.int("events"),
+			name:  r.bytes("name"),
+			page:  r.bytes("path"),
+		}
+		if url := r.bytes("link_url"); len(url) > 0 {
+			ev.props = [][]byte{models.Prop([]byte("url"), url)}
+		}
+		if ev.count > 0 && len(ev.name) > 0 {
+			d.events = append(d.events, ev)
+		}
+	}
+	return nil
+}
+
+func (d *Day) session(kind string, count int64, apply func(m *models.Model)) {
+	if count <= 0 {
+		return
+	}
+	i := slices.Index(kinds, kind)
+	if len(d.sessions) == 0 {
+		d.sessions = make([][]run, len(kinds))
+	}
+	d.sessions[i] = append(d.sessions[i], run{count: count, apply: apply})
+}
+
+func (d *Day) view(kind string, count int64, apply func(m *models.Model)) {
+	if count <= 0 {
+		return
+	}
+	i := slices.Index(kinds, kind)
+	if len(d.views) == 0 {
+		d.views = make([][]run, len(kinds))
+	}
+	d.views[i] = append(d.views[i], run{count: count, apply: apply})
+}
+
+type record struct {
+	cols   map[string]int
+	values []string
+}
+
+func (r record) get(name string) string {
+	i, ok := r.cols[name]
+	if !ok || i >= len(r.values) {
+		return ""
+	}
+	return strings.TrimSpace(r.values[i])
+}
+
+func (r record) bytes(name string) []byte {
+	v := r.get(name)
+	if v == "" {
+		return nil
+	}
+	return []byte(v)
+}
+
+func (r record) int(name string) int64 {
+	v, _ := strconv.ParseInt(r.get(name), 10, 64)
+	return v
+}
@@ -0,0 +1,56 @@
+package plausible
+
+import (
+	"archive/zip"
+	"bytes"
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"github.com/vinceanalytics/vince/internal/api/aggregates"
+	"github.com/vinceanalytics/vince/internal/timeseries/timeseriestest"
+	"github.com/vinceanalytics/vince/internal/web/query"
+)
+
+func TestImport(t *testing.T) {
+	var b bytes.Buffer
+	w := zip.NewWriter(&b)
+	for name, data := range map[string]string{
+		"imported_visitors_20240101_20240102.csv": "date,visitors,pageviews,bounces,visits,visit_duration\n" +
+			"2024-01-01,3,10,2,4,120\n" +
+			"2024-01-02,1,2,1,1,0\n",
+		"imported_sources_20240101_20240102.csv": "date,source,referrer,utm_source,utm_medium,utm_campaign,utm_content,utm_term,pageviews,visitors,visits,visit_duration,bounces\n" +
+			"2024-01-01,Google,,,,,,,6,2,3,100,1\n" +
+			"2024-01-01,Twitter,,,,,,,4,1,1,20,1\n",
+		"imported_custom_events_20240101_20240102.csv": "date,name,link_url,path,visitors,events\n" +
+			"2024-01-01,Signup,,/register,1,2\n",
+	} {
+		f, err := w.Create(name)
+		require.NoError(t, err)
+		f.Write([]byte(data))
+	}
+	require.NoError(t, w.Close())
+
+	zr, err := zip.NewReader(bytes.NewReader(b.Bytes()), int64(b.Len()))
+	require.NoError(t, err)
+	export, err := Read(zr)
+	require.NoError(t, err)
+	start, end := export.Range()
+	require.Equal(t, time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC), start)
+	require.Equal(t, time.Date(2024, 1, 2, 0, 0, 0, 0, time.UTC), end)
+
+	_, ts := timeseriestest.New(t)
+	require.NoError(t, Import(ts, "vinceanalytics.com", export))
+
+	s := aggregates.Aggregates(context.Background(), ts, "vinceanalytics.com",
+		start.Add(-time.Second), end, query.Date, nil,
+		[]string{"visitors", "visits", "pageviews", "bounce_rate"})
+	s.Compute()
+	require.Equal(t, float64(12), s.PageViews)
+	require.Equal(t, float64(5), s.Visits)
+	require.Equal(t, float64(60), s.BounceRate)
+	require.Equal(t, float64(4), s.Visitors)
+
+	require.ErrorIs(t, Import(ts, "vinceanalytics.com", export), ErrOverlap)
+}
@@ -0,0 +1,122 @@
+// Package ratelimit implements token bucket rate limiting for arbitrary keys.
+package ratelimit
+
+import (
+	"math"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/vinceanalytics/vince/internal/util/xtime"
+)
+
+// idle is how often buckets that refilled completely are dropped.
+const idle = time.Minute
+
+// Limit describes a token bucket.
+type Limit struct {
+	// Rate is the number of requests allowed per second. Zero disables limiting.
+	Rate float64
+	// Burst is the maximum number of requests allowed at once. It defaults to
+	// Rate rounded up.
+	Burst int
+}
+
+func (l Limit) burst() float64 {
+	if l.Burst > 0 {
+		return float64(l.Burst)
+	}
+	return math.Max(1, math.Ceil(l.Rate))
+}
+
+type bucket struct {
+	tokens float64
+	last   time.Time
+	limit  Limit
+}
+
+// refill adds tokens accumulated since the last request.
+func (b *bucket) refill(now time.Time) {
+	elapsed := now.Sub(b.last).Seconds()
+	if elapsed > 0 {
+		b.tokens = math.Min(b.limit.burst(), b.tokens+elapsed*b.limit.Rate)
+	}
+	b.last = now
+}
+
+// Limiter tracks a token bucket per key. Rejected requests are counted by
+// group, which is usually coarser than key, for example the site all client
+// buckets belong to.
+type Limiter struct {
+	mu       sync.Mutex
+	buckets  map[string]*bucket
+	rejected map[string]uint64
+	sweep    time.Time
+}
+
+func New() *Limiter {
+	return &Limiter{
+		buckets:  make(map[string]*bucket),
+		rejected: make(map[string]uint64),
+		sweep:    xtime.Now(),
+	}
+}
+
+// Allow takes a token from the bucket for key. When no tokens are left it
+// returns false together with how long until the next token is available.
+func (l *Limiter) Allow(group, key string, limit Limit) (bool, time.Duration) {
+	return l.allow(group, key, limit, xtime.Now())
+}
+
+func (l *Limiter) allow(group, key string, limit Limit, now time.Time) (bool, time.Duration) {
+	if limit.Rate <= 0 {
+		return true, 0
+	}
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if now.Sub(l.sweep) >= idle {
+		l.clean(now)
+	}
+	b, ok := l.buckets[key]
+	if !ok {
+		b = &bucket{tokens: limit.burst(), last: now, limit: limit}
+		l.buckets[key] = b
+	}
+	if b.limit != limit {
+		// Limits changed, keep accumulated tokens within the new burst.
+		b.limit = limit
+		b.tokens = math.Min(b.tokens, limit.burst())
+	}
+	b.refill(now)
+	if b.tokens >= 1 {
+		b.tokens--
+		return true, 0
+	}
+	l.rejected[group]++
+	wait := time.Duration((1 - b.tokens) / limit.Rate * float64(time.Second))
+	return false, wait
+}
+
+// clean drops buckets that would be full by now, they behave exactly like new
+// buckets.
+func (l *Limiter) clean(now time.Time) {
+	for k, b := range l.buckets {
+		b.refill(now)
+		if b.tokens >= b.limit.burst() {
+			delete(l.buckets, k)
+		}
+	}
+	l.sweep = now
+}
+
+// Rejected returns the number of rejected requests for group.
+func (l *Limiter) Rejected(group string) uint64 {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	return l.rejected[group]
+}
+
+// RetryAfter formats wait as a Retry-After header value in whole seconds.
+func RetryAfter(wait time.Duration) string {
+	return strconv.FormatInt(int64(math.Ceil(math.Max(wait.Seconds(), 1))), 10)
+}
@@ -0,0 +1,39 @@
+package ratelimit
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"github.com/vinceanalytics/vince/internal/util/xtime"
+)
+
+func TestLimiter(t *testing.T) {
+	l := New()
+	now := xtime.Test()
+	l.sweep = now
+	limit := Limit{Rate: 2, Burst: 3}
+
+	for range 3 {
+		ok, _ := l.allow("site", "a", limit, now)
+		require.True(t, ok)
+	}
+	ok, wait := l.allow("site", "a", limit, now)
+	require.False(t, ok)
+	require.Equal(t, 500*time.Millisecond, wait)
+	require.Equal(t, "1", RetryAfter(wait))
+
+	// other keys have their own bucket
+	ok, _ = l.allow("site", "b", limit, now)
+	require.True(t, ok)
+
+	ok, _ = l.allow("site", "a", limit, now.Add(wait))
+	require.True(t, ok)
+	require.Equal(t, uint64(1), l.Rejected("site"))
+
+	ok, _ = l.allow("site", "a", Limit{}, now)
+	require.True(t, ok)
+
+	l.allow("site", "a", limit, now.Add(time.Hour))
+	require.Len(t, l.buckets, 1)
+}
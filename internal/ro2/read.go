@@ -159,3 +159,77 @@ func Row(ra OffsetRanger, shard, rowID uint64) *Bitmap {
 func Existence(tx OffsetRanger, shard uint64) *Bitmap {
 	return Row(tx, shard, bsiExistsBit)
 }
+
+// ReadBSI calls fn with the column and value of every BSI entry present in
+// filterBitmap.
+func ReadBSI(ra Reader, shard uint64, filterBitmap *Bitmap, fn func(column uint64, value int64)) {
+	existsBitmap := ra.OffsetRange(shardWidth*shard, shardWidth*0, shardWidth*1)
+	if filterBitmap != nil {
+		existsBitmap = existsBitmap.Intersect(filterBitmap)
+	}
+	signBitmap := ra.OffsetRange(shardWidth*shard, shardWidth*1, shardWidth*2)
+
+	depth := ra.Max() / shardWidth
+	dataBitmaps := make([]*roaring.Bitmap, depth)
+	for i := uint64(0); i < depth; i++ {
+		dataBitmaps[i] = ra.OffsetRange(shardWidth*shard, shardWidth*(i+2), shardWidth*(i+3))
+	}
+	stashWords := make([]uint64, 1024*(depth+2))
+	bitStashes := make([][]uint64, depth)
+	for i := uint64(0); i < depth; i++ {
+		bitStashes[i] = stashWords[i*1024 : (i+1)*1024]
+	}
+	stashOffset := depth * 1024
+	existStash := stashWords[stashOffset : stashOffset+1024]
+	signStash := stashWords[stashOffset+1024 : stashOffset+2048]
+	dataBits := make([][]uint64, depth)
+
+	existIterator, _ := existsBitmap.Containers.Iterator(0)
+	for existIterator.Next() {
+		key, value := existIterator.Value()
+		if value.N() == 0 {
+			continue
+		}
+		exists := value.AsBitmap(existStash)
+		sign := signBitmap.Containers.Get(key).AsBitmap(signStash)
+		for i := uint64(0); i < depth; i++ {
+			dataBits[i] = dataBitmaps[i].Containers.Get(key).AsBitmap(bitStashes[i])
+		}
+		base := key << 16
+		for idx, word := range exists {
+			for word != 0 {
+				shift := uint(bits.TrailingZeros64(word))
+				word &= word - 1
+				mask := uint64(1) << shift
+				value := int64(0)
+				for b := uint64(0); b < depth; b++ {
+					if dataBits[b][idx]&mask != 0 {
+						value += (1 << b)
+					}
+				}
+				if sign[idx]&mask != 0 {
+					value *= -1
+				}
+				fn(base+uint64(idx)*64+uint64(shift), value)
+			}
+		}
+	}
+}
+
+// ReadMutexColumns calls fn with the column and row of every mutex entry
+// present in filterBitmap.
+func ReadMutexColumns(ra Reader, shard uint64, filterBitmap *Bitmap, fn func(column, row uint64)) {
+	for ra.Seek(0); ra.Valid(); ra.Next() {
+		k, c := ra.Value()
+		key := shard*rowWidth + k&keyMask
+		f := filterBitmap.Containers.Get(key)
+		if f == nil || !roaring.IntersectionAny(c, f) {
+			continue
+		}
+		row := k >> rowExponent
+		base := key << 16
+		for _, v := range roaring.Intersect(c, f).Slice() {
+			fn(base+uint64(v), row)
+		}
+	}
+}
@@ -15,6 +15,12 @@ func WriteMutex(ra *Bitmap, id uint64, value uint64) {
 	ra.DirectAdd(value*shardWidth + (id % shardWidth))
 }
 
+// WriteSet adds id to row value of a set field. Unlike mutex fields a column
+// can be in many rows.
+func WriteSet(ra *Bitmap, id uint64, value uint64) {
+	ra.DirectAdd(value*shardWidth + (id % shardWidth))
+}
+
 const (
 	// Row ids used for boolean fields.
 	falseRowID = uint64(0)
@@ -0,0 +1,58 @@
+package shards
+
+import (
+	"os"
+	"path/filepath"
+
+	"github.com/cockroachdb/pebble"
+)
+
+// checkpoints is the directory inside the data directory holding checkpoints.
+// Checkpoints left behind by a crash are removed when the database is opened.
+const checkpoints = "checkpoints"
+
+// Checkpoint writes a consistent copy of the ops database and every shard into
+// a new directory inside the checkpoints directory, with the same layout as the
+// data directory. Files are hard linked where possible so this is cheap.
+// Callers must remove dir when done with it.
+//
+// Shards are checkpointed one after another, callers that need a consistent
+// view across shards must make sure no events are saved meanwhile.
+func (db *DB) Checkpoint() (dir string, shards []uint64, err error) {
+	base := filepath.Join(db.base, checkpoints)
+	err = os.MkdirAll(base, 0755)
+	if err != nil {
+		return "", nil, err
+	}
+	dir, err = os.MkdirTemp(base, "checkpoint-")
+	if err != nil {
+		return "", nil, err
+	}
+	defer func() {
+		if err != nil {
+			os.RemoveAll(dir)
+		}
+	}()
+	err = db.ops.Checkpoint(filepath.Join(dir, "ops"), pebble.WithFlushedWAL())
+	if err != nil {
+		return
+	}
+	err = os.Mkdir(filepath.Join(dir, "shards"), 0755)
+	if err != nil {
+		return
+	}
+	db.shards.RLock()
+	defer db.shards.RUnlock()
+	for i := uint64(0); i <= db.shards.max; i++ {
+		sh := db.shards.data[i]
+		if sh == nil {
+			continue
+		}
+		err = sh.DB.Checkpoint(filepath.Join(dir, "shards", Format(sh.ID)), pebble.WithFlushedWAL())
+		if err != nil {
+			return
+		}
+		shards = append(shards, sh.ID)
+	}
+	return
+}
@@ -0,0 +1,25 @@
+package shards
+
+import (
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestCheckpointCleanup(t *testing.T) {
+	base := t.TempDir()
+	db, err := New(base)
+	require.NoError(t, err)
+	dir, _, err := db.Checkpoint()
+	require.NoError(t, err)
+	require.DirExists(t, dir)
+	require.NoError(t, db.Close())
+
+	// checkpoints left behind are removed on open
+	db, err = New(base)
+	require.NoError(t, err)
+	defer db.Close()
+	_, err = os.Stat(dir)
+	require.ErrorIs(t, err, os.ErrNotExist)
+}
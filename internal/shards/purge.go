@@ -0,0 +1,107 @@
+package shards
+
+import (
+	"math"
+
+	"github.com/cockroachdb/pebble"
+	"github.com/vinceanalytics/vince/internal/encoding"
+	"github.com/vinceanalytics/vince/internal/keys"
+)
+
+var resolutions = []encoding.Resolution{
+	encoding.Global, encoding.Minute, encoding.Hour,
+	encoding.Day, encoding.Week, encoding.Month,
+}
+
+// Purge deletes all views of domainId at resolution res that start before the
+// given view.
+func (db *DB) Purge(domainId uint64, res encoding.Resolution, before uint64) error {
+	return db.purge(domainId, []encoding.Resolution{res}, before)
+}
+
+// PurgeShard deletes all timeseries data for domainId in shard and compacts
+// the shard to reclaim disk space. Missing shards are skipped.
+func (db *DB) PurgeShard(domainId, shard uint64) error {
+	db.shards.RLock()
+	defer db.shards.RUnlock()
+	sh := db.shards.data[shard]
+	if sh == nil {
+		return nil
+	}
+	err := purgeShard(sh.DB, domainId, resolutions, math.MaxUint64)
+	if err != nil {
+		return err
+	}
+	return sh.DB.Compact(keys.DataPrefix, []byte{keys.DataExistsPrefix[0] + 1}, true)
+}
+
+// Shards returns the number of shards, including missing ones, that PurgeShard
+// must visit.
+func (db *DB) Shards() uint64 {
+	db.shards.RLock()
+	defer db.shards.RUnlock()
+	return db.shards.max + 1
+}
+
+func (db *DB) purge(domainId uint64, res []encoding.Resolution, before uint64) error {
+	db.shards.RLock()
+	defer db.shards.RUnlock()
+	for i := uint64(0); i <= db.shards.max; i++ {
+		sh := db.shards.data[i]
+		if sh == nil {
+			continue
+		}
+		err := purgeShard(sh.DB, domainId, res, before)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// purgeShard deletes views of domainId with pebble range deletions. Views come
+// before domain in the key layout, so we seek to each view present in the
+// shard and delete the domain's range within it.
+func purgeShard(db *pebble.DB, domainId uint64, res []encoding.Resolution, before uint64) error {
+	it, err := db.NewIter(nil)
+	if err != nil {
+		return err
+	}
+	defer it.Close()
+	ba := db.NewBatch()
+	defer ba.Close()
+
+	var lo, hi encoding.Key
+	for _, exists := range []bool{false, true} {
+		write := (*encoding.Key).WriteData
+		if exists {
+			write = (*encoding.Key).WriteExistence
+		}
+		for _, re := range res {
+			for view := uint64(0); view < before; view++ {
+				write(&lo, re, 0, view, 0, 0)
+				if !it.SeekGE(lo[:]) {
+					break
+				}
+				key := it.Key()
+				if len(key) != encoding.BitmapKeySize || key[0] != lo[0] || key[1] != lo[1] {
+					break
+				}
+				view = encoding.From(key).View()
+				if view >= before {
+					break
+				}
+				write(&lo, re, 0, view, domainId, 0)
+				write(&hi, re, 0, view, domainId+1, 0)
+				err := ba.DeleteRange(lo[:], hi[:], nil)
+				if err != nil {
+					return err
+				}
+			}
+		}
+	}
+	if ba.Empty() {
+		return nil
+	}
+	return ba.Commit(pebble.Sync)
+}
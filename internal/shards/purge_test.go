@@ -0,0 +1,72 @@
+package shards_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"github.com/vinceanalytics/vince/internal/encoding"
+	"github.com/vinceanalytics/vince/internal/models"
+	"github.com/vinceanalytics/vince/internal/timeseries/timeseriestest"
+)
+
+func TestPurge(t *testing.T) {
+	old := time.Date(2024, 1, 1, 10, 0, 0, 0, time.UTC)
+	now := time.Date(2024, 3, 1, 10, 0, 0, 0, time.UTC)
+	var events []*models.Model
+	for _, domain := range []string{"a.com", "b.com"} {
+		for _, at := range []time.Time{old, now} {
+			events = append(events, &models.Model{
+				Timestamp: at.UnixMilli(),
+				Id:        1,
+				Domain:    []byte(domain),
+				View:      true,
+			})
+		}
+	}
+	db, ts := timeseriestest.New(t, events...)
+
+	visitors := func(domain string, res encoding.Resolution, at time.Time) uint64 {
+		return ts.Visitors(at.Add(-time.Second), at, res, domain)
+	}
+	a := ts.Translate(models.Field_domain, []byte("a.com"))
+	require.NoError(t, db.Purge(a, encoding.Minute, uint64(now.Add(-time.Hour).UnixMilli())))
+
+	require.Zero(t, visitors("a.com", encoding.Minute, old))
+	require.Equal(t, uint64(1), visitors("a.com", encoding.Minute, now))
+	require.Equal(t, uint64(1), visitors("a.com", encoding.Hour, old))
+	require.Equal(t, uint64(1), visitors("b.com", encoding.Minute, old))
+}
+
+func TestPurgeShard(t *testing.T) {
+	db, ts := timeseriestest.New(t)
+
+	now := time.Date(2024, 3, 1, 10, 0, 0, 0, time.UTC)
+	add := func(domain string) {
+		timeseriestest.Add(t, ts, &models.Model{
+			Timestamp: now.UnixMilli(),
+			Id:        1,
+			Domain:    []byte(domain),
+			View:      true,
+		})
+	}
+	visitors := func(domain string) uint64 {
+		return ts.Visitors(now.Add(-time.Second), now, encoding.Hour, domain)
+	}
+	add("a.com")
+	add("b.com")
+
+	a := ts.Translate(models.Field_domain, []byte("a.com"))
+	require.NoError(t, ts.Forget(models.Field_domain, []byte("a.com")))
+	require.Zero(t, ts.Translate(models.Field_domain, []byte("a.com")))
+	for shard := range db.Shards() {
+		require.NoError(t, db.PurgeShard(a, shard))
+	}
+	require.Zero(t, visitors("a.com"))
+	require.Equal(t, uint64(1), visitors("b.com"))
+
+	// a re-created site starts with a new id and no stats.
+	add("a.com")
+	require.NotEqual(t, a, ts.Translate(models.Field_domain, []byte("a.com")))
+	require.Equal(t, uint64(1), visitors("a.com"))
+}
@@ -58,6 +58,12 @@ func New(base string) (*DB, error) {
 	if err != nil {
 		return nil, err
 	}
+	// the ops database is locked, no other process is using checkpoints.
+	err = os.RemoveAll(filepath.Join(base, checkpoints))
+	if err != nil {
+		ops.Close()
+		return nil, err
+	}
 	db := &DB{ops: ops, base: base}
 	shardsBase := filepath.Join(base, "shards")
 	entries, _ := os.ReadDir(shardsBase)
@@ -77,6 +83,11 @@ func (db *DB) Get() *pebble.DB {
 	return db.ops
 }
 
+// Path returns the data directory.
+func (db *DB) Path() string {
+	return db.base
+}
+
 func (db *DB) Iter(
 	domainId uint64,
 	re encoding.Resolution,
@@ -103,11 +103,12 @@ func (cu *Cursor) ApplyFilter(key uint64, filter roaring.BitmapFilter) (err erro
 	}
 	var minKey roaring.FilterKey
 
-	for ; cu.Valid(); cu.it.Next() {
+	for valid := cu.Valid(); valid; {
 		dk := cu.it.Key()
 		ckey := binary.BigEndian.Uint64(dk[len(dk)-8:])
 		key := roaring.FilterKey(ckey)
 		if key < minKey {
+			valid = cu.Next()
 			continue
 		}
 		// Because ne never delete, we are sure that no empty container is ever
@@ -127,10 +128,11 @@ func (cu *Cursor) ApplyFilter(key uint64, filter roaring.BitmapFilter) (err erro
 		}
 		minKey = res.NoKey
 		if minKey > key+1 {
-			if !cu.Seek(uint64(minKey)) {
-				return nil
-			}
+			// Seek leaves the cursor on the next key to consider.
+			valid = cu.Seek(uint64(minKey))
+			continue
 		}
+		valid = cu.Next()
 	}
 	return nil
 
@@ -2,7 +2,9 @@ package timeseries
 
 import (
 	"errors"
+	"time"
 
+	"github.com/cockroachdb/pebble"
 	"github.com/vinceanalytics/vince/internal/encoding"
 	"github.com/vinceanalytics/vince/internal/location"
 	"github.com/vinceanalytics/vince/internal/models"
@@ -31,10 +33,43 @@ func (ts *Timeseries) Translate(field models.Field, value []byte) uint64 {
 	return ts.tree.Get(encoding.TranslateKey(field, value))
 }
 
+// Forget removes the translation of value. Next time value is seen it is
+// assigned a new id. It must be called in the same goroutine as
+// (*Timeseries)Save.
+func (ts *Timeseries) Forget(field models.Field, value []byte) error {
+	key := encoding.TranslateKey(field, value)
+	id := ts.tree.Get(key)
+	if id == 0 {
+		return nil
+	}
+	ba := ts.db.Get().NewBatch()
+	defer ba.Close()
+	err := errors.Join(
+		ba.Delete(key, nil),
+		ba.Delete(encoding.TranslateID(field, id), nil),
+	)
+	if err != nil {
+		return err
+	}
+	err = ba.Commit(pebble.Sync)
+	if err != nil {
+		return err
+	}
+	ts.tree.Delete(key)
+	return nil
+}
+
 func (ts *Timeseries) Get() *shards.DB {
 	return ts.db
 }
 
+// SetZone sets the function used to resolve the timezone of a domain. Day, week
+// and month views of events are computed in the returned location. When unset
+// all views are in UTC.
+func (ts *Timeseries) SetZone(zone func(domain []byte) *time.Location) {
+	ts.ba.zone = zone
+}
+
 func (ts *Timeseries) Location() *location.Location {
 	return ts.lo
 }
@@ -2,6 +2,7 @@ package timeseries
 
 import (
 	"encoding/binary"
+	"time"
 
 	"github.com/cockroachdb/pebble"
 	"github.com/gernest/roaring"
@@ -49,6 +50,7 @@ type batch struct {
 	shard     uint64
 
 	domainId uint64
+	zone     func(domain []byte) *time.Location
 }
 
 func newbatch(db *shards.DB, tr *translation) *batch {
@@ -63,8 +65,11 @@ func newbatch(db *shards.DB, tr *translation) *batch {
 	return b
 }
 
-func (b *batch) setTs(timestamp int64) {
+func (b *batch) setTs(timestamp int64, domain []byte) {
 	ts := xtime.UnixMilli(timestamp)
+	if b.zone != nil {
+		ts = ts.In(b.zone(domain))
+	}
 	b.views[encoding.Minute] = uint64(compute.Minute(ts).UnixMilli())
 	b.views[encoding.Hour] = uint64(compute.Hour(ts).UnixMilli())
 	b.views[encoding.Day] = uint64(compute.Date(ts).UnixMilli())
@@ -72,10 +77,6 @@ func (b *batch) setTs(timestamp int64) {
 	b.views[encoding.Month] = uint64(compute.Month(ts).UnixMilli())
 }
 
-func (b *batch) setDomain(m *models.Model) {
-
-}
-
 // saves only current timestamp.
 func (b *batch) save() error {
 	if b.events == 0 {
@@ -149,7 +150,9 @@ func (b *batch) add(m *models.Model) error {
 
 	b.id = b.translate.Next()
 	id := b.id
-	b.setTs(m.Timestamp)
+	b.setTs(m.Timestamp, m.Domain)
+	// domain id is part of every key we write, it must be set before any field.
+	b.domainId = b.tr(models.Field_domain, m.Domain)
 	b.bs(models.Field_id, id, int64(m.Id))
 	if m.Bounce != 0 {
 		b.boolean(models.Field_bounce, id, m.Bounce == 1)
@@ -163,6 +166,15 @@ func (b *batch) add(m *models.Model) error {
 	if m.Duration > 0 {
 		b.bs(models.Field_duration, id, m.Duration)
 	}
+	if m.Revenue != 0 {
+		b.bs(models.Field_revenue, id, m.Revenue)
+	}
+	if m.SessionId != 0 {
+		b.bs(models.Field_session_id, id, int64(m.SessionId))
+	}
+	if m.Sequence > 0 {
+		b.bs(models.Field_sequence, id, m.Sequence)
+	}
 	if m.City != 0 {
 		b.mx(models.Field_city, id, uint64(m.City))
 	}
@@ -172,7 +184,6 @@ func (b *batch) add(m *models.Model) error {
 	b.set(models.Field_device, id, m.Device)
 
 	// domain is stored as part of the key, we only save existence bit
-	b.domainId = b.tr(models.Field_domain, m.Domain)
 	b.mxExixtenceOnly(models.Field_domain, id)
 
 	b.set(models.Field_entry_page, id, m.EntryPage)
@@ -191,6 +202,7 @@ func (b *batch) add(m *models.Model) error {
 	b.set(models.Field_utm_term, id, m.UtmTerm)
 	b.set(models.Field_subdivision1_code, id, m.Subdivision1Code)
 	b.set(models.Field_subdivision2_code, id, m.Subdivision2Code)
+	b.many(models.Field_props, id, m.Props)
 	return nil
 }
 
@@ -243,6 +255,31 @@ func (b *batch) mx(field models.Field, id uint64, value uint64) {
 	}
 }
 
+// many adds id to the rows of all values of a set field.
+func (b *batch) many(field models.Field, id uint64, values [][]byte) {
+	if len(values) == 0 {
+		return
+	}
+	for i := range b.views {
+		ra := b.ra(Key{
+			Resolution: encoding.Resolution(i),
+			Field:      field,
+			View:       b.views[i],
+			Domain:     b.domainId,
+		})
+		for _, value := range values {
+			ro2.WriteSet(ra, id, b.tr(field, value))
+		}
+		b.ra(Key{
+			Resolution: encoding.Resolution(i),
+			Field:      field,
+			View:       b.views[i],
+			Domain:     b.domainId,
+			Existence:  true,
+		}).DirectAdd(id % shardwidth.ShardWidth)
+	}
+}
+
 func (b *batch) mxExixtenceOnly(field models.Field, id uint64) {
 	for i := range b.views {
 		b.ra(Key{
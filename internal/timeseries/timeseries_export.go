@@ -0,0 +1,199 @@
+package timeseries
+
+import (
+	"context"
+	"time"
+
+	"github.com/vinceanalytics/vince/internal/encoding"
+	"github.com/vinceanalytics/vince/internal/models"
+	"github.com/vinceanalytics/vince/internal/ro2"
+	"github.com/vinceanalytics/vince/internal/timeseries/cursor"
+)
+
+// maxExportNames bounds translations cached while exporting a field.
+const maxExportNames = 1 << 16
+
+var (
+	exportBSI = []models.Field{
+		models.Field_id, models.Field_session_id, models.Field_sequence,
+		models.Field_duration, models.Field_revenue,
+	}
+	exportBool = []models.Field{
+		models.Field_view, models.Field_session, models.Field_bounce,
+	}
+	exportMutex = []models.Field{
+		models.Field_browser, models.Field_browser_version, models.Field_country,
+		models.Field_device, models.Field_entry_page, models.Field_event,
+		models.Field_exit_page, models.Field_host, models.Field_os,
+		models.Field_os_version, models.Field_page, models.Field_referrer,
+		models.Field_source, models.Field_utm_campaign, models.Field_utm_content,
+		models.Field_utm_medium, models.Field_utm_source, models.Field_utm_term,
+		models.Field_subdivision1_code, models.Field_subdivision2_code, models.Field_props,
+	}
+)
+
+// Export reconstructs events of domain recorded in [start, end) and calls fn
+// with each of them. Events are read from minute views so their timestamps are
+// truncated to the minute. They are ordered by shard then by minute.
+//
+// fn must not modify m. Byte slices of m are shared with other events.
+func (ts *Timeseries) Export(ctx context.Context, domain string, start, end time.Time, fn func(m *models.Model) error) error {
+	domainId := ts.Translate(models.Field_domain, []byte(domain))
+	if domainId == 0 {
+		return nil
+	}
+	names := make([]map[uint64][]byte, models.TranslatedFieldsSize)
+	for i := range names {
+		names[i] = map[uint64][]byte{}
+	}
+	find := func(field models.Field, id uint64) []byte {
+		m := names[field.Translated()]
+		v, ok := m[id]
+		if !ok {
+			if len(m) >= maxExportNames {
+				clear(m)
+			}
+			v = []byte(ts.Find(ctx, field, id))
+			m[id] = v
+		}
+		return v
+	}
+	rows := map[uint64]*models.Model{}
+
+	// minute views are yielded for (start, end+1m], shift the range so that
+	// views in [start, end) are included.
+	return ts.db.Iter(domainId, encoding.Minute, start.Add(-time.Millisecond), end.Add(-time.Minute-time.Millisecond),
+		func(cu *cursor.Cursor, shard, view uint64, match *ro2.Bitmap) error {
+			if err := ctx.Err(); err != nil {
+				return err
+			}
+			clear(rows)
+			columns := match.Slice()
+			for _, col := range columns {
+				rows[col] = &models.Model{Timestamp: int64(view), Domain: []byte(domain)}
+			}
+			res := encoding.Minute
+			for _, field := range exportBSI {
+				if !cu.ResetData(res, field, view) {
+					continue
+				}
+				ro2.ReadBSI(cu, shard, match, func(column uint64, value int64) {
+					m, ok := rows[column]
+					if !ok {
+						return
+					}
+					switch field {
+					case models.Field_id:
+						m.Id = uint64(value)
+					case models.Field_session_id:
+						m.SessionId = uint64(value)
+					case models.Field_sequence:
+						m.Sequence = value
+					case models.Field_duration:
+						m.Duration = value
+					case models.Field_revenue:
+						m.Revenue = value
+					}
+				})
+			}
+			for _, field := range exportBool {
+				if !cu.ResetData(res, field, view) {
+					continue
+				}
+				ro2.ReadMutexColumns(cu, shard, match, func(column, row uint64) {
+					m, ok := rows[column]
+					if !ok {
+						return
+					}
+					switch field {
+					case models.Field_view:
+						m.View = row == 1
+					case models.Field_session:
+						m.Session = row == 1
+					case models.Field_bounce:
+						m.Bounce = -1
+						if row == 1 {
+							m.Bounce = 1
+						}
+					}
+				})
+			}
+			if cu.ResetData(res, models.Field_city, view) {
+				ro2.ReadMutexColumns(cu, shard, match, func(column, row uint64) {
+					if m, ok := rows[column]; ok {
+						m.City = uint32(row)
+					}
+				})
+			}
+			for _, field := range exportMutex {
+				if !cu.ResetData(res, field, view) {
+					continue
+				}
+				ro2.ReadMutexColumns(cu, shard, match, func(column, row uint64) {
+					m, ok := rows[column]
+					if !ok {
+						return
+					}
+					value := find(field, row)
+					if field == models.Field_props {
+						m.Props = append(m.Props, value)
+						return
+					}
+					*stringField(m, field) = value
+				})
+			}
+			for _, col := range columns {
+				err := fn(rows[col])
+				if err != nil {
+					return err
+				}
+			}
+			return nil
+		})
+}
+
+// stringField returns the translated field of m.
+func stringField(m *models.Model, field models.Field) *[]byte {
+	switch field {
+	case models.Field_browser:
+		return &m.Browser
+	case models.Field_browser_version:
+		return &m.BrowserVersion
+	case models.Field_country:
+		return &m.Country
+	case models.Field_device:
+		return &m.Device
+	case models.Field_entry_page:
+		return &m.EntryPage
+	case models.Field_event:
+		return &m.Event
+	case models.Field_exit_page:
+		return &m.ExitPage
+	case models.Field_host:
+		return &m.Host
+	case models.Field_os:
+		return &m.Os
+	case models.Field_os_version:
+		return &m.OsVersion
+	case models.Field_page:
+		return &m.Page
+	case models.Field_referrer:
+		return &m.Referrer
+	case models.Field_source:
+		return &m.Source
+	case models.Field_utm_campaign:
+		return &m.UtmCampaign
+	case models.Field_utm_content:
+		return &m.UtmContent
+	case models.Field_utm_medium:
+		return &m.UtmMedium
+	case models.Field_utm_source:
+		return &m.UtmSource
+	case models.Field_utm_term:
+		return &m.UtmTerm
+	case models.Field_subdivision1_code:
+		return &m.Subdivision1Code
+	default:
+		return &m.Subdivision2Code
+	}
+}
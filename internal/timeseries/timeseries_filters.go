@@ -121,6 +121,12 @@ func (ts *Timeseries) compile(fs wq.Filters) Filter {
 			}
 		default:
 			fd := models.Field(models.Field_value[f.Key])
+			// custom properties are translated with their key as prefix
+			var prefix []byte
+			if key, ok := strings.CutPrefix(f.Key, wq.PropsPrefix); ok {
+				fd = models.Field_props
+				prefix = models.PropKey([]byte(key))
+			}
 			if fd == 0 {
 				return nil
 			}
@@ -129,7 +135,7 @@ func (ts *Timeseries) compile(fs wq.Filters) Filter {
 			case "is", "is_not":
 				values := make([]uint64, len(f.Value))
 				for i := range f.Value {
-					values[i] = ts.Translate(fd, []byte(f.Value[i]))
+					values[i] = ts.Translate(fd, join(prefix, f.Value[i]))
 				}
 				if f.Op == "is" {
 					a = And{
@@ -151,17 +157,17 @@ func (ts *Timeseries) compile(fs wq.Filters) Filter {
 			case "matches", "does_not_match":
 				var values []uint64
 				for _, source := range f.Value {
-					prefix, exact := searchPrefix([]byte(source))
+					search, exact := searchPrefix([]byte(source))
 					if exact {
-						values = append(values, ts.Translate(fd, []byte(source)))
+						values = append(values, ts.Translate(fd, join(prefix, source)))
 					} else {
 						re, err := regexp.Compile(source)
 						if err != nil {
 							return nil
 						}
 
-						ts.Search(fd, prefix, func(key []byte, val uint64) {
-							if re.Match(key) {
+						ts.Search(fd, join(prefix, string(search)), func(key []byte, val uint64) {
+							if re.Match(key[len(prefix):]) {
 								values = append(values, val)
 							}
 						})
@@ -194,8 +200,8 @@ func (ts *Timeseries) compile(fs wq.Filters) Filter {
 				if err != nil {
 					return nil
 				}
-				ts.Search(fd, []byte{}, func(b []byte, val uint64) {
-					if re.Match(b) {
+				ts.Search(fd, join(prefix, ""), func(b []byte, val uint64) {
+					if re.Match(b[len(prefix):]) {
 						values = append(values, val)
 					}
 				})
@@ -240,6 +246,12 @@ func (ts *Timeseries) SearchKeys(field models.Field, prefix []byte, f func(key [
 	})
 }
 
+func join(prefix []byte, value string) []byte {
+	o := make([]byte, 0, len(prefix)+len(value))
+	o = append(o, prefix...)
+	return append(o, value...)
+}
+
 func searchPrefix(source []byte) (prefix []byte, exact bool) {
 	for i := range source {
 		if special(source[i]) {
@@ -1,6 +1,7 @@
 package timeseries
 
 import (
+	"errors"
 	"time"
 
 	"github.com/vinceanalytics/vince/internal/encoding"
@@ -26,6 +27,24 @@ func (ts *Timeseries) Visitors(start, end time.Time, resolution encoding.Resolut
 	return
 }
 
+var errExists = errors.New("exists")
+
+// Exists reports whether domain has any events on days between start and end
+// inclusive.
+func (ts *Timeseries) Exists(domain string, start, end time.Time) bool {
+	domainId := ts.Translate(models.Field_domain, []byte(domain))
+	if domainId == 0 {
+		return false
+	}
+	// day views are yielded for (start, end+1day], shift the range so that
+	// both start and end days are included.
+	err := ts.db.Iter(domainId, encoding.Day, start.Add(-time.Millisecond), end.Add(-time.Millisecond),
+		func(cu *cursor.Cursor, shard, view uint64, match *ro2.Bitmap) error {
+			return errExists
+		})
+	return errors.Is(err, errExists)
+}
+
 func (ts *Timeseries) Scan(
 	domainId uint64,
 	res encoding.Resolution,
@@ -43,6 +43,13 @@ func (t *treeLocked) Get(key []byte) (value uint64) {
 	return
 }
 
+func (t *treeLocked) Delete(key []byte) {
+	hash := hash.Bytes(key)
+	t.mu.Lock()
+	t.tree.Delete(hash)
+	t.mu.Unlock()
+}
+
 func (c *treeLocked) Release() error {
 	c.mu.Lock()
 	defer c.mu.Unlock()
@@ -78,7 +85,7 @@ func newTranslation(db *pebble.DB, tree *treeLocked) *translation {
 				continue
 			}
 			f := models.Field(key[2])
-			tr.ranges[f.Mutex()] = val
+			tr.ranges[f.Translated()] = val
 		}
 		start := time.Now()
 		slog.Info("loading translation data")
@@ -113,7 +120,7 @@ func (tr *translation) Assign(field models.Field, value []byte) uint64 {
 	if uid > 0 {
 		return uid
 	}
-	idx := field.Mutex()
+	idx := field.Translated()
 	tr.ranges[idx]++
 	uid = tr.ranges[idx]
 
@@ -145,7 +152,7 @@ func (tr *translation) flush(f func(key, value []byte, _ *pebble.WriteOptions) e
 	}
 
 	for i := range tr.ranges {
-		key[2] = byte(models.Mutex(i))
+		key[2] = byte(models.Translated(i))
 		binary.BigEndian.PutUint64(b[:], tr.ranges[i])
 		err := f(key, b[:], nil)
 		if err != nil {
@@ -156,8 +163,8 @@ func (tr *translation) flush(f func(key, value []byte, _ *pebble.WriteOptions) e
 	value := make([]byte, 3+8)
 	copy(value, keys.TranslateIDPrefix)
 	for i := range tr.keys {
-		key[2] = byte(models.Mutex(i))
-		value[2] = byte(models.Mutex(i))
+		key[2] = byte(models.Translated(i))
+		value[2] = byte(models.Translated(i))
 		for j := range tr.keys[i] {
 			key = append(key[:3], tr.keys[i][j]...)
 			binary.BigEndian.PutUint64(value[3:], tr.values[i][j])
@@ -0,0 +1,37 @@
+// Package timeseriestest provides a timeseries store backed by a temporary
+// database for tests.
+package timeseriestest
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/vinceanalytics/vince/internal/location"
+	"github.com/vinceanalytics/vince/internal/models"
+	"github.com/vinceanalytics/vince/internal/shards"
+	"github.com/vinceanalytics/vince/internal/timeseries"
+)
+
+// New opens a timeseries store in a temporary directory and saves events in
+// it. The database is closed when the test finishes.
+func New(t testing.TB, events ...*models.Model) (*shards.DB, *timeseries.Timeseries) {
+	t.Helper()
+	db, err := shards.New(t.TempDir())
+	require.NoError(t, err)
+	t.Cleanup(func() { db.Close() })
+	ts := timeseries.New(db, location.New())
+	Add(t, ts, events...)
+	return db, ts
+}
+
+// Add saves events in ts.
+func Add(t testing.TB, ts *timeseries.Timeseries, events ...*models.Model) {
+	t.Helper()
+	if len(events) == 0 {
+		return
+	}
+	for _, m := range events {
+		require.NoError(t, ts.Add(m))
+	}
+	require.NoError(t, ts.Save())
+}
@@ -109,6 +109,17 @@ func (c *Cache[Key, Value]) Len() int {
 	return c.ll.Len()
 }
 
+// Range calls f for each item from the least to the most recently used.
+func (c *Cache[Key, Value]) Range(f func(key Key, value Value)) {
+	if c.cache == nil {
+		return
+	}
+	for e := c.ll.Back(); e != nil; e = e.Prev() {
+		kv := e.Value.(*entry[Key, Value])
+		f(kv.key, kv.value)
+	}
+}
+
 // Clear purges all stored items from the cache.
 func (c *Cache[Key, Value]) Clear() {
 	c.ll = nil
@@ -1,7 +1,10 @@
 // Package oracle stores global truths of the whole runnning system.
 package oracle
 
-import "sync/atomic"
+import (
+	"sync/atomic"
+	"time"
+)
 
 var (
 	//Records tracks total records stored in the database. This only accounts for
@@ -21,4 +24,47 @@ var (
 	Demo     string
 
 	Profile bool
+
+	// CurrencyRates is path to a JSON file with currency exchange rates used
+	// to convert revenue to site reporting currency.
+	CurrencyRates string
+
+	// OIDC configures single sign-on. It is disabled when Issuer is empty.
+	OIDC struct {
+		Issuer       string
+		ClientID     string
+		ClientSecret string
+		// EmailDomains restricts login to emails in these domains.
+		EmailDomains []string
+		GroupsClaim  string
+		// Roles maps identity provider groups to site roles, each entry is
+		// group:role or group:role:domain.
+		Roles []string
+	}
+
+	// TrustedProxies are CIDR ranges of reverse proxies allowed to set the
+	// client address with ClientIPHeaders.
+	TrustedProxies  []string
+	ClientIPHeaders []string
+
+	// Ingest configures the queue between event handlers and the event loop.
+	Ingest struct {
+		// Queue is the number of events buffered before new ones are dropped.
+		Queue int64
+		// Batch is the number of events committed together, events are also
+		// committed every Interval.
+		Batch    int64
+		Interval time.Duration
+	}
+
+	// RateLimit configures request throttling, a zero rate disables it.
+	RateLimit struct {
+		// API limits requests per second for each API key.
+		API      float64
+		APIBurst int64
+		// Events limits events per second for each client of a site. Sites can
+		// override it.
+		Events      float64
+		EventsBurst int64
+	}
 )
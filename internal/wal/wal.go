@@ -0,0 +1,143 @@
+// Package wal implements an append only log of records that have been
+// accepted but are not yet durable elsewhere.
+//
+// Records are written without fsync. They survive a crash of the process but
+// not of the host.
+package wal
+
+import (
+	"bufio"
+	"encoding/binary"
+	"errors"
+	"hash/crc32"
+	"io"
+	"os"
+	"sync"
+)
+
+const (
+	// header is the size of the length and checksum preceding each record.
+	header = 8
+	// maxRecord bounds record sizes so a corrupt length is not allocated.
+	maxRecord = 16 << 20
+)
+
+var table = crc32.MakeTable(crc32.Castagnoli)
+
+// Log is safe for concurrent use.
+type Log struct {
+	mu  sync.Mutex
+	f   *os.File
+	buf []byte
+}
+
+// Open opens the log at path, creating it if it does not exist.
+func Open(path string) (*Log, error) {
+	f, err := os.OpenFile(path, os.O_RDWR|os.O_CREATE, 0644)
+	if err != nil {
+		return nil, err
+	}
+	return &Log{f: f}, nil
+}
+
+// Replay calls fn with every record in the log in the order they were
+// appended, fn may retain record. A partially written record at the end of
+// the log is discarded. New records are appended after the last complete one.
+func (l *Log) Replay(fn func(record []byte) error) error {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	_, err := l.f.Seek(0, io.SeekStart)
+	if err != nil {
+		return err
+	}
+	r := bufio.NewReader(l.f)
+	var offset int64
+	var h [header]byte
+	for {
+		_, err = io.ReadFull(r, h[:])
+		if err != nil {
+			break
+		}
+		size := binary.LittleEndian.Uint32(h[:])
+		if size > maxRecord {
+			break
+		}
+		record := make([]byte, size)
+		_, err = io.ReadFull(r, record)
+		if err != nil || crc32.Checksum(record, table) != binary.LittleEndian.Uint32(h[4:]) {
+			break
+		}
+		err = fn(record)
+		if err != nil {
+			return err
+		}
+		offset += header + int64(len(record))
+	}
+	if !errors.Is(err, io.EOF) && !errors.Is(err, io.ErrUnexpectedEOF) && err != nil {
+		return err
+	}
+	err = l.f.Truncate(offset)
+	if err != nil {
+		return err
+	}
+	_, err = l.f.Seek(offset, io.SeekStart)
+	return err
+}
+
+// Append adds record to the end of the log.
+func (l *Log) Append(record []byte) error {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.buf = binary.LittleEndian.AppendUint32(l.buf[:0], uint32(len(record)))
+	l.buf = binary.LittleEndian.AppendUint32(l.buf, crc32.Checksum(record, table))
+	l.buf = append(l.buf, record...)
+	_, err := l.f.Write(l.buf)
+	return err
+}
+
+// Trim removes the first n records from the log. Records appended after them
+// are kept, they are moved to the start of the log.
+func (l *Log) Trim(n int) error {
+	if n <= 0 {
+		return nil
+	}
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	end, err := l.f.Seek(0, io.SeekCurrent)
+	if err != nil {
+		return err
+	}
+	var offset int64
+	var h [header]byte
+	for ; n > 0 && offset < end; n-- {
+		_, err = l.f.ReadAt(h[:], offset)
+		if err != nil {
+			return err
+		}
+		offset += header + int64(binary.LittleEndian.Uint32(h[:]))
+	}
+	offset = min(offset, end)
+	if offset < end {
+		rest := make([]byte, end-offset)
+		_, err = l.f.ReadAt(rest, offset)
+		if err != nil {
+			return err
+		}
+		_, err = l.f.WriteAt(rest, 0)
+		if err != nil {
+			return err
+		}
+	}
+	err = l.f.Truncate(end - offset)
+	if err != nil {
+		return err
+	}
+	_, err = l.f.Seek(end-offset, io.SeekStart)
+	return err
+}
+
+func (l *Log) Close() error {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	return l.f.Close()
+}
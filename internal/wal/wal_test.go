@@ -0,0 +1,52 @@
+package wal
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestLog(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "events.wal")
+	l, err := Open(path)
+	require.NoError(t, err)
+	require.NoError(t, l.Append([]byte("one")))
+	require.NoError(t, l.Append([]byte("two")))
+	require.NoError(t, l.Close())
+
+	// simulate a crash in the middle of writing a record
+	f, err := os.OpenFile(path, os.O_APPEND|os.O_WRONLY, 0)
+	require.NoError(t, err)
+	_, err = f.Write([]byte{5, 0, 0, 0, 1, 2})
+	require.NoError(t, err)
+	require.NoError(t, f.Close())
+
+	l, err = Open(path)
+	require.NoError(t, err)
+	defer l.Close()
+	var got []string
+	replay := func(record []byte) error {
+		got = append(got, string(record))
+		return nil
+	}
+	require.NoError(t, l.Replay(replay))
+	require.Equal(t, []string{"one", "two"}, got)
+
+	require.NoError(t, l.Append([]byte("three")))
+	got = got[:0]
+	require.NoError(t, l.Replay(replay))
+	require.Equal(t, []string{"one", "two", "three"}, got)
+
+	require.NoError(t, l.Trim(2))
+	require.NoError(t, l.Append([]byte("four")))
+	got = got[:0]
+	require.NoError(t, l.Replay(replay))
+	require.Equal(t, []string{"three", "four"}, got)
+
+	require.NoError(t, l.Trim(5))
+	got = got[:0]
+	require.NoError(t, l.Replay(replay))
+	require.Empty(t, got)
+}
@@ -0,0 +1,111 @@
+package web
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/url"
+	"strconv"
+	"time"
+
+	v1 "github.com/vinceanalytics/vince/gen/go/vince/v1"
+	"github.com/vinceanalytics/vince/internal/ops"
+	"github.com/vinceanalytics/vince/internal/web/db"
+)
+
+const auditPageSize = 50
+
+type auditEntry struct {
+	Time    time.Time `json:"time"`
+	Actor   string    `json:"actor"`
+	Action  string    `json:"action"`
+	Site    string    `json:"site,omitempty"`
+	Target  string    `json:"target,omitempty"`
+	Details string    `json:"details,omitempty"`
+}
+
+func newAuditEntry(e *v1.AuditEntry) auditEntry {
+	return auditEntry{
+		Time:    time.Unix(0, int64(e.Timestamp)).UTC(),
+		Actor:   e.Actor,
+		Action:  e.Action,
+		Site:    e.Site,
+		Target:  e.Target,
+		Details: e.Details,
+	}
+}
+
+func auditFilter(r *http.Request) ops.AuditFilter {
+	q := r.URL.Query()
+	before, _ := strconv.ParseUint(q.Get("before"), 10, 64)
+	return ops.AuditFilter{
+		Actor:  q.Get("actor"),
+		Action: q.Get("action"),
+		Site:   q.Get("site"),
+		Before: before,
+	}
+}
+
+func AuditLog(db *db.Config, w http.ResponseWriter, r *http.Request) {
+	filter := auditFilter(r)
+	ls := make([]map[string]any, 0, auditPageSize)
+	var last, next uint64
+	err := db.Ops().AuditLog(filter, func(e *v1.AuditEntry) bool {
+		if len(ls) == auditPageSize {
+			next = last
+			return false
+		}
+		last = e.Timestamp
+		a := newAuditEntry(e)
+		ls = append(ls, map[string]any{
+			"time":    a.Time.Format("Jan 2, 2006 15:04:05"),
+			"actor":   a.Actor,
+			"action":  a.Action,
+			"site":    a.Site,
+			"target":  a.Target,
+			"details": a.Details,
+		})
+		return true
+	})
+	if err != nil {
+		db.Logger().Error("reading audit log", "err", err)
+		db.HTML(w, e500, nil)
+		return
+	}
+	q := url.Values{}
+	for k, v := range map[string]string{"actor": filter.Actor, "action": filter.Action, "site": filter.Site} {
+		if v != "" {
+			q.Set(k, v)
+		}
+	}
+	ctx := map[string]any{
+		"entries": ls,
+		"filter":  filter,
+		"actions": ops.AuditActions(),
+		"export":  "/settings/audit/export?" + q.Encode(),
+	}
+	if next != 0 {
+		q.Set("before", strconv.FormatUint(next, 10))
+		ctx["older"] = "/settings/audit?" + q.Encode()
+	}
+	db.HTML(w, auditLog, ctx)
+}
+
+// ExportAuditLog streams all entries matching the filter as a JSON array.
+func ExportAuditLog(db *db.Config, w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("content-type", "application/json")
+	w.Header().Set("content-disposition", `attachment; filename="vince-audit-log.json"`)
+	enc := json.NewEncoder(w)
+	var n int
+	w.Write([]byte("["))
+	err := db.Ops().AuditLog(auditFilter(r), func(e *v1.AuditEntry) bool {
+		if n > 0 {
+			w.Write([]byte(","))
+		}
+		n++
+		return enc.Encode(newAuditEntry(e)) == nil
+	})
+	w.Write([]byte("]\n"))
+	if err != nil {
+		db.Logger().Error("exporting audit log", "err", err)
+	}
+}
@@ -3,30 +3,135 @@ package web
 import (
 	"crypto/rand"
 	"encoding/base64"
+	"errors"
 	"net/http"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
 
+	v1 "github.com/vinceanalytics/vince/gen/go/vince/v1"
+	"github.com/vinceanalytics/vince/internal/ops"
 	"github.com/vinceanalytics/vince/internal/web/db"
 )
 
 func UserSetting(db *db.Config, w http.ResponseWriter, r *http.Request) {
+	user := db.CurrentUser()
+	ctx := map[string]any{
+		"two_factor":     db.Ops().HasTwoFactor(user),
+		"recovery_codes": db.Ops().RemainingRecoveryCodes(user),
+	}
+	if !db.IsAdmin() {
+		db.HTML(w, userSettings, ctx)
+		return
+	}
 	keys, err := db.Ops().APIKeys()
 	if err != nil {
 		db.Logger().Error("reading api keys", "err", err)
 		db.HTML(w, e500, nil)
 		return
 	}
-	db.HTML(w, userSettings, map[string]any{
-		"keys": keys,
-	})
+	users, err := db.Ops().Users()
+	if err != nil {
+		db.Logger().Error("reading users", "err", err)
+		db.HTML(w, e500, nil)
+		return
+	}
+	ls := make([]map[string]any, 0, len(users))
+	for _, u := range users {
+		var sites []string
+		db.Ops().Domains(func(s *v1.Site) {
+			if role := db.Ops().RoleOf(s, u.Name); role != "" {
+				sites = append(sites, s.Domain+" ("+role+")")
+			}
+		})
+		slices.Sort(sites)
+		ls = append(ls, map[string]any{
+			"name":  u.Name,
+			"sites": sites,
+		})
+	}
+	ctx["keys"] = apiKeys(db, keys)
+	ctx["users"] = ls
+	db.HTML(w, userSettings, ctx)
+}
+
+func ChangePassword(db *db.Config, w http.ResponseWriter, r *http.Request) {
+	r.ParseForm()
+	user := db.CurrentUser()
+	switch {
+	case !db.PasswordMatch(user, r.Form.Get("current_password")):
+		db.Fail("Current password is incorrect")
+	case r.Form.Get("password") != r.Form.Get("password_confirmation"):
+		db.Fail("Password confirmation does not match")
+	default:
+		err := db.Ops().ChangePassword(user, r.Form.Get("password"))
+		if err != nil {
+			db.Fail(err.Error())
+		} else {
+			db.Audit(ops.AuditUserPassword, "", user, "")
+			db.Success("Password updated successfully")
+		}
+	}
+	db.SaveSession(w)
+	http.Redirect(w, r, "/settings#password", http.StatusFound)
+}
+
+func NewUserForm(db *db.Config, w http.ResponseWriter, r *http.Request) {
+	db.HTML(w, newUser, nil)
+}
+
+func CreateUser(db *db.Config, w http.ResponseWriter, r *http.Request) {
+	r.ParseForm()
+	name := r.Form.Get("name")
+	err := db.Ops().CreateUser(name, r.Form.Get("password"))
+	if err != nil {
+		db.SaveCsrf(w)
+		db.HTML(w, newUser, map[string]any{
+			"name":  name,
+			"error": err.Error(),
+		})
+		return
+	}
+	db.Audit(ops.AuditUserCreate, "", strings.TrimSpace(name), "")
+	db.Success("User created successfully")
+	db.SaveSession(w)
+	http.Redirect(w, r, "/settings#users", http.StatusFound)
+}
+
+func DeleteUser(db *db.Config, w http.ResponseWriter, r *http.Request) {
+	name := r.PathValue("name")
+	err := db.Ops().DeleteUser(name)
+	if err != nil {
+		db.Logger().Error("deleting user", "name", name, "err", err)
+		db.Fail(err.Error())
+	} else {
+		db.Audit(ops.AuditUserDelete, "", name, "")
+		db.Success("User deleted successfully")
+	}
+	db.SaveSession(w)
+	http.Redirect(w, r, "/settings#users", http.StatusFound)
 }
 
 func NewApiKey(db *db.Config, w http.ResponseWriter, r *http.Request) {
 	data := make([]byte, 64)
 	rand.Read(data)
-	key := base64.StdEncoding.EncodeToString(data)[:64]
-	db.HTML(w, newAPIKey, map[string]any{
-		"key": key,
+	key := base64.StdEncoding.EncodeToString(data)[:ops.MinAPIKeyLen]
+	renderNewAPIKey(db, w, map[string]any{
+		"key":    key,
+		"scopes": []string{ops.ScopeStatsRead},
+	})
+}
+
+func renderNewAPIKey(db *db.Config, w http.ResponseWriter, ctx map[string]any) {
+	var sites []string
+	db.Ops().Domains(func(s *v1.Site) {
+		sites = append(sites, s.Domain)
 	})
+	slices.Sort(sites)
+	ctx["all_sites"] = sites
+	ctx["all_scopes"] = ops.Scopes()
+	db.HTML(w, newAPIKey, ctx)
 }
 
 func DeleteAPiKey(db *db.Config, w http.ResponseWriter, r *http.Request) {
@@ -37,6 +142,7 @@ func DeleteAPiKey(db *db.Config, w http.ResponseWriter, r *http.Request) {
 		db.HTML(w, e500, nil)
 		return
 	}
+	db.Audit(ops.AuditAPIKeyRevoke, "", name, "")
 	db.Success("API key revoked successfully")
 	db.SaveSession(w)
 	http.Redirect(w, r, "/settings#api-keys", http.StatusFound)
@@ -46,23 +152,89 @@ func CreateAPiKey(db *db.Config, w http.ResponseWriter, r *http.Request) {
 	r.ParseForm()
 	name := r.FormValue("name")
 	key := r.FormValue("key")
-	valid := map[string]string{}
+	days, _ := strconv.Atoi(r.FormValue("expires"))
+	a := &v1.APIKey{
+		Name:     name,
+		Sites:    r.Form["sites"],
+		AllSites: r.FormValue("all_sites") == "on",
+		Scopes:   r.Form["scopes"],
+		Expires:  ops.APIKeyExpiry(days),
+	}
+	valid := map[string]any{}
 	if name == "" {
 		valid["error_name"] = "missing"
 	}
 	if key == "" {
 		valid["error_key"] = "missing"
 	}
-	if key != "" && len(key) < 64 {
+	if key != "" && len(key) < ops.MinAPIKeyLen {
 		valid["error_key"] = "invalid key"
 	}
-	err := db.Ops().CreateAPIKey(name, key)
-	if err != nil {
-		db.Logger().Error("creating new key", "err", err)
-		db.HTML(w, e500, nil)
-		return
+	if len(a.Scopes) == 0 {
+		valid["error_scopes"] = "select at least one permission"
 	}
-	db.Success("API key created successfully")
-	db.SaveSession(w)
-	http.Redirect(w, r, "/settings#api-keys", http.StatusFound)
+	if !a.AllSites && len(a.Sites) == 0 {
+		valid["error_sites"] = ops.ErrAPIKeySites.Error()
+	}
+	if len(valid) == 0 {
+		err := db.Ops().CreateAPIKey(key, a)
+		switch {
+		case err == nil:
+			db.Audit(ops.AuditAPIKeyCreate, "", name, "scopes="+strings.Join(a.Scopes, ",")+" sites="+apiKeySites(a))
+			db.Success("API key created successfully")
+			db.SaveSession(w)
+			http.Redirect(w, r, "/settings#api-keys", http.StatusFound)
+			return
+		case errors.Is(err, ops.ErrAPIKeyExists):
+			valid["error_name"] = err.Error()
+		case errors.Is(err, ops.ErrScope):
+			valid["error_scopes"] = err.Error()
+		case errors.Is(err, ops.ErrNoSite), errors.Is(err, ops.ErrAPIKeySites):
+			valid["error_sites"] = err.Error()
+		default:
+			db.Logger().Error("creating new key", "err", err)
+			db.HTML(w, e500, nil)
+			return
+		}
+	}
+	valid["name"] = name
+	valid["key"] = key
+	valid["sites"] = a.Sites
+	valid["all_sites_checked"] = a.AllSites
+	valid["scopes"] = a.Scopes
+	valid["expires"] = days
+	db.SaveCsrf(w)
+	renderNewAPIKey(db, w, valid)
+}
+
+func apiKeySites(k *v1.APIKey) string {
+	if ops.KeyAllSites(k) {
+		return "All sites"
+	}
+	return strings.Join(k.Sites, ", ")
+}
+
+// apiKeys formats keys for the settings page.
+func apiKeys(db *db.Config, keys []*v1.APIKey) []map[string]any {
+	date := func(ms uint64, zero string) string {
+		if ms == 0 {
+			return zero
+		}
+		return time.UnixMilli(int64(ms)).UTC().Format("Jan 2, 2006 15:04")
+	}
+	ls := make([]map[string]any, 0, len(keys))
+	for _, k := range keys {
+		ls = append(ls, map[string]any{
+			"name":      k.Name,
+			"prefix":    k.Prefix,
+			"sites":     apiKeySites(k),
+			"scopes":    strings.Join(ops.KeyScopes(k), ", "),
+			"expires":   date(k.Expires, "Never"),
+			"expired":   ops.KeyExpired(k),
+			"last_used": date(k.LastUsed, "Never"),
+			"requests":  k.Requests,
+			"throttled": db.APIRejected(k.Name),
+		})
+	}
+	return ls
 }
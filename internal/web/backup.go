@@ -0,0 +1,19 @@
+package web
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/vinceanalytics/vince/internal/ops"
+	"github.com/vinceanalytics/vince/internal/web/db"
+)
+
+// Backup downloads an archive of all data, it can be restored with vince
+// restore.
+func Backup(db *db.Config, w http.ResponseWriter, r *http.Request) {
+	m := db.Backup(w, r)
+	if m == nil {
+		return
+	}
+	db.Audit(ops.AuditBackup, "", "", fmt.Sprintf("shards=%d files=%d", len(m.Shards), len(m.Files)))
+}
@@ -1,8 +1,11 @@
 package conversions
 
 import (
+	"fmt"
 	"math"
 	"net/http"
+	"strconv"
+	"strings"
 
 	"github.com/vinceanalytics/vince/internal/api/aggregates"
 	"github.com/vinceanalytics/vince/internal/api/breakdown"
@@ -13,7 +16,7 @@ import (
 func Conversion(db *db.Config, w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 	site := db.CurrentSite()
-	params := query.New(r.URL.Query())
+	params := query.New(r.URL.Query(), db.Timezone(site.Domain))
 	sx := aggregates.
 		Aggregates(
 			ctx, db.TimeSeries(),
@@ -21,7 +24,25 @@ func Conversion(db *db.Config, w http.ResponseWriter, r *http.Request) {
 
 	sx.Compute()
 	totalVisitors := sx.Visitors
-	rs, err := breakdown.BreakdownGoals(ctx, db.TimeSeries(), site, params, []string{"visitors", "events"})
+
+	// revenue is recorded in site currency, there is none to report when the
+	// site has no currency.
+	revenue := make(map[string]string)
+	for _, g := range site.Goals {
+		if g.Currency == "" || site.Currency == "" {
+			continue
+		}
+		name := g.Name
+		if name == "" {
+			name = g.Path
+		}
+		revenue[name] = site.Currency
+	}
+	metrics := []string{"visitors", "events"}
+	if len(revenue) > 0 {
+		metrics = append(metrics, "total_revenue", "average_revenue")
+	}
+	rs, err := breakdown.BreakdownGoals(ctx, db.TimeSeries(), site, params, metrics)
 	if err != nil {
 		db.Logger().Error("breakdown goals", "err", err)
 		rs = new(breakdown.Result)
@@ -29,6 +50,18 @@ func Conversion(db *db.Config, w http.ResponseWriter, r *http.Request) {
 	for i := range rs.Results {
 		m := rs.Results[i]
 		m["conversion_rate"] = coversionRate(totalVisitors, m["visitors"].(float64))
+		if len(revenue) == 0 {
+			continue
+		}
+		name, _ := m["name"].(string)
+		code, ok := revenue[name]
+		if !ok {
+			m["total_revenue"] = nil
+			m["average_revenue"] = nil
+			continue
+		}
+		m["total_revenue"] = money(m["total_revenue"].(float64), code)
+		m["average_revenue"] = money(m["average_revenue"].(float64), code)
 	}
 	db.JSON(w, rs)
 }
@@ -39,3 +72,44 @@ func coversionRate(uniq, converted float64) float64 {
 	}
 	return 0
 }
+
+// money formats value the way the dashboard renders revenue metrics.
+func money(value float64, code string) map[string]any {
+	return map[string]any{
+		"short":    code + " " + short(value),
+		"long":     code + " " + long(value),
+		"value":    value,
+		"currency": code,
+	}
+}
+
+func short(value float64) string {
+	switch abs := math.Abs(value); {
+	case abs >= 1e9:
+		return strconv.FormatFloat(value/1e9, 'f', 1, 64) + "B"
+	case abs >= 1e6:
+		return strconv.FormatFloat(value/1e6, 'f', 1, 64) + "M"
+	case abs >= 1e3:
+		return strconv.FormatFloat(value/1e3, 'f', 1, 64) + "K"
+	default:
+		return strconv.FormatFloat(value, 'f', 2, 64)
+	}
+}
+
+func long(value float64) string {
+	s := fmt.Sprintf("%.2f", math.Abs(value))
+	whole, frac, _ := strings.Cut(s, ".")
+	var b strings.Builder
+	if value < 0 {
+		b.WriteByte('-')
+	}
+	for i := range whole {
+		if i > 0 && (len(whole)-i)%3 == 0 {
+			b.WriteByte(',')
+		}
+		b.WriteByte(whole[i])
+	}
+	b.WriteByte('.')
+	b.WriteString(frac)
+	return b.String()
+}
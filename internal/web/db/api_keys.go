@@ -0,0 +1,25 @@
+package db
+
+import (
+	"context"
+	"time"
+)
+
+// apiKeyUsageInterval is how often API key usage counters are saved.
+const apiKeyUsageInterval = time.Minute
+
+func (db *Config) apiKeyUsageLoop(ctx context.Context) {
+	ts := time.NewTicker(apiKeyUsageInterval)
+	defer ts.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ts.C:
+			err := db.ops.SaveAPIKeyUsage()
+			if err != nil {
+				db.logger.Error("saving api key usage", "err", err)
+			}
+		}
+	}
+}
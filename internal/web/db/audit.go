@@ -0,0 +1,21 @@
+package db
+
+import (
+	v1 "github.com/vinceanalytics/vince/gen/go/vince/v1"
+)
+
+// Audit records action performed by the current user on target of site. Site
+// is empty for instance wide actions. Failing to record an entry does not fail
+// the request.
+func (db *Config) Audit(action, site, target, details string) {
+	err := db.ops.Audit(&v1.AuditEntry{
+		Actor:   db.CurrentUser(),
+		Action:  action,
+		Site:    site,
+		Target:  target,
+		Details: details,
+	})
+	if err != nil {
+		db.logger.Error("recording audit entry", "action", action, "site", site, "err", err)
+	}
+}
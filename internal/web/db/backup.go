@@ -0,0 +1,42 @@
+package db
+
+import (
+	"net/http"
+	"os"
+
+	"github.com/vinceanalytics/vince/internal/backup"
+	"github.com/vinceanalytics/vince/internal/timeseries"
+	"github.com/vinceanalytics/vince/internal/util/xtime"
+)
+
+// Backup streams an archive of the data directory as an attachment. The
+// checkpoint is taken in the event loop so buffered events are included and
+// shards are consistent with each other. It returns nil when the backup failed,
+// errors are already reported to the client.
+func (db *Config) Backup(w http.ResponseWriter, r *http.Request) *backup.Manifest {
+	var (
+		dir    string
+		shards []uint64
+	)
+	err := db.Exec(r.Context(), func(ts *timeseries.Timeseries) (err error) {
+		dir, shards, err = ts.Get().Checkpoint()
+		return
+	})
+	if err != nil {
+		db.logger.Error("creating checkpoint", "err", err)
+		http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+		return nil
+	}
+	defer os.RemoveAll(dir)
+
+	w.Header().Set("content-type", "application/zstd")
+	w.Header().Set("content-disposition", `attachment; filename="`+backup.Filename(xtime.Now())+`"`)
+	m, err := backup.Write(w, dir, shards)
+	if err != nil {
+		// Headers are already sent, the client sees a truncated archive which
+		// restore rejects.
+		db.logger.Error("writing backup", "err", err)
+		return nil
+	}
+	return m
+}
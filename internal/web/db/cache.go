@@ -6,7 +6,17 @@ import (
 
 func (db *Config) append(e *models.Model) error {
 	hit(e)
-	if cached, ok := db.cache.Get(e.Id); ok {
+	cached, ok := db.cache.Get(e.Id)
+	if !ok && e.PreviousId != 0 {
+		// Continue sessions started before the salt was rotated.
+		cached, ok = db.cache.Get(e.PreviousId)
+		if ok && !cached.Expired(e.Timestamp) {
+			e.Id = e.PreviousId
+		} else {
+			ok = false
+		}
+	}
+	if ok {
 		if m := e.Update(cached); m != nil {
 			db.cache.Add(e.Id, m)
 		}
@@ -4,20 +4,26 @@ import (
 	"context"
 	"encoding/json"
 	"errors"
+	"fmt"
 	"html/template"
 	"log/slog"
 	"net/http"
+	"path/filepath"
 	"time"
 
 	"github.com/cockroachdb/pebble"
+	"github.com/vinceanalytics/vince/internal/clientip"
+	"github.com/vinceanalytics/vince/internal/currency"
 	"github.com/vinceanalytics/vince/internal/geo"
 	"github.com/vinceanalytics/vince/internal/location"
 	"github.com/vinceanalytics/vince/internal/models"
+	"github.com/vinceanalytics/vince/internal/oidc"
 	"github.com/vinceanalytics/vince/internal/ops"
 	"github.com/vinceanalytics/vince/internal/shards"
 	"github.com/vinceanalytics/vince/internal/timeseries"
 	"github.com/vinceanalytics/vince/internal/util/lru"
 	"github.com/vinceanalytics/vince/internal/util/oracle"
+	"github.com/vinceanalytics/vince/internal/util/xtime"
 )
 
 type Config struct {
@@ -30,6 +36,17 @@ type Config struct {
 	logger  *slog.Logger
 	cache   *lru.Cache[uint64, *models.Cached]
 	buffer  chan *models.Model
+	jobs    chan func()
+	rates   currency.Rates
+	sso     *oidc.Provider
+	limits  *limits
+	purge   chan struct{}
+	salts   *salts
+	rules   *exclusions
+	ips     *clientip.Resolver
+	ingest  *ingest
+	// stopped is closed when the event loop exits.
+	stopped chan struct{}
 }
 
 func Open(db *shards.DB, startDomains []string) (*Config, error) {
@@ -39,8 +56,25 @@ func Open(db *shards.DB, startDomains []string) (*Config, error) {
 	if err != nil {
 		return nil, err
 	}
+	var rates currency.Rates
+	if oracle.CurrencyRates != "" {
+		rates, err = currency.Load(oracle.CurrencyRates)
+		if err != nil {
+			return nil, err
+		}
+	}
+	var sso *oidc.Provider
+	if oracle.OIDC.Issuer != "" {
+		sso, err = openSSO()
+		if err != nil {
+			return nil, err
+		}
+	}
 	ts := timeseries.New(db, lo)
 	ops := ops.New(db.Get(), ts, startDomains...)
+	ts.SetZone(func(domain []byte) *time.Location {
+		return ops.Timezone(string(domain))
+	})
 
 	// setup session
 	secret, err := ops.Web()
@@ -48,7 +82,19 @@ func Open(db *shards.DB, startDomains []string) (*Config, error) {
 		db.Close()
 		return nil, err
 	}
-	return &Config{
+	salt, err := ops.Salts(xtime.Now())
+	if err != nil {
+		db.Close()
+		return nil, err
+	}
+	ids := new(salts)
+	ids.set(salt)
+	ips, err := clientip.New(oracle.TrustedProxies, oracle.ClientIPHeaders)
+	if err != nil {
+		db.Close()
+		return nil, err
+	}
+	c := &Config{
 		lo:     lo,
 		geo:    g,
 		db:     db,
@@ -56,15 +102,68 @@ func Open(db *shards.DB, startDomains []string) (*Config, error) {
 		ops:    ops,
 		logger: slog.Default(),
 		cache:  lru.New[uint64, *models.Cached](1 << 20),
-		buffer: make(chan *models.Model, 1),
+		buffer: make(chan *models.Model, ingestQueue()),
+		jobs:   make(chan func()),
+		rates:  rates,
+		sso:    sso,
+		limits: newLimits(),
+		purge:  make(chan struct{}, 1),
+		salts:  ids,
+		rules:  new(exclusions),
+		ips:    ips,
+		ingest: newIngest(),
 		session: &SessionContext{
 			secret: secret,
 		},
-	}, nil
+	}
+	sessions, err := c.loadSessions(filepath.Join(db.Path(), sessionsFile))
+	if err != nil {
+		// Losing sessions only splits ongoing visits, it is not worth failing.
+		slog.Error("restoring sessions", "err", err)
+	} else if sessions > 0 {
+		slog.Info("restored sessions", "sessions", sessions)
+	}
+	events, err := c.recoverEvents(filepath.Join(db.Path(), eventsLog))
+	if err != nil {
+		db.Close()
+		return nil, fmt.Errorf("recovering events: %w", err)
+	}
+	if events > 0 {
+		slog.Info("recovered unsaved events", "events", events)
+	}
+	return c, nil
+}
+
+func openSSO() (*oidc.Provider, error) {
+	roles := make([]oidc.Mapping, 0, len(oracle.OIDC.Roles))
+	for _, r := range oracle.OIDC.Roles {
+		m, err := oidc.ParseMapping(r)
+		if err != nil {
+			return nil, err
+		}
+		if !ops.ValidRole(m.Role) {
+			return nil, fmt.Errorf("oidc: unknown role %q in mapping %q", m.Role, r)
+		}
+		roles = append(roles, m)
+	}
+	return oidc.New(oidc.Config{
+		Issuer:       oracle.OIDC.Issuer,
+		ClientID:     oracle.OIDC.ClientID,
+		ClientSecret: oracle.OIDC.ClientSecret,
+		RedirectURL:  oracle.Endpoint + "/login/oidc/callback",
+		EmailDomains: oracle.OIDC.EmailDomains,
+		GroupsClaim:  oracle.OIDC.GroupsClaim,
+		Roles:        roles,
+	}), nil
+}
+
+// SSO returns the single sign-on provider or nil when it is not configured.
+func (db *Config) SSO() *oidc.Provider {
+	return db.sso
 }
 
-func (db *Config) PasswordMatch(pwd string) bool {
-	return db.ops.VerifyPassword(pwd)
+func (db *Config) PasswordMatch(name, pwd string) bool {
+	return db.ops.Authenticate(name, pwd)
 }
 
 func (db *Config) TimeSeries() *timeseries.Timeseries {
@@ -79,6 +178,11 @@ func (db *Config) Ops() *ops.Ops {
 	return db.ops
 }
 
+// Timezone returns the location used for views and reports of domain.
+func (db *Config) Timezone(domain string) *time.Location {
+	return db.ops.Timezone(domain)
+}
+
 func (db *Config) Pebble() *pebble.DB {
 	return db.db.Get()
 }
@@ -88,19 +192,51 @@ func (db *Config) Logger() *slog.Logger {
 }
 
 func (db *Config) Start(ctx context.Context) {
+	db.stopped = make(chan struct{})
 	go db.eventsLoop(ctx)
+	go db.retentionLoop(ctx)
+	go db.purgeLoop(ctx)
+	go db.saltLoop(ctx)
+	go db.apiKeyUsageLoop(ctx)
 }
 
 func (db *Config) eventsLoop(cts context.Context) {
 	db.logger.Info("starting event processing loop")
-	ts := time.NewTicker(time.Second)
+	interval, size := ingestInterval(), ingestBatch()
+	ts := time.NewTicker(interval)
+	var pending int
+	var dropped uint64
+	save := func() error {
+		start := time.Now()
+		err := db.ts.Save()
+		if err != nil {
+			return err
+		}
+		err = db.ingest.trim()
+		if err != nil {
+			return err
+		}
+		if pending > 0 {
+			db.ingest.commit(uint64(pending), time.Since(start))
+			pending = 0
+		}
+		return nil
+	}
 	defer func() {
 		ts.Stop()
 
-		err := db.ts.Save()
+		pending += db.drain()
+		err := save()
 		if err != nil {
 			db.logger.Error("applying events batch before exiting", "err", err)
 		}
+		n, err := db.saveSessions(filepath.Join(db.db.Path(), sessionsFile))
+		if err != nil {
+			db.logger.Error("saving sessions before exiting", "err", err)
+		} else {
+			db.logger.Info("saved sessions", "sessions", n)
+		}
+		close(db.stopped)
 	}()
 
 	for {
@@ -109,21 +245,65 @@ func (db *Config) eventsLoop(cts context.Context) {
 			db.logger.Info("exiting event processing loop")
 			return
 		case <-ts.C:
-			err := db.ts.Save()
+			err := save()
 			if err != nil {
 				db.logger.Error("applying events batch", "err", err)
 			}
+			if n := db.ingest.dropped.Load(); n != dropped {
+				db.logger.Warn("ingestion queue is full, dropped events",
+					"dropped", n-dropped, "queue", cap(db.buffer))
+				dropped = n
+			}
 		case e := <-db.buffer:
+			db.ingest.take()
 			err := db.append(e)
 			if err != nil {
 				db.logger.Error("appening event", "err", err)
+				continue
+			}
+			pending++
+			if pending >= size {
+				err = save()
+				if err != nil {
+					db.logger.Error("applying events batch", "err", err)
+				}
+				ts.Reset(interval)
 			}
+		case job := <-db.jobs:
+			job()
 		}
 	}
 }
 
+// Exec runs fn in the event processing loop after saving buffered events. Use
+// this to write directly to the timeseries without racing with ingestion.
+func (db *Config) Exec(ctx context.Context, fn func(ts *timeseries.Timeseries) error) error {
+	done := make(chan error, 1)
+	job := func() {
+		err := db.ts.Save()
+		if err != nil {
+			done <- err
+			return
+		}
+		done <- fn(db.ts)
+	}
+	select {
+	case db.jobs <- job:
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+	return <-done
+}
+
+// Close releases resources. When the event loop was started it waits for it to
+// exit, cancel the context passed to Start first.
 func (db *Config) Close() error {
+	if db.stopped != nil {
+		<-db.stopped
+	}
 	return errors.Join(
+		db.ops.SaveAPIKeyUsage(),
+		db.ingest.close(),
 		db.ts.Close(),
 		db.geo.Close(),
 		db.lo.Close(),
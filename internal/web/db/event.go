@@ -2,15 +2,20 @@ package db
 
 import (
 	"bytes"
+	"context"
 	"errors"
 	"fmt"
-	"hash/crc32"
+	"maps"
+	"math"
 	"net"
 	"net/http"
 	"net/url"
+	"slices"
 	"strings"
 	"sync"
 
+	"github.com/vinceanalytics/vince/internal/accesslog"
+	"github.com/vinceanalytics/vince/internal/exclude"
 	"github.com/vinceanalytics/vince/internal/location"
 	"github.com/vinceanalytics/vince/internal/models"
 	"github.com/vinceanalytics/vince/internal/ref"
@@ -20,12 +25,68 @@ import (
 var pageView = []byte("pageview")
 
 func (db *Config) ProcessEvent(r *http.Request) error {
-	m, err := db.parse(r)
+	req := newRequest()
+	defer req.Release()
+
+	err := req.Parse(r, db.ips)
 	if err != nil {
 		return err
 	}
-	db.buffer <- m
-	return nil
+	err = db.allowEvent(req)
+	if err != nil {
+		return err
+	}
+	m, err := db.parse(req)
+	if err != nil {
+		return err
+	}
+	return db.enqueue(m)
+}
+
+// ProcessLine is like ProcessEvent but for a single line of a newline delimited
+// JSON batch. Events for domains rejected by allow fail with ErrForbidden.
+//
+// Instead of dropping events when the ingestion queue is full it waits until
+// ctx is done.
+func (db *Config) ProcessLine(ctx context.Context, line []byte, allow func(domain string) bool) error {
+	req := newRequest()
+	defer req.Release()
+
+	err := req.ParseLine(line)
+	if err != nil {
+		return err
+	}
+	for _, domain := range req.domains {
+		if !allow(domain) {
+			return ErrForbidden
+		}
+	}
+	m, err := db.parse(req)
+	if err != nil {
+		return err
+	}
+	return db.enqueueWait(ctx, m)
+}
+
+// ProcessLog adds an access log entry of domain as a pageview. Entries go
+// through the same pipeline as live events including session tracking, so they
+// must be processed in chronological order.
+//
+// Events are added directly to the timeseries, this must not be called while
+// the event loop is running.
+func (db *Config) ProcessLog(domain string, e *accesslog.Entry) error {
+	req := newRequest()
+	defer req.Release()
+
+	err := req.ParseLog(domain, e)
+	if err != nil {
+		return err
+	}
+	m, err := db.parse(req)
+	if err != nil {
+		return err
+	}
+	return db.append(m)
 }
 
 func hit(e *models.Model) {
@@ -38,6 +99,7 @@ func hit(e *models.Model) {
 }
 
 func newSessionEvent(e *models.Model) {
+	e.StartSession()
 	if e.View {
 		e.EntryPage = e.Page
 		e.ExitPage = e.Page
@@ -47,7 +109,8 @@ func newSessionEvent(e *models.Model) {
 }
 
 var (
-	ErrDrop = errors.New("event dropped")
+	ErrDrop      = errors.New("event dropped")
+	ErrForbidden = errors.New("site not allowed")
 )
 var eventsPool = &sync.Pool{New: func() any { return new(models.Model) }}
 
@@ -60,18 +123,17 @@ func releaseEvent(e *models.Model) {
 	eventsPool.Put(e)
 }
 
-func (db *Config) parse(r *http.Request) (*models.Model, error) {
-	req := newRequest()
-	defer req.Release()
-
-	err := req.Parse(r)
-	if err != nil {
-		return nil, err
+func (db *Config) parse(req *Request) (*models.Model, error) {
+	if len(req.domains) == 0 {
+		return nil, errors.New("missing domain")
 	}
 	domain := req.domains[0]
 	if !db.ops.HasSite(domain) {
 		return nil, ErrDrop
 	}
+	if db.excluded(domain, req) != exclude.None {
+		return nil, ErrDrop
+	}
 
 	host := req.hostname
 	query := req.uri.Query()
@@ -82,8 +144,12 @@ func (db *Config) parse(r *http.Request) (*models.Model, error) {
 	}
 	path := req.pathname
 
-	userID := uniqueID(req.remoteIp, req.userAgent, domain, host)
+	current, previous := db.salts.at(req.ts)
 	e := newEevent()
+	e.Id = uniqueID(current, req.remoteIp, req.userAgent, domain, host)
+	if len(previous) != 0 {
+		e.PreviousId = uniqueID(previous, req.remoteIp, req.userAgent, domain, host)
+	}
 	if req.remoteIp != "" {
 		ip := net.ParseIP(req.remoteIp)
 		err := db.geo.UpdateCity(ip, e)
@@ -91,7 +157,6 @@ func (db *Config) parse(r *http.Request) (*models.Model, error) {
 			db.logger.Error("updating geo data", "err", err)
 		}
 	}
-	e.Id = userID
 	e.Event = []byte(req.eventName)
 	e.Page = []byte(path)
 	e.Host = []byte(host)
@@ -108,9 +173,34 @@ func (db *Config) parse(r *http.Request) (*models.Model, error) {
 	e.Source = src
 	e.Referrer = []byte(ref)
 	e.Timestamp = req.ts.UnixMilli()
+	if req.revenue.currency != "" {
+		e.Revenue = db.revenue(domain, req.revenue)
+	}
+	if len(req.props) > 0 {
+		e.Props = make([][]byte, 0, len(req.props))
+		for _, k := range slices.Sorted(maps.Keys(req.props)) {
+			e.Props = append(e.Props, models.Prop([]byte(k), []byte(req.props[k])))
+		}
+	}
 	return e, nil
 }
 
+// revenue converts r to the site reporting currency. Amounts are summed in
+// reports, so revenue is dropped for sites without reporting currency.
+func (db *Config) revenue(domain string, r revenue) int64 {
+	to := db.ops.Currency(domain)
+	if to == "" {
+		db.logger.Warn("site has no reporting currency, dropping revenue", "domain", domain, "from", r.currency)
+		return 0
+	}
+	amount, ok := db.rates.Convert(r.amount, r.currency, to)
+	if !ok {
+		db.logger.Warn("missing currency rate, dropping revenue", "from", r.currency, "to", to)
+		return 0
+	}
+	return int64(math.Round(amount * models.RevenueScale))
+}
+
 func sanitizeHost(s string) string {
 	return strings.TrimPrefix(strings.TrimSpace(s), "www.")
 }
@@ -139,12 +229,3 @@ func refSource(lo *location.Location, q url.Values, u string) (xref string, sour
 	source, err = ref.Search(lo, r.Host)
 	return
 }
-
-func uniqueID(remoteIP, userAgent, domain, host string) uint64 {
-	h := crc32.NewIEEE()
-	h.Write([]byte(remoteIP))
-	h.Write([]byte(userAgent))
-	h.Write([]byte(domain))
-	h.Write([]byte(host))
-	return uint64(h.Sum32())
-}
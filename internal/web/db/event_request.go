@@ -7,10 +7,14 @@ import (
 	"net"
 	"net/http"
 	"net/url"
+	"strconv"
 	"strings"
 	"sync"
 	"time"
 
+	"github.com/vinceanalytics/vince/internal/accesslog"
+	"github.com/vinceanalytics/vince/internal/clientip"
+	"github.com/vinceanalytics/vince/internal/currency"
 	"github.com/vinceanalytics/vince/internal/util/xtime"
 )
 
@@ -20,7 +24,10 @@ type Request struct {
 	ts        time.Time
 	uri       *url.URL
 	props     map[string]string
+	revenue   revenue
 	remoteIp  string
+	cookie    string
+	optOut    string
 	userAgent string
 	hostname  string
 	referrer  string
@@ -39,22 +46,88 @@ func (r *Request) Release() {
 	requestPool.Put(r)
 }
 
-func (rq *Request) Parse(r *http.Request) error {
+func (rq *Request) Parse(r *http.Request, ips *clientip.Resolver) error {
 	body := map[string]any{}
 	err := json.NewDecoder(r.Body).Decode(&body)
 	if err != nil {
 		return err
 	}
 	rq.ts = xtime.Now().UTC()
-	rq.remoteIp = remoteIP(r)
-	err = rq.parseUri(body)
+	rq.remoteIp = ips.IP(r)
+	rq.userAgent = r.UserAgent()
+	rq.cookie = r.Header.Get("Cookie")
+	// Cookies of the site are not sent when the tracker is served from another
+	// domain, the tracker reports the opt out cookie instead.
+	rq.optOut, _ = body["c"].(string)
+	return rq.parse(body)
+}
+
+// ParseLine parses a single event from a newline delimited JSON batch sent by
+// server side sources. Besides the fields accepted by Parse, a line can
+// set timestamp, ip and user_agent since they cannot be derived from the
+// request. user_agent is required because bots are detected from it.
+func (rq *Request) ParseLine(data []byte) error {
+	body := map[string]any{}
+	err := json.Unmarshal(data, &body)
+	if err != nil {
+		return err
+	}
+	rq.ts = xtime.Now().UTC()
+	err = rq.parse(body)
+	if err != nil {
+		return err
+	}
+	err = rq.parseOverrides(body)
+	if err != nil {
+		return err
+	}
+	if rq.userAgent == "" {
+		return errors.New("missing user_agent")
+	}
+	return nil
+}
+
+// ParseLog builds a pageview request for domain from an access log entry.
+func (rq *Request) ParseLog(domain string, e *accesslog.Entry) error {
+	host := e.Host
+	if host == "" {
+		host = domain
+	}
+	uri, err := url.Parse("https://" + host + e.Path)
 	if err != nil {
 		return err
 	}
+	rq.ts = e.Time.UTC()
+	rq.uri = uri
+	rq.hostname = uri.Host
+	rq.eventName = "pageview"
+	rq.userAgent = e.UserAgent
+	rq.remoteIp = "-"
+	if ip := net.ParseIP(e.IP); ip != nil {
+		rq.remoteIp = ip.String()
+	}
+	if len(e.Referrer) > maxURI {
+		rq.referrer = e.Referrer[:maxURI]
+	} else {
+		rq.referrer = e.Referrer
+	}
+	rq.domains = []string{domain}
+	rq.parsePathname()
+	return rq.validate()
+}
+
+func (rq *Request) parse(body map[string]any) error {
+	err := rq.parseUri(body)
+	if err != nil {
+		return err
+	}
+	if rq.uri == nil {
+		return errors.New("missing url")
+	}
 	rq.hostname = rq.uri.Host
-	rq.userAgent = r.UserAgent()
 	rq.parseParams(body)
 	rq.parseProps(body)
+	rq.parseRevenue(body)
 	rq.parsePathname()
 	rq.parseReferrer(body)
 	rq.parseDomains(body)
@@ -130,10 +203,75 @@ func (rq *Request) parseProps(m map[string]any) {
 	}
 	rq.props = make(map[string]string)
 	for k, v := range os {
+		if k == "" || v == nil {
+			continue
+		}
 		rq.props[k] = fmt.Sprint(v)
 	}
 }
 
+type revenue struct {
+	amount   float64
+	currency string
+}
+
+func (rq *Request) parseRevenue(m map[string]any) {
+	o, ok := m["$"]
+	if !ok {
+		o, ok = m["revenue"]
+	}
+	if !ok {
+		return
+	}
+	rs, ok := o.(map[string]any)
+	if !ok {
+		return
+	}
+	var amount float64
+	switch e := rs["amount"].(type) {
+	case float64:
+		amount = e
+	case string:
+		amount, _ = strconv.ParseFloat(e, 64)
+	}
+	code, _ := rs["currency"].(string)
+	code = strings.ToUpper(strings.TrimSpace(code))
+	if amount == 0 || !currency.Valid(code) {
+		return
+	}
+	rq.revenue = revenue{amount: amount, currency: code}
+}
+
+func (rq *Request) parseOverrides(m map[string]any) error {
+	switch e := m["timestamp"].(type) {
+	case nil:
+	case float64:
+		if e <= 0 {
+			return errors.New("invalid timestamp")
+		}
+		rq.ts = xtime.UnixMilli(int64(e)).UTC()
+	case string:
+		ts, err := time.Parse(time.RFC3339, e)
+		if err != nil {
+			return fmt.Errorf("invalid timestamp %w", err)
+		}
+		rq.ts = ts.UTC()
+	default:
+		return errors.New("invalid timestamp")
+	}
+	if ip, ok := m["ip"].(string); ok {
+		addr := net.ParseIP(ip)
+		if addr == nil {
+			return errors.New("invalid ip")
+		}
+		rq.remoteIp = addr.String()
+	}
+	if ua, ok := m["user_agent"].(string); ok {
+		rq.userAgent = ua
+	}
+	return nil
+}
+
 const maxURI = 2_000
 
 func (rq *Request) parseReferrer(m map[string]any) {
@@ -187,31 +325,3 @@ func (rq *Request) parseUri(m map[string]any) error {
 
 	return nil
 }
-
-var remoteIPHeaders = []string{
-	"x-vince-ip", "cf-connecting-ip", "b-forwarded-for",
-	"X-Real-IP", "X-Forwarded-For", "X-Client-IP", "Fly-Client-IP",
-}
-
-func remoteIP(r *http.Request) string {
-	var raw string
-	for _, v := range remoteIPHeaders {
-		if raw = r.Header.Get(v); raw != "" {
-			break
-		}
-	}
-	if raw == "" && r.RemoteAddr != "" {
-		raw = r.RemoteAddr
-	}
-	var host string
-	host, _, err := net.SplitHostPort(raw)
-	if err != nil {
-		host = raw
-	}
-
-	ip := net.ParseIP(host)
-	if ip == nil {
-		return "-"
-	}
-	return ip.String()
-}
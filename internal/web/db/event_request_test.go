@@ -0,0 +1,38 @@
+package db
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseLine(t *testing.T) {
+	t.Run("overrides", func(t *testing.T) {
+		var rq Request
+		err := rq.ParseLine([]byte(`{"n":"pageview","u":"https://vinceanalytics.com/","d":"vinceanalytics.com","timestamp":"2024-06-01T10:00:00Z","ip":"1.2.3.4","user_agent":"curl/8.0"}`))
+		require.NoError(t, err)
+		require.Equal(t, time.Date(2024, 6, 1, 10, 0, 0, 0, time.UTC), rq.ts)
+		require.Equal(t, "1.2.3.4", rq.remoteIp)
+		require.Equal(t, "curl/8.0", rq.userAgent)
+		require.Equal(t, []string{"vinceanalytics.com"}, rq.domains)
+	})
+	t.Run("unix milliseconds", func(t *testing.T) {
+		var rq Request
+		err := rq.ParseLine([]byte(`{"n":"pageview","u":"https://vinceanalytics.com/","d":"vinceanalytics.com","timestamp":1717236000000,"user_agent":"curl/8.0"}`))
+		require.NoError(t, err)
+		require.Equal(t, int64(1717236000000), rq.ts.UnixMilli())
+	})
+	t.Run("invalid", func(t *testing.T) {
+		for _, line := range []string{
+			`{"n":"pageview"`,
+			`{"n":"pageview","d":"vinceanalytics.com"}`,
+			`{"n":"pageview","u":"https://vinceanalytics.com/","d":"vinceanalytics.com","timestamp":"yesterday"}`,
+			`{"n":"pageview","u":"https://vinceanalytics.com/","d":"vinceanalytics.com","ip":"localhost"}`,
+			`{"n":"pageview","u":"https://vinceanalytics.com/","d":"vinceanalytics.com","ip":"1.2.3.4"}`,
+		} {
+			var rq Request
+			require.Error(t, rq.ParseLine([]byte(line)), line)
+		}
+	})
+}
@@ -0,0 +1,33 @@
+package db
+
+import (
+	"log/slog"
+	"testing"
+
+	"github.com/cockroachdb/pebble"
+	"github.com/stretchr/testify/require"
+	"github.com/vinceanalytics/vince/internal/currency"
+	"github.com/vinceanalytics/vince/internal/models"
+	"github.com/vinceanalytics/vince/internal/ops"
+)
+
+func TestRevenue(t *testing.T) {
+	pb, err := pebble.Open(t.TempDir(), nil)
+	require.NoError(t, err)
+	defer pb.Close()
+
+	require.NoError(t, ops.CreateAdmin(pb, "root", "password"))
+	o := ops.New(pb, nil, "eur.com", "none.com")
+	site := o.Site("eur.com")
+	site.Currency = "EUR"
+	o.Save(site)
+
+	db := &Config{
+		ops:    o,
+		rates:  currency.Rates{"EUR": 1, "USD": 2},
+		logger: slog.Default(),
+	}
+	require.Equal(t, int64(5*models.RevenueScale), db.revenue("eur.com", revenue{amount: 10, currency: "USD"}))
+	require.Equal(t, int64(0), db.revenue("eur.com", revenue{amount: 10, currency: "GBP"}), "missing rate")
+	require.Equal(t, int64(0), db.revenue("none.com", revenue{amount: 10, currency: "USD"}), "no site currency")
+}
@@ -0,0 +1,82 @@
+package db
+
+import (
+	"net/http"
+	"sync"
+	"sync/atomic"
+
+	v1 "github.com/vinceanalytics/vince/gen/go/vince/v1"
+	"github.com/vinceanalytics/vince/internal/exclude"
+)
+
+// exclusions caches compiled site exclusion rules and counts events they
+// dropped.
+type exclusions struct {
+	compiled sync.Map // domain => *compiled
+	dropped  sync.Map // domain => *[exclude.Reasons]atomic.Uint64
+}
+
+type compiled struct {
+	src   *v1.Exclusions
+	rules *exclude.Rules
+}
+
+// excluded returns why the event in req for domain must be dropped.
+func (db *Config) excluded(domain string, req *Request) exclude.Reason {
+	src := db.ops.Exclusions(domain)
+	if src == nil {
+		return exclude.None
+	}
+	var rules *exclude.Rules
+	if c, ok := db.rules.compiled.Load(domain); ok && c.(*compiled).src == src {
+		rules = c.(*compiled).rules
+	} else {
+		var err error
+		rules, err = exclude.Compile(src)
+		if err != nil {
+			// rules are validated before they are saved
+			db.logger.Error("compiling exclusions", "domain", domain, "err", err)
+			return exclude.None
+		}
+		db.rules.compiled.Store(domain, &compiled{src: src, rules: rules})
+	}
+	var cookie func(string) bool
+	if req.cookie != "" || req.optOut != "" {
+		cookie = func(name string) bool {
+			if req.optOut == name {
+				return true
+			}
+			ls, err := http.ParseCookie(req.cookie)
+			if err != nil {
+				return false
+			}
+			for _, c := range ls {
+				if c.Name == name {
+					return true
+				}
+			}
+			return false
+		}
+	}
+	reason := rules.Match(req.remoteIp, req.hostname, req.pathname, cookie)
+	if reason != exclude.None {
+		v, _ := db.rules.dropped.LoadOrStore(domain, new([exclude.Reasons]atomic.Uint64))
+		v.(*[exclude.Reasons]atomic.Uint64)[reason-1].Add(1)
+	}
+	return reason
+}
+
+// EventsExcluded returns the number of events of domain dropped by each
+// exclusion reason since the process started.
+func (db *Config) EventsExcluded(domain string) map[string]uint64 {
+	o := make(map[string]uint64, exclude.Reasons)
+	v, ok := db.rules.dropped.Load(domain)
+	for i := range exclude.Reasons {
+		var n uint64
+		if ok {
+			n = v.(*[exclude.Reasons]atomic.Uint64)[i].Load()
+		}
+		o[exclude.Reason(i+1).String()] = n
+	}
+	return o
+}
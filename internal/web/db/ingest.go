@@ -0,0 +1,264 @@
+package db
+
+import (
+	"cmp"
+	"context"
+	"fmt"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/vinceanalytics/vince/internal/models"
+	"github.com/vinceanalytics/vince/internal/util/oracle"
+	"github.com/vinceanalytics/vince/internal/wal"
+)
+
+// Defaults for the ingestion queue when they are not configured. Queued
+// events are written to the events log before they are accepted and removed
+// from it once saved, so the log holds at most one queue and one batch of
+// events. The queue holds two batches so requests are not shed while a batch
+// is being saved.
+const (
+	DefaultIngestBatch    = 4 << 10
+	DefaultIngestQueue    = 2 * DefaultIngestBatch
+	DefaultIngestInterval = time.Second
+)
+
+// Errors returned when an event is shed. They wrap ErrDrop so trackers treat
+// them like any dropped event.
+var (
+	ErrQueueFull = fmt.Errorf("%w: ingestion queue is full", ErrDrop)
+	ErrStopped   = fmt.Errorf("%w: event processing stopped", ErrDrop)
+)
+
+// ingest counts events going through the ingestion queue.
+type ingest struct {
+	// mu is held for reading while adding to the queue. Once closed is set no
+	// events are added and the queue can be drained.
+	mu     sync.RWMutex
+	closed bool
+	// stop wakes up callers waiting for room in the queue.
+	stop chan struct{}
+	// room wakes up a caller waiting for room in the queue when an event is
+	// taken from it.
+	room chan struct{}
+
+	// send is held while logging an event and adding it to the queue, so that
+	// events are logged in the order they are processed.
+	send   sync.Mutex
+	log    *wal.Log
+	record []byte
+	// taken is the number of logged events taken from the queue since the log
+	// was last trimmed. It is only used by the event loop.
+	taken int
+
+	accepted  atomic.Uint64
+	dropped   atomic.Uint64
+	commits   atomic.Uint64
+	committed atomic.Uint64
+	// durations of commits in nanoseconds
+	last atomic.Int64
+	max  atomic.Int64
+}
+
+func newIngest() *ingest {
+	return &ingest{
+		stop: make(chan struct{}),
+		room: make(chan struct{}, 1),
+	}
+}
+
+// push logs m and adds it to the queue. It returns false without logging when
+// the queue is full.
+func (db *Config) push(m *models.Model) (bool, error) {
+	i := db.ingest
+	i.send.Lock()
+	defer i.send.Unlock()
+	// Only the event loop takes events from the queue, there is room for m
+	// until we add it.
+	if len(db.buffer) == cap(db.buffer) {
+		return false, nil
+	}
+	if i.log != nil {
+		i.record = m.Marshal(i.record[:0])
+		err := i.log.Append(i.record)
+		if err != nil {
+			return false, err
+		}
+	}
+	db.buffer <- m
+	i.accepted.Add(1)
+	if len(db.buffer) < cap(db.buffer) {
+		i.wake()
+	}
+	return true, nil
+}
+
+// take records that an event was taken from the queue.
+func (i *ingest) take() {
+	i.taken++
+	i.wake()
+}
+
+func (i *ingest) wake() {
+	select {
+	case i.room <- struct{}{}:
+	default:
+	}
+}
+
+func (i *ingest) close() error {
+	if i.log == nil {
+		return nil
+	}
+	return i.log.Close()
+}
+
+// trim removes events taken from the queue from the log, it must be called
+// after they are saved.
+func (i *ingest) trim() error {
+	if i.log == nil || i.taken == 0 {
+		return nil
+	}
+	err := i.log.Trim(i.taken)
+	if err != nil {
+		return err
+	}
+	i.taken = 0
+	return nil
+}
+
+func (i *ingest) commit(events uint64, took time.Duration) {
+	i.commits.Add(1)
+	i.committed.Add(events)
+	i.last.Store(int64(took))
+	for {
+		m := i.max.Load()
+		if int64(took) <= m || i.max.CompareAndSwap(m, int64(took)) {
+			return
+		}
+	}
+}
+
+// IngestStats reports the state of the ingestion queue.
+type IngestStats struct {
+	Queued    int     `json:"queued"`
+	Capacity  int     `json:"capacity"`
+	Accepted  uint64  `json:"accepted"`
+	Dropped   uint64  `json:"dropped"`
+	Commits   uint64  `json:"commits"`
+	Committed uint64  `json:"committed"`
+	LastMs    float64 `json:"last_commit_ms"`
+	MaxMs     float64 `json:"max_commit_ms"`
+}
+
+func (db *Config) IngestStats() IngestStats {
+	return IngestStats{
+		Queued:    len(db.buffer),
+		Capacity:  cap(db.buffer),
+		Accepted:  db.ingest.accepted.Load(),
+		Dropped:   db.ingest.dropped.Load(),
+		Commits:   db.ingest.commits.Load(),
+		Committed: db.ingest.committed.Load(),
+		LastMs:    ms(db.ingest.last.Load()),
+		MaxMs:     ms(db.ingest.max.Load()),
+	}
+}
+
+func ms(ns int64) float64 {
+	return float64(ns) / float64(time.Millisecond)
+}
+
+// enqueue adds m to the ingestion queue without blocking, m is released when it
+// is not added. Events are logged before they are added, so events accepted
+// but not yet saved are recovered after a crash.
+func (db *Config) enqueue(m *models.Model) error {
+	db.ingest.mu.RLock()
+	defer db.ingest.mu.RUnlock()
+	err := ErrStopped
+	if !db.ingest.closed {
+		var ok bool
+		ok, err = db.push(m)
+		if ok {
+			return nil
+		}
+		err = cmp.Or(err, ErrQueueFull)
+	}
+	db.ingest.dropped.Add(1)
+	releaseEvent(m)
+	return err
+}
+
+// enqueueWait is like enqueue but waits for room in the queue until ctx is
+// done.
+func (db *Config) enqueueWait(ctx context.Context, m *models.Model) error {
+	db.ingest.mu.RLock()
+	defer db.ingest.mu.RUnlock()
+	err := ErrStopped
+	for !db.ingest.closed {
+		var ok bool
+		ok, err = db.push(m)
+		if ok {
+			return nil
+		}
+		if err != nil {
+			break
+		}
+		select {
+		case <-db.ingest.room:
+			continue
+		case <-ctx.Done():
+			err = ctx.Err()
+		case <-db.ingest.stop:
+			err = ErrStopped
+		}
+		break
+	}
+	db.ingest.dropped.Add(1)
+	releaseEvent(m)
+	return err
+}
+
+// drain stops adding events to the queue and processes events left in it.
+// Events that already got a response must not be lost on shutdown.
+func (db *Config) drain() (n int) {
+	close(db.ingest.stop)
+	db.ingest.mu.Lock()
+	db.ingest.closed = true
+	db.ingest.mu.Unlock()
+	for {
+		select {
+		case e := <-db.buffer:
+			db.ingest.take()
+			err := db.append(e)
+			if err != nil {
+				db.logger.Error("appening event", "err", err)
+				continue
+			}
+			n++
+		default:
+			return
+		}
+	}
+}
+
+func ingestQueue() int {
+	if oracle.Ingest.Queue <= 0 {
+		return DefaultIngestQueue
+	}
+	return int(oracle.Ingest.Queue)
+}
+
+func ingestBatch() int {
+	if oracle.Ingest.Batch <= 0 {
+		return DefaultIngestBatch
+	}
+	return int(oracle.Ingest.Batch)
+}
+
+func ingestInterval() time.Duration {
+	if oracle.Ingest.Interval <= 0 {
+		return DefaultIngestInterval
+	}
+	return oracle.Ingest.Interval
+}
@@ -0,0 +1,70 @@
+package db
+
+import (
+	"context"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"github.com/vinceanalytics/vince/internal/models"
+	"github.com/vinceanalytics/vince/internal/wal"
+)
+
+func TestEnqueue(t *testing.T) {
+	db := &Config{buffer: make(chan *models.Model, 1), ingest: newIngest()}
+	require.NoError(t, db.enqueue(new(models.Model)))
+	err := db.enqueue(new(models.Model))
+	require.ErrorIs(t, err, ErrQueueFull)
+	require.ErrorIs(t, err, ErrDrop)
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Millisecond)
+	defer cancel()
+	require.ErrorIs(t, db.enqueueWait(ctx, new(models.Model)), context.DeadlineExceeded)
+
+	db.ingest.commit(3, 2*time.Millisecond)
+	db.ingest.commit(1, time.Millisecond)
+	require.Equal(t, IngestStats{
+		Queued: 1, Capacity: 1,
+		Accepted: 1, Dropped: 2,
+		Commits: 2, Committed: 4,
+		LastMs: 1, MaxMs: 2,
+	}, db.IngestStats())
+}
+
+func TestDrain(t *testing.T) {
+	db := &Config{buffer: make(chan *models.Model, 1), ingest: newIngest()}
+	require.Equal(t, 0, db.drain())
+	require.ErrorIs(t, db.enqueue(new(models.Model)), ErrStopped)
+	require.ErrorIs(t, db.enqueueWait(context.Background(), new(models.Model)), ErrStopped)
+	require.Empty(t, db.buffer)
+}
+
+func TestEnqueueLog(t *testing.T) {
+	l, err := wal.Open(filepath.Join(t.TempDir(), eventsLog))
+	require.NoError(t, err)
+	defer l.Close()
+	db := &Config{buffer: make(chan *models.Model, 2), ingest: newIngest()}
+	db.ingest.log = l
+
+	logged := func() (ids []uint64) {
+		require.NoError(t, l.Replay(func(record []byte) error {
+			var m models.Model
+			require.NoError(t, m.Unmarshal(record))
+			ids = append(ids, m.Id)
+			return nil
+		}))
+		return
+	}
+	require.NoError(t, db.enqueue(&models.Model{Id: 1}))
+	require.NoError(t, db.enqueueWait(context.Background(), &models.Model{Id: 2}))
+	require.ErrorIs(t, db.enqueue(&models.Model{Id: 3}), ErrQueueFull)
+	// accepted events are logged before they are processed
+	require.Equal(t, []uint64{1, 2}, logged())
+
+	<-db.buffer
+	db.ingest.take()
+	require.NoError(t, db.enqueue(&models.Model{Id: 4}))
+	require.NoError(t, db.ingest.trim())
+	require.Equal(t, []uint64{2, 4}, logged())
+}
@@ -32,6 +32,7 @@ func Browser() Pipeline {
 		SecureHeaders,
 		SessionTimeout,
 		FetchFlash,
+		SecondFactor,
 	}
 }
 
@@ -52,6 +53,19 @@ func FetchSession(h Handler) Handler {
 	}
 }
 
+// SecondFactor sends users who passed the password check to two-factor
+// verification until they complete it or log out.
+func SecondFactor(h Handler) Handler {
+	return func(db *db.Config, w http.ResponseWriter, r *http.Request) {
+		if db.PendingLogin() != "" &&
+			!strings.HasPrefix(r.URL.Path, "/login") && r.URL.Path != "/logout" {
+			http.Redirect(w, r, "/login/2fa", http.StatusFound)
+			return
+		}
+		h(db, w, r)
+	}
+}
+
 func FetchFlash(h Handler) Handler {
 	return func(db *db.Config, w http.ResponseWriter, r *http.Request) {
 		db.Flash(w)
@@ -131,6 +145,18 @@ func RequireAccount(h Handler) Handler {
 	}
 }
 
+// RequireAdmin allows only the instance administrator. It must be used after
+// RequireAccount.
+func RequireAdmin(h Handler) Handler {
+	return func(db *db.Config, w http.ResponseWriter, r *http.Request) {
+		if !db.IsAdmin() {
+			http.Error(w, http.StatusText(http.StatusForbidden), http.StatusForbidden)
+			return
+		}
+		h(db, w, r)
+	}
+}
+
 func RequireLogout(h Handler) Handler {
 	return func(db *db.Config, w http.ResponseWriter, r *http.Request) {
 		if !db.IsLoggedOut(w) {
@@ -0,0 +1,97 @@
+package db
+
+import (
+	"context"
+
+	v1 "github.com/vinceanalytics/vince/gen/go/vince/v1"
+	"github.com/vinceanalytics/vince/internal/models"
+	"github.com/vinceanalytics/vince/internal/timeseries"
+	"github.com/vinceanalytics/vince/internal/util/xtime"
+)
+
+// DeleteDomain removes the site and schedules removal of all of its timeseries
+// data. The domain id is forgotten right away, if the site is created again its
+// stats start from scratch while old data is purged in the background.
+func (db *Config) DeleteDomain(ctx context.Context, domain string) error {
+	db.ops.DeleteDomain(domain)
+	// Buffered events for domain must be saved before purging or they will
+	// outlive the site.
+	err := db.Exec(ctx, func(ts *timeseries.Timeseries) error {
+		domainId := ts.Translate(models.Field_domain, []byte(domain))
+		if domainId == 0 {
+			return nil
+		}
+		err := db.ops.SavePurge(&v1.Purge{
+			Domain:   domain,
+			DomainId: domainId,
+			Shards:   ts.Get().Shards(),
+			Started:  uint64(xtime.Now().UnixMilli()),
+			By:       db.CurrentUser(),
+		})
+		if err != nil {
+			return err
+		}
+		return ts.Forget(models.Field_domain, []byte(domain))
+	})
+	if err != nil {
+		return err
+	}
+	select {
+	case db.purge <- struct{}{}:
+	default:
+	}
+	return nil
+}
+
+// Purges returns pending site deletions.
+func (db *Config) Purges() []*v1.Purge {
+	ls, err := db.ops.Purges()
+	if err != nil {
+		db.logger.Error("reading purge jobs", "err", err)
+	}
+	return ls
+}
+
+// purgeLoop removes data of deleted sites one shard at a time. Progress is
+// saved after each shard so jobs interrupted by a restart resume where they
+// stopped.
+func (db *Config) purgeLoop(ctx context.Context) {
+	db.logger.Info("starting purge loop")
+	for {
+		db.applyPurges(ctx)
+		select {
+		case <-ctx.Done():
+			return
+		case <-db.purge:
+		}
+	}
+}
+
+func (db *Config) applyPurges(ctx context.Context) {
+	for _, p := range db.Purges() {
+		for p.Shard < p.Shards {
+			if ctx.Err() != nil {
+				return
+			}
+			err := db.db.PurgeShard(p.DomainId, p.Shard)
+			if err != nil {
+				db.logger.Error("purging site data", "domain", p.Domain, "shard", p.Shard, "err", err)
+				break
+			}
+			p.Shard++
+			err = db.ops.SavePurge(p)
+			if err != nil {
+				db.logger.Error("saving purge progress", "domain", p.Domain, "err", err)
+			}
+		}
+		if p.Shard < p.Shards {
+			continue
+		}
+		err := db.ops.DeletePurge(p.DomainId)
+		if err != nil {
+			db.logger.Error("completing purge", "domain", p.Domain, "err", err)
+			continue
+		}
+		db.logger.Info("purged site data", "domain", p.Domain, "shards", p.Shards)
+	}
+}
@@ -0,0 +1,76 @@
+package db
+
+import (
+	"time"
+
+	"github.com/vinceanalytics/vince/internal/ratelimit"
+	"github.com/vinceanalytics/vince/internal/util/oracle"
+)
+
+// LimitError is returned when a client sends events faster than allowed.
+type LimitError struct {
+	Wait time.Duration
+}
+
+func (e *LimitError) Error() string {
+	return "rate limit exceeded"
+}
+
+// AllowAPI reports whether API key name can make another request. When it can
+// not, it also returns how long to wait before retrying.
+func (db *Config) AllowAPI(name string) (bool, time.Duration) {
+	return db.limits.api.Allow(name, name, ratelimit.Limit{
+		Rate:  oracle.RateLimit.API,
+		Burst: int(oracle.RateLimit.APIBurst),
+	})
+}
+
+// APIRejected returns the number of requests rejected for API key name.
+func (db *Config) APIRejected(name string) uint64 {
+	return db.limits.api.Rejected(name)
+}
+
+// EventsRejected returns the number of events rejected for domain.
+func (db *Config) EventsRejected(domain string) uint64 {
+	return db.limits.events.Rejected(domain)
+}
+
+func (db *Config) allowEvent(req *Request) error {
+	if len(req.domains) == 0 {
+		return nil
+	}
+	domain := req.domains[0]
+	if !db.ops.HasSite(domain) {
+		// Dropped later, no need to track clients of unknown sites.
+		return nil
+	}
+	ok, wait := db.limits.events.Allow(domain, domain+"/"+req.remoteIp, db.eventsLimit(domain))
+	if !ok {
+		return &LimitError{Wait: wait}
+	}
+	return nil
+}
+
+// eventsLimit returns the per client limit of domain, sites without their own
+// limit use the global one.
+func (db *Config) eventsLimit(domain string) ratelimit.Limit {
+	if rate, burst := db.ops.RateLimit(domain); rate > 0 {
+		return ratelimit.Limit{Rate: rate, Burst: int(burst)}
+	}
+	return ratelimit.Limit{
+		Rate:  oracle.RateLimit.Events,
+		Burst: int(oracle.RateLimit.EventsBurst),
+	}
+}
+
+type limits struct {
+	api    *ratelimit.Limiter
+	events *ratelimit.Limiter
+}
+
+func newLimits() *limits {
+	return &limits{
+		api:    ratelimit.New(),
+		events: ratelimit.New(),
+	}
+}
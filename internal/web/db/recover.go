@@ -0,0 +1,131 @@
+package db
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+
+	"github.com/vinceanalytics/vince/internal/models"
+	"github.com/vinceanalytics/vince/internal/util/xtime"
+	"github.com/vinceanalytics/vince/internal/wal"
+)
+
+// Files in the data directory keeping ingestion state across restarts.
+const (
+	eventsLog    = "events.wal"
+	sessionsFile = "sessions"
+)
+
+var errSessions = errors.New("corrupt sessions snapshot")
+
+// recoverEvents processes events logged at path that were accepted but not
+// saved before the process stopped. From then on accepted events are logged at
+// path until they are saved. It returns the number of recovered events.
+//
+// Events are applied twice if the process stopped after saving them but
+// before they were removed from the log.
+func (db *Config) recoverEvents(path string) (n int, err error) {
+	l, err := wal.Open(path)
+	if err != nil {
+		return 0, err
+	}
+	var events []*models.Model
+	err = l.Replay(func(record []byte) error {
+		m := new(models.Model)
+		err := m.Unmarshal(record)
+		if err != nil {
+			return fmt.Errorf("decoding logged event: %w", err)
+		}
+		events = append(events, m)
+		return nil
+	})
+	if err == nil {
+		for _, m := range events {
+			err = db.append(m)
+			if err != nil {
+				break
+			}
+		}
+	}
+	if err == nil {
+		err = db.ts.Save()
+	}
+	if err == nil {
+		err = l.Trim(len(events))
+	}
+	if err != nil {
+		l.Close()
+		return 0, err
+	}
+	db.ingest.log = l
+	return len(events), nil
+}
+
+// saveSessions writes active sessions to path so visits continue after a
+// restart. It must be called from the event loop.
+func (db *Config) saveSessions(path string) (n int, err error) {
+	now := xtime.Now().UnixMilli()
+	var b, record []byte
+	db.cache.Range(func(id uint64, c *models.Cached) {
+		if c.Expired(now) {
+			return
+		}
+		record = c.Marshal(record[:0])
+		b = binary.AppendUvarint(b, id)
+		b = binary.AppendUvarint(b, uint64(len(record)))
+		b = append(b, record...)
+		n++
+	})
+	tmp := path + ".tmp"
+	f, err := os.Create(tmp)
+	if err != nil {
+		return 0, err
+	}
+	_, err = f.Write(b)
+	err = errors.Join(err, f.Sync(), f.Close())
+	if err != nil {
+		os.Remove(tmp)
+		return 0, err
+	}
+	return n, os.Rename(tmp, path)
+}
+
+// loadSessions restores sessions saved with saveSessions. Sessions are added
+// from least to most recently used, like they were saved. The snapshot is kept
+// so commands that open the database without the event loop don't lose it.
+func (db *Config) loadSessions(path string) (n int, err error) {
+	b, err := os.ReadFile(path)
+	if err != nil {
+		if errors.Is(err, fs.ErrNotExist) {
+			return 0, nil
+		}
+		return 0, err
+	}
+	now := xtime.Now().UnixMilli()
+	for len(b) > 0 {
+		id, i := binary.Uvarint(b)
+		if i <= 0 {
+			return n, errSessions
+		}
+		b = b[i:]
+		size, i := binary.Uvarint(b)
+		if i <= 0 || size > uint64(len(b[i:])) {
+			return n, errSessions
+		}
+		b = b[i:]
+		c := new(models.Cached)
+		err = c.Unmarshal(b[:size])
+		if err != nil {
+			return n, err
+		}
+		b = b[size:]
+		if c.Expired(now) {
+			continue
+		}
+		db.cache.Add(id, c)
+		n++
+	}
+	return n, nil
+}
@@ -0,0 +1,31 @@
+package db
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/vinceanalytics/vince/internal/models"
+	"github.com/vinceanalytics/vince/internal/util/lru"
+	"github.com/vinceanalytics/vince/internal/util/xtime"
+)
+
+func TestSessions(t *testing.T) {
+	path := filepath.Join(t.TempDir(), sessionsFile)
+	now := xtime.Now().UnixMilli()
+	a := &Config{cache: lru.New[uint64, *models.Cached](16)}
+	a.cache.Add(1, &models.Cached{Start: now, Session: 7, Sequence: 2, EntryPage: []byte("/"), ExitPage: []byte("/about"), Timestamp: now})
+	a.cache.Add(2, &models.Cached{Start: now - 3600_000, Timestamp: now - 3600_000})
+	n, err := a.saveSessions(path)
+	require.NoError(t, err)
+	require.Equal(t, 1, n)
+
+	b := &Config{cache: lru.New[uint64, *models.Cached](16)}
+	n, err = b.loadSessions(path)
+	require.NoError(t, err)
+	require.Equal(t, 1, n)
+	c, ok := b.cache.Get(1)
+	require.True(t, ok)
+	require.Equal(t, uint64(7), c.Session)
+	require.Equal(t, "/about", string(c.ExitPage))
+}
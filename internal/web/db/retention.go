@@ -0,0 +1,75 @@
+package db
+
+import (
+	"context"
+	"time"
+
+	v1 "github.com/vinceanalytics/vince/gen/go/vince/v1"
+	"github.com/vinceanalytics/vince/internal/compute"
+	"github.com/vinceanalytics/vince/internal/encoding"
+	"github.com/vinceanalytics/vince/internal/models"
+	"github.com/vinceanalytics/vince/internal/util/xtime"
+)
+
+const retentionInterval = time.Hour
+
+func (db *Config) retentionLoop(ctx context.Context) {
+	db.logger.Info("starting retention loop", "interval", retentionInterval)
+	ts := time.NewTicker(retentionInterval)
+	defer ts.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ts.C:
+			db.applyRetention(xtime.Now().UTC())
+		}
+	}
+}
+
+// applyRetention deletes views older than each site retention policy.
+func (db *Config) applyRetention(now time.Time) {
+	sites := map[string]*v1.Retention{}
+	db.ops.Domains(func(s *v1.Site) {
+		if s.Retention != nil {
+			sites[s.Domain] = s.Retention
+		}
+	})
+	for domain, r := range sites {
+		domainId := db.ts.Translate(models.Field_domain, []byte(domain))
+		if domainId == 0 {
+			continue
+		}
+		for res, days := range map[encoding.Resolution]uint32{
+			encoding.Minute: r.Minute,
+			encoding.Hour:   r.Hour,
+			encoding.Day:    r.Day,
+			encoding.Week:   r.Week,
+			encoding.Month:  r.Month,
+		} {
+			if days == 0 {
+				continue
+			}
+			before := truncate(res, now.In(db.ops.Timezone(domain)).AddDate(0, 0, -int(days)))
+			err := db.db.Purge(domainId, res, uint64(before.UnixMilli()))
+			if err != nil {
+				db.logger.Error("applying retention", "domain", domain, "resolution", res, "err", err)
+			}
+		}
+	}
+}
+
+func truncate(res encoding.Resolution, ts time.Time) time.Time {
+	switch res {
+	case encoding.Minute:
+		return compute.Minute(ts)
+	case encoding.Hour:
+		return compute.Hour(ts)
+	case encoding.Day:
+		return compute.Date(ts)
+	case encoding.Week:
+		return compute.Week(ts)
+	default:
+		return compute.Month(ts)
+	}
+}
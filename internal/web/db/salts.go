@@ -0,0 +1,112 @@
+package db
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/binary"
+	"sync"
+	"time"
+
+	v1 "github.com/vinceanalytics/vince/gen/go/vince/v1"
+	"github.com/vinceanalytics/vince/internal/models"
+	"github.com/vinceanalytics/vince/internal/ops"
+	"github.com/vinceanalytics/vince/internal/util/xtime"
+)
+
+// salts holds the secrets used to hash visitor ids.
+type salts struct {
+	mu       sync.RWMutex
+	current  []byte
+	previous []byte
+	created  time.Time
+	// days holds salts of days before the previous salt. They are only used
+	// by imported events, are never stored and live until the process exits.
+	days map[int64][]byte
+}
+
+func (s *salts) set(v *v1.Salts) {
+	s.mu.Lock()
+	s.current = v.Current
+	s.previous = v.Previous
+	s.created = time.UnixMilli(int64(v.Created))
+	s.mu.Unlock()
+}
+
+// at returns salts for an event that happened at ts. Events of the current
+// salt day or later use the current and previous salts, events of the day
+// before use the previous salt alone. Older events get a random salt for their
+// UTC day so visitors of that day share ids without linking them to any other
+// day.
+func (s *salts) at(ts time.Time) (current, previous []byte) {
+	day := ts.UTC().Truncate(24 * time.Hour)
+	s.mu.RLock()
+	today := s.created.UTC().Truncate(24 * time.Hour)
+	switch {
+	case !day.Before(today):
+		current, previous = s.current, s.previous
+		s.mu.RUnlock()
+		return
+	case day.Equal(today.Add(-24*time.Hour)) && len(s.previous) != 0:
+		current = s.previous
+		s.mu.RUnlock()
+		return
+	}
+	current = s.days[day.Unix()]
+	s.mu.RUnlock()
+	if current != nil {
+		return
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if current = s.days[day.Unix()]; current != nil {
+		return
+	}
+	if s.days == nil {
+		s.days = make(map[int64][]byte)
+	}
+	current = make([]byte, 32)
+	rand.Read(current)
+	s.days[day.Unix()] = current
+	return
+}
+
+func (s *salts) next() time.Time {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return ops.SaltRotation(s.created)
+}
+
+// saltLoop rotates visitor id salts at midnight UTC.
+func (db *Config) saltLoop(ctx context.Context) {
+	for {
+		wait := time.NewTimer(time.Until(db.salts.next()))
+		select {
+		case <-ctx.Done():
+			wait.Stop()
+			return
+		case <-wait.C:
+		}
+		s, err := db.ops.Salts(xtime.Now())
+		if err != nil {
+			db.logger.Error("rotating salts", "err", err)
+			// avoid spinning while storage is failing
+			time.Sleep(time.Minute)
+			continue
+		}
+		db.salts.set(s)
+		db.logger.Info("rotated visitor id salt")
+	}
+}
+
+// uniqueID derives a visitor id from request details keyed by salt. Ids
+// can not be reversed or linked across salts without knowing them.
+func uniqueID(salt []byte, remoteIP, userAgent, domain, host string) uint64 {
+	h := hmac.New(sha256.New, salt)
+	for _, s := range []string{remoteIP, userAgent, domain, host} {
+		h.Write([]byte(s))
+		h.Write([]byte{0})
+	}
+	return models.VisitorID(binary.BigEndian.Uint64(h.Sum(nil)))
+}
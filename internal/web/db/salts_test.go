@@ -0,0 +1,55 @@
+package db
+
+import (
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	v1 "github.com/vinceanalytics/vince/gen/go/vince/v1"
+	"github.com/vinceanalytics/vince/internal/models"
+)
+
+func TestUniqueID(t *testing.T) {
+	a, b := []byte("salt-a"), []byte("salt-b")
+	id := uniqueID(a, "127.0.0.1", "Mozilla/5.0", "vinceanalytics.com", "vinceanalytics.com")
+	require.Equal(t, id, uniqueID(a, "127.0.0.1", "Mozilla/5.0", "vinceanalytics.com", "vinceanalytics.com"))
+	require.NotEqual(t, id, uniqueID(b, "127.0.0.1", "Mozilla/5.0", "vinceanalytics.com", "vinceanalytics.com"))
+	// fields are delimited, moving bytes between them changes the id
+	require.NotEqual(t, id, uniqueID(a, "127.0.0.1M", "ozilla/5.0", "vinceanalytics.com", "vinceanalytics.com"))
+
+	// ids are stored as bsi values and must not collide with imported visitors
+	for i := range 64 {
+		id := uniqueID(a, strconv.Itoa(i), "Mozilla/5.0", "vinceanalytics.com", "vinceanalytics.com")
+		require.Less(t, id, uint64(models.ImportedVisitor))
+	}
+}
+
+func TestSaltsAt(t *testing.T) {
+	created := time.Date(2024, 3, 10, 8, 0, 0, 0, time.UTC)
+	s := new(salts)
+	s.set(&v1.Salts{
+		Current:  []byte("current"),
+		Previous: []byte("previous"),
+		Created:  uint64(created.UnixMilli()),
+	})
+
+	current, previous := s.at(created.Add(12 * time.Hour))
+	require.Equal(t, "current", string(current))
+	require.Equal(t, "previous", string(previous))
+
+	// the previous salt was used the day before the current one was created
+	current, previous = s.at(created.Add(-10 * time.Hour))
+	require.Equal(t, "previous", string(current))
+	require.Nil(t, previous)
+
+	// older days get their own salt
+	old := time.Date(2024, 1, 5, 1, 0, 0, 0, time.UTC)
+	current, previous = s.at(old)
+	require.Nil(t, previous)
+	require.NotContains(t, []string{"current", "previous"}, string(current))
+	same, _ := s.at(old.Add(20 * time.Hour))
+	require.Equal(t, current, same)
+	other, _ := s.at(old.Add(24 * time.Hour))
+	require.NotEqual(t, current, other)
+}
@@ -2,6 +2,7 @@ package db
 
 import (
 	"bytes"
+	"cmp"
 	"crypto/rand"
 	"crypto/subtle"
 	"encoding/base64"
@@ -14,6 +15,7 @@ import (
 	"net/url"
 	"runtime/trace"
 	"strconv"
+	"strings"
 	"time"
 
 	"filippo.io/age"
@@ -46,6 +48,24 @@ type Data struct {
 	Csrf          string    `json:",omitempty"`
 	LoginDest     string    `json:",omitempty"`
 	LoggedIn      bool      `json:",omitempty"`
+	SSO           *SSO      `json:",omitempty"`
+	// PendingUser passed password check and must complete two-factor
+	// authentication before PendingAt expires.
+	PendingUser string    `json:",omitempty"`
+	PendingAt   time.Time `json:",omitempty"`
+}
+
+// pendingTimeout is how long users have to complete two-factor authentication.
+const pendingTimeout = 5 * time.Minute
+
+// SSO tracks single sign-on. While login is in progress it holds the pending
+// authorization request, after login the identity asserted by the provider.
+type SSO struct {
+	State    string `json:",omitempty"`
+	Nonce    string `json:",omitempty"`
+	Verifier string `json:",omitempty"`
+	Issuer   string `json:",omitempty"`
+	Subject  string `json:",omitempty"`
 }
 
 func (s *SessionContext) SuccessFlash(m string) *SessionContext {
@@ -77,7 +97,7 @@ func (c *Config) Wrap(kind string) func(f func(db *Config, w http.ResponseWriter
 func (c *Config) Load(w http.ResponseWriter, r *http.Request) {
 	c.load(r)
 	if c.session.Data.CurrentUserID != "" {
-		if c.ops.Admin() != c.session.Data.CurrentUserID {
+		if !c.ops.HasUser(c.session.Data.CurrentUserID) {
 			c.session = c.session.clone()
 			c.SaveSession(w)
 		} else {
@@ -118,14 +138,38 @@ func (c *Config) Context(base map[string]any) map[string]any {
 		base["current_user"] = map[string]any{
 			"name":  u,
 			"email": u,
-			"admin": true,
+			"admin": c.ops.IsAdmin(u),
 		}
 	}
 	if s := s.site; s != nil {
 		site := map[string]any{
-			"domain": s.Domain,
-			"public": s.Public,
-			"locked": s.Locked,
+			"domain":          s.Domain,
+			"public":          s.Public,
+			"locked":          s.Locked,
+			"currency":        s.Currency,
+			"timezone":        cmp.Or(s.Timezone, "UTC"),
+			"timezone_locked": c.ops.SeenFirstStats(s.Domain),
+			"role":            c.ops.RoleOf(s, c.session.user),
+			"rate_limit": map[string]any{
+				"rate":     s.RateLimit.GetRate(),
+				"burst":    s.RateLimit.GetBurst(),
+				"default":  oracle.RateLimit.Events,
+				"rejected": c.EventsRejected(s.Domain),
+			},
+			"exclusions": map[string]any{
+				"ips":      strings.Join(s.Exclusions.GetIps(), "\n"),
+				"paths":    strings.Join(s.Exclusions.GetPaths(), "\n"),
+				"hosts":    strings.Join(s.Exclusions.GetHosts(), "\n"),
+				"cookie":   s.Exclusions.GetCookie(),
+				"excluded": c.EventsExcluded(s.Domain),
+			},
+			"retention": []map[string]any{
+				{"name": "minute", "days": s.Retention.GetMinute()},
+				{"name": "hour", "days": s.Retention.GetHour()},
+				{"name": "day", "days": s.Retention.GetDay()},
+				{"name": "week", "days": s.Retention.GetWeek()},
+				{"name": "month", "days": s.Retention.GetMonth()},
+			},
 		}
 		share := make([]map[string]any, 0, len(s.Shares))
 		u := oracle.Endpoint
@@ -154,9 +198,35 @@ func (c *Config) Context(base map[string]any) map[string]any {
 		if len(goals) > 0 {
 			site["goals"] = goals
 		}
+		funnels := make([]map[string]any, 0, len(s.Funnels))
+		for _, f := range s.Funnels {
+			steps := make([]string, 0, len(f.Steps))
+			for _, g := range f.Steps {
+				if g.Path != "" {
+					steps = append(steps, "Visit "+g.Path)
+				} else {
+					steps = append(steps, g.Name)
+				}
+			}
+			funnels = append(funnels, map[string]any{
+				"id":          len(funnels),
+				"name":        f.Name,
+				"steps":       steps,
+				"steps_count": len(steps),
+			})
+		}
+		if len(funnels) > 0 {
+			site["funnels"] = funnels
+		}
+		// dashboard expects funnels metadata as a json array
+		meta, _ := json.Marshal(funnels)
+		site["funnels_json"] = string(meta)
 		base["site"] = site
 	}
 
+	if c.sso != nil {
+		base["sso"] = true
+	}
 	if s.Data.Csrf != "" {
 		base["csrf"] = template.HTML(s.Data.Csrf)
 	}
@@ -183,6 +253,15 @@ func (c *Config) clone(r *http.Request) *Config {
 		cache:   c.cache,
 		session: c.session.clone(),
 		buffer:  c.buffer,
+		jobs:    c.jobs,
+		rates:   c.rates,
+		sso:     c.sso,
+		limits:  c.limits,
+		purge:   c.purge,
+		salts:   c.salts,
+		rules:   c.rules,
+		ips:     c.ips,
+		ingest:  c.ingest,
 		logger:  c.logger.With(slog.String("path", r.URL.Path), "method", r.Method),
 	}
 }
@@ -256,8 +335,79 @@ func (c *Config) CurrentSite() *v1.Site {
 	return c.session.site
 }
 
-func (c *Config) Login(w http.ResponseWriter) string {
-	c.session.Data.CurrentUserID = c.ops.Admin()
+// Role returns the role of the current user on the current site.
+func (c *Config) Role() string {
+	if c.session.user == "" || c.session.site == nil {
+		return ""
+	}
+	return c.ops.RoleOf(c.session.site, c.session.user)
+}
+
+// IsAdmin returns true if the current user is the instance administrator.
+func (c *Config) IsAdmin() bool {
+	return c.ops.IsAdmin(c.session.user)
+}
+
+// StartSSO records a pending single sign-on request in the session.
+func (c *Config) StartSSO(w http.ResponseWriter, state, nonce, verifier string) {
+	c.session.Data.SSO = &SSO{State: state, Nonce: nonce, Verifier: verifier}
+	c.SaveSession(w)
+}
+
+// PendingSSO returns the pending single sign-on request if any.
+func (c *Config) PendingSSO() *SSO {
+	if s := c.session.Data.SSO; s != nil && s.State != "" {
+		return s
+	}
+	return nil
+}
+
+// LoginSSO is like Login but records identity asserted by the provider.
+func (c *Config) LoginSSO(w http.ResponseWriter, name, issuer, subject string) string {
+	c.session.Data.SSO = &SSO{Issuer: issuer, Subject: subject}
+	return c.Login(w, name)
+}
+
+// LoginSSOPending is like LoginPending but records identity asserted by the
+// provider.
+func (c *Config) LoginSSOPending(w http.ResponseWriter, name, issuer, subject string) {
+	c.session.Data.SSO = &SSO{Issuer: issuer, Subject: subject}
+	c.LoginPending(w, name)
+}
+
+// LoginPending records that name passed the password check and must still
+// provide a second factor.
+func (c *Config) LoginPending(w http.ResponseWriter, name string) {
+	c.session.Data.PendingUser = name
+	c.session.Data.PendingAt = xtime.Now()
+	c.SaveSession(w)
+}
+
+// PendingLogin returns user waiting for two-factor authentication.
+func (c *Config) PendingLogin() string {
+	d := &c.session.Data
+	if d.PendingUser == "" || xtime.Now().Sub(d.PendingAt) > pendingTimeout {
+		return ""
+	}
+	return d.PendingUser
+}
+
+// CancelLogin forgets the pending user, the password must be entered again.
+func (c *Config) CancelLogin() {
+	c.session.Data.PendingUser = ""
+	c.session.Data.PendingAt = time.Time{}
+}
+
+// CompleteLogin logs in the pending user after a successful second factor.
+func (c *Config) CompleteLogin(w http.ResponseWriter) string {
+	name := c.PendingLogin()
+	c.CancelLogin()
+	return c.Login(w, name)
+}
+
+func (c *Config) Login(w http.ResponseWriter, name string) string {
+	c.session.Data.CurrentUserID = name
+	c.session.user = name
 	c.session.Data.LoggedIn = true
 	dest := c.session.Data.LoginDest
 	c.session.Data.LoginDest = ""
@@ -278,6 +428,10 @@ func (c *Config) Success(msg string) {
 	c.session.SuccessFlash(msg)
 }
 
+func (c *Config) Fail(msg string) {
+	c.session.FailFlash(msg)
+}
+
 func (c *Config) SaveSession(w http.ResponseWriter) {
 	err := c.session.save(w)
 	if err != nil {
@@ -367,7 +521,7 @@ func (s *SessionContext) saveCsrf(w http.ResponseWriter) error {
 }
 
 func (c *Config) IsValidCsrf(r *http.Request) bool {
-	r.ParseForm()
-	value := r.Form.Get("_csrf")
+	// FormValue also parses multipart forms used for file uploads.
+	value := r.FormValue("_csrf")
 	return subtle.ConstantTimeCompare([]byte(value), []byte(c.session.Data.Csrf)) == 1
 }
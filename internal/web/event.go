@@ -4,6 +4,7 @@ import (
 	"errors"
 	"net/http"
 
+	"github.com/vinceanalytics/vince/internal/ratelimit"
 	"github.com/vinceanalytics/vince/internal/web/db"
 )
 
@@ -13,6 +14,12 @@ func Event(dba *db.Config, w http.ResponseWriter, r *http.Request) {
 	w.Header().Add("Access-Control-Allow-Headers", "Content-Type")
 
 	if err := dba.ProcessEvent(r); err != nil {
+		var limit *db.LimitError
+		if errors.As(err, &limit) {
+			w.Header().Set("Retry-After", ratelimit.RetryAfter(limit.Wait))
+			Json(w, map[string]any{"error": err.Error()}, http.StatusTooManyRequests)
+			return
+		}
 		if errors.Is(err, db.ErrDrop) {
 			w.Header().Set("x-plausible-dropped", "1")
 			w.WriteHeader(http.StatusAccepted)
@@ -0,0 +1,50 @@
+package web
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	v1 "github.com/vinceanalytics/vince/gen/go/vince/v1"
+	"github.com/vinceanalytics/vince/internal/ops"
+	"github.com/vinceanalytics/vince/internal/shards"
+	"github.com/vinceanalytics/vince/internal/util/oracle"
+	"github.com/vinceanalytics/vince/internal/web/db"
+)
+
+func TestEventOptOutCookie(t *testing.T) {
+	oracle.DataPath = t.TempDir()
+	bdb, err := shards.New(oracle.DataPath)
+	require.NoError(t, err)
+	defer bdb.Close()
+	require.NoError(t, ops.CreateAdmin(bdb.Get(), "root", "password"))
+	dba, err := db.Open(bdb, []string{"vinceanalytics.com"})
+	require.NoError(t, err)
+	defer dba.Close()
+
+	site := dba.Ops().Site("vinceanalytics.com")
+	site.Exclusions = &v1.Exclusions{Cookie: "vince_ignore"}
+	dba.Ops().Save(site)
+
+	send := func(body string) *httptest.ResponseRecorder {
+		r := httptest.NewRequest(http.MethodPost, "/api/event", strings.NewReader(body))
+		r.Header.Set("User-Agent", "Mozilla/5.0 (Windows NT 10.0; Win64; x64) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/120.0.0.0 Safari/537.36")
+		r.Header.Set("Origin", "https://vinceanalytics.com")
+		w := httptest.NewRecorder()
+		Event(dba, w, r)
+		return w
+	}
+
+	// cross origin requests carry the cookie name reported by the tracker
+	w := send(`{"n":"pageview","u":"https://vinceanalytics.com/","d":"vinceanalytics.com","c":"vince_ignore"}`)
+	require.Equal(t, http.StatusAccepted, w.Code)
+	require.Equal(t, "1", w.Header().Get("x-plausible-dropped"))
+	require.Equal(t, uint64(1), dba.EventsExcluded("vinceanalytics.com")["cookie"])
+
+	w = send(`{"n":"pageview","u":"https://vinceanalytics.com/","d":"vinceanalytics.com","c":"other"}`)
+	require.Equal(t, http.StatusAccepted, w.Code)
+	require.Empty(t, w.Header().Get("x-plausible-dropped"))
+	require.Equal(t, uint64(1), dba.EventsExcluded("vinceanalytics.com")["cookie"])
+}
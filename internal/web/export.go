@@ -0,0 +1,247 @@
+package web
+
+import (
+	"archive/zip"
+	"bytes"
+	"context"
+	"encoding/csv"
+	"fmt"
+	"io"
+	"net/http"
+	"slices"
+	"strconv"
+
+	v1 "github.com/vinceanalytics/vince/gen/go/vince/v1"
+	"github.com/vinceanalytics/vince/internal/api/aggregates"
+	"github.com/vinceanalytics/vince/internal/api/breakdown"
+	"github.com/vinceanalytics/vince/internal/api/timeseries"
+	"github.com/vinceanalytics/vince/internal/models"
+	"github.com/vinceanalytics/vince/internal/web/db"
+	"github.com/vinceanalytics/vince/internal/web/query"
+)
+
+// Export downloads a zip of csv files with dashboard reports for the current
+// period and filters. File names and columns follow plausible's dashboard
+// export.
+func Export(db *db.Config, w http.ResponseWriter, r *http.Request) {
+	site := db.CurrentSite()
+	params := query.New(r.URL.Query(), db.Timezone(site.Domain))
+	var b bytes.Buffer
+	err := exportZip(r.Context(), db, site, params, &b)
+	if err != nil {
+		db.Logger().Error("exporting stats", "domain", site.Domain, "err", err)
+		http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+		return
+	}
+	name := fmt.Sprintf("Vince export %s %s to %s.zip", site.Domain, params.From(), params.To())
+	w.Header().Set("content-type", "application/zip")
+	w.Header().Set("content-disposition", fmt.Sprintf("attachment; filename=%q", name))
+	// The dashboard polls for this cookie to stop showing export progress.
+	http.SetCookie(w, &http.Cookie{Name: "exporting", Path: "/", MaxAge: -1})
+	w.Write(b.Bytes())
+}
+
+// table is a csv file in the export. Columns are read from keys of breakdown
+// results.
+type table struct {
+	name    string
+	header  []string
+	columns []string
+	rows    []map[string]any
+}
+
+func (t *table) write(zw *zip.Writer) error {
+	f, err := zw.Create(t.name)
+	if err != nil {
+		return err
+	}
+	w := csv.NewWriter(f)
+	err = w.Write(t.header)
+	if err != nil {
+		return err
+	}
+	record := make([]string, len(t.columns))
+	for _, m := range t.rows {
+		for i, k := range t.columns {
+			record[i] = cell(m[k])
+		}
+		err = w.Write(record)
+		if err != nil {
+			return err
+		}
+	}
+	w.Flush()
+	return w.Error()
+}
+
+func cell(v any) string {
+	switch e := v.(type) {
+	case nil:
+		return ""
+	case string:
+		return e
+	case float64:
+		return strconv.FormatFloat(e, 'f', -1, 64)
+	default:
+		return fmt.Sprint(e)
+	}
+}
+
+func exportZip(ctx context.Context, db *db.Config, site *v1.Site, params *query.Query, w io.Writer) error {
+	ts := db.TimeSeries()
+	tables := make([]*table, 0, 16)
+
+	graph := []string{"visitors", "pageviews", "visits", "views_per_visit", "bounce_rate", "visit_duration"}
+	series, err := timeseries.Timeseries(ctx, ts, site.Domain, params, graph)
+	if err != nil {
+		return err
+	}
+	visitors := &table{
+		name:    "visitors.csv",
+		header:  append([]string{"date"}, graph...),
+		columns: append([]string{"date"}, graph...),
+	}
+	dates := make([]string, 0, len(series))
+	for date := range series {
+		dates = append(dates, date)
+	}
+	slices.Sort(dates)
+	reduce := aggregates.Reduce(graph)
+	for _, date := range dates {
+		stat := series[date]
+		stat.Compute()
+		m := map[string]any{"date": date}
+		reduce(stat, m)
+		visitors.rows = append(visitors.rows, m)
+	}
+	tables = append(tables, visitors)
+
+	sources := []struct {
+		name  string
+		field models.Field
+	}{
+		{"sources.csv", models.Field_source},
+		{"utm_mediums.csv", models.Field_utm_medium},
+		{"utm_sources.csv", models.Field_utm_source},
+		{"utm_campaigns.csv", models.Field_utm_campaign},
+		{"utm_contents.csv", models.Field_utm_content},
+		{"utm_terms.csv", models.Field_utm_term},
+	}
+	for _, s := range sources {
+		o, err := breakdown.Breakdown(ctx, ts, site.Domain, params, []string{"visitors", "bounce_rate", "visit_duration"}, s.field)
+		if err != nil {
+			return err
+		}
+		tables = append(tables, &table{
+			name:    s.name,
+			header:  []string{"name", "visitors", "bounce_rate", "visit_duration"},
+			columns: []string{s.field.String(), "visitors", "bounce_rate", "visit_duration"},
+			rows:    o.Results,
+		})
+	}
+
+	o, err := breakdown.Breakdown(ctx, ts, site.Domain, params, []string{"visitors", "pageviews", "bounce_rate"}, models.Field_page)
+	if err != nil {
+		return err
+	}
+	tables = append(tables, &table{
+		name:    "pages.csv",
+		header:  []string{"name", "visitors", "pageviews", "bounce_rate"},
+		columns: []string{"page", "visitors", "pageviews", "bounce_rate"},
+		rows:    o.Results,
+	})
+
+	o, err = breakdown.Breakdown(ctx, ts, site.Domain, params, []string{"visitors", "visits", "visit_duration"}, models.Field_entry_page)
+	if err != nil {
+		return err
+	}
+	tables = append(tables, &table{
+		name:    "entry_pages.csv",
+		header:  []string{"name", "unique_entrances", "total_entrances", "visit_duration"},
+		columns: []string{"entry_page", "visitors", "visits", "visit_duration"},
+		rows:    o.Results,
+	})
+
+	o, err = breakdown.BreakdownExitPages(ctx, ts, site.Domain, params)
+	if err != nil {
+		return err
+	}
+	tables = append(tables, &table{
+		name:    "exit_pages.csv",
+		header:  []string{"name", "unique_exits", "total_exits", "exit_rate"},
+		columns: []string{"name", "visitors", "visits", "exit_rate"},
+		rows:    o.Results,
+	})
+
+	o, err = breakdown.Breakdown(ctx, ts, site.Domain, params, []string{"visitors"}, models.Field_country)
+	if err != nil {
+		return err
+	}
+	for _, m := range o.Results {
+		m["name"] = db.Location().GetCountry(m["country"].(string)).Name
+	}
+	tables = append(tables, visitorsTable("countries.csv", o))
+
+	o, err = breakdown.Breakdown(ctx, ts, site.Domain, params, []string{"visitors"}, models.Field_subdivision1_code)
+	if err != nil {
+		return err
+	}
+	for _, m := range o.Results {
+		m["name"] = db.Location().GetRegion([]byte(m["subdivision1_code"].(string))).Name
+	}
+	tables = append(tables, visitorsTable("regions.csv", o))
+
+	o, err = breakdown.BreakdownCity(ctx, ts, site.Domain, params, []string{"visitors"})
+	if err != nil {
+		return err
+	}
+	tables = append(tables, visitorsTable("cities.csv", o))
+
+	devices := []struct {
+		name  string
+		field models.Field
+	}{
+		{"browsers.csv", models.Field_browser},
+		{"operating_systems.csv", models.Field_os},
+		{"devices.csv", models.Field_device},
+	}
+	for _, d := range devices {
+		o, err := breakdown.Breakdown(ctx, ts, site.Domain, params, []string{"visitors"}, d.field)
+		if err != nil {
+			return err
+		}
+		for _, m := range o.Results {
+			m["name"] = m[d.field.String()]
+		}
+		tables = append(tables, visitorsTable(d.name, o))
+	}
+
+	o, err = breakdown.BreakdownGoals(ctx, ts, site, params, []string{"visitors", "events"})
+	if err != nil {
+		return err
+	}
+	tables = append(tables, &table{
+		name:    "conversions.csv",
+		header:  []string{"name", "unique_conversions", "total_conversions"},
+		columns: []string{"name", "visitors", "events"},
+		rows:    o.Results,
+	})
+
+	zw := zip.NewWriter(w)
+	for _, t := range tables {
+		err = t.write(zw)
+		if err != nil {
+			return err
+		}
+	}
+	return zw.Close()
+}
+
+func visitorsTable(name string, o *breakdown.Result) *table {
+	return &table{
+		name:    name,
+		header:  []string{"name", "visitors"},
+		columns: []string{"name", "visitors"},
+		rows:    o.Results,
+	}
+}
@@ -0,0 +1,36 @@
+package web
+
+import (
+	"archive/zip"
+	"bytes"
+	"io"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestTableWrite(t *testing.T) {
+	var b bytes.Buffer
+	zw := zip.NewWriter(&b)
+	tb := &table{
+		name:    "entry_pages.csv",
+		header:  []string{"name", "unique_entrances", "total_entrances", "visit_duration"},
+		columns: []string{"entry_page", "visitors", "visits", "visit_duration"},
+		rows: []map[string]any{
+			{"entry_page": "/", "visitors": 2.0, "visits": 3.0, "visit_duration": 12.5},
+			{"entry_page": "/a,b", "visitors": 1.0, "visits": 1.0},
+		},
+	}
+	require.NoError(t, tb.write(zw))
+	require.NoError(t, zw.Close())
+
+	zr, err := zip.NewReader(bytes.NewReader(b.Bytes()), int64(b.Len()))
+	require.NoError(t, err)
+	require.Len(t, zr.File, 1)
+	require.Equal(t, "entry_pages.csv", zr.File[0].Name)
+	f, err := zr.File[0].Open()
+	require.NoError(t, err)
+	data, err := io.ReadAll(f)
+	require.NoError(t, err)
+	require.Equal(t, "name,unique_entrances,total_entrances,visit_duration\n/,2,3,12.5\n\"/a,b\",1,1,\n", string(data))
+}
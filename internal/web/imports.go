@@ -0,0 +1,47 @@
+package web
+
+import (
+	"archive/zip"
+	"fmt"
+	"net/http"
+	"net/url"
+
+	"github.com/vinceanalytics/vince/internal/ops"
+	"github.com/vinceanalytics/vince/internal/plausible"
+	"github.com/vinceanalytics/vince/internal/timeseries"
+	"github.com/vinceanalytics/vince/internal/web/db"
+)
+
+func ImportPlausible(db *db.Config, w http.ResponseWriter, r *http.Request) {
+	site := db.CurrentSite()
+	err := importPlausible(db, r, site.Domain)
+	if err != nil {
+		db.Logger().Error("importing plausible export", "domain", site.Domain, "err", err)
+		db.Fail(fmt.Sprintf("Import failed: %s", err))
+	} else {
+		db.Audit(ops.AuditSiteImport, site.Domain, "", "plausible")
+		db.Success("Plausible export was successfully imported")
+	}
+	db.SaveSession(w)
+	http.Redirect(w, r, fmt.Sprintf("/%s/settings#imports", url.PathEscape(site.Domain)), http.StatusFound)
+}
+
+func importPlausible(db *db.Config, r *http.Request, domain string) error {
+	f, h, err := r.FormFile("file")
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+	zr, err := zip.NewReader(f, h.Size)
+	if err != nil {
+		return err
+	}
+	export, err := plausible.Read(zr)
+	if err != nil {
+		return err
+	}
+	export.In(db.Timezone(domain))
+	return db.Exec(r.Context(), func(ts *timeseries.Timeseries) error {
+		return plausible.Import(ts, domain, export)
+	})
+}
@@ -0,0 +1,13 @@
+package web
+
+import (
+	"net/http"
+
+	"github.com/vinceanalytics/vince/internal/web/db"
+)
+
+// Ingest reports the state of the ingestion queue, use it to tell when events
+// are dropped under load.
+func Ingest(db *db.Config, w http.ResponseWriter, r *http.Request) {
+	db.JSON(w, db.IngestStats())
+}
@@ -1,8 +1,10 @@
 package web
 
 import (
+	"errors"
 	"net/http"
 
+	"github.com/vinceanalytics/vince/internal/ops"
 	"github.com/vinceanalytics/vince/internal/web/db"
 )
 
@@ -12,18 +14,70 @@ func LoginForm(db *db.Config, w http.ResponseWriter, r *http.Request) {
 
 func Login(db *db.Config, w http.ResponseWriter, r *http.Request) {
 	r.ParseForm()
+	name := r.Form.Get("name")
 	password := r.Form.Get("password")
-	if !db.PasswordMatch(password) {
+	if !db.PasswordMatch(name, password) {
 		db.SaveCsrf(w)
 		valid := map[string]any{
-			"error": "Wrong password. Please try again.",
+			"error": "Wrong name or password. Please try again.",
+			"name":  name,
 		}
 		db.HTML(w, login, valid)
 		return
 	}
-	http.Redirect(w, r, db.Login(w), http.StatusFound)
+	if db.Ops().HasTwoFactor(name) {
+		if db.Ops().TwoFactorLocked(name) {
+			db.SaveCsrf(w)
+			db.HTML(w, login, map[string]any{
+				"error": twoFactorLocked,
+				"name":  name,
+			})
+			return
+		}
+		db.LoginPending(w, name)
+		http.Redirect(w, r, "/login/2fa", http.StatusFound)
+		return
+	}
+	http.Redirect(w, r, db.Login(w, name), http.StatusFound)
+}
+
+func TwoFactorForm(db *db.Config, w http.ResponseWriter, r *http.Request) {
+	if db.PendingLogin() == "" {
+		http.Redirect(w, r, "/login", http.StatusFound)
+		return
+	}
+	db.HTML(w, twoFactorLogin, nil)
+}
+
+func TwoFactorLogin(db *db.Config, w http.ResponseWriter, r *http.Request) {
+	name := db.PendingLogin()
+	if name == "" {
+		http.Redirect(w, r, "/login", http.StatusFound)
+		return
+	}
+	err := db.Ops().VerifyTwoFactor(name, r.FormValue("code"))
+	if errors.Is(err, ops.ErrTwoFactorLocked) {
+		db.Logger().Warn("two-factor verification locked", "user", name)
+		db.CancelLogin()
+		db.SaveCsrf(w)
+		db.HTML(w, login, map[string]any{
+			"error": twoFactorLocked,
+			"name":  name,
+		})
+		return
+	}
+	if err != nil {
+		db.SaveCsrf(w)
+		db.HTML(w, twoFactorLogin, map[string]any{
+			"error": "Invalid code. Please try again.",
+		})
+		return
+	}
+	http.Redirect(w, r, db.CompleteLogin(w), http.StatusFound)
 }
 
+const twoFactorLocked = "Too many invalid codes. Please try again later."
+
 func Logout(db *db.Config, w http.ResponseWriter, r *http.Request) {
 	db.Logout(w)
 	http.Redirect(w, r, "/", http.StatusFound)
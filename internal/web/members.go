@@ -0,0 +1,201 @@
+package web
+
+import (
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/vinceanalytics/vince/internal/ops"
+	"github.com/vinceanalytics/vince/internal/util/oracle"
+	"github.com/vinceanalytics/vince/internal/util/xtime"
+	"github.com/vinceanalytics/vince/internal/web/db"
+)
+
+// people returns template context for the site members section.
+func people(db *db.Config) map[string]any {
+	site := db.CurrentSite()
+	role := db.Role()
+	members := make([]map[string]any, 0, len(site.Members))
+	for _, m := range site.Members {
+		members = append(members, map[string]any{
+			"user":     m.User,
+			"role":     m.Role,
+			"editable": m.User != db.CurrentUser() && ops.Allows(role, m.Role),
+		})
+	}
+	invites, err := db.Ops().Invitations(site.Domain)
+	if err != nil {
+		db.Logger().Error("reading invitations", "domain", site.Domain, "err", err)
+	}
+	invitations := make([]map[string]any, 0, len(invites))
+	for _, i := range invites {
+		invitations = append(invitations, map[string]any{
+			"id":      i.Id,
+			"role":    i.Role,
+			"link":    invitationLink(i.Id),
+			"expires": xtime.UnixMilli(int64(i.Expires)).UTC().Format("Jan 2, 2006"),
+		})
+	}
+	return map[string]any{
+		"members":     members,
+		"invitations": invitations,
+		"roles":       grantable(role),
+	}
+}
+
+// grantable returns roles that a member with role can hand out.
+func grantable(role string) (ls []string) {
+	for _, r := range ops.Roles() {
+		if ops.Allows(role, r) {
+			ls = append(ls, r)
+		}
+	}
+	return
+}
+
+func invitationLink(id string) string {
+	return fmt.Sprintf("%s/invitations/%s/accept", oracle.Endpoint, url.PathEscape(id))
+}
+
+func peopleSettings(domain string) string {
+	return fmt.Sprintf("/%s/settings#people", url.PathEscape(domain))
+}
+
+func Invite(db *db.Config, w http.ResponseWriter, r *http.Request) {
+	site := db.CurrentSite()
+	role := r.FormValue("role")
+	if !ops.Allows(db.Role(), role) {
+		db.Fail("You are not allowed to invite members with this role")
+		db.SaveSession(w)
+		http.Redirect(w, r, peopleSettings(site.Domain), http.StatusFound)
+		return
+	}
+	inv, err := db.Ops().CreateInvitation(site.Domain, role, db.CurrentUser())
+	if err != nil {
+		db.Logger().Error("creating invitation", "domain", site.Domain, "err", err)
+		db.Fail("Failed to create invitation")
+	} else {
+		db.Audit(ops.AuditMemberInvite, site.Domain, inv.Id, role)
+		db.Success("Invitation created. Share the link with the person you want to invite")
+	}
+	db.SaveSession(w)
+	http.Redirect(w, r, peopleSettings(site.Domain), http.StatusFound)
+}
+
+func UpdateMemberRole(db *db.Config, w http.ResponseWriter, r *http.Request) {
+	site := db.CurrentSite()
+	user := r.FormValue("user")
+	role := r.FormValue("role")
+	current := db.Ops().RoleOf(site, user)
+	if current == "" || user == db.CurrentUser() ||
+		!ops.Allows(db.Role(), current) || !ops.Allows(db.Role(), role) {
+		db.Fail("You are not allowed to change the role of this member")
+		db.SaveSession(w)
+		http.Redirect(w, r, peopleSettings(site.Domain), http.StatusFound)
+		return
+	}
+	err := db.Ops().SetRole(site.Domain, user, role)
+	if err != nil {
+		db.Fail(err.Error())
+	} else {
+		db.Audit(ops.AuditMemberRole, site.Domain, user, role)
+		db.Success("Member role updated")
+	}
+	db.SaveSession(w)
+	http.Redirect(w, r, peopleSettings(site.Domain), http.StatusFound)
+}
+
+func RemoveMember(db *db.Config, w http.ResponseWriter, r *http.Request) {
+	site := db.CurrentSite()
+	user := r.FormValue("user")
+	current := db.Ops().RoleOf(site, user)
+	if current == "" || user == db.CurrentUser() || !ops.Allows(db.Role(), current) {
+		db.Fail("You are not allowed to remove this member")
+	} else {
+		db.Ops().RemoveMember(site.Domain, user)
+		db.Audit(ops.AuditMemberRemove, site.Domain, user, "")
+		db.Success("Member removed")
+	}
+	db.SaveSession(w)
+	http.Redirect(w, r, peopleSettings(site.Domain), http.StatusFound)
+}
+
+func DeleteInvitation(db *db.Config, w http.ResponseWriter, r *http.Request) {
+	site := db.CurrentSite()
+	id := r.FormValue("id")
+	inv, err := db.Ops().Invitation(id)
+	if err == nil && inv.Domain == site.Domain {
+		err = db.Ops().DeleteInvitation(id)
+		if err != nil {
+			db.Logger().Error("deleting invitation", "id", id, "err", err)
+		}
+		db.Audit(ops.AuditInvitationDelete, site.Domain, id, "")
+		db.Success("Invitation revoked")
+		db.SaveSession(w)
+	}
+	http.Redirect(w, r, peopleSettings(site.Domain), http.StatusFound)
+}
+
+func InvitationForm(db *db.Config, w http.ResponseWriter, r *http.Request) {
+	id := r.PathValue("id")
+	inv, err := db.Ops().Invitation(id)
+	if err != nil {
+		db.HTMLCode(http.StatusNotFound, w, e404, map[string]any{})
+		return
+	}
+	if !db.Authorize(w, r) {
+		// Authorize remembers this page, existing users who choose to login
+		// are brought back here.
+		db.HTML(w, acceptInvitation, map[string]any{
+			"invitation": map[string]any{
+				"id":     inv.Id,
+				"domain": inv.Domain,
+				"role":   inv.Role,
+			},
+		})
+		return
+	}
+	accept(db, w, r, id)
+}
+
+func AcceptInvitation(db *db.Config, w http.ResponseWriter, r *http.Request) {
+	id := r.PathValue("id")
+	inv, err := db.Ops().Invitation(id)
+	if err != nil {
+		db.HTMLCode(http.StatusNotFound, w, e404, map[string]any{})
+		return
+	}
+	if db.CurrentUser() == "" {
+		name := strings.TrimSpace(r.FormValue("name"))
+		err = db.Ops().CreateUser(name, r.FormValue("password"))
+		if err != nil {
+			db.SaveCsrf(w)
+			db.HTML(w, acceptInvitation, map[string]any{
+				"invitation": map[string]any{
+					"id":     inv.Id,
+					"domain": inv.Domain,
+					"role":   inv.Role,
+				},
+				"name":  name,
+				"error": err.Error(),
+			})
+			return
+		}
+		db.Login(w, name)
+	}
+	accept(db, w, r, id)
+}
+
+func accept(db *db.Config, w http.ResponseWriter, r *http.Request, id string) {
+	inv, err := db.Ops().AcceptInvitation(id, db.CurrentUser())
+	if err != nil {
+		db.Logger().Error("accepting invitation", "id", id, "err", err)
+		db.HTMLCode(http.StatusNotFound, w, e404, map[string]any{})
+		return
+	}
+	db.Audit(ops.AuditInvitationAccept, inv.Domain, id, inv.Role)
+	db.Success(fmt.Sprintf("You now have access to %s", inv.Domain))
+	db.SaveSession(w)
+	http.Redirect(w, r, "/"+url.PathEscape(inv.Domain), http.StatusFound)
+}
@@ -8,6 +8,9 @@ import (
 
 type Filters []*Filter
 
+// PropsPrefix is the prefix of translated custom property filter keys.
+const PropsPrefix = "props:"
+
 func (f Filters) Translate() Filters {
 	o := make(Filters, 0, len(f))
 	for i := range f {
@@ -66,8 +69,11 @@ func (c *Filter) To() *Filter {
 	if len(c.Value) == 0 {
 		return nil
 	}
-	if strings.HasPrefix(c.Key, "event:props:") {
-		return nil
+	if key, ok := strings.CutPrefix(c.Key, "event:props:"); ok {
+		if key == "" {
+			return nil
+		}
+		return &Filter{Op: c.Op, Key: PropsPrefix + key, Value: c.Value}
 	}
 	if strings.HasPrefix(c.Key, "event:") {
 		key := strings.TrimPrefix(c.Key, "event:")
@@ -29,3 +29,8 @@ func TestFilter(t *testing.T) {
 	require.Equal(t, want, tr)
 
 }
+
+func TestFilterProps(t *testing.T) {
+	f := &Filter{Op: "is", Key: "event:props:author", Value: []string{"john"}}
+	require.Equal(t, &Filter{Op: "is", Key: "props:author", Value: []string{"john"}}, f.To())
+}
@@ -7,12 +7,12 @@ import (
 	"github.com/vinceanalytics/vince/internal/util/xtime"
 )
 
-func period(str, date string) Period {
-	base := dateParse(date)
+func period(str, date string, loc *time.Location) Period {
+	base := dateParse(date, loc)
 	last := base.End
 	switch str {
 	case "realtime":
-		end := xtime.Now().Truncate(time.Minute)
+		end := xtime.Now().In(loc).Truncate(time.Minute)
 		return Period{Start: end.Add(-15 * time.Minute), End: end, Interval: Minute}
 	case "day":
 		base.Interval = Hour
@@ -40,7 +40,7 @@ func period(str, date string) Period {
 		first := beginOfYear(last)
 		return Period{Start: first, End: last, Interval: Month}
 	case "all":
-		return Period{Start: time.Date(2000, 1, 1, 0, 0, 0, 0, time.UTC), End: last, Interval: Date}
+		return Period{Start: time.Date(2000, 1, 1, 0, 0, 0, 0, loc), End: last, Interval: Date}
 	default:
 		base.Interval = Hour
 		return Period{Start: beginOfDay(base.Start), End: last, Interval: Hour}
@@ -52,16 +52,16 @@ type Period struct {
 	Interval   Interval
 }
 
-func dateParse(date string) Period {
+func dateParse(date string, loc *time.Location) Period {
 	if date == "" {
-		now := xtime.Now()
+		now := xtime.Now().In(loc)
 		return Period{Start: now, End: now}
 	}
 	a, b, ok := strings.Cut(date, ",")
-	start, _ := time.Parse(time.DateOnly, a)
+	start, _ := time.ParseInLocation(time.DateOnly, a, loc)
 
 	if ok {
-		end, _ := time.Parse(time.DateOnly, b)
+		end, _ := time.ParseInLocation(time.DateOnly, b, loc)
 		return Period{Start: start, End: end}
 	}
 	return Period{Start: start, End: endOfDay(start)}
@@ -69,17 +69,17 @@ func dateParse(date string) Period {
 
 func beginOfDay(ts time.Time) time.Time {
 	yy, mm, dd := ts.Date()
-	return time.Date(yy, mm, dd, 0, 0, 0, 0, time.UTC)
+	return time.Date(yy, mm, dd, 0, 0, 0, 0, ts.Location())
 }
 
 func beginOfMonth(ts time.Time) time.Time {
 	yy, mm, _ := ts.Date()
-	return time.Date(yy, mm, 1, 0, 0, 0, 0, time.UTC)
+	return time.Date(yy, mm, 1, 0, 0, 0, 0, ts.Location())
 }
 
 func beginOfYear(ts time.Time) time.Time {
 	yy, _, _ := ts.Date()
-	return time.Date(yy, time.January, 1, 0, 0, 0, 0, time.UTC)
+	return time.Date(yy, time.January, 1, 0, 0, 0, 0, ts.Location())
 }
 
 func endOfDay(ts time.Time) time.Time {
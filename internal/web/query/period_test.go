@@ -41,3 +41,19 @@ func TestEndOfYear(t *testing.T) {
 		})
 	}
 }
+
+func TestPeriodLocation(t *testing.T) {
+	loc, err := time.LoadLocation("Asia/Tokyo")
+	if err != nil {
+		t.Skip("missing timezone data")
+	}
+	p := period("month", "2024-08-15", loc)
+	start := time.Date(2024, time.August, 1, 0, 0, 0, 0, loc)
+	end := time.Date(2024, time.August, 31, 23, 59, 59, int(time.Second-time.Nanosecond), loc)
+	if !p.Start.Equal(start) {
+		t.Errorf("start = %v, expected %v", p.Start, start)
+	}
+	if !p.End.Equal(end) {
+		t.Errorf("end = %v, expected %v", p.End, end)
+	}
+}
@@ -19,9 +19,16 @@ type Query struct {
 	all      bool
 	realtime bool
 	property models.Field
+	prop     string
+	loc      *time.Location
 }
 
-func New(u url.Values) *Query {
+// New parses query parameters. Periods and comparisons are resolved in loc, a
+// nil loc means UTC.
+func New(u url.Values, loc *time.Location) *Query {
+	if loc == nil {
+		loc = time.UTC
+	}
 	var fs Filters
 	json.Unmarshal([]byte(u.Get("filters")), &fs)
 
@@ -36,7 +43,7 @@ func New(u url.Values) *Query {
 	} else {
 		dateParam = u.Get("date")
 	}
-	period := period(u.Get("period"), dateParam)
+	period := period(u.Get("period"), dateParam, loc)
 	if i := u.Get("interval"); i != "" {
 		switch i {
 		case "minute":
@@ -55,7 +62,7 @@ func New(u url.Values) *Query {
 	switch u.Get("period") {
 	case "all", "realtime":
 	default:
-		now := xtime.Now().Truncate(time.Minute)
+		now := xtime.Now().In(loc).Truncate(time.Minute)
 		switch u.Get("comparison") {
 		case "previous_period":
 			diff := period.End.Sub(period.Start)
@@ -67,11 +74,12 @@ func New(u url.Values) *Query {
 		case "custom":
 		}
 	}
+	prop, isProp := strings.CutPrefix(u.Get("property"), "event:props:")
 	mets := []string{"visitors"}
 	if ms := u.Get("metrics"); ms != "" {
 		mets = strings.Split(ms, ",")
 	}
-	return &Query{
+	q := &Query{
 		period:   period,
 		cmp:      cmp,
 		filter:   fs.Translate(),
@@ -80,7 +88,13 @@ func New(u url.Values) *Query {
 		metrics:  mets,
 		all:      u.Get("period") == "all",
 		realtime: u.Get("period") == "realtime",
+		loc:      loc,
+	}
+	if isProp && prop != "" {
+		q.property = models.Field_props
+		q.prop = prop
 	}
+	return q
 }
 
 func (q *Query) With(fs ...*Filter) *Query {
@@ -109,3 +123,9 @@ func (q *Query) Metric() string         { return q.metric }
 func (q *Query) Metrics() []string      { return q.metrics }
 func (q *Query) Compare() *Period       { return q.cmp }
 func (q *Query) Property() models.Field { return q.property }
+
+// Location returns the timezone periods were resolved in.
+func (q *Query) Location() *time.Location { return q.loc }
+
+// Prop returns custom property key when breaking down by event:props:<key>.
+func (q *Query) Prop() string { return q.prop }
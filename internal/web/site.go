@@ -4,13 +4,20 @@ import (
 	"cmp"
 	"fmt"
 	"html/template"
+	"math"
 	"net/http"
 	"net/url"
 	"slices"
 	"strconv"
+	"strings"
+	"time"
 
 	v1 "github.com/vinceanalytics/vince/gen/go/vince/v1"
+	"github.com/vinceanalytics/vince/internal/api/funnel"
 	"github.com/vinceanalytics/vince/internal/api/visitors"
+	"github.com/vinceanalytics/vince/internal/currency"
+	"github.com/vinceanalytics/vince/internal/exclude"
+	"github.com/vinceanalytics/vince/internal/ops"
 	"github.com/vinceanalytics/vince/internal/util/oracle"
 	"github.com/vinceanalytics/vince/internal/util/xtime"
 	"github.com/vinceanalytics/vince/internal/web/db"
@@ -29,8 +36,9 @@ func GoalSettings(db *db.Config, w http.ResponseWriter, r *http.Request) {
 
 func CreateGoal(db *db.Config, w http.ResponseWriter, r *http.Request) {
 	g := &v1.Goal{
-		Name: r.FormValue("event_name"),
-		Path: r.FormValue("page_path"),
+		Name:     r.FormValue("event_name"),
+		Path:     r.FormValue("page_path"),
+		Currency: strings.ToUpper(strings.TrimSpace(r.FormValue("currency"))),
 	}
 	if g.Name == "" && g.Path == "" {
 		db.SaveCsrf(w)
@@ -41,9 +49,23 @@ func CreateGoal(db *db.Config, w http.ResponseWriter, r *http.Request) {
 		})
 		return
 	}
+	if g.Currency != "" && (g.Name == "" || !currency.Valid(g.Currency)) {
+		db.SaveCsrf(w)
+		db.HTML(w, newGoal, map[string]any{
+			"error_currency": "revenue goals require event_name and a 3 letter currency code",
+		})
+		return
+	}
 	site := db.CurrentSite()
 	site.Goals = append(site.Goals, g)
+	if g.Currency != "" && site.Currency == "" {
+		// Revenue is only recorded in the site reporting currency, the first
+		// revenue goal sets it.
+		site.Currency = g.Currency
+		db.Audit(ops.AuditSiteCurrency, site.Domain, "", g.Currency)
+	}
 	db.Ops().Save(site)
+	db.Audit(ops.AuditGoalCreate, site.Domain, goalName(g), "")
 	db.Success("Goal was successfully created")
 	db.SaveSession(w)
 	to := fmt.Sprintf("/%s/settings#goals", url.PathEscape(site.Domain))
@@ -53,16 +75,84 @@ func CreateGoal(db *db.Config, w http.ResponseWriter, r *http.Request) {
 func DeleteGoal(db *db.Config, w http.ResponseWriter, r *http.Request) {
 	site := db.CurrentSite()
 	idx, err := strconv.Atoi(r.URL.Query().Get("id"))
-	if err == nil {
+	if err == nil && idx >= 0 && idx < len(site.Goals) {
+		name := goalName(site.Goals[idx])
 		site.Goals = slices.Delete(site.Goals, idx, idx+1)
+		db.Ops().Save(site)
+		db.Audit(ops.AuditGoalDelete, site.Domain, name, "")
 	}
-	db.Ops().Save(site)
 	db.Success("Goal was successfully deleted")
 	db.SaveSession(w)
 	to := fmt.Sprintf("/%s/settings#goals", url.PathEscape(site.Domain))
 	http.Redirect(w, r, to, http.StatusFound)
 }
 
+func FunnelSettings(db *db.Config, w http.ResponseWriter, r *http.Request) {
+	site := db.CurrentSite()
+	to := fmt.Sprintf("/%s/settings#funnels", url.PathEscape(site.Domain))
+	http.Redirect(w, r, to, http.StatusFound)
+}
+
+func NewFunnelForm(db *db.Config, w http.ResponseWriter, r *http.Request) {
+	db.HTML(w, newFunnel, nil)
+}
+
+// CreateFunnel adds a funnel from the submitted form. Steps starting with / are
+// pageview paths, others are custom event names.
+func CreateFunnel(db *db.Config, w http.ResponseWriter, r *http.Request) {
+	r.ParseForm()
+	f := &v1.Funnel{
+		Name: strings.TrimSpace(r.FormValue("name")),
+	}
+	for _, step := range r.Form["step"] {
+		step = strings.TrimSpace(step)
+		switch {
+		case step == "":
+		case strings.HasPrefix(step, "/"):
+			f.Steps = append(f.Steps, &v1.Goal{Path: step})
+		default:
+			f.Steps = append(f.Steps, &v1.Goal{Name: step})
+		}
+	}
+	site := db.CurrentSite()
+	if f.Name == "" || slices.ContainsFunc(site.Funnels, func(x *v1.Funnel) bool { return x.Name == f.Name }) {
+		db.SaveCsrf(w)
+		db.HTML(w, newFunnel, map[string]any{
+			"error_name": "funnel name must be set and unique",
+		})
+		return
+	}
+	if err := funnel.Validate(f); err != nil {
+		db.SaveCsrf(w)
+		db.HTML(w, newFunnel, map[string]any{
+			"error_steps": err.Error(),
+		})
+		return
+	}
+	site.Funnels = append(site.Funnels, f)
+	db.Ops().Save(site)
+	db.Audit(ops.AuditFunnelCreate, site.Domain, f.Name, "")
+	db.Success("Funnel was successfully created")
+	db.SaveSession(w)
+	to := fmt.Sprintf("/%s/settings#funnels", url.PathEscape(site.Domain))
+	http.Redirect(w, r, to, http.StatusFound)
+}
+
+func DeleteFunnel(db *db.Config, w http.ResponseWriter, r *http.Request) {
+	site := db.CurrentSite()
+	idx, err := strconv.Atoi(r.FormValue("id"))
+	if err == nil && idx >= 0 && idx < len(site.Funnels) {
+		name := site.Funnels[idx].Name
+		site.Funnels = slices.Delete(site.Funnels, idx, idx+1)
+		db.Ops().Save(site)
+		db.Audit(ops.AuditFunnelDelete, site.Domain, name, "")
+	}
+	db.Success("Funnel was successfully deleted")
+	db.SaveSession(w)
+	to := fmt.Sprintf("/%s/settings#funnels", url.PathEscape(site.Domain))
+	http.Redirect(w, r, to, http.StatusFound)
+}
+
 func Status(db *db.Config, w http.ResponseWriter, r *http.Request) {
 	body := "WAITING"
 	if db.Ops().SeenFirstStats(db.CurrentSite().Domain) {
@@ -84,6 +174,7 @@ func DeleteSharedLink(db *db.Config, w http.ResponseWriter, r *http.Request) {
 	site := db.CurrentSite()
 	slug := r.PathValue("slug")
 	db.Ops().DeleteSharedLink(site, slug)
+	db.Audit(ops.AuditSharedLinkDelete, site.Domain, slug, "")
 	http.Redirect(w, r, fmt.Sprintf("/%s/settings#visibility", url.PathEscape(site.Domain)), http.StatusFound)
 }
 
@@ -93,6 +184,7 @@ func EditSharedLink(db *db.Config, w http.ResponseWriter, r *http.Request) {
 	site := db.CurrentSite()
 	slug := r.PathValue("slug")
 	db.Ops().EditSharedLink(site, slug, name)
+	db.Audit(ops.AuditSharedLinkUpdate, site.Domain, slug, name)
 	http.Redirect(w, r, fmt.Sprintf("/%s/settings#visibility", url.PathEscape(site.Domain)), http.StatusFound)
 }
 
@@ -103,19 +195,147 @@ func CreateSharedLink(db *db.Config, w http.ResponseWriter, r *http.Request) {
 	site := db.CurrentSite()
 	site.Public = true
 	err := db.Ops().FindOrCreateCreateSharedLink(site.Domain, name, password)
-	if err == nil {
-		db.Logger().Error("failed creating shared link", "domain", db.CurrentSite().Domain)
+	if err != nil {
+		db.Logger().Error("failed creating shared link", "domain", db.CurrentSite().Domain, "err", err)
+	} else {
+		db.Audit(ops.AuditSharedLinkCreate, site.Domain, name, "")
 	}
 	http.Redirect(w, r, fmt.Sprintf("/%s/settings#visibility", url.PathEscape(site.Domain)), http.StatusFound)
 }
 
 func Settings(db *db.Config, w http.ResponseWriter, r *http.Request) {
-	db.HTML(w, siteSettings, nil)
+	db.HTML(w, siteSettings, people(db))
+}
+
+func UpdateCurrency(db *db.Config, w http.ResponseWriter, r *http.Request) {
+	site := db.CurrentSite()
+	code := strings.ToUpper(strings.TrimSpace(r.FormValue("currency")))
+	if code == "" && slices.ContainsFunc(site.Goals, isRevenueGoal) {
+		db.Fail("Sites with revenue goals require a reporting currency")
+		db.SaveSession(w)
+	} else if code == "" || currency.Valid(code) {
+		site.Currency = code
+		db.Ops().Save(site)
+		db.Audit(ops.AuditSiteCurrency, site.Domain, "", code)
+		db.Success("Reporting currency was successfully updated")
+		db.SaveSession(w)
+	}
+	http.Redirect(w, r, fmt.Sprintf("/%s/settings#revenue", url.PathEscape(site.Domain)), http.StatusFound)
+}
+
+func isRevenueGoal(g *v1.Goal) bool {
+	return g.Currency != ""
+}
+
+func UpdateTimezone(db *db.Config, w http.ResponseWriter, r *http.Request) {
+	site := db.CurrentSite()
+	name := strings.TrimSpace(r.FormValue("timezone"))
+	if name == "UTC" {
+		name = ""
+	}
+	if _, err := time.LoadLocation(name); err != nil || name == "Local" {
+		db.Fail(fmt.Sprintf("Unknown timezone %q", name))
+	} else if name != site.Timezone && db.Ops().SeenFirstStats(site.Domain) {
+		// Views are bucketed in the site timezone when events are recorded,
+		// queries in another zone would miss existing history.
+		db.Fail("Timezone can not be changed after the site has recorded stats")
+	} else {
+		site.Timezone = name
+		db.Ops().Save(site)
+		db.Audit(ops.AuditSiteTimezone, site.Domain, "", name)
+		db.Success("Timezone was successfully updated")
+	}
+	db.SaveSession(w)
+	http.Redirect(w, r, fmt.Sprintf("/%s/settings#timezone", url.PathEscape(site.Domain)), http.StatusFound)
+}
+
+func UpdateRateLimit(db *db.Config, w http.ResponseWriter, r *http.Request) {
+	site := db.CurrentSite()
+	var rate float64
+	var err error
+	if v := strings.TrimSpace(r.FormValue("rate")); v != "" {
+		rate, err = strconv.ParseFloat(v, 64)
+	}
+	burst, _ := strconv.ParseUint(r.FormValue("burst"), 10, 32)
+	if err != nil || !(rate >= 0) || math.IsInf(rate, 0) {
+		db.Fail("Rate limit must be a positive number")
+	} else {
+		site.RateLimit = nil
+		if rate > 0 {
+			site.RateLimit = &v1.RateLimit{Rate: rate, Burst: uint32(burst)}
+		}
+		db.Ops().Save(site)
+		db.Audit(ops.AuditSiteRateLimit, site.Domain, "", fmt.Sprintf("rate=%v burst=%d", rate, burst))
+		db.Success("Rate limit was successfully updated")
+	}
+	db.SaveSession(w)
+	http.Redirect(w, r, fmt.Sprintf("/%s/settings#rate-limit", url.PathEscape(site.Domain)), http.StatusFound)
+}
+
+func UpdateExclusions(db *db.Config, w http.ResponseWriter, r *http.Request) {
+	site := db.CurrentSite()
+	e := &v1.Exclusions{
+		Ips:    lines(r.FormValue("ips")),
+		Paths:  lines(r.FormValue("paths")),
+		Hosts:  lines(r.FormValue("hosts")),
+		Cookie: strings.TrimSpace(r.FormValue("cookie")),
+	}
+	if _, err := exclude.Compile(e); err != nil {
+		db.Fail(err.Error())
+	} else {
+		site.Exclusions = e
+		if len(e.Ips) == 0 && len(e.Paths) == 0 && len(e.Hosts) == 0 && e.Cookie == "" {
+			site.Exclusions = nil
+		}
+		db.Ops().Save(site)
+		db.Audit(ops.AuditSiteExclusions, site.Domain, "", fmt.Sprintf("ips=%d paths=%d hosts=%d cookie=%q",
+			len(e.Ips), len(e.Paths), len(e.Hosts), e.Cookie))
+		db.Success("Exclusions were successfully updated")
+	}
+	db.SaveSession(w)
+	http.Redirect(w, r, fmt.Sprintf("/%s/settings#exclusions", url.PathEscape(site.Domain)), http.StatusFound)
+}
+
+// lines returns non empty lines of s with surrounding space removed.
+func lines(s string) (o []string) {
+	for _, line := range strings.Split(s, "\n") {
+		if line = strings.TrimSpace(line); line != "" {
+			o = append(o, line)
+		}
+	}
+	return
+}
+
+func UpdateRetention(db *db.Config, w http.ResponseWriter, r *http.Request) {
+	site := db.CurrentSite()
+	days := func(name string) uint32 {
+		v, _ := strconv.ParseUint(r.FormValue(name), 10, 32)
+		return uint32(v)
+	}
+	site.Retention = &v1.Retention{
+		Minute: days("minute"),
+		Hour:   days("hour"),
+		Day:    days("day"),
+		Week:   days("week"),
+		Month:  days("month"),
+	}
+	db.Ops().Save(site)
+	db.Audit(ops.AuditSiteRetention, site.Domain, "", fmt.Sprintf("minute=%d hour=%d day=%d week=%d month=%d",
+		site.Retention.Minute, site.Retention.Hour, site.Retention.Day, site.Retention.Week, site.Retention.Month))
+	db.Success("Data retention was successfully updated")
+	db.SaveSession(w)
+	http.Redirect(w, r, fmt.Sprintf("/%s/settings#retention", url.PathEscape(site.Domain)), http.StatusFound)
 }
 
 func Delete(db *db.Config, w http.ResponseWriter, r *http.Request) {
 	domain := db.CurrentSite().Domain
-	db.Ops().DeleteDomain(domain)
+	err := db.DeleteDomain(r.Context(), domain)
+	if err != nil {
+		db.Logger().Error("deleting site data", "domain", domain, "err", err)
+	}
+	db.Audit(ops.AuditSiteDelete, domain, "", "")
+	db.Success(fmt.Sprintf("%s was deleted, its stats are being removed", domain))
+	db.SaveSession(w)
 	http.Redirect(w, r, "/sites", http.StatusFound)
 }
 
@@ -123,6 +343,7 @@ func MakePublic(db *db.Config, w http.ResponseWriter, r *http.Request) {
 	site := db.CurrentSite()
 	site.Public = true
 	db.Ops().Save(site)
+	db.Audit(ops.AuditSitePublic, site.Domain, "", "")
 	http.Redirect(w, r, fmt.Sprintf("/%s/settings#visibility", url.PathEscape(site.Domain)), http.StatusFound)
 }
 
@@ -130,6 +351,7 @@ func MakePrivate(db *db.Config, w http.ResponseWriter, r *http.Request) {
 	site := db.CurrentSite()
 	site.Public = false
 	db.Ops().Save(site)
+	db.Audit(ops.AuditSitePrivate, site.Domain, "", "")
 	http.Redirect(w, r, fmt.Sprintf("/%s/settings#visibility", url.PathEscape(site.Domain)), http.StatusFound)
 }
 
@@ -149,32 +371,46 @@ func CreateSite(db *db.Config, w http.ResponseWriter, r *http.Request) {
 		return
 	}
 	db.Ops().CreateSite(domain, false)
+	db.Audit(ops.AuditSiteCreate, domain, "", "")
+	if !db.IsAdmin() {
+		err := db.Ops().SetRole(domain, db.CurrentUser(), ops.RoleOwner)
+		if err != nil {
+			db.Logger().Error("adding site owner", "domain", domain, "err", err)
+		}
+	}
 	to := fmt.Sprintf("/%s/snippet", url.PathEscape(domain))
 	http.Redirect(w, r, to, http.StatusFound)
 }
 
 func SitesIndex(db *db.Config, w http.ResponseWriter, r *http.Request) {
-	sites := make([]map[string]any, 0, 16)
-	db.Ops().Domains(func(s *v1.Site) {
-		sites = append(sites, map[string]any{
-			"domain": s.Domain,
-			"public": s.Public,
-			"locked": s.Locked,
-		})
-	})
+	sites := memberSites(db)
 	db.JSON(w, map[string]any{
 		"data": sites,
 	})
 }
-func Sites(db *db.Config, w http.ResponseWriter, r *http.Request) {
+
+// memberSites returns sites the current user has a role on.
+func memberSites(db *db.Config) []map[string]any {
 	sites := make([]map[string]any, 0, 16)
+	user := db.CurrentUser()
 	db.Ops().Domains(func(s *v1.Site) {
+		role := db.Ops().RoleOf(s, user)
+		if role == "" {
+			return
+		}
 		sites = append(sites, map[string]any{
 			"domain": s.Domain,
 			"public": s.Public,
 			"locked": s.Locked,
+			"role":   role,
+			"manage": ops.Allows(role, ops.RoleAdmin),
 		})
 	})
+	return sites
+}
+
+func Sites(db *db.Config, w http.ResponseWriter, r *http.Request) {
+	sites := memberSites(db)
 
 	for i := range sites {
 		// compute visitors
@@ -191,12 +427,40 @@ func Sites(db *db.Config, w http.ResponseWriter, r *http.Request) {
 	if len(sites) > 0 {
 		ctx["sites"] = sites
 	}
+	if ls := deletions(db); len(ls) > 0 {
+		ctx["deletions"] = ls
+	}
 	db.HTML(w, sitesIndex, ctx)
 }
 
+// deletions returns progress of site data purges visible to the current user.
+func deletions(db *db.Config) []map[string]any {
+	user := db.CurrentUser()
+	admin := db.IsAdmin()
+	var ls []map[string]any
+	for _, p := range db.Purges() {
+		if !admin && p.By != user {
+			continue
+		}
+		ls = append(ls, map[string]any{
+			"domain":  p.Domain,
+			"shard":   p.Shard,
+			"shards":  p.Shards,
+			"percent": p.Shard * 100 / max(p.Shards, 1),
+			"started": time.UnixMilli(int64(p.Started)).UTC().Format("Jan 2, 2006 15:04"),
+		})
+	}
+	return ls
+}
+
 func AddSnippet(db *db.Config, w http.ResponseWriter, r *http.Request) {
+	site := db.CurrentSite()
 	tracker := fmt.Sprintf("%s/js/script.js", oracle.Endpoint)
-	snippet := fmt.Sprintf(`<script defer data-domain=%q src=%q></script>`, db.CurrentSite().Domain, tracker)
+	var optOut string
+	if c := site.Exclusions.GetCookie(); c != "" {
+		optOut = fmt.Sprintf(" data-exclude-cookie=%q", c)
+	}
+	snippet := fmt.Sprintf(`<script defer data-domain=%q%s src=%q></script>`, site.Domain, optOut, tracker)
 
 	db.HTML(w, addSnippet, map[string]any{
 		"snippet": template.HTML(snippet),
@@ -214,7 +478,7 @@ func RequireSiteAccess(h plug.Handler) plug.Handler {
 			db.HTMLCode(http.StatusNotFound, w, e404, map[string]any{})
 			return
 		}
-		if db.CurrentUser() != "" || site.Public {
+		if u := db.CurrentUser(); (u != "" && db.Ops().RoleOf(site, u) != "") || site.Public {
 			db.SetSite(site)
 			h(db, w, r)
 			return
@@ -248,3 +512,24 @@ func RequireSiteAccess(h plug.Handler) plug.Handler {
 		db.HTMLCode(http.StatusNotFound, w, e404, map[string]any{})
 	}
 }
+
+// RequireSiteRole allows only members with at least role on the current site.
+// It must be used after RequireSiteAccess.
+func RequireSiteRole(role string) plug.Middleware {
+	return func(h plug.Handler) plug.Handler {
+		return func(db *db.Config, w http.ResponseWriter, r *http.Request) {
+			if !ops.Allows(db.Role(), role) {
+				db.HTMLCode(http.StatusNotFound, w, e404, map[string]any{})
+				return
+			}
+			h(db, w, r)
+		}
+	}
+}
+
+func goalName(g *v1.Goal) string {
+	if g.Path != "" {
+		return "Visit " + g.Path
+	}
+	return g.Name
+}
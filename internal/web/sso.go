@@ -0,0 +1,126 @@
+package web
+
+import (
+	"net/http"
+
+	v1 "github.com/vinceanalytics/vince/gen/go/vince/v1"
+	"github.com/vinceanalytics/vince/internal/oidc"
+	"github.com/vinceanalytics/vince/internal/ops"
+	"github.com/vinceanalytics/vince/internal/web/db"
+)
+
+func SSOLogin(db *db.Config, w http.ResponseWriter, r *http.Request) {
+	sso := db.SSO()
+	if sso == nil {
+		db.HTMLCode(http.StatusNotFound, w, e404, map[string]any{})
+		return
+	}
+	state, nonce, verifier := oidc.Random(), oidc.Random(), oidc.Random()
+	to, err := sso.AuthURL(r.Context(), state, nonce, verifier)
+	if err != nil {
+		db.Logger().Error("starting single sign-on", "err", err)
+		ssoFailed(db, w, "Single sign-on is currently unavailable. Please try again later.")
+		return
+	}
+	db.StartSSO(w, state, nonce, verifier)
+	http.Redirect(w, r, to, http.StatusFound)
+}
+
+func SSOCallback(db *db.Config, w http.ResponseWriter, r *http.Request) {
+	sso := db.SSO()
+	if sso == nil {
+		db.HTMLCode(http.StatusNotFound, w, e404, map[string]any{})
+		return
+	}
+	q := r.URL.Query()
+	if e := q.Get("error"); e != "" {
+		db.Logger().Error("identity provider rejected login", "error", e, "description", q.Get("error_description"))
+		ssoFailed(db, w, "Login was cancelled or rejected by the identity provider.")
+		return
+	}
+	pending := db.PendingSSO()
+	if pending == nil || q.Get("state") != pending.State {
+		db.Logger().Error("completing single sign-on", "err", oidc.ErrState)
+		ssoFailed(db, w, "Login session expired. Please try again.")
+		return
+	}
+	claims, err := sso.Exchange(r.Context(), q.Get("code"), pending.Verifier, pending.Nonce)
+	if err != nil {
+		db.Logger().Error("completing single sign-on", "err", err)
+		ssoFailed(db, w, "Failed to verify your identity. Please try again.")
+		return
+	}
+	name, err := sso.User(claims)
+	if err != nil {
+		ssoFailed(db, w, "Your account is not allowed to access this instance.")
+		return
+	}
+	err = db.Ops().EnsureUser(name)
+	if err != nil {
+		db.Logger().Error("creating single sign-on user", "name", name, "err", err)
+		db.HTML(w, e500, nil)
+		return
+	}
+	syncRoles(db, name, sso.Roles(claims))
+	if db.Ops().HasTwoFactor(name) {
+		// The provider only replaces the password, accounts with two-factor
+		// authentication must still provide a code.
+		if db.Ops().TwoFactorLocked(name) {
+			ssoFailed(db, w, twoFactorLocked)
+			return
+		}
+		db.LoginSSOPending(w, name, claims.Issuer, claims.Subject)
+		http.Redirect(w, r, "/login/2fa", http.StatusFound)
+		return
+	}
+	http.Redirect(w, r, db.LoginSSO(w, name, claims.Issuer, claims.Subject), http.StatusFound)
+}
+
+// syncRoles applies group role mappings for user. Group mappings are
+// authoritative for roles they granted: those are downgraded or revoked once
+// the user leaves the group. Roles given by other means are never downgraded.
+func syncRoles(db *db.Config, user string, roles []oidc.Mapping) {
+	want := make(map[string]string)
+	for _, m := range roles {
+		if m.Domain != "" {
+			want[m.Domain] = highest(want[m.Domain], m.Role)
+			continue
+		}
+		db.Ops().Domains(func(s *v1.Site) {
+			want[s.Domain] = highest(want[s.Domain], m.Role)
+		})
+	}
+	err := db.Ops().SyncRoles(user, want, func(domain, role string) {
+		e := &v1.AuditEntry{
+			Actor:   "sso",
+			Action:  ops.AuditMemberRole,
+			Site:    domain,
+			Target:  user,
+			Details: role,
+		}
+		if role == "" {
+			e.Action = ops.AuditMemberRemove
+		}
+		err := db.Ops().Audit(e)
+		if err != nil {
+			db.Logger().Error("auditing single sign-on role", "domain", domain, "user", user, "err", err)
+		}
+	})
+	if err != nil {
+		db.Logger().Error("syncing single sign-on roles", "user", user, "err", err)
+	}
+}
+
+func highest(a, b string) string {
+	if ops.Allows(a, b) {
+		return a
+	}
+	return b
+}
+
+func ssoFailed(db *db.Config, w http.ResponseWriter, msg string) {
+	db.SaveCsrf(w)
+	db.HTMLCode(http.StatusUnauthorized, w, login, map[string]any{
+		"error": msg,
+	})
+}
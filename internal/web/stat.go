@@ -4,10 +4,14 @@ import (
 	"math"
 	"net/http"
 	"slices"
+	"strconv"
 	"strings"
 
 	"github.com/vinceanalytics/vince/internal/api/aggregates"
 	"github.com/vinceanalytics/vince/internal/api/breakdown"
+	"github.com/vinceanalytics/vince/internal/api/cohort"
+	"github.com/vinceanalytics/vince/internal/api/funnel"
+	"github.com/vinceanalytics/vince/internal/api/paths"
 	"github.com/vinceanalytics/vince/internal/api/timeseries"
 	"github.com/vinceanalytics/vince/internal/api/visitors"
 	"github.com/vinceanalytics/vince/internal/models"
@@ -21,7 +25,7 @@ func UnimplementedStat(db *db.Config, w http.ResponseWriter, r *http.Request) {
 func MainGraph(db *db.Config, w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 	site := db.CurrentSite()
-	params := query.New(r.URL.Query())
+	params := query.New(r.URL.Query(), db.Timezone(site.Domain))
 	metric := params.Metric()
 
 	result, err := timeseries.Timeseries(ctx, db.TimeSeries(), site.Domain, params, []string{metric})
@@ -59,7 +63,7 @@ func MainGraph(db *db.Config, w http.ResponseWriter, r *http.Request) {
 func TopStats(db *db.Config, w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 	site := db.CurrentSite()
-	params := query.New(r.URL.Query())
+	params := query.New(r.URL.Query(), db.Timezone(site.Domain))
 
 	metrics := []string{"visitors", "visits", "pageviews", "views_per_visit", "bounce_rate", "visit_duration"}
 	stats := aggregates.Aggregates(
@@ -129,7 +133,7 @@ func CurrentVisitors(db *db.Config, w http.ResponseWriter, r *http.Request) {
 func Sources(db *db.Config, w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 	site := db.CurrentSite()
-	params := query.New(r.URL.Query())
+	params := query.New(r.URL.Query(), db.Timezone(site.Domain))
 	metrics := []string{"visitors"}
 	if r.URL.Query().Get("detailed") != "" {
 		metrics = append(metrics, "bounce_rate", "visit_duration")
@@ -150,7 +154,7 @@ func Sources(db *db.Config, w http.ResponseWriter, r *http.Request) {
 func UtmMediums(db *db.Config, w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 	site := db.CurrentSite()
-	params := query.New(r.URL.Query())
+	params := query.New(r.URL.Query(), db.Timezone(site.Domain))
 	metrics := []string{"visitors"}
 	if r.URL.Query().Get("detailed") != "" {
 		metrics = append(metrics, "bounce_rate", "visit_duration")
@@ -171,7 +175,7 @@ func UtmMediums(db *db.Config, w http.ResponseWriter, r *http.Request) {
 func UtmCampaigns(db *db.Config, w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 	site := db.CurrentSite()
-	params := query.New(r.URL.Query())
+	params := query.New(r.URL.Query(), db.Timezone(site.Domain))
 	metrics := []string{"visitors"}
 	if r.URL.Query().Get("detailed") != "" {
 		metrics = append(metrics, "bounce_rate", "visit_duration")
@@ -192,7 +196,7 @@ func UtmCampaigns(db *db.Config, w http.ResponseWriter, r *http.Request) {
 func UtmContents(db *db.Config, w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 	site := db.CurrentSite()
-	params := query.New(r.URL.Query())
+	params := query.New(r.URL.Query(), db.Timezone(site.Domain))
 	metrics := []string{"visitors"}
 	if r.URL.Query().Get("detailed") != "" {
 		metrics = append(metrics, "bounce_rate", "visit_duration")
@@ -213,7 +217,7 @@ func UtmContents(db *db.Config, w http.ResponseWriter, r *http.Request) {
 func UtmTerms(db *db.Config, w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 	site := db.CurrentSite()
-	params := query.New(r.URL.Query())
+	params := query.New(r.URL.Query(), db.Timezone(site.Domain))
 	metrics := []string{"visitors"}
 	if r.URL.Query().Get("detailed") != "" {
 		metrics = append(metrics, "bounce_rate", "visit_duration")
@@ -234,7 +238,7 @@ func UtmTerms(db *db.Config, w http.ResponseWriter, r *http.Request) {
 func UtmSources(db *db.Config, w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 	site := db.CurrentSite()
-	params := query.New(r.URL.Query())
+	params := query.New(r.URL.Query(), db.Timezone(site.Domain))
 	metrics := []string{"visitors"}
 	if r.URL.Query().Get("detailed") != "" {
 		metrics = append(metrics, "bounce_rate", "visit_duration")
@@ -255,7 +259,7 @@ func UtmSources(db *db.Config, w http.ResponseWriter, r *http.Request) {
 func Referrer(db *db.Config, w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 	site := db.CurrentSite()
-	params := query.New(r.URL.Query())
+	params := query.New(r.URL.Query(), db.Timezone(site.Domain))
 	referrer := r.PathValue("referrer")
 	if referrer == "Google" {
 		db.JSONCode(http.StatusUnprocessableEntity, w, map[string]any{
@@ -292,7 +296,7 @@ func Referrer(db *db.Config, w http.ResponseWriter, r *http.Request) {
 func Pages(db *db.Config, w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 	site := db.CurrentSite()
-	params := query.New(r.URL.Query())
+	params := query.New(r.URL.Query(), db.Timezone(site.Domain))
 	metrics := []string{"visitors"}
 	if r.URL.Query().Get("detailed") != "" {
 		metrics = append(metrics, "pageviews", "bounce_rate")
@@ -313,7 +317,7 @@ func Pages(db *db.Config, w http.ResponseWriter, r *http.Request) {
 func EntryPages(db *db.Config, w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 	site := db.CurrentSite()
-	params := query.New(r.URL.Query())
+	params := query.New(r.URL.Query(), db.Timezone(site.Domain))
 	o, err := breakdown.Breakdown(ctx, db.TimeSeries(),
 		site.Domain,
 		params,
@@ -335,7 +339,7 @@ func EntryPages(db *db.Config, w http.ResponseWriter, r *http.Request) {
 func ExitPages(db *db.Config, w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 	site := db.CurrentSite()
-	params := query.New(r.URL.Query())
+	params := query.New(r.URL.Query(), db.Timezone(site.Domain))
 	o, err := breakdown.BreakdownExitPages(ctx, db.TimeSeries(), site.Domain, params)
 	if err != nil {
 		db.Logger().Error("breaking down", "err", err)
@@ -344,10 +348,28 @@ func ExitPages(db *db.Config, w http.ResponseWriter, r *http.Request) {
 	db.JSON(w, o)
 }
 
+func CustomPropValues(db *db.Config, w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	site := db.CurrentSite()
+	params := query.New(r.URL.Query(), db.Timezone(site.Domain))
+	key := r.PathValue("prop_key")
+	o, err := breakdown.BreakdownProps(ctx, db.TimeSeries(), site.Domain, params, []string{"visitors", "events"}, key)
+	if err != nil {
+		db.Logger().Error("breaking down", "err", err)
+		o = &breakdown.Result{}
+	}
+	for i := range o.Results {
+		m := o.Results[i]
+		m["name"] = m[key]
+		delete(m, key)
+	}
+	db.JSON(w, o)
+}
+
 func Countries(db *db.Config, w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 	site := db.CurrentSite()
-	params := query.New(r.URL.Query())
+	params := query.New(r.URL.Query(), db.Timezone(site.Domain))
 	o, err := breakdown.Breakdown(ctx, db.TimeSeries(), site.Domain, params, []string{"visitors"}, models.Field_country)
 	if err != nil {
 		db.Logger().Error("breaking down", "err", err)
@@ -369,7 +391,7 @@ func Countries(db *db.Config, w http.ResponseWriter, r *http.Request) {
 func Regions(db *db.Config, w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 	site := db.CurrentSite()
-	params := query.New(r.URL.Query())
+	params := query.New(r.URL.Query(), db.Timezone(site.Domain))
 	o, err := breakdown.Breakdown(ctx, db.TimeSeries(), site.Domain, params, []string{"visitors"}, models.Field_subdivision1_code)
 	if err != nil {
 		db.Logger().Error("breaking down", "err", err)
@@ -390,7 +412,7 @@ func Regions(db *db.Config, w http.ResponseWriter, r *http.Request) {
 func Cities(db *db.Config, w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 	site := db.CurrentSite()
-	params := query.New(r.URL.Query())
+	params := query.New(r.URL.Query(), db.Timezone(site.Domain))
 	o, err := breakdown.BreakdownCity(ctx, db.TimeSeries(), site.Domain, params, []string{"visitors"})
 	if err != nil {
 		db.Logger().Error("breaking down", "err", err)
@@ -402,7 +424,7 @@ func Cities(db *db.Config, w http.ResponseWriter, r *http.Request) {
 func Browsers(db *db.Config, w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 	site := db.CurrentSite()
-	params := query.New(r.URL.Query())
+	params := query.New(r.URL.Query(), db.Timezone(site.Domain))
 	o, err := breakdown.BreakdownVisitorsWithPercentage(ctx, db.TimeSeries(), site.Domain, params, models.Field_browser)
 	if err != nil {
 		db.Logger().Error("breaking down", "err", err)
@@ -419,7 +441,7 @@ func Browsers(db *db.Config, w http.ResponseWriter, r *http.Request) {
 func BrowserVersions(db *db.Config, w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 	site := db.CurrentSite()
-	params := query.New(r.URL.Query())
+	params := query.New(r.URL.Query(), db.Timezone(site.Domain))
 	o, err := breakdown.BreakdownVisitorsWithPercentage(ctx, db.TimeSeries(), site.Domain, params, models.Field_browser_version)
 	if err != nil {
 		db.Logger().Error("breaking down", "err", err)
@@ -436,7 +458,7 @@ func BrowserVersions(db *db.Config, w http.ResponseWriter, r *http.Request) {
 func Os(db *db.Config, w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 	site := db.CurrentSite()
-	params := query.New(r.URL.Query())
+	params := query.New(r.URL.Query(), db.Timezone(site.Domain))
 	o, err := breakdown.BreakdownVisitorsWithPercentage(ctx, db.TimeSeries(), site.Domain, params, models.Field_os)
 	if err != nil {
 		db.Logger().Error("breaking down", "err", err)
@@ -453,7 +475,7 @@ func Os(db *db.Config, w http.ResponseWriter, r *http.Request) {
 func OsVersion(db *db.Config, w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 	site := db.CurrentSite()
-	params := query.New(r.URL.Query())
+	params := query.New(r.URL.Query(), db.Timezone(site.Domain))
 	o, err := breakdown.BreakdownVisitorsWithPercentage(ctx, db.TimeSeries(), site.Domain, params, models.Field_os_version)
 	if err != nil {
 		db.Logger().Error("breaking down", "err", err)
@@ -470,7 +492,7 @@ func OsVersion(db *db.Config, w http.ResponseWriter, r *http.Request) {
 func ScreenSize(db *db.Config, w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 	site := db.CurrentSite()
-	params := query.New(r.URL.Query())
+	params := query.New(r.URL.Query(), db.Timezone(site.Domain))
 	o, err := breakdown.BreakdownVisitorsWithPercentage(ctx, db.TimeSeries(), site.Domain, params, models.Field_device)
 	if err != nil {
 		db.Logger().Error("breaking down", "err", err)
@@ -483,3 +505,49 @@ func ScreenSize(db *db.Config, w http.ResponseWriter, r *http.Request) {
 	}
 	db.JSON(w, o)
 }
+
+// Funnel computes the site funnel at index id in the path.
+func Funnel(db *db.Config, w http.ResponseWriter, r *http.Request) {
+	site := db.CurrentSite()
+	idx, err := strconv.Atoi(r.PathValue("id"))
+	if err != nil || idx < 0 || idx >= len(site.Funnels) {
+		http.Error(w, http.StatusText(http.StatusNotFound), http.StatusNotFound)
+		return
+	}
+	params := query.New(r.URL.Query(), db.Timezone(site.Domain))
+	o, err := funnel.Funnel(r.Context(), db.TimeSeries(), site.Domain, params, site.Funnels[idx])
+	if err != nil {
+		db.Logger().Error("computing funnel", "err", err)
+		http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+		return
+	}
+	db.JSON(w, o)
+}
+
+func Retention(db *db.Config, w http.ResponseWriter, r *http.Request) {
+	site := db.CurrentSite()
+	params := query.New(r.URL.Query(), db.Timezone(site.Domain))
+	o, err := cohort.Cohorts(r.Context(), db.TimeSeries(), site.Domain, params)
+	if err != nil {
+		db.Logger().Error("computing cohorts", "err", err)
+		o = &cohort.Result{}
+	}
+	db.JSON(w, o)
+}
+
+func Paths(db *db.Config, w http.ResponseWriter, r *http.Request) {
+	site := db.CurrentSite()
+	target, steps, ok := paths.Parse(r.URL.Query())
+	if !ok {
+		http.Error(w, "missing page or entry_page", http.StatusBadRequest)
+		return
+	}
+	params := query.New(r.URL.Query(), db.Timezone(site.Domain))
+	o, err := paths.Explore(r.Context(), db.TimeSeries(), site.Domain, params, target, steps)
+	if err != nil {
+		db.Logger().Error("exploring paths", "err", err)
+		http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+		return
+	}
+	db.JSON(w, o)
+}
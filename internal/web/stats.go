@@ -1,9 +1,13 @@
 package web
 
 import (
+	"fmt"
 	"net/http"
+	"net/url"
 
+	"github.com/vinceanalytics/vince/internal/api/cohort"
 	"github.com/vinceanalytics/vince/internal/web/db"
+	"github.com/vinceanalytics/vince/internal/web/query"
 )
 
 func Stats(db *db.Config, w http.ResponseWriter, r *http.Request) {
@@ -19,3 +23,63 @@ func Stats(db *db.Config, w http.ResponseWriter, r *http.Request) {
 		"title":            "vince · " + site.Domain,
 	})
 }
+
+// RetentionReport renders the cohort retention matrix. It accepts the same
+// query parameters as the dashboard, defaulting to weekly cohorts of the last
+// six months.
+func RetentionReport(db *db.Config, w http.ResponseWriter, r *http.Request) {
+	site := db.CurrentSite()
+	q := r.URL.Query()
+	if q.Get("period") == "" {
+		q.Set("period", "6mo")
+	}
+	if q.Get("interval") != "month" {
+		q.Set("interval", "week")
+	}
+	params := query.New(q, db.Timezone(site.Domain))
+	rs, err := cohort.Cohorts(r.Context(), db.TimeSeries(), site.Domain, params)
+	if err != nil {
+		db.Logger().Error("computing cohorts", "err", err)
+		rs = &cohort.Result{Interval: q.Get("interval")}
+	}
+	link := func(key, value string) map[string]any {
+		o := url.Values{}
+		for k, v := range q {
+			o[k] = v
+		}
+		o.Set(key, value)
+		return map[string]any{
+			"name":   value,
+			"href":   fmt.Sprintf("/%s/retention?%s", url.PathEscape(site.Domain), o.Encode()),
+			"active": q.Get(key) == value,
+		}
+	}
+	rows := make([]map[string]any, 0, len(rs.Cohorts))
+	for _, c := range rs.Cohorts {
+		cells := make([]map[string]any, 0, len(c.Retention))
+		for _, p := range c.Retention {
+			cells = append(cells, map[string]any{
+				"visitors":   p.Visitors,
+				"percentage": p.Percentage,
+				"alpha":      fmt.Sprintf("%.2f", p.Percentage/100),
+			})
+		}
+		rows = append(rows, map[string]any{
+			"date":     c.Date,
+			"visitors": c.Visitors,
+			"cells":    cells,
+		})
+	}
+	columns := make([]int, len(rs.Cohorts))
+	for i := range columns {
+		columns[i] = i
+	}
+	db.HTML(w, retentionReport, map[string]any{
+		"title":     "vince · retention · " + site.Domain,
+		"interval":  rs.Interval,
+		"columns":   columns,
+		"rows":      rows,
+		"periods":   []any{link("period", "30d"), link("period", "6mo"), link("period", "12mo"), link("period", "all")},
+		"intervals": []any{link("interval", "week"), link("interval", "month")},
+	})
+}
@@ -14,20 +14,28 @@ var (
 	layouts = template.Must(template.ParseFS(
 		templateData, "templates/layout/*",
 	)).Funcs(funcMap())
-	home          = template.Must(look("focus").ParseFS(templateData, "templates/page/index.html"))
-	login         = template.Must(look("focus").ParseFS(templateData, "templates/auth/login.html"))
-	statsLocked   = template.Must(look("focus").ParseFS(templateData, "templates/stats/site_locked.html"))
-	sharePassword = template.Must(look("focus").ParseFS(templateData, "templates/stats/shared_link_password.html"))
-	stats         = template.Must(look("app").ParseFS(templateData, "templates/stats/stats.html"))
-	createSite    = template.Must(look("focus").ParseFS(templateData, "templates/site/new.html"))
-	shared        = template.Must(look("focus").ParseFS(templateData, "templates/site/new_shared_link.html"))
-	edit          = template.Must(look("focus").ParseFS(templateData, "templates/site/edit_shared_link.html"))
-	sitesIndex    = template.Must(look("app").ParseFS(templateData, "templates/site/index.html"))
-	siteSettings  = template.Must(look("app").ParseFS(templateData, "templates/site/settings.html"))
-	userSettings  = template.Must(look("app").ParseFS(templateData, "templates/auth/settings.html"))
-	newAPIKey     = template.Must(look("focus").ParseFS(templateData, "templates/auth/new_api_key.html"))
-	addSnippet    = template.Must(look("focus").ParseFS(templateData, "templates/site/snippet.html"))
-	newGoal       = template.Must(look("focus").ParseFS(templateData, "templates/site/new_goal.html"))
+	home             = template.Must(look("focus").ParseFS(templateData, "templates/page/index.html"))
+	login            = template.Must(look("focus").ParseFS(templateData, "templates/auth/login.html"))
+	statsLocked      = template.Must(look("focus").ParseFS(templateData, "templates/stats/site_locked.html"))
+	sharePassword    = template.Must(look("focus").ParseFS(templateData, "templates/stats/shared_link_password.html"))
+	stats            = template.Must(look("app").ParseFS(templateData, "templates/stats/stats.html"))
+	retentionReport  = template.Must(look("app").ParseFS(templateData, "templates/stats/retention.html"))
+	createSite       = template.Must(look("focus").ParseFS(templateData, "templates/site/new.html"))
+	shared           = template.Must(look("focus").ParseFS(templateData, "templates/site/new_shared_link.html"))
+	edit             = template.Must(look("focus").ParseFS(templateData, "templates/site/edit_shared_link.html"))
+	sitesIndex       = template.Must(look("app").ParseFS(templateData, "templates/site/index.html"))
+	siteSettings     = template.Must(look("app").ParseFS(templateData, "templates/site/settings.html"))
+	userSettings     = template.Must(look("app").ParseFS(templateData, "templates/auth/settings.html"))
+	newAPIKey        = template.Must(look("focus").ParseFS(templateData, "templates/auth/new_api_key.html"))
+	newUser          = template.Must(look("focus").ParseFS(templateData, "templates/auth/new_user.html"))
+	acceptInvitation = template.Must(look("focus").ParseFS(templateData, "templates/auth/invitation.html"))
+	twoFactorLogin   = template.Must(look("focus").ParseFS(templateData, "templates/auth/two_factor_login.html"))
+	twoFactorSetup   = template.Must(look("focus").ParseFS(templateData, "templates/auth/two_factor_setup.html"))
+	recoveryCodes    = template.Must(look("focus").ParseFS(templateData, "templates/auth/recovery_codes.html"))
+	auditLog         = template.Must(look("app").ParseFS(templateData, "templates/auth/audit.html"))
+	addSnippet       = template.Must(look("focus").ParseFS(templateData, "templates/site/snippet.html"))
+	newGoal          = template.Must(look("focus").ParseFS(templateData, "templates/site/new_goal.html"))
+	newFunnel        = template.Must(look("focus").ParseFS(templateData, "templates/site/new_funnel.html"))
 
 	e404 = template.Must(look("focus").ParseFS(templateData, "templates/error/404.html"))
 	e500 = template.Must(look("focus").ParseFS(templateData, "templates/error/500.html"))
@@ -0,0 +1,79 @@
+package web
+
+import (
+	"bytes"
+	"encoding/base64"
+	"errors"
+	"html/template"
+	"image/png"
+	"net/http"
+
+	"github.com/pquerna/otp"
+	"github.com/vinceanalytics/vince/internal/ops"
+	"github.com/vinceanalytics/vince/internal/web/db"
+)
+
+func TwoFactorSetup(db *db.Config, w http.ResponseWriter, r *http.Request) {
+	key, err := db.Ops().SetupTwoFactor(db.CurrentUser())
+	if err != nil {
+		db.Fail(err.Error())
+		db.SaveSession(w)
+		http.Redirect(w, r, "/settings#two-factor", http.StatusFound)
+		return
+	}
+	renderSetup(db, w, key, "")
+}
+
+func EnableTwoFactor(db *db.Config, w http.ResponseWriter, r *http.Request) {
+	codes, err := db.Ops().EnableTwoFactor(db.CurrentUser(), r.FormValue("code"))
+	if err != nil {
+		key, kerr := db.Ops().SetupTwoFactor(db.CurrentUser())
+		if kerr != nil {
+			db.Fail(kerr.Error())
+			db.SaveSession(w)
+			http.Redirect(w, r, "/settings#two-factor", http.StatusFound)
+			return
+		}
+		db.SaveCsrf(w)
+		renderSetup(db, w, key, err.Error())
+		return
+	}
+	db.Audit(ops.AuditTwoFactorEnable, "", db.CurrentUser(), "")
+	db.HTML(w, recoveryCodes, map[string]any{
+		"codes": codes,
+	})
+}
+
+func DisableTwoFactor(db *db.Config, w http.ResponseWriter, r *http.Request) {
+	user := db.CurrentUser()
+	if err := db.Ops().VerifyTwoFactor(user, r.FormValue("code")); errors.Is(err, ops.ErrTwoFactorLocked) {
+		db.Fail(twoFactorLocked)
+	} else if err != nil {
+		db.Fail("Invalid code. Two-factor authentication is still enabled")
+	} else if err := db.Ops().DisableTwoFactor(user); err != nil {
+		db.Logger().Error("disabling two-factor authentication", "user", user, "err", err)
+		db.Fail("Failed to disable two-factor authentication")
+	} else {
+		db.Audit(ops.AuditTwoFactorDisable, "", user, "")
+		db.Success("Two-factor authentication disabled")
+	}
+	db.SaveSession(w)
+	http.Redirect(w, r, "/settings#two-factor", http.StatusFound)
+}
+
+func renderSetup(db *db.Config, w http.ResponseWriter, key *otp.Key, msg string) {
+	img, err := key.Image(200, 200)
+	if err != nil {
+		db.Logger().Error("rendering two-factor qr code", "err", err)
+		db.HTML(w, e500, nil)
+		return
+	}
+	var b bytes.Buffer
+	png.Encode(&b, img)
+	qr := "data:image/png;base64," + base64.StdEncoding.EncodeToString(b.Bytes())
+	db.HTML(w, twoFactorSetup, map[string]any{
+		"qr":     template.URL(qr),
+		"secret": key.Secret(),
+		"error":  msg,
+	})
+}
@@ -75,6 +75,10 @@
     {{/if}}
     payload.d = scriptEl.getAttribute('data-domain')
     payload.r = document.referrer || null
+    var excludeCookie = scriptEl.getAttribute('data-exclude-cookie')
+    if (excludeCookie && document.cookie.split(';').some(function(c) { return c.trim().split('=')[0] === excludeCookie })) {
+      payload.c = excludeCookie
+    }
     if (options && options.meta) {
       payload.m = JSON.stringify(options.meta)
     }
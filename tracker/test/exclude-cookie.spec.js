@@ -0,0 +1,22 @@
+const { mockRequest } = require('./support/test-utils')
+const { expect, test } = require('@playwright/test');
+
+test.describe('data-exclude-cookie', () => {
+  test('reports the opt out cookie when it is set', async ({ page, context }) => {
+    await context.addCookies([{ name: 'vince_ignore', value: 'true', url: 'http://localhost:3000' }])
+    const plausibleRequestMock = mockRequest(page, '/api/event')
+    await page.goto('/exclude-cookie.html');
+
+    const plausibleRequest = await plausibleRequestMock;
+    expect(plausibleRequest.postDataJSON().c).toEqual('vince_ignore')
+  });
+
+  test('does not report other cookies', async ({ page, context }) => {
+    await context.addCookies([{ name: 'vince_ignored', value: 'true', url: 'http://localhost:3000' }])
+    const plausibleRequestMock = mockRequest(page, '/api/event')
+    await page.goto('/exclude-cookie.html');
+
+    const plausibleRequest = await plausibleRequestMock;
+    expect(plausibleRequest.postDataJSON().c).toBeUndefined()
+  });
+});